@@ -0,0 +1,246 @@
+// Package address encodes, decodes and inspects Radix addresses in pure Go.
+//
+// It implements the subset of radix_engine_toolkit_uniffi.Address which does
+// not need the native library: parsing and validating bech32m addresses of
+// every entity type and network, encoding node ids and the entity type
+// predicates. Its errors carry the same information as the native errors; the
+// radix_engine_toolkit_uniffi package converts them into their
+// RadixEngineToolkitError counterparts.
+package address
+
+import (
+	"fmt"
+	"slices"
+)
+
+// NodeIdLength is the length of the node id of an entity, the payload of its
+// address.
+const NodeIdLength = 30
+
+// FailedToExtractNetworkError reports an address whose hrp names no network.
+type FailedToExtractNetworkError struct {
+	Address string
+}
+
+func (err FailedToExtractNetworkError) Error() string {
+	return fmt.Sprint("FailedToExtractNetwork",
+		": ",
+
+		"Address=",
+		err.Address,
+	)
+}
+
+// InvalidLengthError reports a node id of the wrong length.
+type InvalidLengthError struct {
+	Expected uint64
+	Actual   uint64
+	Data     []byte
+}
+
+func (err InvalidLengthError) Error() string {
+	return fmt.Sprint("InvalidLength",
+		": ",
+
+		"Expected=",
+		err.Expected,
+		", ",
+		"Actual=",
+		err.Actual,
+		", ",
+		"Data=",
+		err.Data,
+	)
+}
+
+// EntityTypeMismatchError reports an address of an entity type other than
+// the expected ones. Actual is nil when the node id has no known entity type.
+type EntityTypeMismatchError struct {
+	Expected []EntityType
+	Actual   *EntityType
+}
+
+func (err EntityTypeMismatchError) Error() string {
+	return fmt.Sprint("EntityTypeMismatchError",
+		": ",
+
+		"Expected=",
+		err.Expected,
+		", ",
+		"Actual=",
+		err.Actual,
+	)
+}
+
+// Address is the address of an entity on a network.
+type Address struct {
+	nodeId    [NodeIdLength]byte
+	networkId uint8
+}
+
+// New parses and validates a bech32m encoded address, like
+// radix_engine_toolkit_uniffi.NewAddress.
+func New(address string) (Address, error) {
+	networkId, ok := NetworkIdFromBech32(address)
+	if !ok {
+		return Address{}, &FailedToExtractNetworkError{Address: address}
+	}
+	hrp, data, err := Bech32mDecode(address)
+	if err != nil {
+		return Address{}, err
+	}
+	if len(data) == 0 {
+		return Address{}, &Bech32DecodeError{Error_: "MissingEntityTypeByte"}
+	}
+	entityType, ok := EntityTypeFromByte(data[0])
+	if !ok {
+		return Address{}, &Bech32DecodeError{Error_: fmt.Sprintf("InvalidEntityTypeId(%d)", data[0])}
+	}
+	if hrp != Hrp(entityType, networkId) {
+		return Address{}, &Bech32DecodeError{Error_: "InvalidHrp"}
+	}
+	return FromRaw(data, networkId)
+}
+
+// NewOfType parses an address like New, additionally requiring it to be of
+// one of the expected entity types.
+func NewOfType(address string, expected ...EntityType) (Address, error) {
+	decoded, err := New(address)
+	if err != nil {
+		return Address{}, err
+	}
+	if entityType := decoded.EntityType(); !slices.Contains(expected, entityType) {
+		return Address{}, &EntityTypeMismatchError{Expected: expected, Actual: &entityType}
+	}
+	return decoded, nil
+}
+
+// FromRaw returns the address of a node id on a network, like
+// radix_engine_toolkit_uniffi.AddressFromRaw. Unlike the native function it
+// rejects node ids of no known entity type, which have no address string,
+// with an EntityTypeMismatchError whose Actual is nil.
+func FromRaw(nodeIdBytes []byte, networkId uint8) (Address, error) {
+	if len(nodeIdBytes) != NodeIdLength {
+		return Address{}, &InvalidLengthError{
+			Expected: NodeIdLength,
+			Actual:   uint64(len(nodeIdBytes)),
+			Data:     nodeIdBytes,
+		}
+	}
+	if _, ok := EntityTypeFromByte(nodeIdBytes[0]); !ok {
+		return Address{}, &EntityTypeMismatchError{Expected: allEntityTypes()}
+	}
+	address := Address{networkId: networkId}
+	copy(address.nodeId[:], nodeIdBytes)
+	return address, nil
+}
+
+func allEntityTypes() []EntityType {
+	all := make([]EntityType, 0, len(entityTypes))
+	for entityType := EntityTypeGlobalPackage; entityType <= EntityTypeInternalKeyValueStore; entityType++ {
+		all = append(all, entityType)
+	}
+	return all
+}
+
+// Hrp returns the hrp of the addresses of an entity type on a network, e.g.
+// "account_rdx" or "internal_vault_tdx_2_".
+func Hrp(entityType EntityType, networkId uint8) string {
+	return entityType.HrpPrefix() + "_" + NetworkHrpSuffix(networkId)
+}
+
+// AddressString returns the bech32m encoding of the address.
+func (address Address) AddressString() string {
+	encoded, err := Bech32mEncode(Hrp(address.EntityType(), address.networkId), address.nodeId[:])
+	if err != nil {
+		panic(err)
+	}
+	return encoded
+}
+
+func (address Address) AsStr() string {
+	return address.AddressString()
+}
+
+func (address Address) String() string {
+	return address.AddressString()
+}
+
+// Bytes returns the node id of the address.
+func (address Address) Bytes() []byte {
+	return slices.Clone(address.nodeId[:])
+}
+
+func (address Address) NetworkId() uint8 {
+	return address.networkId
+}
+
+func (address Address) EntityType() EntityType {
+	entityType, _ := EntityTypeFromByte(address.nodeId[0])
+	return entityType
+}
+
+func (address Address) IsGlobal() bool {
+	return address.EntityType().IsGlobal()
+}
+
+func (address Address) IsGlobalComponent() bool {
+	return address.EntityType().IsGlobalComponent()
+}
+
+func (address Address) IsGlobalConsensusManager() bool {
+	return address.EntityType().IsGlobalConsensusManager()
+}
+
+func (address Address) IsGlobalFungibleResourceManager() bool {
+	return address.EntityType().IsGlobalFungibleResourceManager()
+}
+
+func (address Address) IsGlobalNonFungibleResourceManager() bool {
+	return address.EntityType().IsGlobalNonFungibleResourceManager()
+}
+
+func (address Address) IsGlobalPackage() bool {
+	return address.EntityType().IsGlobalPackage()
+}
+
+func (address Address) IsGlobalPreallocated() bool {
+	return address.EntityType().IsGlobalPreallocated()
+}
+
+func (address Address) IsGlobalResourceManager() bool {
+	return address.EntityType().IsGlobalResourceManager()
+}
+
+func (address Address) IsInternal() bool {
+	return address.EntityType().IsInternal()
+}
+
+func (address Address) IsInternalFungibleVault() bool {
+	return address.EntityType().IsInternalFungibleVault()
+}
+
+func (address Address) IsInternalKvStore() bool {
+	return address.EntityType().IsInternalKvStore()
+}
+
+func (address Address) IsInternalNonFungibleVault() bool {
+	return address.EntityType().IsInternalNonFungibleVault()
+}
+
+func (address Address) IsInternalVault() bool {
+	return address.EntityType().IsInternalVault()
+}
+
+func (address Address) MarshalText() ([]byte, error) {
+	return []byte(address.AddressString()), nil
+}
+
+func (address *Address) UnmarshalText(text []byte) error {
+	decoded, err := New(string(text))
+	if err != nil {
+		return err
+	}
+	*address = decoded
+	return nil
+}
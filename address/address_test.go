@@ -0,0 +1,125 @@
+package address
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		address    string
+		entityType EntityType
+		networkId  uint8
+	}{
+		{"resource_rdx1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxradxrd", EntityTypeGlobalFungibleResourceManager, 0x01},
+		{"resource_tdx_2_1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxtfd2jc", EntityTypeGlobalFungibleResourceManager, 0x02},
+		{"resource_sim1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxakj8n3", EntityTypeGlobalFungibleResourceManager, 0xf2},
+		{"consensusmanager_rdx1scxxxxxxxxxxcnsmgrxxxxxxxxx000999665565xxxxxxxxxcnsmgr", EntityTypeGlobalConsensusManager, 0x01},
+		{"package_rdx1pkgxxxxxxxxxaccntxxxxxxxxxx000929625493xxxxxxxxxaccntx", EntityTypeGlobalPackage, 0x01},
+		{"package_rdx1pkgxxxxxxxxxresrcexxxxxxxxx000538436477xxxxxxxxxresrce", EntityTypeGlobalPackage, 0x01},
+		{"component_rdx1cptxxxxxxxxxfaucetxxxxxxxxx000527798379xxxxxxxxxfaucet", EntityTypeGlobalGenericComponent, 0x01},
+		{"account_rdx128dtethfy8ujrsfdztemyjk0kvhnah6dafr57frz85dcw2c8z0td87", EntityTypeGlobalPreallocatedEd25519Account, 0x01},
+	}
+	for _, test := range tests {
+		address, err := New(test.address)
+		if err != nil {
+			t.Errorf("New(%q): %v", test.address, err)
+			continue
+		}
+		if address.EntityType() != test.entityType || address.NetworkId() != test.networkId {
+			t.Errorf("New(%q) = %v on network %d, want %v on network %d", test.address, address.EntityType(), address.NetworkId(), test.entityType, test.networkId)
+		}
+		if address.String() != test.address {
+			t.Errorf("New(%q).String() = %q", test.address, address.String())
+		}
+		raw, err := FromRaw(address.Bytes(), address.NetworkId())
+		if err != nil || raw != address {
+			t.Errorf("FromRaw(New(%q).Bytes()) = %v, %v", test.address, raw, err)
+		}
+	}
+
+	xrd, err := New("resource_rdx1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxradxrd")
+	if err != nil || hex.EncodeToString(xrd.Bytes()) != "5da66318c6318c61f5a61b4c6318c6318cf794aa8d295f14e6318c6318c6" {
+		t.Errorf("node id of XRD = %x, %v", xrd.Bytes(), err)
+	}
+	stokenetXrd, err := FromRaw(xrd.Bytes(), 0x02)
+	if err != nil || stokenetXrd.String() != "resource_tdx_2_1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxtfd2jc" {
+		t.Errorf("XRD on the stokenet = %v, %v", stokenetXrd, err)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	var networkError *FailedToExtractNetworkError
+	if _, err := New("abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx"); !errors.As(err, &networkError) {
+		t.Errorf("New of a non-Radix hrp = %v, want a FailedToExtractNetworkError", err)
+	}
+
+	for _, invalid := range []struct {
+		address, reason string
+	}{
+		// the checksum of the last character changed
+		{"resource_rdx1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxradxrq", "Bech32mDecodingError(InvalidChecksum)"},
+		// an XRD node id under an account hrp
+		{"account_rdx1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxradxrd", "Bech32mDecodingError(InvalidChecksum)"},
+	} {
+		var decodeError *Bech32DecodeError
+		if _, err := New(invalid.address); !errors.As(err, &decodeError) || decodeError.Error_ != invalid.reason {
+			t.Errorf("New(%q) = %v, want %s", invalid.address, err, invalid.reason)
+		}
+	}
+
+	xrd, err := New("resource_rdx1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxradxrd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	misnamed, err := Bech32mEncode("account_rdx", xrd.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decodeError *Bech32DecodeError
+	if _, err := New(misnamed); !errors.As(err, &decodeError) || decodeError.Error_ != "InvalidHrp" {
+		t.Errorf("New of a resource node id under an account hrp = %v, want InvalidHrp", err)
+	}
+
+	var mismatch *EntityTypeMismatchError
+	if _, err := NewOfType(xrd.String(), EntityTypeGlobalAccount); !errors.As(err, &mismatch) || *mismatch.Actual != EntityTypeGlobalFungibleResourceManager {
+		t.Errorf("NewOfType(XRD, GlobalAccount) = %v, want an EntityTypeMismatchError", err)
+	}
+	var length *InvalidLengthError
+	if _, err := FromRaw(xrd.Bytes()[:29], 0x01); !errors.As(err, &length) || length.Actual != 29 {
+		t.Errorf("FromRaw of 29 bytes = %v, want an InvalidLengthError", err)
+	}
+	if _, err := FromRaw(make([]byte, NodeIdLength), 0x01); !errors.As(err, &mismatch) || mismatch.Actual != nil {
+		t.Errorf("FromRaw of a zero node id = %v, want an EntityTypeMismatchError", err)
+	}
+}
+
+func TestNetworkIdFromHrp(t *testing.T) {
+	tests := []struct {
+		hrp       string
+		networkId uint8
+		ok        bool
+	}{
+		{"account_rdx", 0x01, true},
+		{"account_tdx_2_", 0x02, true},
+		{"txid_tdx_22_", 0x22, true},
+		{"resource_loc", 0xf0, true},
+		{"resource_sim", 0xf2, true},
+		{"resource_tdx_02_", 0, false},
+		{"resource_tdx_2", 0, false},
+		{"rdx", 0, false},
+		{"abcdef", 0, false},
+	}
+	for _, test := range tests {
+		networkId, ok := NetworkIdFromHrp(test.hrp)
+		if networkId != test.networkId || ok != test.ok {
+			t.Errorf("NetworkIdFromHrp(%q) = %d, %v, want %d, %v", test.hrp, networkId, ok, test.networkId, test.ok)
+		}
+	}
+	for networkId, suffix := range map[uint8]string{0x01: "rdx", 0x02: "tdx_2_", 0x22: "tdx_22_", 0xf1: "test"} {
+		if NetworkHrpSuffix(networkId) != suffix {
+			t.Errorf("NetworkHrpSuffix(%d) = %q, want %q", networkId, NetworkHrpSuffix(networkId), suffix)
+		}
+	}
+}
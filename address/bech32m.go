@@ -0,0 +1,181 @@
+package address
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bech32m (BIP 350) following the rust-bech32 crate used by the engine, so
+// that decoding failures are reported with the same reasons: MissingSeparator,
+// InvalidLength, InvalidChar('x'), MixedCase, InvalidChecksum and
+// InvalidPadding.
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+const (
+	bech32Constant  = 1
+	bech32mConstant = 0x2bc830a3
+	checksumLength  = 6
+)
+
+var bech32CharsetIndex = func() (index [128]int8) {
+	for i := range index {
+		index[i] = -1
+	}
+	for i, character := range bech32Charset {
+		index[character] = int8(i)
+	}
+	return index
+}()
+
+// Bech32DecodeError reports a malformed bech32m string, Error_ holding the
+// reason in the format of the native RadixEngineToolkitErrorBech32DecodeError.
+type Bech32DecodeError struct {
+	Error_ string
+}
+
+func (err Bech32DecodeError) Error() string {
+	return fmt.Sprint("Bech32DecodeError",
+		": ",
+
+		"Error_=",
+		err.Error_,
+	)
+}
+
+func bech32DecodingError(reason string) error {
+	return &Bech32DecodeError{Error_: "Bech32mDecodingError(" + reason + ")"}
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generators := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	checksum := uint32(1)
+	for _, value := range values {
+		top := checksum >> 25
+		checksum = (checksum&0x1ffffff)<<5 ^ uint32(value)
+		for i, generator := range generators {
+			if (top>>i)&1 == 1 {
+				checksum ^= generator
+			}
+		}
+	}
+	return checksum
+}
+
+func bech32HrpExpand(hrp string) []byte {
+	expanded := make([]byte, 0, 2*len(hrp)+1)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]>>5)
+	}
+	expanded = append(expanded, 0)
+	for i := 0; i < len(hrp); i++ {
+		expanded = append(expanded, hrp[i]&31)
+	}
+	return expanded
+}
+
+// convertBits regroups bits, padding the last group with zeros when pad is
+// set and rejecting non-zero or excess padding otherwise.
+func convertBits(data []byte, from, to uint, pad bool) ([]byte, error) {
+	var accumulator, bits uint
+	maxValue := uint(1)<<to - 1
+	converted := make([]byte, 0, len(data)*int(from)/int(to)+1)
+	for _, value := range data {
+		accumulator = accumulator<<from | uint(value)
+		bits += from
+		for bits >= to {
+			bits -= to
+			converted = append(converted, byte(accumulator>>bits&maxValue))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			converted = append(converted, byte(accumulator<<(to-bits)&maxValue))
+		}
+	} else if bits >= from || accumulator<<(to-bits)&maxValue != 0 {
+		return nil, bech32DecodingError("InvalidPadding")
+	}
+	return converted, nil
+}
+
+// Bech32mEncode encodes data under hrp as a bech32m string.
+func Bech32mEncode(hrp string, data []byte) (string, error) {
+	if hrp == "" {
+		return "", bech32DecodingError("InvalidLength")
+	}
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 || (hrp[i] >= 'A' && hrp[i] <= 'Z') {
+			return "", bech32DecodingError(fmt.Sprintf("InvalidChar(%q)", hrp[i]))
+		}
+	}
+	values, _ := convertBits(data, 8, 5, true)
+	checksumInput := append(bech32HrpExpand(hrp), values...)
+	checksumInput = append(checksumInput, make([]byte, checksumLength)...)
+	checksum := bech32Polymod(checksumInput) ^ bech32mConstant
+
+	var encoded strings.Builder
+	encoded.Grow(len(hrp) + 1 + len(values) + checksumLength)
+	encoded.WriteString(hrp)
+	encoded.WriteByte('1')
+	for _, value := range values {
+		encoded.WriteByte(bech32Charset[value])
+	}
+	for i := 0; i < checksumLength; i++ {
+		encoded.WriteByte(bech32Charset[checksum>>(5*(checksumLength-1-i))&31])
+	}
+	return encoded.String(), nil
+}
+
+// Bech32mDecode decodes a bech32m string into its lower case hrp and data.
+// Strings with a valid bech32 (rather than bech32m) checksum are rejected
+// with InvalidVariant(Bech32).
+func Bech32mDecode(value string) (hrp string, data []byte, err error) {
+	separator := strings.LastIndexByte(value, '1')
+	if separator < 0 {
+		return "", nil, bech32DecodingError("MissingSeparator")
+	}
+	rawHrp, rawData := value[:separator], value[separator+1:]
+	if rawHrp == "" || len(rawData) < checksumLength {
+		return "", nil, bech32DecodingError("InvalidLength")
+	}
+
+	var hasLower, hasUpper bool
+	for _, character := range rawHrp {
+		if character < 33 || character > 126 {
+			return "", nil, bech32DecodingError(fmt.Sprintf("InvalidChar(%q)", character))
+		}
+		hasLower = hasLower || (character >= 'a' && character <= 'z')
+		hasUpper = hasUpper || (character >= 'A' && character <= 'Z')
+	}
+	values := make([]byte, 0, len(rawData))
+	for _, character := range rawData {
+		hasLower = hasLower || (character >= 'a' && character <= 'z')
+		hasUpper = hasUpper || (character >= 'A' && character <= 'Z')
+		lower := character
+		if lower >= 'A' && lower <= 'Z' {
+			lower += 'a' - 'A'
+		}
+		if lower >= 128 || bech32CharsetIndex[lower] < 0 {
+			return "", nil, bech32DecodingError(fmt.Sprintf("InvalidChar(%q)", character))
+		}
+		values = append(values, byte(bech32CharsetIndex[lower]))
+	}
+	if hasLower && hasUpper {
+		return "", nil, bech32DecodingError("MixedCase")
+	}
+
+	hrp = strings.ToLower(rawHrp)
+	switch bech32Polymod(append(bech32HrpExpand(hrp), values...)) {
+	case bech32mConstant:
+	case bech32Constant:
+		return "", nil, &Bech32DecodeError{Error_: "InvalidVariant(Bech32)"}
+	default:
+		return "", nil, bech32DecodingError("InvalidChecksum")
+	}
+
+	data, err = convertBits(values[:len(values)-checksumLength], 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+	return hrp, data, nil
+}
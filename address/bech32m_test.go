@@ -0,0 +1,66 @@
+package address
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBech32mDecode(t *testing.T) {
+	// valid bech32m strings of BIP 350
+	for _, valid := range []struct {
+		value, hrp string
+		length     int
+	}{
+		{"A1LQFN3A", "a", 0},
+		{"a1lqfn3a", "a", 0},
+		{"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", "abcdef", 20},
+		{"split1checkupstagehandshakeupstreamerranterredcaperredlc445v", "split", 30},
+		{"?1v759aa", "?", 0},
+	} {
+		hrp, data, err := Bech32mDecode(valid.value)
+		if err != nil || hrp != valid.hrp || len(data) != valid.length {
+			t.Errorf("Bech32mDecode(%q) = %q, %x, %v", valid.value, hrp, data, err)
+		}
+	}
+
+	for _, invalid := range []struct {
+		value, reason string
+	}{
+		{"qyrz8wqd2c9m", "Bech32mDecodingError(MissingSeparator)"},
+		{"1qyrz8wqd2c9m", "Bech32mDecodingError(InvalidLength)"},
+		{"y1b0jsk6g", "Bech32mDecodingError(InvalidChar('b'))"},
+		{"lt1igcx5c0", "Bech32mDecodingError(InvalidChar('i'))"},
+		{"in1muywd", "Bech32mDecodingError(InvalidLength)"},
+		{"mm1crxm3i", "Bech32mDecodingError(InvalidChar('i'))"},
+		{"M1VUXWEZ", "Bech32mDecodingError(InvalidChecksum)"},
+		{"A1lqfn3a", "Bech32mDecodingError(MixedCase)"},
+		{"abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw", "InvalidVariant(Bech32)"},
+	} {
+		_, _, err := Bech32mDecode(invalid.value)
+		var decodeError *Bech32DecodeError
+		if !errors.As(err, &decodeError) || decodeError.Error_ != invalid.reason {
+			t.Errorf("Bech32mDecode(%q) = %v, want %s", invalid.value, err, invalid.reason)
+		}
+	}
+}
+
+func TestBech32mEncode(t *testing.T) {
+	for _, value := range []string{
+		"a1lqfn3a",
+		"abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx",
+		"split1checkupstagehandshakeupstreamerranterredcaperredlc445v",
+	} {
+		hrp, data, err := Bech32mDecode(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if encoded, err := Bech32mEncode(hrp, data); err != nil || encoded != value {
+			t.Errorf("Bech32mEncode(%q, %x) = %q, %v, want %q", hrp, data, encoded, err, value)
+		}
+	}
+	for _, hrp := range []string{"", "Resource", "a b"} {
+		if _, err := Bech32mEncode(hrp, nil); err == nil {
+			t.Errorf("Bech32mEncode(%q) succeeded", hrp)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package address
+
+import "fmt"
+
+// EntityType is the type of the entity an address refers to. Its values are
+// those of radix_engine_toolkit_uniffi.EntityType, so the two convert into
+// each other with a plain type conversion.
+type EntityType uint
+
+const (
+	EntityTypeGlobalPackage                       EntityType = 1
+	EntityTypeGlobalFungibleResourceManager       EntityType = 2
+	EntityTypeGlobalNonFungibleResourceManager    EntityType = 3
+	EntityTypeGlobalConsensusManager              EntityType = 4
+	EntityTypeGlobalValidator                     EntityType = 5
+	EntityTypeGlobalAccessController              EntityType = 6
+	EntityTypeGlobalAccount                       EntityType = 7
+	EntityTypeGlobalIdentity                      EntityType = 8
+	EntityTypeGlobalGenericComponent              EntityType = 9
+	EntityTypeGlobalPreallocatedSecp256k1Account  EntityType = 10
+	EntityTypeGlobalPreallocatedEd25519Account    EntityType = 11
+	EntityTypeGlobalPreallocatedSecp256k1Identity EntityType = 12
+	EntityTypeGlobalPreallocatedEd25519Identity   EntityType = 13
+	EntityTypeGlobalOneResourcePool               EntityType = 14
+	EntityTypeGlobalTwoResourcePool               EntityType = 15
+	EntityTypeGlobalMultiResourcePool             EntityType = 16
+	EntityTypeGlobalAccountLocker                 EntityType = 17
+	EntityTypeGlobalTransactionTracker            EntityType = 18
+	EntityTypeInternalFungibleVault               EntityType = 19
+	EntityTypeInternalNonFungibleVault            EntityType = 20
+	EntityTypeInternalGenericComponent            EntityType = 21
+	EntityTypeInternalKeyValueStore               EntityType = 22
+)
+
+type entityTypeInfo struct {
+	name string
+	// first byte of the node ids of the entity type
+	byte byte
+	// hrp prefix of the addresses of the entity type
+	hrpPrefix string
+}
+
+var entityTypes = map[EntityType]entityTypeInfo{
+	EntityTypeGlobalPackage:                       {"GlobalPackage", 0b00001101, "package"},
+	EntityTypeGlobalFungibleResourceManager:       {"GlobalFungibleResourceManager", 0b01011101, "resource"},
+	EntityTypeGlobalNonFungibleResourceManager:    {"GlobalNonFungibleResourceManager", 0b10011010, "resource"},
+	EntityTypeGlobalConsensusManager:              {"GlobalConsensusManager", 0b10000110, "consensusmanager"},
+	EntityTypeGlobalValidator:                     {"GlobalValidator", 0b10000011, "validator"},
+	EntityTypeGlobalAccessController:              {"GlobalAccessController", 0b11000011, "accesscontroller"},
+	EntityTypeGlobalAccount:                       {"GlobalAccount", 0b11000001, "account"},
+	EntityTypeGlobalIdentity:                      {"GlobalIdentity", 0b11000010, "identity"},
+	EntityTypeGlobalGenericComponent:              {"GlobalGenericComponent", 0b11000000, "component"},
+	EntityTypeGlobalPreallocatedSecp256k1Account:  {"GlobalPreallocatedSecp256k1Account", 0b11010001, "account"},
+	EntityTypeGlobalPreallocatedEd25519Account:    {"GlobalPreallocatedEd25519Account", 0b01010001, "account"},
+	EntityTypeGlobalPreallocatedSecp256k1Identity: {"GlobalPreallocatedSecp256k1Identity", 0b11010010, "identity"},
+	EntityTypeGlobalPreallocatedEd25519Identity:   {"GlobalPreallocatedEd25519Identity", 0b01010010, "identity"},
+	EntityTypeGlobalOneResourcePool:               {"GlobalOneResourcePool", 0b11000100, "pool"},
+	EntityTypeGlobalTwoResourcePool:               {"GlobalTwoResourcePool", 0b11000101, "pool"},
+	EntityTypeGlobalMultiResourcePool:             {"GlobalMultiResourcePool", 0b11000110, "pool"},
+	EntityTypeGlobalAccountLocker:                 {"GlobalAccountLocker", 0b11000111, "locker"},
+	EntityTypeGlobalTransactionTracker:            {"GlobalTransactionTracker", 0b10000010, "transactiontracker"},
+	EntityTypeInternalFungibleVault:               {"InternalFungibleVault", 0b01011000, "internal_vault"},
+	EntityTypeInternalNonFungibleVault:            {"InternalNonFungibleVault", 0b10011000, "internal_vault"},
+	EntityTypeInternalGenericComponent:            {"InternalGenericComponent", 0b11111000, "internal_component"},
+	EntityTypeInternalKeyValueStore:               {"InternalKeyValueStore", 0b10110000, "internal_keyvaluestore"},
+}
+
+var entityTypesByByte = func() map[byte]EntityType {
+	byByte := make(map[byte]EntityType, len(entityTypes))
+	for entityType, info := range entityTypes {
+		byByte[info.byte] = entityType
+	}
+	return byByte
+}()
+
+// EntityTypeFromByte returns the entity type of a node id starting with
+// value.
+func EntityTypeFromByte(value byte) (EntityType, bool) {
+	entityType, ok := entityTypesByByte[value]
+	return entityType, ok
+}
+
+// Byte returns the first byte of the node ids of the entity type.
+func (entityType EntityType) Byte() byte {
+	return entityTypes[entityType].byte
+}
+
+// HrpPrefix returns the prefix of the hrp of the addresses of the entity
+// type, e.g. "account" or "internal_vault".
+func (entityType EntityType) HrpPrefix() string {
+	return entityTypes[entityType].hrpPrefix
+}
+
+func (entityType EntityType) String() string {
+	if info, ok := entityTypes[entityType]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("EntityType(%d)", uint(entityType))
+}
+
+func (entityType EntityType) IsGlobal() bool {
+	return entityType >= EntityTypeGlobalPackage && entityType <= EntityTypeGlobalTransactionTracker
+}
+
+func (entityType EntityType) IsInternal() bool {
+	return entityType >= EntityTypeInternalFungibleVault && entityType <= EntityTypeInternalKeyValueStore
+}
+
+func (entityType EntityType) IsGlobalComponent() bool {
+	return entityType.IsGlobal() && !entityType.IsGlobalPackage() && !entityType.IsGlobalResourceManager()
+}
+
+func (entityType EntityType) IsGlobalPackage() bool {
+	return entityType == EntityTypeGlobalPackage
+}
+
+func (entityType EntityType) IsGlobalConsensusManager() bool {
+	return entityType == EntityTypeGlobalConsensusManager
+}
+
+func (entityType EntityType) IsGlobalResourceManager() bool {
+	return entityType.IsGlobalFungibleResourceManager() || entityType.IsGlobalNonFungibleResourceManager()
+}
+
+func (entityType EntityType) IsGlobalFungibleResourceManager() bool {
+	return entityType == EntityTypeGlobalFungibleResourceManager
+}
+
+func (entityType EntityType) IsGlobalNonFungibleResourceManager() bool {
+	return entityType == EntityTypeGlobalNonFungibleResourceManager
+}
+
+func (entityType EntityType) IsGlobalPreallocated() bool {
+	switch entityType {
+	case EntityTypeGlobalPreallocatedSecp256k1Account,
+		EntityTypeGlobalPreallocatedEd25519Account,
+		EntityTypeGlobalPreallocatedSecp256k1Identity,
+		EntityTypeGlobalPreallocatedEd25519Identity:
+		return true
+	}
+	return false
+}
+
+func (entityType EntityType) IsInternalVault() bool {
+	return entityType.IsInternalFungibleVault() || entityType.IsInternalNonFungibleVault()
+}
+
+func (entityType EntityType) IsInternalFungibleVault() bool {
+	return entityType == EntityTypeInternalFungibleVault
+}
+
+func (entityType EntityType) IsInternalNonFungibleVault() bool {
+	return entityType == EntityTypeInternalNonFungibleVault
+}
+
+func (entityType EntityType) IsInternalKvStore() bool {
+	return entityType == EntityTypeInternalKeyValueStore
+}
@@ -0,0 +1,57 @@
+package address
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NetworkHrpSuffix returns the hrp suffix of the addresses of a network,
+// e.g. "rdx" for the mainnet and "tdx_2_" for the stokenet. Networks without
+// a well known suffix use "tdx_<hex id>_", as the native library does.
+func NetworkHrpSuffix(networkId uint8) string {
+	switch networkId {
+	case 0x01:
+		return "rdx"
+	case 0xf0:
+		return "loc"
+	case 0xf1:
+		return "test"
+	case 0xf2:
+		return "sim"
+	}
+	return fmt.Sprintf("tdx_%x_", networkId)
+}
+
+// NetworkIdFromHrp returns the id of the network an hrp belongs to, e.g. 2
+// for "account_tdx_2_" or "txid_tdx_2_".
+func NetworkIdFromHrp(hrp string) (uint8, bool) {
+	for _, suffix := range []struct {
+		suffix    string
+		networkId uint8
+	}{{"_rdx", 0x01}, {"_loc", 0xf0}, {"_test", 0xf1}, {"_sim", 0xf2}} {
+		if strings.HasSuffix(hrp, suffix.suffix) && len(hrp) > len(suffix.suffix) {
+			return suffix.networkId, true
+		}
+	}
+	start := strings.LastIndex(hrp, "_tdx_")
+	if start <= 0 || !strings.HasSuffix(hrp, "_") {
+		return 0, false
+	}
+	id := hrp[start+len("_tdx_") : len(hrp)-1]
+	networkId, err := strconv.ParseUint(id, 16, 8)
+	if err != nil || id != strconv.FormatUint(networkId, 16) {
+		return 0, false
+	}
+	return uint8(networkId), true
+}
+
+// NetworkIdFromBech32 returns the id of the network of a bech32m encoded
+// address or hash, read from its hrp.
+func NetworkIdFromBech32(value string) (uint8, bool) {
+	separator := strings.LastIndexByte(value, '1')
+	if separator < 0 {
+		return 0, false
+	}
+	return NetworkIdFromHrp(strings.ToLower(value[:separator]))
+}
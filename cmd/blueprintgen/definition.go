@@ -0,0 +1,220 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/internal/typegen"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/schema"
+)
+
+// The parts of a PackageDefinition the generator needs, read positionally
+// from its Manifest SBOR encoding:
+//
+//	PackageDefinition { blueprints: IndexMap<String, BlueprintDefinitionInit> }
+//	BlueprintDefinitionInit { blueprint_type, is_transient, feature_set,
+//	    dependencies, schema: BlueprintSchemaInit, royalty_config, auth_config }
+//	BlueprintSchemaInit { generics, schema: VersionedScryptoSchema, state,
+//	    events: { event_schema: IndexMap<String, TypeRef> }, types,
+//	    functions: { functions: IndexMap<String, FunctionSchemaInit> }, hooks }
+//	FunctionSchemaInit { receiver: Option<ReceiverInfo>, input: TypeRef,
+//	    output: TypeRef, export: String }
+
+type packageDefinition struct {
+	blueprints []*blueprintDefinition
+}
+
+type blueprintDefinition struct {
+	name   string
+	schema *schema.Schema
+	// the Scrypto SBOR encoded schema, embedded in the generated code
+	schemaPayload []byte
+	// the variable of the embedded schema, once a decoder uses it
+	schemaVar string
+	scope     *typegen.Scope
+	functions []functionDefinition
+	events    []eventDefinition
+}
+
+type functionDefinition struct {
+	name   string
+	method bool
+	input  typeRef
+	output typeRef
+}
+
+type eventDefinition struct {
+	name string
+	typ  typeRef
+}
+
+// typeRef is a TypeRef<LocalTypeId>: a type of the blueprint schema or a
+// generic parameter of the blueprint, id.Index being its index.
+type typeRef struct {
+	generic bool
+	id      schema.LocalTypeId
+}
+
+func parsePackageDefinition(payload []byte) (*packageDefinition, error) {
+	value, err := sbor.Decode(payload, sbor.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	blueprints, err := value.Field(0)
+	if err != nil {
+		return nil, fmt.Errorf("blueprints: %w", err)
+	}
+	entries, err := mapEntries(blueprints)
+	if err != nil {
+		return nil, fmt.Errorf("blueprints: %w", err)
+	}
+	definition := &packageDefinition{}
+	for _, entry := range entries {
+		name, err := stringValue(entry.Key)
+		if err != nil {
+			return nil, fmt.Errorf("blueprints: %w", err)
+		}
+		blueprint, err := parseBlueprint(name, entry.Value)
+		if err != nil {
+			return nil, fmt.Errorf("blueprint %s: %w", name, err)
+		}
+		definition.blueprints = append(definition.blueprints, blueprint)
+	}
+	return definition, nil
+}
+
+func parseBlueprint(name string, value sbor.Value) (*blueprintDefinition, error) {
+	blueprint := &blueprintDefinition{name: name}
+	blueprintSchema, err := value.Field(4)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+
+	versioned, err := blueprintSchema.Field(1)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	if blueprint.schema, err = schema.Parse(versioned); err != nil {
+		return nil, err
+	}
+	if blueprint.schemaPayload, err = sbor.Encode(versioned, sbor.Scrypto); err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+
+	events, err := path(blueprintSchema, 3, 0)
+	if err != nil {
+		return nil, fmt.Errorf("events: %w", err)
+	}
+	eventEntries, err := mapEntries(events)
+	if err != nil {
+		return nil, fmt.Errorf("events: %w", err)
+	}
+	for _, entry := range eventEntries {
+		eventName, err := stringValue(entry.Key)
+		if err != nil {
+			return nil, fmt.Errorf("events: %w", err)
+		}
+		typ, err := parseTypeRef(entry.Value)
+		if err != nil {
+			return nil, fmt.Errorf("event %s: %w", eventName, err)
+		}
+		blueprint.events = append(blueprint.events, eventDefinition{name: eventName, typ: typ})
+	}
+
+	functions, err := path(blueprintSchema, 5, 0)
+	if err != nil {
+		return nil, fmt.Errorf("functions: %w", err)
+	}
+	functionEntries, err := mapEntries(functions)
+	if err != nil {
+		return nil, fmt.Errorf("functions: %w", err)
+	}
+	for _, entry := range functionEntries {
+		functionName, err := stringValue(entry.Key)
+		if err != nil {
+			return nil, fmt.Errorf("functions: %w", err)
+		}
+		function, err := parseFunction(functionName, entry.Value)
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %w", functionName, err)
+		}
+		blueprint.functions = append(blueprint.functions, function)
+	}
+	return blueprint, nil
+}
+
+func parseFunction(name string, value sbor.Value) (functionDefinition, error) {
+	function := functionDefinition{name: name}
+	receiver, err := enumValue(value, 0)
+	if err != nil {
+		return functionDefinition{}, fmt.Errorf("receiver: %w", err)
+	}
+	function.method = receiver.Discriminator == 1
+	for index, typ := range []*typeRef{&function.input, &function.output} {
+		ref, err := value.Field(index + 1)
+		if err != nil {
+			return functionDefinition{}, err
+		}
+		if *typ, err = parseTypeRef(ref); err != nil {
+			return functionDefinition{}, err
+		}
+	}
+	return function, nil
+}
+
+func parseTypeRef(value sbor.Value) (typeRef, error) {
+	if value.Kind != sbor.KindEnum || len(value.Elements) != 1 {
+		return typeRef{}, fmt.Errorf("invalid type ref")
+	}
+	switch value.Discriminator {
+	case 0:
+		id, err := schema.ParseLocalTypeId(value.Elements[0])
+		return typeRef{id: id}, err
+	case 1:
+		index, err := integerValue(value.Elements[0])
+		return typeRef{generic: true, id: schema.LocalTypeId{Index: index}}, err
+	}
+	return typeRef{}, fmt.Errorf("invalid type ref variant %d", value.Discriminator)
+}
+
+func path(value sbor.Value, indices ...int) (sbor.Value, error) {
+	for _, index := range indices {
+		var err error
+		if value, err = value.Field(index); err != nil {
+			return sbor.Value{}, err
+		}
+	}
+	return value, nil
+}
+
+func enumValue(value sbor.Value, index int) (sbor.Value, error) {
+	value, err := value.Field(index)
+	if err != nil {
+		return sbor.Value{}, err
+	}
+	if value.Kind != sbor.KindEnum {
+		return sbor.Value{}, fmt.Errorf("expected an enum")
+	}
+	return value, nil
+}
+
+func mapEntries(value sbor.Value) ([]sbor.Entry, error) {
+	if value.Kind != sbor.KindMap {
+		return nil, fmt.Errorf("expected a map")
+	}
+	return value.Entries, nil
+}
+
+func stringValue(value sbor.Value) (string, error) {
+	if value.Kind != sbor.KindString {
+		return "", fmt.Errorf("expected a string")
+	}
+	return value.String, nil
+}
+
+func integerValue(value sbor.Value) (uint64, error) {
+	if value.Int == nil || value.Int.Sign() < 0 || !value.Int.IsUint64() {
+		return 0, fmt.Errorf("expected an unsigned integer")
+	}
+	return value.Int.Uint64(), nil
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"strings"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/internal/typegen"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/schema"
+)
+
+type generator struct {
+	*typegen.Generator
+	packageName string
+	source      string
+}
+
+func newGenerator(packageName, source string) *generator {
+	return &generator{Generator: typegen.New(), packageName: packageName, source: source}
+}
+
+func (g *generator) generate(definition *packageDefinition) []byte {
+	var body bytes.Buffer
+	for _, blueprint := range definition.blueprints {
+		g.blueprint(&body, blueprint)
+	}
+	header := fmt.Sprintf("// Code generated by cmd/blueprintgen from %s. DO NOT EDIT.", g.source)
+	return g.File(header, g.packageName, body.Bytes())
+}
+
+func (g *generator) blueprint(body *bytes.Buffer, blueprint *blueprintDefinition) {
+	blueprintName := typegen.Identifier(blueprint.name, true)
+	blueprint.scope = &typegen.Scope{
+		Schema: blueprint.schema,
+		Prefix: blueprintName,
+		Owner:  fmt.Sprintf("the %s blueprint", blueprint.name),
+	}
+	var functions, methods []functionDefinition
+	for _, function := range blueprint.functions {
+		if function.method {
+			methods = append(methods, function)
+		} else {
+			functions = append(functions, function)
+		}
+	}
+
+	if len(functions) > 0 {
+		packageType := g.UniqueName(blueprintName+"Package", "")
+		fmt.Fprintf(body, "// %s calls the functions of the %s blueprint of a package.\n", packageType, blueprint.name)
+		fmt.Fprintf(body, "type %s struct {\n\tAddress radix.ManifestBuilderAddress\n}\n\n", packageType)
+		g.calls(body, blueprint, blueprintName, packageType, "blueprint", functions)
+	}
+	if len(methods) > 0 {
+		componentType := g.UniqueName(blueprintName, "")
+		fmt.Fprintf(body, "// %s calls the methods of a component of the %s blueprint.\n", componentType, blueprint.name)
+		fmt.Fprintf(body, "type %s struct {\n\tAddress radix.ManifestBuilderAddress\n}\n\n", componentType)
+		g.calls(body, blueprint, blueprintName, componentType, "component", methods)
+	}
+	g.events(body, blueprint, blueprintName)
+
+	if blueprint.schemaVar != "" {
+		fmt.Fprintf(body, "// %s is the Scrypto SBOR encoded schema of the %s blueprint.\n", blueprint.schemaVar, blueprint.name)
+		fmt.Fprintf(body, "var %s = %s\n\n", blueprint.schemaVar, typegen.ByteSlice(blueprint.schemaPayload))
+	}
+}
+
+func (g *generator) calls(body *bytes.Buffer, blueprint *blueprintDefinition, blueprintName, receiverType, receiver string, functions []functionDefinition) {
+	methodNames := map[string]bool{"Address": true}
+	for _, function := range functions {
+		methodName := typegen.Identifier(function.name, true)
+		if methodNames[methodName] {
+			methodName = "Call" + methodName
+		}
+		methodNames[methodName] = true
+
+		params, fields, ok := g.params(blueprint, function.input)
+		kind := "function"
+		if function.method {
+			kind = "method"
+		}
+		fmt.Fprintf(body, "// %s adds a call to the %s %s.\n", methodName, function.name, kind)
+		fmt.Fprintf(body, "func (%s %s) %s(builder *radix.ManifestV2Builder", receiver, receiverType, methodName)
+		if !ok {
+			body.WriteString(", args []radix.ManifestBuilderValue")
+		}
+		for _, param := range params {
+			fmt.Fprintf(body, ", %s %s", param.name, param.typ.Expr)
+		}
+		body.WriteString(") (*radix.ManifestV2Builder, error) {\n")
+		switch {
+		case !ok:
+		case len(params) == 0:
+			body.WriteString("\targs := []radix.ManifestBuilderValue{}\n")
+		default:
+			body.WriteString("\targs, err := radix.MarshalManifestArgsStruct(struct {\n")
+			names := make([]string, len(params))
+			for index, param := range params {
+				fmt.Fprintf(body, "\t\t%s %s%s\n", fields[index], param.typ.Expr, param.typ.Tag())
+				names[index] = param.name
+			}
+			fmt.Fprintf(body, "\t}{%s})\n", strings.Join(names, ", "))
+			body.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+		}
+		if function.method {
+			fmt.Fprintf(body, "\treturn builder.CallMethod(%s.Address, %q, args)\n}\n\n", receiver, function.name)
+		} else {
+			fmt.Fprintf(body, "\treturn builder.CallFunction(%s.Address, %q, %q, args)\n}\n\n", receiver, blueprint.name, function.name)
+		}
+
+		if g.isUnit(blueprint, function.output) {
+			continue
+		}
+		decoder := g.UniqueName("Decode"+blueprintName+typegen.Identifier(function.name, true)+"Output", "")
+		fmt.Fprintf(body, "// %s decodes the Scrypto SBOR encoded return value of the %s %s.\n", decoder, function.name, kind)
+		g.decoder(body, blueprint, blueprintName, decoder, function.output)
+	}
+}
+
+// decoder writes a function decoding a payload of the type ref, validated
+// against the schema of the blueprint. The type of a generic parameter is
+// not in the schema, so its payloads are decoded unvalidated.
+func (g *generator) decoder(body *bytes.Buffer, blueprint *blueprintDefinition, blueprintName, name string, ref typeRef) {
+	output := g.typeRefType(blueprint, ref, false)
+	fmt.Fprintf(body, "func %s(networkId uint8, data []byte) (%s, error) {\n", name, output.Expr)
+	fmt.Fprintf(body, "\tvar output %s\n", output.Expr)
+	if ref.generic {
+		body.WriteString("\terr := radix.UnmarshalScryptoSbor(data, networkId, &output)\n")
+		body.WriteString("\treturn output, err\n}\n\n")
+		return
+	}
+	if blueprint.schemaVar == "" {
+		blueprint.schemaVar = g.UniqueName(typegen.Identifier(blueprintName, false)+"Schema", "")
+	}
+	body.WriteString("\terr := radix.UnmarshalScryptoSborWithSchema(data, networkId, radix.Schema{\n")
+	if ref.id.WellKnown {
+		fmt.Fprintf(body, "\t\tLocalTypeId: radix.LocalTypeIdWellKnown{Value: %d},\n", ref.id.Index)
+	} else {
+		fmt.Fprintf(body, "\t\tLocalTypeId: radix.LocalTypeIdSchemaLocalIndex{Value: %d},\n", ref.id.Index)
+	}
+	fmt.Fprintf(body, "\t\tSchema:      %s,\n", blueprint.schemaVar)
+	body.WriteString("\t}, &output)\n")
+	body.WriteString("\treturn output, err\n}\n\n")
+}
+
+func (g *generator) events(body *bytes.Buffer, blueprint *blueprintDefinition, blueprintName string) {
+	if len(blueprint.events) == 0 {
+		return
+	}
+	decoders := make([]string, len(blueprint.events))
+	for index, event := range blueprint.events {
+		eventName := typegen.Identifier(event.name, true)
+		if !strings.HasSuffix(eventName, "Event") {
+			eventName += "Event"
+		}
+		decoders[index] = g.UniqueName("Decode"+blueprintName+eventName, "")
+		fmt.Fprintf(body, "// %s decodes the Scrypto SBOR encoded data of a %s event.\n", decoders[index], event.name)
+		g.decoder(body, blueprint, blueprintName, decoders[index], event.typ)
+	}
+
+	g.Import(`"fmt"`)
+	dispatcher := g.UniqueName("Decode"+blueprintName+"Event", "")
+	fmt.Fprintf(body, "// %s decodes the data of an event of the %s blueprint by the name of\n", dispatcher, blueprint.name)
+	body.WriteString("// the event.\n")
+	fmt.Fprintf(body, "func %s(networkId uint8, name string, data []byte) (any, error) {\n\tswitch name {\n", dispatcher)
+	for index, event := range blueprint.events {
+		fmt.Fprintf(body, "\tcase %q:\n\t\treturn %s(networkId, data)\n", event.name, decoders[index])
+	}
+	fmt.Fprintf(body, "\t}\n\treturn nil, fmt.Errorf(\"unknown %s event %%q\", name)\n}\n\n", blueprint.name)
+}
+
+type param struct {
+	name string
+	typ  typegen.Type
+}
+
+// params returns the parameters of a function taking input, and the names of
+// the fields they are encoded as, or false if input is not a tuple.
+func (g *generator) params(blueprint *blueprintDefinition, input typeRef) ([]param, []string, bool) {
+	if input.generic {
+		return nil, nil, false
+	}
+	if input.id.WellKnown {
+		return nil, nil, input.id.Index == schema.WellKnownUnit
+	}
+	t, ok := blueprint.schema.Type(input.id)
+	if !ok || t.Kind != schema.TypeKindTuple {
+		return nil, nil, false
+	}
+	params := make([]param, len(t.Fields))
+	fields := make([]string, len(t.Fields))
+	used := map[string]bool{"builder": true, "args": true, "err": true, "blueprint": true, "component": true}
+	for index, field := range t.Fields {
+		name := fmt.Sprintf("arg%d", index)
+		if len(t.FieldNames) == len(t.Fields) && typegen.Identifier(t.FieldNames[index], false) != "" {
+			name = typegen.Identifier(t.FieldNames[index], false)
+		}
+		for token.IsKeyword(name) || predeclared[name] || used[name] {
+			name += "Arg"
+		}
+		used[name] = true
+		params[index] = param{name: name, typ: g.FieldType(blueprint.scope, field, true)}
+		fields[index] = fmt.Sprintf("Field%d", index)
+	}
+	return params, fields, true
+}
+
+var predeclared = map[string]bool{
+	"any": true, "bool": true, "byte": true, "error": true, "false": true, "int": true, "int8": true,
+	"int16": true, "int32": true, "int64": true, "nil": true, "string": true, "true": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true, "len": true,
+	"make": true, "new": true, "append": true, "cap": true, "copy": true, "radix": true,
+	"address": true, "big": true, "fmt": true, "networkId": true, "data": true, "output": true,
+}
+
+func (g *generator) isUnit(blueprint *blueprintDefinition, ref typeRef) bool {
+	if ref.generic {
+		return false
+	}
+	if ref.id.WellKnown {
+		return ref.id.Index == schema.WellKnownUnit
+	}
+	t, ok := blueprint.schema.Type(ref.id)
+	return ok && t.Kind == schema.TypeKindTuple && len(t.Fields) == 0
+}
+
+func (g *generator) typeRefType(blueprint *blueprintDefinition, ref typeRef, input bool) typegen.Type {
+	if ref.generic {
+		return typegen.Raw(input)
+	}
+	return g.TypeOf(blueprint.scope, ref.id, input)
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/schema"
+)
+
+var update = flag.Bool("update", false, "rewrite the files of testdata")
+
+func enum(discriminator uint8, fields ...sbor.Value) sbor.Value {
+	return sbor.Value{Kind: sbor.KindEnum, Discriminator: discriminator, Elements: fields}
+}
+
+func tuple(fields ...sbor.Value) sbor.Value {
+	return sbor.Value{Kind: sbor.KindTuple, Elements: fields}
+}
+
+func array(kind sbor.Kind, elements ...sbor.Value) sbor.Value {
+	return sbor.Value{Kind: sbor.KindArray, ElementKind: kind, Elements: elements}
+}
+
+func str(value string) sbor.Value {
+	return sbor.Value{Kind: sbor.KindString, String: value}
+}
+
+func wellKnown(id uint8) sbor.Value {
+	return enum(0, tuple(sbor.Value{Kind: sbor.KindU8, Int: big.NewInt(int64(id))}))
+}
+
+func schemaLocal(index uint64) sbor.Value {
+	return enum(1, sbor.Value{Kind: sbor.KindU64, Int: new(big.Int).SetUint64(index)})
+}
+
+func typeRefs(ids ...sbor.Value) sbor.Value {
+	return array(sbor.KindEnum, ids...)
+}
+
+func stringMap(valueKind sbor.Kind, entries ...sbor.Entry) sbor.Value {
+	return sbor.Value{Kind: sbor.KindMap, KeyKind: sbor.KindString, ValueKind: valueKind, Entries: entries}
+}
+
+func metadata(name string, fieldNames ...string) sbor.Value {
+	if len(fieldNames) == 0 {
+		return tuple(enum(1, str(name)), enum(0))
+	}
+	names := make([]sbor.Value, len(fieldNames))
+	for index, fieldName := range fieldNames {
+		names[index] = str(fieldName)
+	}
+	return tuple(enum(1, str(name)), enum(1, enum(0, array(sbor.KindString, names...))))
+}
+
+func function(name string, method bool, input, output sbor.Value) sbor.Entry {
+	receiver := enum(0)
+	if method {
+		receiver = enum(1, tuple(enum(0), tuple()))
+	}
+	return sbor.Entry{Key: str(name), Value: tuple(receiver, enum(0, input), enum(0, output), str(name))}
+}
+
+// dexDefinition is the package definition of testdata/dex.rpd, a blueprint
+//
+//	struct Dex
+//	fn instantiate(fee: Decimal) -> Global<Dex>
+//	fn swap(&mut self, input: Bucket, min_out: Decimal) -> Bucket
+//	fn status(&self) -> Status
+//	enum Status { Open, Closed { reason: String } }
+//	#[derive(ScryptoEvent)] struct SwapEvent { amount: Decimal, trader: String }
+func dexDefinition() sbor.Value {
+	kinds := array(sbor.KindEnum,
+		enum(uint8(schema.TypeKindTuple), typeRefs(wellKnown(schema.WellKnownDecimal))),
+		enum(uint8(schema.TypeKindTuple), typeRefs(wellKnown(schema.WellKnownOwnBucket), wellKnown(schema.WellKnownDecimal))),
+		enum(uint8(schema.TypeKindEnum), sbor.Value{Kind: sbor.KindMap, KeyKind: sbor.KindU8, ValueKind: sbor.KindArray, Entries: []sbor.Entry{
+			{Key: sbor.Value{Kind: sbor.KindU8, Int: big.NewInt(0)}, Value: typeRefs()},
+			{Key: sbor.Value{Kind: sbor.KindU8, Int: big.NewInt(1)}, Value: typeRefs(wellKnown(schema.WellKnownString))},
+		}}),
+		enum(uint8(schema.TypeKindTuple), typeRefs(wellKnown(schema.WellKnownDecimal), wellKnown(schema.WellKnownString))),
+	)
+	variantNames := sbor.Value{Kind: sbor.KindMap, KeyKind: sbor.KindU8, ValueKind: sbor.KindTuple, Entries: []sbor.Entry{
+		{Key: sbor.Value{Kind: sbor.KindU8, Int: big.NewInt(0)}, Value: metadata("Open")},
+		{Key: sbor.Value{Kind: sbor.KindU8, Int: big.NewInt(1)}, Value: metadata("Closed", "reason")},
+	}}
+	typeMetadata := array(sbor.KindTuple,
+		metadata("Dex_instantiate_Input", "fee"),
+		metadata("Dex_swap_Input", "input", "min_out"),
+		tuple(enum(1, str("Status")), enum(1, enum(1, variantNames))),
+		metadata("SwapEvent", "amount", "trader"),
+	)
+	validations := array(sbor.KindEnum, enum(0), enum(0), enum(0), enum(0))
+	versionedSchema := enum(0, tuple(kinds, typeMetadata, validations))
+
+	blueprintSchema := tuple(
+		array(sbor.KindEnum),
+		versionedSchema,
+		tuple(),
+		tuple(stringMap(sbor.KindEnum, sbor.Entry{Key: str("SwapEvent"), Value: enum(0, schemaLocal(3))})),
+		tuple(),
+		tuple(stringMap(sbor.KindTuple,
+			function("instantiate", false, schemaLocal(0), wellKnown(schema.WellKnownReference)),
+			function("swap", true, schemaLocal(1), wellKnown(schema.WellKnownOwnBucket)),
+			function("status", true, wellKnown(schema.WellKnownUnit), schemaLocal(2)),
+		)),
+		tuple(),
+	)
+	blueprint := tuple(enum(0), sbor.Value{Kind: sbor.KindBool}, tuple(), tuple(), blueprintSchema, tuple(), tuple())
+	return tuple(stringMap(sbor.KindTuple, sbor.Entry{Key: str("Dex"), Value: blueprint}))
+}
+
+// golden compares got with the file of testdata, or rewrites the file with
+// -update.
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from the output, rerun the test with -update to see the difference:\n%s", path, got)
+	}
+}
+
+func generateDex(t *testing.T) []byte {
+	t.Helper()
+	payload, err := sbor.Encode(dexDefinition(), sbor.Manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "dex.rpd", payload)
+
+	definition, err := parsePackageDefinition(payload)
+	if err != nil {
+		t.Fatalf("parsePackageDefinition = %v", err)
+	}
+	source, err := format.Source(newGenerator("dex", "dex.rpd").generate(definition))
+	if err != nil {
+		t.Fatalf("formatting generated code: %v", err)
+	}
+	return source
+}
+
+func TestGenerate(t *testing.T) {
+	golden(t, "dex_client.go.golden", generateDex(t))
+}
+
+func TestSchemaPayload(t *testing.T) {
+	payload, err := sbor.Encode(dexDefinition(), sbor.Manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	definition, err := parsePackageDefinition(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blueprint := definition.blueprints[0]
+	embedded, err := schema.Decode(blueprint.schemaPayload)
+	if err != nil {
+		t.Fatalf("schema.Decode of the embedded schema = %v", err)
+	}
+	if len(embedded.Types) != len(blueprint.schema.Types) {
+		t.Errorf("embedded schema has %d types, want %d", len(embedded.Types), len(blueprint.schema.Types))
+	}
+	for index := range embedded.Types {
+		if embedded.Types[index].Name != blueprint.schema.Types[index].Name || embedded.Types[index].Kind != blueprint.schema.Types[index].Kind {
+			t.Errorf("embedded type %d = %s, want %s", index, embedded.Types[index].Name, blueprint.schema.Types[index].Name)
+		}
+	}
+}
+
+// TestGenerateVet type checks and vets the generated code against the
+// radix_engine_toolkit_uniffi package, which needs cgo and the header of the
+// native library.
+func TestGenerateVet(t *testing.T) {
+	if testing.Short() {
+		t.Skip("vetting the generated code builds the bindings")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	dir, err := os.MkdirTemp("testdata", "vet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.WriteFile(filepath.Join(dir, "dex_client.go"), generateDex(t), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := exec.Command(goTool, "vet", "./"+filepath.ToSlash(dir)).CombinedOutput()
+	if err != nil {
+		t.Errorf("go vet of the generated code: %v\n%s", err, output)
+	}
+}
@@ -0,0 +1,53 @@
+// Command blueprintgen generates typed Go clients for the blueprints of a
+// Scrypto package from its package definition, the .rpd file written next to
+// the .wasm by scrypto build.
+//
+// For every blueprint it generates a type wrapping the package address with a
+// method per function, a type wrapping a component address with a method per
+// method, each adding the call to a ManifestV2Builder with its arguments
+// encoded from Go values, and functions decoding the return values and the
+// events of the blueprint from their Scrypto SBOR encoding, validated against
+// the blueprint schema embedded in the generated file:
+//
+//	//go:generate go run github.com/radixdlt/radix-engine-toolkit-go/v2/cmd/blueprintgen -in dex.rpd -package dex -out dex_client.go
+//
+// The named structs and enums of the blueprint schema become Go types; the
+// types which have no Go counterpart, such as generics, are passed as raw
+// ManifestBuilderValues and ManifestValues.
+package main
+
+import (
+	"flag"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	in := flag.String("in", "", "package definition (.rpd) file")
+	out := flag.String("out", "", "output file")
+	packageName := flag.String("package", "main", "package name of the generated file")
+	flag.Parse()
+	if *in == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	payload, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	definition, err := parsePackageDefinition(payload)
+	if err != nil {
+		log.Fatalf("parsing %s: %v", *in, err)
+	}
+
+	source, err := format.Source(newGenerator(*packageName, filepath.Base(*in)).generate(definition))
+	if err != nil {
+		log.Fatalf("formatting generated code: %v", err)
+	}
+	if err := os.WriteFile(*out, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,101 @@
+// Command rtmfmt formats manifest text, the .rtm files of transaction
+// manifests, in the canonical layout of manifesttext.Format, comments kept:
+//
+//	rtmfmt -l -w manifests
+//
+// A directory stands for the .rtm files under it. Without paths, rtmfmt
+// formats its standard input to its standard output.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/manifesttext"
+)
+
+func main() {
+	list := flag.Bool("l", false, "list the files whose formatting differs")
+	write := flag.Bool("w", false, "write the result to the files rather than to the standard output")
+	flag.Parse()
+	log.SetFlags(0)
+
+	if flag.NArg() == 0 {
+		text, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			log.Fatal(err)
+		}
+		formatted, err := manifesttext.Format(text)
+		if err != nil {
+			log.Fatalf("<standard input>:%s", syntaxError(err))
+		}
+		os.Stdout.Write(formatted)
+		return
+	}
+
+	failed := false
+	for _, path := range files(flag.Args()) {
+		text, err := os.ReadFile(path)
+		if err != nil {
+			log.Print(err)
+			failed = true
+			continue
+		}
+		formatted, err := manifesttext.Format(text)
+		if err != nil {
+			log.Printf("%s:%s", path, syntaxError(err))
+			failed = true
+			continue
+		}
+		if *list && !bytes.Equal(text, formatted) {
+			fmt.Println(path)
+		}
+		if *write {
+			if !bytes.Equal(text, formatted) {
+				if err := os.WriteFile(path, formatted, 0o644); err != nil {
+					log.Print(err)
+					failed = true
+				}
+			}
+		} else if !*list {
+			os.Stdout.Write(formatted)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// syntaxError formats a syntax error as line:column: reason.
+func syntaxError(err error) string {
+	if syntax, ok := err.(*manifesttext.SyntaxError); ok {
+		return fmt.Sprintf("%s: %s", syntax.Pos, syntax.Reason)
+	}
+	return err.Error()
+}
+
+// files returns the paths, the directories replaced by the .rtm files under
+// them.
+func files(paths []string) []string {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil || !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		filepath.WalkDir(path, func(path string, entry fs.DirEntry, err error) error {
+			if err == nil && !entry.IsDir() && filepath.Ext(path) == ".rtm" {
+				files = append(files, path)
+			}
+			return nil
+		})
+	}
+	return files
+}
@@ -0,0 +1,111 @@
+// Command rtmlint reports the mistakes manifesttext.Lint finds in manifest
+// text, the .rtm files of transaction manifests, such as unused buckets or
+// withdraws without guarantees, as path:line:column: rule: message:
+//
+//	rtmlint -disable missing-lock-fee manifests
+//
+// A directory stands for the .rtm files under it. rtmlint exits with status
+// 1 if it reports anything, and with status 2 on a usage error such as an
+// unknown rule.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/manifesttext"
+)
+
+func main() {
+	disable := flag.String("disable", "", "comma separated rules not to check")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: rtmlint [-disable rules] path...\n\nrules:\n")
+		for _, rule := range manifesttext.Rules {
+			fmt.Fprintf(flag.CommandLine.Output(), "  %s\n", rule)
+		}
+		fmt.Fprintln(flag.CommandLine.Output())
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetFlags(0)
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	disabled := map[manifesttext.Rule]bool{}
+	for _, name := range strings.Split(*disable, ",") {
+		rule := manifesttext.Rule(strings.TrimSpace(name))
+		if rule == "" {
+			continue
+		}
+		if !slices.Contains(manifesttext.Rules, rule) {
+			fmt.Fprintf(flag.CommandLine.Output(), "rtmlint: unknown rule %q\n", rule)
+			flag.Usage()
+			os.Exit(2)
+		}
+		disabled[rule] = true
+	}
+	var rules []manifesttext.Rule
+	for _, rule := range manifesttext.Rules {
+		if !disabled[rule] {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return
+	}
+
+	reported := false
+	for _, path := range files(flag.Args()) {
+		text, err := os.ReadFile(path)
+		if err != nil {
+			log.Print(err)
+			reported = true
+			continue
+		}
+		manifest, err := manifesttext.Parse(text)
+		if err != nil {
+			if syntax, ok := err.(*manifesttext.SyntaxError); ok {
+				fmt.Printf("%s:%s: syntax: %s\n", path, syntax.Pos, syntax.Reason)
+			} else {
+				log.Printf("%s: %v", path, err)
+			}
+			reported = true
+			continue
+		}
+		for _, diagnostic := range manifesttext.Lint(manifest, rules...) {
+			fmt.Printf("%s:%s\n", path, diagnostic)
+			reported = true
+		}
+	}
+	if reported {
+		os.Exit(1)
+	}
+}
+
+// files returns the paths, the directories replaced by the .rtm files under
+// them.
+func files(paths []string) []string {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil || !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		filepath.WalkDir(path, func(path string, entry fs.DirEntry, err error) error {
+			if err == nil && !entry.IsDir() && filepath.Ext(path) == ".rtm" {
+				files = append(files, path)
+			}
+			return nil
+		})
+	}
+	return files
+}
@@ -0,0 +1,120 @@
+// Command schemagen generates Go types from the types of an SBOR schema, such
+// as the schema of the events or the key-value stores of a blueprint, and
+// functions decoding Scrypto SBOR payloads of them:
+//
+//	//go:generate go run github.com/radixdlt/radix-engine-toolkit-go/v2/cmd/schemagen -in pool.schema -type 3 -type 7 -package pool -out pool_types.go
+//
+// The schema is read from its Scrypto SBOR encoding, the Schema of a Schema
+// in the radix_engine_toolkit_uniffi package, either raw or in hex. Every
+// type given by its index with -type is generated with the types it refers
+// to, as cmd/blueprintgen generates the types of a blueprint, along with a
+// function decoding its payloads with UnmarshalScryptoSborWithSchema: the
+// generated file embeds the schema, against which the payloads are validated
+// before being decoded, so that a payload of another type is reported by the
+// names of the schema.
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/internal/typegen"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/schema"
+)
+
+// typeIndices is the flag of the indices of the types to generate.
+type typeIndices []uint64
+
+func (indices *typeIndices) String() string {
+	return fmt.Sprint(*indices)
+}
+
+func (indices *typeIndices) Set(value string) error {
+	index, err := strconv.ParseUint(value, 0, 64)
+	if err != nil {
+		return err
+	}
+	*indices = append(*indices, index)
+	return nil
+}
+
+func main() {
+	in := flag.String("in", "", "schema file, raw or in hex")
+	out := flag.String("out", "", "output file")
+	packageName := flag.String("package", "main", "package name of the generated file")
+	var types typeIndices
+	flag.Var(&types, "type", "index of a type of the schema to generate, repeatable")
+	flag.Parse()
+	if *in == "" || *out == "" || len(types) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	payload, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if decoded, err := hex.DecodeString(strings.TrimSpace(string(payload))); err == nil {
+		payload = decoded
+	}
+	parsed, err := schema.Decode(payload)
+	if err != nil {
+		log.Fatalf("parsing %s: %v", *in, err)
+	}
+	for _, index := range types {
+		if _, ok := parsed.Type(schema.LocalTypeId{Index: index}); !ok {
+			log.Fatalf("%s has no type %d", *in, index)
+		}
+	}
+
+	source, err := format.Source(generate(*packageName, filepath.Base(*in), payload, parsed, types))
+	if err != nil {
+		log.Fatalf("formatting generated code: %v", err)
+	}
+	if err := os.WriteFile(*out, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(packageName, source string, payload []byte, parsed *schema.Schema, types []uint64) []byte {
+	g := typegen.New()
+	scope := &typegen.Scope{Schema: parsed, Owner: fmt.Sprintf("the %s schema", source)}
+	schemaVar := typegen.Identifier(strings.TrimSuffix(source, filepath.Ext(source)), false) + "Schema"
+
+	var body bytes.Buffer
+	for _, index := range types {
+		t := g.TypeOf(scope, schema.LocalTypeId{Index: index}, false)
+		name := t.Expr
+		if !token.IsIdentifier(name) || !token.IsExported(name) {
+			name = g.UniqueName(fmt.Sprintf("Type%d", index), "")
+			if strings.HasPrefix(t.Expr, "struct") {
+				g.Declare(fmt.Sprintf("// %s is the type %d of %s.\ntype %s %s\n\n", name, index, scope.Owner, name, t.Expr))
+				t.Expr = name
+			}
+		}
+		decoder := g.UniqueName("Decode"+name, "")
+		fmt.Fprintf(&body, "// %s decodes a Scrypto SBOR payload of the type %d of %s.\n", decoder, index, scope.Owner)
+		fmt.Fprintf(&body, "func %s(networkId uint8, data []byte) (%s, error) {\n", decoder, t.Expr)
+		fmt.Fprintf(&body, "\tvar output %s\n", t.Expr)
+		body.WriteString("\terr := radix.UnmarshalScryptoSborWithSchema(data, networkId, radix.Schema{\n")
+		fmt.Fprintf(&body, "\t\tLocalTypeId: radix.LocalTypeIdSchemaLocalIndex{Value: %d},\n", index)
+		fmt.Fprintf(&body, "\t\tSchema:      %s,\n", schemaVar)
+		body.WriteString("\t}, &output)\n")
+		body.WriteString("\treturn output, err\n}\n\n")
+	}
+
+	fmt.Fprintf(&body, "// %s is the Scrypto SBOR encoded schema the types are generated from.\n", schemaVar)
+	fmt.Fprintf(&body, "var %s = %s\n\n", schemaVar, typegen.ByteSlice(payload))
+
+	header := fmt.Sprintf("// Code generated by cmd/schemagen from %s. DO NOT EDIT.", source)
+	return g.File(header, packageName, body.Bytes())
+}
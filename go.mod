@@ -1,3 +1,5 @@
 module github.com/radixdlt/radix-engine-toolkit-go/v2
 
 go 1.22.1
+
+require github.com/decred/dcrd/dcrec/secp256k1/v4 v4.4.1
@@ -0,0 +1,142 @@
+// Package blake2b implements the unkeyed BLAKE2b hash function of RFC 7693
+// with a 256 bit digest, the hash function of the Radix engine.
+package blake2b
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Size is the length of a digest.
+const Size = 32
+
+// BlockSize is the length of the blocks the input is compressed in.
+const BlockSize = 128
+
+var iv = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+var sigma = [12][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+}
+
+// Digest is a running hash, written to with Write. The zero value is not
+// usable; create one with New.
+type Digest struct {
+	h      [8]uint64
+	t      [2]uint64
+	block  [BlockSize]byte
+	length int
+	size   int
+}
+
+// New returns a digest of Size bytes.
+func New() *Digest {
+	return newDigest(Size)
+}
+
+// newDigest returns a digest of size bytes, at most 64.
+func newDigest(size int) *Digest {
+	digest := &Digest{h: iv, size: size}
+	// The parameter block: digest length, no key, fanout and depth of 1.
+	digest.h[0] ^= 0x01010000 ^ uint64(size)
+	return digest
+}
+
+// Write adds data to the hash. It never fails.
+func (digest *Digest) Write(data []byte) (int, error) {
+	written := len(data)
+	for len(data) > 0 {
+		// The last block is compressed by Sum, with the finalization flag,
+		// so a full block is only compressed once more data follows it.
+		if digest.length == BlockSize {
+			digest.compress(BlockSize, false)
+			digest.length = 0
+		}
+		copied := copy(digest.block[digest.length:], data)
+		digest.length += copied
+		data = data[copied:]
+	}
+	return written, nil
+}
+
+// Sum returns the digest of the data written so far, leaving the running
+// hash unchanged.
+func (digest *Digest) Sum() [Size]byte {
+	return [Size]byte(digest.sum())
+}
+
+func (digest *Digest) sum() []byte {
+	final := *digest
+	clear(final.block[final.length:])
+	final.compress(final.length, true)
+	sum := make([]byte, final.size)
+	for index := range sum {
+		sum[index] = byte(final.h[index/8] >> (8 * (index % 8)))
+	}
+	return sum
+}
+
+// Sum256 returns the digest of data.
+func Sum256(data []byte) [Size]byte {
+	digest := New()
+	digest.Write(data)
+	return digest.Sum()
+}
+
+// compress mixes the block, whose first length bytes are data, into the
+// state.
+func (digest *Digest) compress(length int, last bool) {
+	var carry uint64
+	digest.t[0], carry = bits.Add64(digest.t[0], uint64(length), 0)
+	digest.t[1] += carry
+
+	var m [16]uint64
+	for index := range m {
+		m[index] = binary.LittleEndian.Uint64(digest.block[8*index:])
+	}
+	var v [16]uint64
+	copy(v[:8], digest.h[:])
+	copy(v[8:], iv[:])
+	v[12] ^= digest.t[0]
+	v[13] ^= digest.t[1]
+	if last {
+		v[14] = ^v[14]
+	}
+	g := func(a, b, c, d int, x, y uint64) {
+		v[a] += v[b] + x
+		v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+		v[a] += v[b] + y
+		v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+	}
+	for _, s := range sigma {
+		g(0, 4, 8, 12, m[s[0]], m[s[1]])
+		g(1, 5, 9, 13, m[s[2]], m[s[3]])
+		g(2, 6, 10, 14, m[s[4]], m[s[5]])
+		g(3, 7, 11, 15, m[s[6]], m[s[7]])
+		g(0, 5, 10, 15, m[s[8]], m[s[9]])
+		g(1, 6, 11, 12, m[s[10]], m[s[11]])
+		g(2, 7, 8, 13, m[s[12]], m[s[13]])
+		g(3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+	for index := range digest.h {
+		digest.h[index] ^= v[index] ^ v[index+8]
+	}
+}
@@ -0,0 +1,59 @@
+package blake2b
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestRFC7693(t *testing.T) {
+	// RFC 7693, appendix A: BLAKE2b-512("abc")
+	digest := newDigest(64)
+	digest.Write([]byte("abc"))
+	const expected = "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d1" +
+		"7d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"
+	if sum := hex.EncodeToString(digest.sum()); sum != expected {
+		t.Errorf("BLAKE2b-512(abc) = %s, want %s", sum, expected)
+	}
+}
+
+func TestSum256(t *testing.T) {
+	tests := []struct {
+		length int
+		sum    string
+	}{
+		{0, "0e5751c026e543b2e8ab2eb06099daa1d1e5df47778f7787faab45cdf12fe3a8"},
+		{3, "3d8c3d594928271f44aad7a04b177154806867bcf918e1549c0bc16f9da2b09b"},
+		{127, "f2fe67ff342e21b8f45e8f2e0bcd1d9243245d50ee6c78042e9c491388791c72"},
+		{128, "c3582f71ebb2be66fa5dd750f80baae97554f3b015663c8be377cfcb2488c1d1"},
+		{129, "f7f3c46ba2564ff4c4c162da1f5b605f9f1c4aa6a20652a9f9a337c1a2f5b9c9"},
+		{255, "d9ef0fc521b4266d16df662bec231bc2ec3989e7adeaf63169c295dc239dbbea"},
+		{256, "582f782226018ec33076bd8d1c42413530ac7e1126260ffc0f306ba3befc3f24"},
+		{1000, "b372d0608f720c8c3dd41e9c8eecb10143b41abe520b616607e754bf79c08331"},
+	}
+	for _, test := range tests {
+		data := make([]byte, test.length)
+		for index := range data {
+			data[index] = byte(index % 251)
+		}
+		if sum := Sum256(data); hex.EncodeToString(sum[:]) != test.sum {
+			t.Errorf("Sum256(%d bytes) = %x, want %s", test.length, sum, test.sum)
+		}
+
+		// Writing in pieces across block boundaries hashes the same.
+		digest := New()
+		for offset := 0; offset < len(data); offset += 37 {
+			digest.Write(data[offset:min(offset+37, len(data))])
+		}
+		if sum := digest.Sum(); hex.EncodeToString(sum[:]) != test.sum {
+			t.Errorf("Sum of %d bytes written in pieces = %x, want %s", test.length, sum, test.sum)
+		}
+	}
+
+	digest := New()
+	digest.Write([]byte("ab"))
+	digest.Sum()
+	digest.Write([]byte("c"))
+	if sum := digest.Sum(); hex.EncodeToString(sum[:]) != "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319" {
+		t.Errorf("Sum after an intermediate Sum = %x", sum)
+	}
+}
@@ -0,0 +1,326 @@
+// Command jsongen generates the JSON marshalling methods for the records,
+// enums and tagged unions declared in the uniffi generated bindings.
+//
+// The generated methods are thin wrappers around the reflection based codec
+// in radix_engine_toolkit_uniffi/json.go; this command only needs to know
+// which types exist and how the unions map onto their variants, both of which
+// it learns by parsing the bindings file.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type enumType struct {
+	name     string
+	variants []enumVariant
+}
+
+type enumVariant struct {
+	constant string
+	name     string
+}
+
+type unionType struct {
+	name     string
+	variants []string
+}
+
+type bindings struct {
+	records []string
+	enums   []*enumType
+	unions  []*unionType
+}
+
+func main() {
+	in := flag.String("in", "radix_engine_toolkit_uniffi.go", "uniffi generated bindings file")
+	out := flag.String("out", "radix_engine_toolkit_uniffi_json.go", "output file")
+	flag.Parse()
+
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, *in, nil, 0)
+	if err != nil {
+		log.Fatalf("parsing %s: %v", *in, err)
+	}
+	handwritten, err := handwrittenMarshalers(fileSet, filepath.Dir(*in), *in, *out)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := format.Source(generate(collect(file), handwritten))
+	if err != nil {
+		log.Fatalf("formatting generated code: %v", err)
+	}
+	if err := os.WriteFile(*out, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// handwrittenMarshalers returns the types of the package which already have
+// a JSON or text marshaller declared outside of the generated files.
+func handwrittenMarshalers(fileSet *token.FileSet, dir string, skip ...string) (map[string]bool, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]bool{}
+	for _, path := range paths {
+		if isSkipped(path, skip) || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fileSet, path, nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Recv == nil {
+				continue
+			}
+			switch function.Name.Name {
+			case "MarshalJSON", "MarshalText":
+				result[receiverName(function)] = true
+			}
+		}
+	}
+	return result, nil
+}
+
+func isSkipped(path string, skip []string) bool {
+	for _, candidate := range skip {
+		if filepath.Clean(path) == filepath.Clean(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func receiverName(function *ast.FuncDecl) string {
+	expr := function.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func collect(file *ast.File) *bindings {
+	specs := map[string]*ast.TypeSpec{}
+	var order []string
+	for _, decl := range file.Decls {
+		general, ok := decl.(*ast.GenDecl)
+		if !ok || general.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range general.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			specs[typeSpec.Name.Name] = typeSpec
+			order = append(order, typeSpec.Name.Name)
+		}
+	}
+
+	// Only types with a dedicated FfiConverterType converter are part of the
+	// interface definition; variant structs, errors and internals are not.
+	isBindingType := func(name string) bool {
+		_, ok := specs["FfiConverterType"+name]
+		return ok && !strings.HasPrefix(name, "RadixEngineToolkitError")
+	}
+
+	unionVariants := map[string][]string{}
+	enumVariants := map[string][]enumVariant{}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil || decl.Name.Name != "Write" {
+				continue
+			}
+			name := strings.TrimPrefix(receiverName(decl), "FfiConverterType")
+			if spec, ok := specs[name]; ok && isInterface(spec) {
+				unionVariants[name] = switchCases(decl)
+			}
+		case *ast.GenDecl:
+			if decl.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				value := spec.(*ast.ValueSpec)
+				ident, ok := value.Type.(*ast.Ident)
+				if !ok || len(value.Names) != 1 {
+					continue
+				}
+				constant := value.Names[0].Name
+				enumVariants[ident.Name] = append(enumVariants[ident.Name], enumVariant{
+					constant: constant,
+					name:     strings.TrimPrefix(constant, ident.Name),
+				})
+			}
+		}
+	}
+
+	result := &bindings{}
+	for _, name := range order {
+		if !isBindingType(name) {
+			continue
+		}
+		spec := specs[name]
+		switch {
+		case isInterface(spec):
+			if variants, ok := unionVariants[name]; ok {
+				result.unions = append(result.unions, &unionType{name: name, variants: variants})
+			}
+		case isStruct(spec):
+			if hasOnlyExportedFields(spec) {
+				result.records = append(result.records, name)
+			}
+		default:
+			if variants, ok := enumVariants[name]; ok {
+				result.enums = append(result.enums, &enumType{name: name, variants: variants})
+			}
+		}
+	}
+	return result
+}
+
+func isInterface(spec *ast.TypeSpec) bool {
+	_, ok := spec.Type.(*ast.InterfaceType)
+	return ok
+}
+
+func isStruct(spec *ast.TypeSpec) bool {
+	_, ok := spec.Type.(*ast.StructType)
+	return ok
+}
+
+func hasOnlyExportedFields(spec *ast.TypeSpec) bool {
+	for _, field := range spec.Type.(*ast.StructType).Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// switchCases returns the variant types listed, in order, by the type
+// switch of a union converter's Write method.
+func switchCases(function *ast.FuncDecl) []string {
+	var cases []string
+	ast.Inspect(function.Body, func(node ast.Node) bool {
+		clause, ok := node.(*ast.CaseClause)
+		if !ok {
+			return true
+		}
+		for _, expr := range clause.List {
+			if ident, ok := expr.(*ast.Ident); ok {
+				cases = append(cases, ident.Name)
+			}
+		}
+		return false
+	})
+	return cases
+}
+
+func generate(b *bindings, handwritten map[string]bool) []byte {
+	var buffer bytes.Buffer
+	p := func(format string, args ...any) {
+		fmt.Fprintf(&buffer, format, args...)
+		buffer.WriteByte('\n')
+	}
+
+	p("// Code generated by internal/cmd/jsongen. DO NOT EDIT.")
+	p("")
+	p("package radix_engine_toolkit_uniffi")
+	p("")
+	p("import (")
+	p("\t\"fmt\"")
+	p("\t\"reflect\"")
+	p(")")
+
+	for _, enum := range b.enums {
+		if handwritten[enum.name] {
+			continue
+		}
+		p("")
+		p("func (e %s) MarshalText() ([]byte, error) {", enum.name)
+		p("\tswitch e {")
+		for _, variant := range enum.variants {
+			p("\tcase %s:", variant.constant)
+			p("\t\treturn []byte(%q), nil", variant.name)
+		}
+		p("\t}")
+		p("\treturn nil, fmt.Errorf(\"invalid %s value %%d\", e)", enum.name)
+		p("}")
+		p("")
+		p("func (e *%s) UnmarshalText(text []byte) error {", enum.name)
+		p("\tswitch string(text) {")
+		for _, variant := range enum.variants {
+			p("\tcase %q:", variant.name)
+			p("\t\t*e = %s", variant.constant)
+		}
+		p("\tdefault:")
+		p("\t\treturn fmt.Errorf(\"invalid %s variant %%q\", text)", enum.name)
+		p("\t}")
+		p("\treturn nil")
+		p("}")
+	}
+
+	for _, record := range b.records {
+		if handwritten[record] {
+			continue
+		}
+		generateMarshalers(p, "r", record)
+	}
+
+	for _, union := range b.unions {
+		p("")
+		p("// %sFromJSON decodes a %s from its JSON encoding.", union.name, union.name)
+		p("func %sFromJSON(data []byte) (%s, error) {", union.name, union.name)
+		p("\tvar value %s", union.name)
+		p("\terr := unmarshalJSON(data, &value)")
+		p("\treturn value, err")
+		p("}")
+		for _, variant := range union.variants {
+			if handwritten[variant] {
+				continue
+			}
+			generateMarshalers(p, "e", variant)
+		}
+	}
+
+	p("")
+	p("var jsonUnions = map[reflect.Type][]jsonVariant{")
+	for _, union := range b.unions {
+		p("\treflect.TypeFor[%s](): {", union.name)
+		for _, variant := range union.variants {
+			p("\t\t{%q, reflect.TypeFor[%s]()},", strings.TrimPrefix(variant, union.name), variant)
+		}
+		p("\t},")
+	}
+	p("}")
+
+	return buffer.Bytes()
+}
+
+func generateMarshalers(p func(string, ...any), receiver, name string) {
+	p("")
+	p("func (%s %s) MarshalJSON() ([]byte, error) {", receiver, name)
+	p("\treturn marshalJSON(%s)", receiver)
+	p("}")
+	p("")
+	p("func (%s *%s) UnmarshalJSON(data []byte) error {", receiver, name)
+	p("\treturn unmarshalJSON(data, %s)", receiver)
+	p("}")
+}
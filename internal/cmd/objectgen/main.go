@@ -0,0 +1,206 @@
+// Command objectgen adapts the object runtime of the uniffi generated
+// bindings to the arenas of radix_engine_toolkit_uniffi/arena.go.
+//
+// The bindings file is rewritten in place, after uniffi-bindgen-go, so that:
+//
+//   - every object lifted from the native library is handed to trackObject,
+//     which records it in the arena scope of the calling goroutine;
+//   - the use of a destroyed object panics with a
+//     RadixEngineToolkitErrorObjectDestroyed error rather than a plain one;
+//   - the exported functions and methods which return an error recover that
+//     panic, through recoverObjectDestroyed, and return it as their error.
+//
+// Only the affected statements and result lists are edited, the rest of the
+// file is left byte for byte as generated. Running the command again on its
+// own output changes nothing.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	destroyedMessage = "object has already been destroyed"
+	destroyedError   = "NewRadixEngineToolkitErrorObjectDestroyed(debugName)"
+	trackStatement   = "trackObject(result)"
+	errorResult      = "_uniffiObjectErr"
+	recoverStatement = "defer recoverObjectDestroyed(&" + errorResult + ")"
+)
+
+// edit replaces the bytes of the source between start and end by text.
+type edit struct {
+	start, end int
+	text       string
+}
+
+func main() {
+	in := flag.String("in", "radix_engine_toolkit_uniffi.go", "uniffi generated bindings file")
+	flag.Parse()
+
+	source, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	patched, err := patch(*in, source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !bytes.Equal(patched, source) {
+		if err := os.WriteFile(*in, patched, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// patch returns source with the edits described in the package comment
+// applied.
+func patch(name string, source []byte) ([]byte, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, name, source, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", name, err)
+	}
+	offset := func(pos token.Pos) int { return fileSet.Position(pos).Offset }
+	text := func(node ast.Node) string { return string(source[offset(node.Pos()):offset(node.End())]) }
+
+	var edits []edit
+	guarded := false
+	for _, decl := range file.Decls {
+		function, ok := decl.(*ast.FuncDecl)
+		if !ok || function.Body == nil {
+			continue
+		}
+		switch receiver := receiverType(function); {
+		case receiver == "FfiObject" && function.Name.Name == "incrementPointer":
+			ast.Inspect(function.Body, func(node ast.Node) bool {
+				if argument := destroyedPanic(node); argument != nil {
+					edits = append(edits, edit{offset(argument.Pos()), offset(argument.End()), destroyedError})
+					guarded = true
+				}
+				return true
+			})
+		case strings.HasPrefix(receiver, "FfiConverter") && function.Name.Name == "Lift":
+			edits = append(edits, trackEdits(function, offset, text)...)
+		case function.Name.IsExported() && (function.Recv == nil || receiverName(function) == "_self"):
+			edits = append(edits, recoverEdits(function, offset, text)...)
+		}
+	}
+	if !guarded && !bytes.Contains(source, []byte(destroyedError)) {
+		return nil, fmt.Errorf("%s: FfiObject.incrementPointer does not panic with %q", name, destroyedMessage)
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	patched := bytes.Clone(source)
+	for _, edit := range edits {
+		patched = append(patched[:edit.start], append([]byte(edit.text), patched[edit.end:]...)...)
+	}
+	return patched, nil
+}
+
+// destroyedPanic returns the argument of node when node is the
+// panic(fmt.Errorf("%v object has already been destroyed", ...)) call of the
+// generated incrementPointer.
+func destroyedPanic(node ast.Node) ast.Expr {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !isIdent(call.Fun, "panic") {
+		return nil
+	}
+	errorf, ok := call.Args[0].(*ast.CallExpr)
+	if !ok || len(errorf.Args) == 0 {
+		return nil
+	}
+	selector, ok := errorf.Fun.(*ast.SelectorExpr)
+	if !ok || !isIdent(selector.X, "fmt") || selector.Sel.Name != "Errorf" {
+		return nil
+	}
+	literal, ok := errorf.Args[0].(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return nil
+	}
+	message, err := strconv.Unquote(literal.Value)
+	if err != nil || !strings.HasSuffix(message, destroyedMessage) {
+		return nil
+	}
+	return call.Args[0]
+}
+
+// trackEdits inserts a trackObject call after the finalizer of the object
+// lifted by an object converter.
+func trackEdits(function *ast.FuncDecl, offset func(token.Pos) int, text func(ast.Node) string) []edit {
+	var finalizer ast.Stmt
+	for _, statement := range function.Body.List {
+		switch source := text(statement); {
+		case source == trackStatement:
+			return nil
+		case strings.HasPrefix(source, "runtime.SetFinalizer(result,"):
+			finalizer = statement
+		}
+	}
+	if finalizer == nil {
+		return nil
+	}
+	end := offset(finalizer.End())
+	return []edit{{end, end, "\n\t" + trackStatement}}
+}
+
+// recoverEdits names the error result of function and defers
+// recoverObjectDestroyed at the start of its body.
+func recoverEdits(function *ast.FuncDecl, offset func(token.Pos) int, text func(ast.Node) string) []edit {
+	results := function.Type.Results
+	if results == nil || len(results.List) == 0 || !isIdent(results.List[len(results.List)-1].Type, "error") {
+		return nil
+	}
+	var names []string
+	for _, field := range results.List {
+		if len(field.Names) > 0 {
+			// Named results are never generated, so the function has been
+			// patched already.
+			return nil
+		}
+		names = append(names, "_ "+text(field.Type))
+	}
+	names[len(names)-1] = errorResult + " error"
+
+	start, end := offset(results.Pos()), offset(results.End())
+	lbrace := offset(function.Body.Lbrace) + 1
+	return []edit{
+		{lbrace, lbrace, "\n\t" + recoverStatement},
+		{start, end, "(" + strings.Join(names, ", ") + ")"},
+	}
+}
+
+func receiverType(function *ast.FuncDecl) string {
+	if function.Recv == nil || len(function.Recv.List) == 0 {
+		return ""
+	}
+	expr := function.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+func receiverName(function *ast.FuncDecl) string {
+	if names := function.Recv.List[0].Names; len(names) > 0 {
+		return names[0].Name
+	}
+	return ""
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
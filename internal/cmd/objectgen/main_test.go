@@ -0,0 +1,109 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const bindings = `package bindings
+
+func (ffiObject *FfiObject)incrementPointer(debugName string) unsafe.Pointer {
+	for {
+		counter := ffiObject.callCounter.Load()
+		if counter <= -1 {
+			panic(fmt.Errorf("%v object has already been destroyed", debugName))
+		}
+		if counter == math.MaxInt64 {
+			panic(fmt.Errorf("%v object call counter would overflow", debugName))
+		}
+	}
+}
+
+func NewDecimal(value string) (*Decimal, error) {
+	return nil, nil
+}
+
+func (_self *Decimal)AsStr() string {
+	return ""
+}
+
+func (_self *Decimal)Validate() error {
+	return nil
+}
+
+func (c FfiConverterTypeRadixEngineToolkitError) Lift(eb RustBufferI) error {
+	return nil
+}
+
+func (c FfiConverterDecimal) Lift(pointer unsafe.Pointer) *Decimal {
+	result := &Decimal {
+		newFfiObject(pointer, free),
+	}
+	runtime.SetFinalizer(result, (*Decimal).Destroy)
+	return result
+}
+`
+
+const patched = `package bindings
+
+func (ffiObject *FfiObject)incrementPointer(debugName string) unsafe.Pointer {
+	for {
+		counter := ffiObject.callCounter.Load()
+		if counter <= -1 {
+			panic(NewRadixEngineToolkitErrorObjectDestroyed(debugName))
+		}
+		if counter == math.MaxInt64 {
+			panic(fmt.Errorf("%v object call counter would overflow", debugName))
+		}
+	}
+}
+
+func NewDecimal(value string) (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
+	return nil, nil
+}
+
+func (_self *Decimal)AsStr() string {
+	return ""
+}
+
+func (_self *Decimal)Validate() (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
+	return nil
+}
+
+func (c FfiConverterTypeRadixEngineToolkitError) Lift(eb RustBufferI) error {
+	return nil
+}
+
+func (c FfiConverterDecimal) Lift(pointer unsafe.Pointer) *Decimal {
+	result := &Decimal {
+		newFfiObject(pointer, free),
+	}
+	runtime.SetFinalizer(result, (*Decimal).Destroy)
+	trackObject(result)
+	return result
+}
+`
+
+func TestPatch(t *testing.T) {
+	got, err := patch("bindings.go", []byte(bindings))
+	if err != nil {
+		t.Fatalf("patch = %v", err)
+	}
+	if string(got) != patched {
+		t.Errorf("patch =\n%s\nwant\n%s", got, patched)
+	}
+
+	again, err := patch("bindings.go", got)
+	if err != nil || string(again) != patched {
+		t.Errorf("patching the patched bindings = %v, changed: %v", err, string(again) != patched)
+	}
+}
+
+func TestPatchUnknownBindings(t *testing.T) {
+	source := strings.Replace(bindings, "has already been destroyed", "is gone", 1)
+	if _, err := patch("bindings.go", []byte(source)); err == nil {
+		t.Error("patch of bindings without the destroyed panic succeeded")
+	}
+}
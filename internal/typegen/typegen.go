@@ -0,0 +1,425 @@
+// Package typegen generates Go types from the types of SBOR schemas, for
+// cmd/blueprintgen and cmd/schemagen.
+//
+// Tuples become structs and enums become interfaces implemented by a struct
+// per variant, registered with RegisterManifestEnum, so that the generated
+// types encode with MarshalManifestValue and decode with UnmarshalScryptoSbor
+// and UnmarshalScryptoSborWithSchema. The types which have no Go counterpart,
+// such as Any, are raw ManifestBuilderValues and ManifestValues.
+package typegen
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/schema"
+)
+
+// Type is the Go type a schema type is generated as.
+type Type struct {
+	Expr       string
+	Comparable bool
+	// the type is a big.Int holding a U128
+	U128 bool
+	// the type is the pointer of an Option
+	Option bool
+}
+
+// Tag returns the manifest struct tag of a field of the type, with a leading
+// space, or "" if it needs none.
+func (t Type) Tag() string {
+	var options []string
+	if t.Option {
+		options = append(options, "option")
+	}
+	if t.U128 {
+		options = append(options, "u128")
+	}
+	if len(options) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" `manifest:%q`", strings.Join(options, ","))
+}
+
+// Raw returns the raw value type, which takes any value.
+func Raw(input bool) Type {
+	if input {
+		return Type{Expr: "radix.ManifestBuilderValue"}
+	}
+	return Type{Expr: "radix.ManifestValue"}
+}
+
+// Scope is a schema the types are generated from. Prefix is prepended to the
+// names of its types which clash with others, and Owner ends their doc
+// comments, e.g. "the Dex blueprint".
+type Scope struct {
+	Schema *schema.Schema
+	Prefix string
+	Owner  string
+}
+
+type typeKey struct {
+	scope *Scope
+	index uint64
+	input bool
+}
+
+// Generator generates the types of one Go file. The types are generated for
+// inputs, the arguments encoded into manifests, or outputs, the values
+// decoded from Scrypto SBOR, which differ in the raw and owned types.
+type Generator struct {
+	names        map[string]bool
+	types        map[typeKey]Type
+	declarations []string
+	enums        []string
+	imports      map[string]bool
+}
+
+func New() *Generator {
+	return &Generator{
+		names:   map[string]bool{},
+		types:   map[typeKey]Type{},
+		imports: map[string]bool{},
+	}
+}
+
+// Import adds a quoted import path, with its name if any, to the file.
+func (g *Generator) Import(path string) {
+	g.imports[path] = true
+}
+
+// File returns the source of the file: the header comment, the package
+// clause, the imports, the registration of the enums, body and the
+// declarations of the types.
+func (g *Generator) File(header, packageName string, body []byte) []byte {
+	var file bytes.Buffer
+	fmt.Fprintf(&file, "%s\n\n", header)
+	fmt.Fprintf(&file, "package %s\n\n", packageName)
+	file.WriteString("import (\n")
+	var standard, module []string
+	for path := range g.imports {
+		if strings.Contains(path, ".") {
+			module = append(module, path)
+		} else {
+			standard = append(standard, path)
+		}
+	}
+	module = append(module, `radix "github.com/radixdlt/radix-engine-toolkit-go/v2/radix_engine_toolkit_uniffi"`)
+	sort.Strings(standard)
+	sort.Strings(module)
+	for _, path := range standard {
+		fmt.Fprintf(&file, "\t%s\n", path)
+	}
+	file.WriteString("\n")
+	for _, path := range module {
+		fmt.Fprintf(&file, "\t%s\n", path)
+	}
+	file.WriteString(")\n\n")
+	if len(g.enums) > 0 {
+		file.WriteString("func init() {\n")
+		for _, enum := range g.enums {
+			file.WriteString("\t" + enum + "\n")
+		}
+		file.WriteString("}\n\n")
+	}
+	file.Write(body)
+	for _, declaration := range g.declarations {
+		file.WriteString(declaration)
+	}
+	return file.Bytes()
+}
+
+// TypeOf returns the type of a value of the schema type id refers to,
+// generating its declaration if needed.
+func (g *Generator) TypeOf(scope *Scope, id schema.LocalTypeId, input bool) Type {
+	return g.typeOf(scope, id, input, false)
+}
+
+// FieldType returns the type of a struct field, Options being pointers.
+func (g *Generator) FieldType(scope *Scope, id schema.LocalTypeId, input bool) Type {
+	return g.typeOf(scope, id, input, true)
+}
+
+// elementType returns the type of an array element or a map key or value,
+// which cannot take tag options.
+func (g *Generator) elementType(scope *Scope, id schema.LocalTypeId, input bool) Type {
+	t := g.typeOf(scope, id, input, false)
+	if t.U128 && input {
+		return Raw(input)
+	}
+	return t
+}
+
+func (g *Generator) typeOf(scope *Scope, id schema.LocalTypeId, input bool, field bool) Type {
+	if id.WellKnown {
+		return g.wellKnownType(id.Index, input)
+	}
+	t, ok := scope.Schema.Type(id)
+	if !ok {
+		return Raw(input)
+	}
+	switch t.Kind {
+	case schema.TypeKindBool, schema.TypeKindI8, schema.TypeKindI16, schema.TypeKindI32, schema.TypeKindI64,
+		schema.TypeKindI128, schema.TypeKindU8, schema.TypeKindU16, schema.TypeKindU32, schema.TypeKindU64,
+		schema.TypeKindU128, schema.TypeKindString:
+		// The basic type kinds and their well-known ids are numbered alike.
+		return g.wellKnownType(uint64(t.Kind), input)
+	case schema.TypeKindArray:
+		if isU8(scope.Schema, t.Element) {
+			return Type{Expr: "[]byte"}
+		}
+		element := g.elementType(scope, t.Element, input)
+		return Type{Expr: "[]" + element.Expr}
+	case schema.TypeKindMap:
+		key := g.elementType(scope, t.Key, input)
+		value := g.elementType(scope, t.Value, input)
+		if !key.Comparable {
+			return Raw(input)
+		}
+		return Type{Expr: fmt.Sprintf("map[%s]%s", key.Expr, value.Expr)}
+	case schema.TypeKindTuple:
+		return g.tupleType(scope, id.Index, input)
+	case schema.TypeKindEnum:
+		if some, ok := t.Option(); ok && field {
+			inner := g.typeOf(scope, some, input, false)
+			return Type{Expr: "*" + inner.Expr, U128: inner.U128, Option: true}
+		}
+		return g.enumType(scope, id.Index, input)
+	case schema.TypeKindCustom:
+		switch t.Custom {
+		case schema.CustomTypeKindReference:
+			return g.addressType()
+		case schema.CustomTypeKindOwn:
+			if !input {
+				return g.addressType()
+			}
+			switch t.OwnValidation {
+			case schema.OwnValidationIsBucket:
+				return Type{Expr: "radix.ManifestBuilderBucket", Comparable: true}
+			case schema.OwnValidationIsProof:
+				return Type{Expr: "radix.ManifestBuilderProof", Comparable: true}
+			case schema.OwnValidationIsGlobalAddressReservation:
+				return Type{Expr: "radix.ManifestBuilderAddressReservation", Comparable: true}
+			}
+			return g.addressType()
+		case schema.CustomTypeKindDecimal:
+			return Type{Expr: "radix.DecimalValue", Comparable: true}
+		case schema.CustomTypeKindPreciseDecimal:
+			return Type{Expr: "radix.PreciseDecimalValue", Comparable: true}
+		case schema.CustomTypeKindNonFungibleLocalId:
+			return Type{Expr: "radix.NonFungibleLocalId"}
+		}
+	}
+	return Raw(input)
+}
+
+func (g *Generator) wellKnownType(id uint64, input bool) Type {
+	switch id {
+	case schema.WellKnownBool:
+		return Type{Expr: "bool", Comparable: true}
+	case schema.WellKnownI8, schema.WellKnownI16, schema.WellKnownI32, schema.WellKnownI64:
+		return Type{Expr: fmt.Sprintf("int%d", 8<<(id-schema.WellKnownI8)), Comparable: true}
+	case schema.WellKnownU8, schema.WellKnownU16, schema.WellKnownU32, schema.WellKnownU64:
+		return Type{Expr: fmt.Sprintf("uint%d", 8<<(id-schema.WellKnownU8)), Comparable: true}
+	case schema.WellKnownI128, schema.WellKnownU128:
+		g.Import(`"math/big"`)
+		return Type{Expr: "*big.Int", U128: id == schema.WellKnownU128}
+	case schema.WellKnownString:
+		return Type{Expr: "string", Comparable: true}
+	case schema.WellKnownBytes:
+		return Type{Expr: "[]byte"}
+	case schema.WellKnownUnit:
+		return Type{Expr: "struct{}", Comparable: true}
+	case schema.WellKnownDecimal:
+		return Type{Expr: "radix.DecimalValue", Comparable: true}
+	case schema.WellKnownPreciseDecimal:
+		return Type{Expr: "radix.PreciseDecimalValue", Comparable: true}
+	case schema.WellKnownLocalId:
+		return Type{Expr: "radix.NonFungibleLocalId"}
+	}
+	switch {
+	case id >= schema.WellKnownReference && id < schema.WellKnownOwn:
+		return g.addressType()
+	case id >= schema.WellKnownOwn && id < schema.WellKnownDecimal:
+		switch {
+		case !input:
+		case id >= schema.WellKnownOwnBucket && id < schema.WellKnownOwnProof:
+			return Type{Expr: "radix.ManifestBuilderBucket", Comparable: true}
+		case id >= schema.WellKnownOwnProof && id < schema.WellKnownOwnProof+3:
+			return Type{Expr: "radix.ManifestBuilderProof", Comparable: true}
+		case id == schema.WellKnownOwnReservation:
+			return Type{Expr: "radix.ManifestBuilderAddressReservation", Comparable: true}
+		}
+		return g.addressType()
+	}
+	return Raw(input)
+}
+
+func (g *Generator) addressType() Type {
+	g.Import(`"github.com/radixdlt/radix-engine-toolkit-go/v2/address"`)
+	return Type{Expr: "address.Address", Comparable: true}
+}
+
+func isU8(s *schema.Schema, id schema.LocalTypeId) bool {
+	if id.WellKnown {
+		return id.Index == schema.WellKnownU8
+	}
+	t, ok := s.Type(id)
+	return ok && t.Kind == schema.TypeKindU8
+}
+
+func (g *Generator) tupleType(scope *Scope, index uint64, input bool) Type {
+	key := typeKey{scope, index, input}
+	if t, ok := g.types[key]; ok {
+		return t
+	}
+	t := scope.Schema.Types[index]
+	if t.Name == "" {
+		fields, comparable := g.structFields(scope, t.Fields, t.FieldNames, input)
+		return Type{Expr: "struct {\n" + fields + "}", Comparable: comparable}
+	}
+
+	name := g.UniqueName(Identifier(t.Name, true), scope.Prefix)
+	g.types[key] = Type{Expr: name}
+	fields, comparable := g.structFields(scope, t.Fields, t.FieldNames, input)
+	g.types[key] = Type{Expr: name, Comparable: comparable}
+	g.declarations = append(g.declarations, fmt.Sprintf(
+		"// %s is the %s type of %s.\ntype %s struct {\n%s}\n\n",
+		name, t.Name, scope.Owner, name, fields,
+	))
+	return g.types[key]
+}
+
+func (g *Generator) structFields(scope *Scope, fields []schema.LocalTypeId, names []string, input bool) (string, bool) {
+	var builder strings.Builder
+	comparable := true
+	used := map[string]bool{}
+	for index, field := range fields {
+		name := fmt.Sprintf("Field%d", index)
+		if len(names) == len(fields) && Identifier(names[index], true) != "" {
+			name = Identifier(names[index], true)
+		}
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+		t := g.FieldType(scope, field, input)
+		comparable = comparable && t.Comparable && !t.Option
+		fmt.Fprintf(&builder, "\t%s %s%s\n", name, t.Expr, t.Tag())
+	}
+	return builder.String(), comparable
+}
+
+func (g *Generator) enumType(scope *Scope, index uint64, input bool) Type {
+	key := typeKey{scope, index, input}
+	if t, ok := g.types[key]; ok {
+		return t
+	}
+	t := scope.Schema.Types[index]
+	baseName := t.Name
+	if baseName == "" {
+		baseName = fmt.Sprintf("Enum%d", index)
+	}
+	name := g.UniqueName(Identifier(baseName, true), scope.Prefix)
+	g.types[key] = Type{Expr: name}
+
+	var declaration strings.Builder
+	fmt.Fprintf(&declaration, "// %s is the %s enum of %s.\n", name, baseName, scope.Owner)
+	fmt.Fprintf(&declaration, "type %s interface {\n\tradix.ManifestEnum\n\tis%s()\n}\n\n", name, name)
+	variantNames := make([]string, len(t.Variants))
+	for position, variant := range t.Variants {
+		variantName := variant.Name
+		if variantName == "" {
+			variantName = fmt.Sprintf("Variant%d", variant.Discriminator)
+		}
+		variantType := g.UniqueName(name+Identifier(variantName, true), "")
+		variantNames[position] = variantType + "{}"
+		fields, _ := g.structFields(scope, variant.Fields, variant.FieldNames, input)
+		fmt.Fprintf(&declaration, "// %s is the %s variant of %s.\n", variantType, variantName, name)
+		fmt.Fprintf(&declaration, "type %s struct {\n%s}\n\n", variantType, fields)
+		fmt.Fprintf(&declaration, "func (%s) ManifestEnumDiscriminator() uint8 { return %d }\n\n", variantType, variant.Discriminator)
+		fmt.Fprintf(&declaration, "func (%s) is%s() {}\n\n", variantType, name)
+	}
+	g.declarations = append(g.declarations, declaration.String())
+	g.enums = append(g.enums, fmt.Sprintf("radix.RegisterManifestEnum[%s](%s)", name, strings.Join(variantNames, ", ")))
+	return g.types[key]
+}
+
+// Declare adds the declaration of a type to the file.
+func (g *Generator) Declare(declaration string) {
+	g.declarations = append(g.declarations, declaration)
+}
+
+// UniqueName returns name, or if it is taken, name prefixed with prefix or
+// suffixed with a number.
+func (g *Generator) UniqueName(name, prefix string) string {
+	if name == "" {
+		name = "Type"
+	}
+	candidates := []string{name}
+	if prefix != "" && !strings.HasPrefix(name, prefix) {
+		candidates = append(candidates, prefix+name)
+	}
+	for _, candidate := range candidates {
+		if !g.names[candidate] {
+			g.names[candidate] = true
+			return candidate
+		}
+	}
+	for number := 2; ; number++ {
+		candidate := fmt.Sprintf("%s%d", candidates[len(candidates)-1], number)
+		if !g.names[candidate] {
+			g.names[candidate] = true
+			return candidate
+		}
+	}
+}
+
+// ByteSlice returns the []byte literal of payload, sixteen bytes a line, to
+// embed a schema in the generated code.
+func ByteSlice(payload []byte) string {
+	var literal strings.Builder
+	literal.WriteString("[]byte{")
+	for index, b := range payload {
+		if index%16 == 0 {
+			literal.WriteString("\n\t")
+		} else {
+			literal.WriteString(" ")
+		}
+		fmt.Fprintf(&literal, "0x%02x,", b)
+	}
+	literal.WriteString("\n}")
+	return literal.String()
+}
+
+// Identifier converts a snake_case or CamelCase name into a Go identifier,
+// exported or not, e.g. "min_amount_out" into "MinAmountOut" or
+// "minAmountOut".
+func Identifier(name string, exported bool) string {
+	var builder strings.Builder
+	upper := exported
+	for _, r := range name {
+		switch {
+		case r == '_' || !(unicode.IsLetter(r) || unicode.IsDigit(r)):
+			upper = builder.Len() > 0 || exported
+		case upper:
+			builder.WriteRune(unicode.ToUpper(r))
+			upper = false
+		case builder.Len() == 0 && !exported:
+			builder.WriteRune(unicode.ToLower(r))
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	result := builder.String()
+	if result != "" && unicode.IsDigit([]rune(result)[0]) {
+		if exported {
+			return "X" + result
+		}
+		return "x" + result
+	}
+	return result
+}
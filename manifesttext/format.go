@@ -0,0 +1,167 @@
+package manifesttext
+
+import (
+	"strings"
+)
+
+// Format formats manifest text, returning a *SyntaxError if it is invalid.
+func Format(text []byte) ([]byte, error) {
+	manifest, err := Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return manifest.Format(), nil
+}
+
+// Format prints the manifest in the canonical layout, which formatting its
+// output again leaves as is:
+//
+//   - an instruction without arguments on a line, e.g. DROP_ALL_PROOFS;
+//   - otherwise its name, its arguments indented by four spaces on a line
+//     each and its semicolon on a line of its own;
+//   - a call whose arguments are literals or calls without arguments on a
+//     line, e.g. Address("...") or Map<String, U8>("a" => 1u8);
+//   - otherwise the call with its arguments on a line each, separated by
+//     commas and indented by four more spaces.
+//
+// Integers are printed without leading zeros and strings with the minimal
+// escapes. Comments are kept on the lines before or at the end of the line of
+// the instruction or value they are attached to, and at most one blank line
+// is kept between instructions.
+func (manifest *Manifest) Format() []byte {
+	p := &printer{}
+	for _, instruction := range manifest.Instructions {
+		if instruction.BlankLineBefore {
+			p.WriteString("\n")
+		}
+		p.comments(instruction.Comments, 0)
+		p.WriteString(instruction.Name)
+		if len(instruction.Args) > 0 || len(instruction.EndComments) > 0 {
+			p.WriteString("\n")
+			for _, arg := range instruction.Args {
+				p.comments(arg.Comments, 1)
+				p.indent(1)
+				p.value(arg, 1)
+				p.lineComment(arg.LineComment)
+				p.WriteString("\n")
+			}
+			p.comments(instruction.EndComments, 1)
+		}
+		p.WriteString(";")
+		p.lineComment(instruction.LineComment)
+		p.WriteString("\n")
+	}
+	if len(manifest.EndComments) > 0 && len(manifest.Instructions) > 0 {
+		p.WriteString("\n")
+	}
+	p.comments(manifest.EndComments, 0)
+	return []byte(p.String())
+}
+
+type printer struct {
+	strings.Builder
+}
+
+func (p *printer) indent(depth int) {
+	p.WriteString(strings.Repeat("    ", depth))
+}
+
+func (p *printer) comments(comments []Comment, depth int) {
+	for _, comment := range comments {
+		p.indent(depth)
+		p.WriteString("#" + comment.Text + "\n")
+	}
+}
+
+func (p *printer) lineComment(comment *Comment) {
+	if comment != nil {
+		p.WriteString(" #" + comment.Text)
+	}
+}
+
+// value prints a value starting on the current line, its closing
+// parenthesis indented by depth if it spans several lines.
+func (p *printer) value(value *Value, depth int) {
+	switch value.Kind {
+	case ValueString:
+		p.WriteString(quote(value.Text))
+	case ValueInteger:
+		integer, suffix, err := parseInteger(value.Text)
+		if err != nil {
+			p.WriteString(value.Text)
+		} else {
+			p.WriteString(integer.String() + suffix)
+		}
+	case ValueEntry:
+		p.value(value.Args[0], depth)
+		p.WriteString(" => ")
+		p.value(value.Args[1], depth)
+	case ValueCall:
+		p.WriteString(value.Text)
+		if len(value.TypeArgs) > 0 {
+			p.WriteString("<" + strings.Join(value.TypeArgs, ", ") + ">")
+		}
+		p.WriteString("(")
+		if inline(value) {
+			for index, arg := range value.Args {
+				if index > 0 {
+					p.WriteString(", ")
+				}
+				p.value(arg, depth)
+			}
+			p.WriteString(")")
+			return
+		}
+		p.WriteString("\n")
+		for index, arg := range value.Args {
+			p.comments(arg.Comments, depth+1)
+			p.indent(depth + 1)
+			p.value(arg, depth+1)
+			if index < len(value.Args)-1 {
+				p.WriteString(",")
+			}
+			p.lineComment(arg.LineComment)
+			p.WriteString("\n")
+		}
+		p.comments(value.EndComments, depth+1)
+		p.indent(depth)
+		p.WriteString(")")
+	case ValuePlaceholder:
+		p.WriteString("${" + value.Text + ": " + value.Type)
+		if len(value.TypeArgs) > 0 {
+			p.WriteString("<" + strings.Join(value.TypeArgs, ", ") + ">")
+		}
+		p.WriteString("}")
+	default:
+		p.WriteString(value.Text)
+	}
+}
+
+// inline reports whether a call is printed on a line: its arguments are
+// simple.
+func inline(value *Value) bool {
+	if len(value.EndComments) > 0 {
+		return false
+	}
+	for _, arg := range value.Args {
+		if !simple(arg) {
+			return false
+		}
+	}
+	return true
+}
+
+// simple reports whether a value is a literal, a call without arguments or
+// an entry of them, without comments.
+func simple(value *Value) bool {
+	if len(value.Comments) > 0 || value.LineComment != nil || len(value.EndComments) > 0 {
+		return false
+	}
+	switch value.Kind {
+	case ValueCall:
+		return len(value.Args) == 0
+	case ValueEntry:
+		return simple(value.Args[0]) && simple(value.Args[1])
+	}
+	return true
+}
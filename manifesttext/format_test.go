@@ -0,0 +1,79 @@
+package manifesttext
+
+import (
+	"testing"
+)
+
+const unformatted = `# fee
+CALL_METHOD Address("account_sim1") "lock_fee" Decimal("5");
+
+
+
+TAKE_ALL_FROM_WORKTOP Address("resource_sim1") Bucket("xrd") ; # take
+CALL_METHOD ${account: Address<GlobalAccount>} "deposit_batch" Array<Bucket>(Bucket("xrd"), Tuple(0012u8, "aA"), # inner
+Map<String, U8>("a" => 01u8)) Expression("ENTIRE_WORKTOP");
+DROP_ALL_PROOFS;
+# end`
+
+const formatted = `# fee
+CALL_METHOD
+    Address("account_sim1")
+    "lock_fee"
+    Decimal("5")
+;
+
+TAKE_ALL_FROM_WORKTOP
+    Address("resource_sim1")
+    Bucket("xrd")
+; # take
+CALL_METHOD
+    ${account: Address<GlobalAccount>}
+    "deposit_batch"
+    Array<Bucket>(
+        Bucket("xrd"),
+        Tuple(12u8, "aA"), # inner
+        Map<String, U8>("a" => 1u8)
+    )
+    Expression("ENTIRE_WORKTOP")
+;
+DROP_ALL_PROOFS;
+
+# end
+`
+
+func TestFormat(t *testing.T) {
+	output, err := Format([]byte(unformatted))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(output) != formatted {
+		t.Errorf("Format =\n%s\nwant\n%s", output, formatted)
+	}
+	if _, err := Format([]byte("A")); err == nil {
+		t.Error("Format of invalid text succeeded")
+	}
+}
+
+func TestFormatIdempotent(t *testing.T) {
+	for _, text := range []string{
+		unformatted,
+		formatted,
+		"",
+		"# only a comment\n",
+		"A;B;C 1u8;",
+		"A\n  # before the semicolon\n;",
+		"A Tuple(\n  # first\n  1u8,\n  2u8 # second\n  # after\n);",
+		`A "\u0001\t\"é" Enum<0u8>() Tuple(Tuple(Tuple()));`,
+		"A -0005i64 ${amount: Decimal} ${ids: Array<NonFungibleLocalId>};",
+	} {
+		once, err := Format([]byte(text))
+		if err != nil {
+			t.Errorf("Format(%q): %v", text, err)
+			continue
+		}
+		twice, err := Format(once)
+		if err != nil || string(twice) != string(once) {
+			t.Errorf("Format(Format(%q)) =\n%s\nwant\n%s", text, twice, once)
+		}
+	}
+}
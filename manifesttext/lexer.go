@@ -0,0 +1,263 @@
+package manifesttext
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Position is a position in manifest text, Line and Column counted from 1
+// and Column in bytes.
+type Position struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+func (pos Position) String() string {
+	return fmt.Sprintf("%d:%d", pos.Line, pos.Column)
+}
+
+// SyntaxError reports manifest text which cannot be parsed.
+type SyntaxError struct {
+	Pos    Position
+	Reason string
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("manifesttext: %s: %s", err.Pos, err.Reason)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenInteger
+	tokenString
+	tokenPunctuation
+	tokenComment
+)
+
+type token struct {
+	kind tokenKind
+	// text is the text of the token, the content of a string and the text
+	// after the # of a comment.
+	text string
+	pos  Position
+	// lines is the number of line breaks between the previous token and
+	// this one.
+	lines int
+}
+
+type lexer struct {
+	text   string
+	offset int
+	line   int
+	column int
+}
+
+func (l *lexer) position() Position {
+	return Position{Offset: l.offset, Line: l.line, Column: l.column}
+}
+
+func (l *lexer) advance(length int) {
+	for _, b := range []byte(l.text[l.offset : l.offset+length]) {
+		if b == '\n' {
+			l.line++
+			l.column = 1
+		} else {
+			l.column++
+		}
+	}
+	l.offset += length
+}
+
+func (l *lexer) errorf(pos Position, format string, args ...any) error {
+	return &SyntaxError{Pos: pos, Reason: fmt.Sprintf(format, args...)}
+}
+
+// tokens splits text into tokens, comments included, ending with an EOF
+// token.
+func tokens(text string) ([]token, error) {
+	l := &lexer{text: text, line: 1, column: 1}
+	var result []token
+	for {
+		lines := 0
+		for l.offset < len(l.text) && strings.ContainsRune(" \t\r\n", rune(l.text[l.offset])) {
+			if l.text[l.offset] == '\n' {
+				lines++
+			}
+			l.advance(1)
+		}
+		pos := l.position()
+		if l.offset == len(l.text) {
+			return append(result, token{kind: tokenEOF, pos: pos, lines: lines}), nil
+		}
+		t, err := l.token()
+		if err != nil {
+			return nil, err
+		}
+		t.pos, t.lines = pos, lines
+		result = append(result, t)
+	}
+}
+
+func (l *lexer) token() (token, error) {
+	pos := l.position()
+	rest := l.text[l.offset:]
+	c := rest[0]
+	switch {
+	case c == '#':
+		length := strings.IndexByte(rest, '\n')
+		if length < 0 {
+			length = len(rest)
+		}
+		l.advance(length)
+		return token{kind: tokenComment, text: strings.TrimRight(rest[1:length], " \t\r")}, nil
+	case c == '"':
+		return l.string()
+	case c == '-' || isDigit(c):
+		length := 1
+		for length < len(rest) && isDigit(rest[length]) {
+			length++
+		}
+		if c == '-' && length == 1 {
+			return token{}, l.errorf(pos, "expected a digit after -")
+		}
+		for length < len(rest) && isIdentifierByte(rest[length]) {
+			length++
+		}
+		l.advance(length)
+		return token{kind: tokenInteger, text: rest[:length]}, nil
+	case isIdentifierByte(c):
+		length := 1
+		for length < len(rest) && isIdentifierByte(rest[length]) {
+			length++
+		}
+		l.advance(length)
+		return token{kind: tokenIdentifier, text: rest[:length]}, nil
+	}
+	for _, punctuation := range []string{"=>", "::", "${", "}", ":", "(", ")", "<", ">", ",", ";"} {
+		if strings.HasPrefix(rest, punctuation) {
+			l.advance(len(punctuation))
+			return token{kind: tokenPunctuation, text: punctuation}, nil
+		}
+	}
+	r, _ := utf8.DecodeRuneInString(rest)
+	return token{}, l.errorf(pos, "unexpected character %q", r)
+}
+
+// string reads a string literal, with the escapes of JSON strings.
+func (l *lexer) string() (token, error) {
+	start := l.position()
+	l.advance(1)
+	var builder strings.Builder
+	for {
+		if l.offset == len(l.text) {
+			return token{}, l.errorf(start, "unterminated string")
+		}
+		pos := l.position()
+		r, size := utf8.DecodeRuneInString(l.text[l.offset:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			return token{}, l.errorf(pos, "invalid UTF-8")
+		case r == '"':
+			l.advance(1)
+			return token{kind: tokenString, text: builder.String()}, nil
+		case r == '\n':
+			return token{}, l.errorf(start, "unterminated string")
+		case r != '\\':
+			builder.WriteRune(r)
+			l.advance(size)
+			continue
+		}
+		if l.offset+1 == len(l.text) {
+			return token{}, l.errorf(start, "unterminated string")
+		}
+		escape := l.text[l.offset+1]
+		if unescaped, ok := escapes[escape]; ok {
+			builder.WriteByte(unescaped)
+			l.advance(2)
+			continue
+		}
+		if escape != 'u' {
+			return token{}, l.errorf(pos, "invalid escape \\%c", escape)
+		}
+		r, length, ok := unicodeEscape(l.text[l.offset:])
+		if !ok {
+			return token{}, l.errorf(pos, "invalid unicode escape")
+		}
+		builder.WriteRune(r)
+		l.advance(length)
+	}
+}
+
+var escapes = map[byte]byte{'"': '"', '\\': '\\', '/': '/', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t'}
+
+// unicodeEscape decodes the \uXXXX escape, or surrogate pair of escapes, at
+// the start of text, returning its length.
+func unicodeEscape(text string) (rune, int, bool) {
+	hex := func(text string) (rune, bool) {
+		if len(text) < 6 || text[:2] != `\u` {
+			return 0, false
+		}
+		value, err := strconv.ParseUint(text[2:6], 16, 16)
+		return rune(value), err == nil
+	}
+	r, ok := hex(text)
+	if !ok {
+		return 0, 0, false
+	}
+	if !utf16.IsSurrogate(r) {
+		return r, 6, true
+	}
+	low, ok := hex(text[6:])
+	if !ok {
+		return 0, 0, false
+	}
+	r = utf16.DecodeRune(r, low)
+	return r, 12, r != utf8.RuneError
+}
+
+// quote returns the string literal of s.
+func quote(s string) string {
+	var builder strings.Builder
+	builder.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			builder.WriteString(`\"`)
+		case '\\':
+			builder.WriteString(`\\`)
+		case '\b':
+			builder.WriteString(`\b`)
+		case '\f':
+			builder.WriteString(`\f`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\t':
+			builder.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&builder, `\u%04x`, r)
+			} else {
+				builder.WriteRune(r)
+			}
+		}
+	}
+	builder.WriteByte('"')
+	return builder.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierByte(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
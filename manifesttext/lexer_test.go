@@ -0,0 +1,106 @@
+package manifesttext
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	text := "CALL_METHOD # comment \n  Address(\"a\\\"b\\u00e9\\ud83d\\ude00\") -5i32 ${x: U8} Map<String, U8>(\"k\" => 1u8) Enum<Metadata::String>();"
+	expected := []struct {
+		kind   tokenKind
+		text   string
+		line   int
+		column int
+		lines  int
+	}{
+		{tokenIdentifier, "CALL_METHOD", 1, 1, 0},
+		{tokenComment, " comment", 1, 13, 0},
+		{tokenIdentifier, "Address", 2, 3, 1},
+		{tokenPunctuation, "(", 2, 10, 0},
+		{tokenString, "a\"b\u00e9\U0001f600", 2, 11, 0},
+		{tokenPunctuation, ")", 2, 35, 0},
+		{tokenInteger, "-5i32", 2, 37, 0},
+		{tokenPunctuation, "${", 2, 43, 0},
+		{tokenIdentifier, "x", 2, 45, 0},
+		{tokenPunctuation, ":", 2, 46, 0},
+		{tokenIdentifier, "U8", 2, 48, 0},
+		{tokenPunctuation, "}", 2, 50, 0},
+		{tokenIdentifier, "Map", 2, 52, 0},
+		{tokenPunctuation, "<", 2, 55, 0},
+		{tokenIdentifier, "String", 2, 56, 0},
+		{tokenPunctuation, ",", 2, 62, 0},
+		{tokenIdentifier, "U8", 2, 64, 0},
+		{tokenPunctuation, ">", 2, 66, 0},
+		{tokenPunctuation, "(", 2, 67, 0},
+		{tokenString, "k", 2, 68, 0},
+		{tokenPunctuation, "=>", 2, 72, 0},
+		{tokenInteger, "1u8", 2, 75, 0},
+		{tokenPunctuation, ")", 2, 78, 0},
+		{tokenIdentifier, "Enum", 2, 80, 0},
+		{tokenPunctuation, "<", 2, 84, 0},
+		{tokenIdentifier, "Metadata", 2, 85, 0},
+		{tokenPunctuation, "::", 2, 93, 0},
+		{tokenIdentifier, "String", 2, 95, 0},
+		{tokenPunctuation, ">", 2, 101, 0},
+		{tokenPunctuation, "(", 2, 102, 0},
+		{tokenPunctuation, ")", 2, 103, 0},
+		{tokenPunctuation, ";", 2, 104, 0},
+		{tokenEOF, "", 2, 105, 0},
+	}
+	tokens, err := tokens(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != len(expected) {
+		t.Fatalf("%d tokens, want %d: %v", len(tokens), len(expected), tokens)
+	}
+	for index, token := range tokens {
+		want := expected[index]
+		if token.kind != want.kind || token.text != want.text || token.pos.Line != want.line || token.pos.Column != want.column || token.lines != want.lines {
+			t.Errorf("token %d = %d %q at %s after %d lines, want %d %q at %d:%d after %d lines",
+				index, token.kind, token.text, token.pos, token.lines, want.kind, want.text, want.line, want.column, want.lines)
+		}
+	}
+}
+
+func TestTokensInvalid(t *testing.T) {
+	tests := []struct {
+		text   string
+		pos    string
+		reason string
+	}{
+		{`"abc`, "1:1", "unterminated string"},
+		{"\"ab\ncd\"", "1:1", "unterminated string"},
+		{`"\x"`, "1:2", `invalid escape \x`},
+		{`"\u12"`, "1:2", "invalid unicode escape"},
+		{`"\ud83d"`, "1:2", "invalid unicode escape"},
+		{"\"\xff\"", "1:2", "invalid UTF-8"},
+		{"A -;", "1:3", "expected a digit after -"},
+		{"A\n  @;", "2:3", `unexpected character '@'`},
+	}
+	for _, test := range tests {
+		_, err := tokens(test.text)
+		var syntaxError *SyntaxError
+		if !errors.As(err, &syntaxError) || syntaxError.Pos.String() != test.pos || syntaxError.Reason != test.reason {
+			t.Errorf("tokens(%q) = %v, want %s: %s", test.text, err, test.pos, test.reason)
+		}
+	}
+}
+
+func TestQuote(t *testing.T) {
+	for text, quoted := range map[string]string{
+		"plain":           `"plain"`,
+		"a\"b\\c":         `"a\"b\\c"`,
+		"\b\f\n\r\t":      `"\b\f\n\r\t"`,
+		"\x01é\U0001f600": "\"\\u0001é\U0001f600\"",
+	} {
+		if quote(text) != quoted {
+			t.Errorf("quote(%q) = %s, want %s", text, quote(text), quoted)
+		}
+		tokens, err := tokens(quoted)
+		if err != nil || tokens[0].text != text {
+			t.Errorf("tokens(%s) = %v, %v", quoted, tokens, err)
+		}
+	}
+}
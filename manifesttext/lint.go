@@ -0,0 +1,278 @@
+package manifesttext
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Rule is a check of Lint.
+type Rule string
+
+const (
+	// RuleUnusedBucket reports a bucket which no instruction uses after
+	// taking it from the worktop.
+	RuleUnusedBucket Rule = "unused-bucket"
+	// RuleUndroppedProof reports a proof which is neither dropped, pushed to
+	// the auth zone nor passed to a call after being created.
+	RuleUndroppedProof Rule = "undropped-proof"
+	// RuleMissingLockFee reports a manifest which locks no fee from an
+	// account, unless it is a subintent, which yields to its parent.
+	RuleMissingLockFee Rule = "missing-lock-fee"
+	// RuleUnguardedWithdraw reports a withdraw from an account followed by a
+	// call which may return resources, a function or a method of another
+	// component, with no assertion guaranteeing what it returns: an
+	// assertion of the worktop or a bucket after it, or of the returns of
+	// the call right before it.
+	RuleUnguardedWithdraw Rule = "unguarded-withdraw"
+	// RuleDeprecatedInstruction reports the instruction names of older
+	// manifest versions which have been renamed.
+	RuleDeprecatedInstruction Rule = "deprecated-instruction"
+)
+
+// Rules are the rules of Lint.
+var Rules = []Rule{RuleUnusedBucket, RuleUndroppedProof, RuleMissingLockFee, RuleUnguardedWithdraw, RuleDeprecatedInstruction}
+
+// Diagnostic is a problem reported by Lint.
+type Diagnostic struct {
+	Pos     Position
+	Rule    Rule
+	Message string
+}
+
+func (diagnostic Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s: %s", diagnostic.Pos, diagnostic.Rule, diagnostic.Message)
+}
+
+// deprecatedInstructions are the renamed instruction names and their
+// current names.
+var deprecatedInstructions = map[string]string{
+	"CLEAR_AUTH_ZONE":                       "DROP_AUTH_ZONE_PROOFS",
+	"CLEAR_SIGNATURE_PROOFS":                "DROP_AUTH_ZONE_SIGNATURE_PROOFS",
+	"CALL_ACCESS_RULES_METHOD":              "CALL_ROLE_ASSIGNMENT_METHOD",
+	"TAKE_FROM_WORKTOP_BY_AMOUNT":           "TAKE_FROM_WORKTOP",
+	"TAKE_FROM_WORKTOP_BY_IDS":              "TAKE_NON_FUNGIBLES_FROM_WORKTOP",
+	"ASSERT_WORKTOP_CONTAINS_BY_AMOUNT":     "ASSERT_WORKTOP_CONTAINS",
+	"ASSERT_WORKTOP_CONTAINS_BY_IDS":        "ASSERT_WORKTOP_CONTAINS_NON_FUNGIBLES",
+	"CREATE_PROOF_FROM_AUTH_ZONE":           "CREATE_PROOF_FROM_AUTH_ZONE_OF_ALL",
+	"CREATE_PROOF_FROM_AUTH_ZONE_BY_AMOUNT": "CREATE_PROOF_FROM_AUTH_ZONE_OF_AMOUNT",
+	"CREATE_PROOF_FROM_AUTH_ZONE_BY_IDS":    "CREATE_PROOF_FROM_AUTH_ZONE_OF_NON_FUNGIBLES",
+	"CREATE_PROOF_FROM_BUCKET":              "CREATE_PROOF_FROM_BUCKET_OF_ALL",
+}
+
+// Lint checks the manifest with the rules, or all the Rules if none is
+// given, returning the diagnostics in the order of their positions.
+func Lint(manifest *Manifest, rules ...Rule) []Diagnostic {
+	if len(rules) == 0 {
+		rules = Rules
+	}
+	var diagnostics []Diagnostic
+	for _, rule := range rules {
+		switch rule {
+		case RuleUnusedBucket:
+			diagnostics = append(diagnostics, lintUnusedBuckets(manifest)...)
+		case RuleUndroppedProof:
+			diagnostics = append(diagnostics, lintUndroppedProofs(manifest)...)
+		case RuleMissingLockFee:
+			diagnostics = append(diagnostics, lintMissingLockFee(manifest)...)
+		case RuleUnguardedWithdraw:
+			diagnostics = append(diagnostics, lintUnguardedWithdraws(manifest)...)
+		case RuleDeprecatedInstruction:
+			for _, instruction := range manifest.Instructions {
+				if current, ok := deprecatedInstructions[instruction.Name]; ok {
+					diagnostics = append(diagnostics, Diagnostic{
+						Pos:     instruction.Pos,
+						Rule:    rule,
+						Message: fmt.Sprintf("%s is deprecated, use %s", instruction.Name, current),
+					})
+				}
+			}
+		}
+	}
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		return diagnostics[i].Pos.Offset < diagnostics[j].Pos.Offset
+	})
+	return diagnostics
+}
+
+// declared returns the value naming the bucket or proof an instruction
+// creates, its last argument, e.g. Bucket("xrd") for TAKE_ALL_FROM_WORKTOP.
+func declared(instruction *Instruction, kind string) (*Value, string, bool) {
+	if len(instruction.Args) == 0 {
+		return nil, "", false
+	}
+	last := instruction.Args[len(instruction.Args)-1]
+	name, ok := named(last, kind)
+	return last, name, ok
+}
+
+// named returns the name of a named value, e.g. "xrd" for Bucket("xrd").
+func named(value *Value, kind string) (string, bool) {
+	if value.Kind != ValueCall || value.Text != kind || len(value.Args) != 1 {
+		return "", false
+	}
+	arg := value.Args[0]
+	if arg.Kind != ValueString && arg.Kind != ValueInteger {
+		return "", false
+	}
+	return arg.Text, true
+}
+
+// uses reports whether the values refer to the named value of the kind.
+func uses(values []*Value, kind, name string) bool {
+	for _, value := range values {
+		if n, ok := named(value, kind); ok && n == name {
+			return true
+		}
+		if uses(value.Args, kind, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func createsBucket(instruction *Instruction) bool {
+	return strings.HasPrefix(instruction.Name, "TAKE_")
+}
+
+func createsProof(instruction *Instruction) bool {
+	return strings.HasPrefix(instruction.Name, "CREATE_PROOF_FROM_") ||
+		instruction.Name == "POP_FROM_AUTH_ZONE" || instruction.Name == "CLONE_PROOF"
+}
+
+func lintUnusedBuckets(manifest *Manifest) []Diagnostic {
+	var diagnostics []Diagnostic
+	for index, instruction := range manifest.Instructions {
+		if !createsBucket(instruction) {
+			continue
+		}
+		value, name, ok := declared(instruction, "Bucket")
+		if !ok {
+			continue
+		}
+		used := false
+		for _, later := range manifest.Instructions[index+1:] {
+			if used = uses(later.Args, "Bucket", name); used {
+				break
+			}
+		}
+		if !used {
+			diagnostics = append(diagnostics, Diagnostic{
+				Pos:     value.Pos,
+				Rule:    RuleUnusedBucket,
+				Message: fmt.Sprintf("bucket %q is never used", name),
+			})
+		}
+	}
+	return diagnostics
+}
+
+func lintUndroppedProofs(manifest *Manifest) []Diagnostic {
+	var diagnostics []Diagnostic
+	for index, instruction := range manifest.Instructions {
+		if !createsProof(instruction) {
+			continue
+		}
+		value, name, ok := declared(instruction, "Proof")
+		if !ok {
+			continue
+		}
+		dropped := false
+		for _, later := range manifest.Instructions[index+1:] {
+			switch {
+			case later.Name == "DROP_ALL_PROOFS" || later.Name == "DROP_NAMED_PROOFS":
+				dropped = true
+			case later.Name == "DROP_PROOF" || later.Name == "PUSH_TO_AUTH_ZONE" || strings.HasPrefix(later.Name, "CALL_"):
+				dropped = uses(later.Args, "Proof", name)
+			}
+			if dropped {
+				break
+			}
+		}
+		if !dropped {
+			diagnostics = append(diagnostics, Diagnostic{
+				Pos:     value.Pos,
+				Rule:    RuleUndroppedProof,
+				Message: fmt.Sprintf("proof %q is never dropped", name),
+			})
+		}
+	}
+	return diagnostics
+}
+
+// method returns the method name of a CALL_METHOD.
+func method(instruction *Instruction) (string, bool) {
+	if instruction.Name != "CALL_METHOD" || len(instruction.Args) < 2 || instruction.Args[1].Kind != ValueString {
+		return "", false
+	}
+	return instruction.Args[1].Text, true
+}
+
+// callsAccount reports whether a CALL_METHOD calls a method of an account,
+// given by its address or by a placeholder of account addresses only.
+func callsAccount(instruction *Instruction) bool {
+	if _, ok := method(instruction); !ok {
+		return false
+	}
+	receiver := instruction.Args[0]
+	if receiver.Kind == ValuePlaceholder {
+		if receiver.Type != "Address" || len(receiver.TypeArgs) == 0 {
+			return false
+		}
+		for _, entityType := range receiver.TypeArgs {
+			if !strings.HasPrefix(entityType, "Global") || !strings.HasSuffix(entityType, "Account") {
+				return false
+			}
+		}
+		return true
+	}
+	return receiver.Kind == ValueCall && receiver.Text == "Address" && len(receiver.Args) == 1 &&
+		receiver.Args[0].Kind == ValueString && strings.HasPrefix(receiver.Args[0].Text, "account_")
+}
+
+func lintMissingLockFee(manifest *Manifest) []Diagnostic {
+	for _, instruction := range manifest.Instructions {
+		if instruction.Name == "YIELD_TO_PARENT" {
+			return nil
+		}
+		if name, ok := method(instruction); ok && (strings.HasPrefix(name, "lock_fee") || strings.HasPrefix(name, "lock_contingent_fee")) {
+			return nil
+		}
+	}
+	pos := Position{Line: 1, Column: 1}
+	if len(manifest.Instructions) > 0 {
+		pos = manifest.Instructions[0].Pos
+	}
+	return []Diagnostic{{Pos: pos, Rule: RuleMissingLockFee, Message: "no instruction locks a fee"}}
+}
+
+func lintUnguardedWithdraws(manifest *Manifest) []Diagnostic {
+	var diagnostics []Diagnostic
+	for index, instruction := range manifest.Instructions {
+		if name, _ := method(instruction); !callsAccount(instruction) || !strings.Contains(name, "withdraw") {
+			continue
+		}
+		var call *Instruction
+		guarded := false
+		for offset, later := range manifest.Instructions[index+1:] {
+			switch {
+			case call == nil && (later.Name == "CALL_FUNCTION" || later.Name == "CALL_METHOD" && !callsAccount(later)):
+				call = later
+				// An assertion of the returns of the call comes before it.
+				previous := manifest.Instructions[index+offset]
+				guarded = strings.HasPrefix(previous.Name, "ASSERT_NEXT_CALL_RETURNS_")
+			case call != nil && strings.HasPrefix(later.Name, "ASSERT_"):
+				guarded = true
+			}
+		}
+		if call != nil && !guarded {
+			diagnostics = append(diagnostics, Diagnostic{
+				Pos:  instruction.Pos,
+				Rule: RuleUnguardedWithdraw,
+				Message: fmt.Sprintf("the resources withdrawn here go to the %s at %s with no assertion guaranteeing what it returns",
+					call.Name, call.Pos),
+			})
+		}
+	}
+	return diagnostics
+}
@@ -0,0 +1,108 @@
+package manifesttext
+
+import (
+	"slices"
+	"testing"
+)
+
+func lintStrings(t *testing.T, text string, rules ...Rule) []string {
+	t.Helper()
+	manifest, err := Parse([]byte(text))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", text, err)
+	}
+	var diagnostics []string
+	for _, diagnostic := range Lint(manifest, rules...) {
+		diagnostics = append(diagnostics, diagnostic.String())
+	}
+	return diagnostics
+}
+
+func TestLintRules(t *testing.T) {
+	tests := []struct {
+		rule Rule
+		text string
+		want []string
+	}{
+		{RuleUnusedBucket, `TAKE_ALL_FROM_WORKTOP Address("resource_sim1") Bucket("xrd");`,
+			[]string{`1:48: unused-bucket: bucket "xrd" is never used`}},
+		{RuleUnusedBucket, "TAKE_ALL_FROM_WORKTOP Address(\"resource_sim1\") Bucket(\"xrd\");\n" +
+			`CALL_METHOD Address("account_sim1") "deposit" Bucket("xrd");`, nil},
+		{RuleUnusedBucket, "TAKE_ALL_FROM_WORKTOP Address(\"resource_sim1\") Bucket(\"xrd\");\n" +
+			`CALL_METHOD Address("account_sim1") "deposit" Bucket("other");`,
+			[]string{`1:48: unused-bucket: bucket "xrd" is never used`}},
+		{RuleUnusedBucket, "RETURN_TO_WORKTOP Bucket(\"xrd\");", nil},
+
+		{RuleUndroppedProof, `CREATE_PROOF_FROM_AUTH_ZONE_OF_ALL Address("resource_sim1") Proof("badge");`,
+			[]string{`1:61: undropped-proof: proof "badge" is never dropped`}},
+		{RuleUndroppedProof, "CREATE_PROOF_FROM_AUTH_ZONE_OF_ALL Address(\"resource_sim1\") Proof(\"badge\");\n" +
+			"DROP_PROOF Proof(\"badge\");", nil},
+		{RuleUndroppedProof, "CREATE_PROOF_FROM_AUTH_ZONE_OF_ALL Address(\"resource_sim1\") Proof(\"badge\");\n" +
+			"DROP_ALL_PROOFS;", nil},
+		{RuleUndroppedProof, "POP_FROM_AUTH_ZONE Proof(\"badge\");\n" +
+			"PUSH_TO_AUTH_ZONE Proof(\"badge\");", nil},
+		{RuleUndroppedProof, "POP_FROM_AUTH_ZONE Proof(\"badge\");\n" +
+			`CALL_METHOD Address("component_sim1") "use" Proof("badge");`, nil},
+		{RuleUndroppedProof, "POP_FROM_AUTH_ZONE Proof(\"badge\");\n" +
+			"DROP_PROOF Proof(\"other\");",
+			[]string{`1:20: undropped-proof: proof "badge" is never dropped`}},
+
+		{RuleMissingLockFee, "", []string{"1:1: missing-lock-fee: no instruction locks a fee"}},
+		{RuleMissingLockFee, "\n  DROP_ALL_PROOFS;", []string{"2:3: missing-lock-fee: no instruction locks a fee"}},
+		{RuleMissingLockFee, `CALL_METHOD Address("account_sim1") "lock_fee" Decimal("5");`, nil},
+		{RuleMissingLockFee, `CALL_METHOD ${account: Address<GlobalAccount>} "lock_contingent_fee" Decimal("5");`, nil},
+		{RuleMissingLockFee, "YIELD_TO_PARENT;", nil},
+		{RuleMissingLockFee, `CALL_METHOD Address("account_sim1") "deposit" "lock_fee";`,
+			[]string{"1:1: missing-lock-fee: no instruction locks a fee"}},
+
+		{RuleUnguardedWithdraw, "CALL_METHOD Address(\"account_sim1\") \"withdraw\" Address(\"resource_sim1\") Decimal(\"1\");\n" +
+			`CALL_METHOD Address("component_sim1") "swap" Expression("ENTIRE_WORKTOP");`,
+			[]string{"1:1: unguarded-withdraw: the resources withdrawn here go to the CALL_METHOD at 2:1 with no assertion guaranteeing what it returns"}},
+		{RuleUnguardedWithdraw, "CALL_METHOD ${account: Address<GlobalAccount, GlobalVirtualSecp256k1Account>} \"withdraw_non_fungibles\";\n" +
+			`CALL_FUNCTION Address("package_sim1") "Blueprint" "new";`,
+			[]string{"1:1: unguarded-withdraw: the resources withdrawn here go to the CALL_FUNCTION at 2:1 with no assertion guaranteeing what it returns"}},
+		{RuleUnguardedWithdraw, "CALL_METHOD Address(\"account_sim1\") \"withdraw\" Address(\"resource_sim1\") Decimal(\"1\");\n" +
+			"CALL_METHOD Address(\"component_sim1\") \"swap\" Expression(\"ENTIRE_WORKTOP\");\n" +
+			`ASSERT_WORKTOP_CONTAINS Address("resource_sim2") Decimal("1");`, nil},
+		{RuleUnguardedWithdraw, "CALL_METHOD Address(\"account_sim1\") \"withdraw\" Address(\"resource_sim1\") Decimal(\"1\");\n" +
+			"ASSERT_NEXT_CALL_RETURNS_ONLY;\n" +
+			`CALL_METHOD Address("component_sim1") "swap" Expression("ENTIRE_WORKTOP");`, nil},
+		{RuleUnguardedWithdraw, "CALL_METHOD Address(\"account_sim1\") \"withdraw\" Address(\"resource_sim1\") Decimal(\"1\");\n" +
+			`CALL_METHOD Address("account_sim2") "deposit_batch" Expression("ENTIRE_WORKTOP");`, nil},
+		{RuleUnguardedWithdraw, "CALL_METHOD Address(\"component_sim1\") \"withdraw\";\n" +
+			`CALL_FUNCTION Address("package_sim1") "Blueprint" "new";`, nil},
+		{RuleUnguardedWithdraw, "CALL_METHOD ${vault: Address<GlobalAccount, InternalFungibleVault>} \"withdraw\";\n" +
+			`CALL_FUNCTION Address("package_sim1") "Blueprint" "new";`, nil},
+
+		{RuleDeprecatedInstruction, "CLEAR_AUTH_ZONE;\nDROP_AUTH_ZONE_PROOFS;\n  TAKE_FROM_WORKTOP_BY_AMOUNT Decimal(\"1\") Address(\"resource_sim1\") Bucket(\"b\");",
+			[]string{
+				"1:1: deprecated-instruction: CLEAR_AUTH_ZONE is deprecated, use DROP_AUTH_ZONE_PROOFS",
+				"3:3: deprecated-instruction: TAKE_FROM_WORKTOP_BY_AMOUNT is deprecated, use TAKE_FROM_WORKTOP",
+			}},
+	}
+	for _, test := range tests {
+		if diagnostics := lintStrings(t, test.text, test.rule); !slices.Equal(diagnostics, test.want) {
+			t.Errorf("Lint(%q, %s) = %q, want %q", test.text, test.rule, diagnostics, test.want)
+		}
+	}
+}
+
+func TestLint(t *testing.T) {
+	text := "CLEAR_AUTH_ZONE;\n" +
+		"TAKE_ALL_FROM_WORKTOP Address(\"resource_sim1\") Bucket(\"xrd\");\n" +
+		"POP_FROM_AUTH_ZONE Proof(\"badge\");\n"
+	want := []string{
+		"1:1: missing-lock-fee: no instruction locks a fee",
+		"1:1: deprecated-instruction: CLEAR_AUTH_ZONE is deprecated, use DROP_AUTH_ZONE_PROOFS",
+		`2:48: unused-bucket: bucket "xrd" is never used`,
+		`3:20: undropped-proof: proof "badge" is never dropped`,
+	}
+	if diagnostics := lintStrings(t, text); !slices.Equal(diagnostics, want) {
+		t.Errorf("Lint = %q, want %q", diagnostics, want)
+	}
+
+	want = []string{`3:20: undropped-proof: proof "badge" is never dropped`}
+	if diagnostics := lintStrings(t, text, RuleUndroppedProof, Rule("unknown")); !slices.Equal(diagnostics, want) {
+		t.Errorf("Lint(%s) = %q, want %q", RuleUndroppedProof, diagnostics, want)
+	}
+}
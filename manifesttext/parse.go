@@ -0,0 +1,413 @@
+// Package manifesttext parses, formats and lints transaction manifest text,
+// the .rtm files of V1 and V2 manifests, in pure Go.
+//
+// Unlike InstructionsV2FromString and InstructionsV2.AsStr of the
+// radix_engine_toolkit_uniffi package, which compile the text, the parsed
+// Manifest keeps the layout of the source: the positions of its
+// instructions and values, and its comments. Format prints it in a canonical
+// layout, the one of the native decompiler with the comments kept, and Lint
+// reports the mistakes of manifest templates by their positions:
+//
+//	manifest, err := manifesttext.Parse(source)
+//	...
+//	formatted := manifest.Format()
+//	for _, diagnostic := range manifesttext.Lint(manifest) {
+//		fmt.Printf("%s:%s\n", path, diagnostic)
+//	}
+//
+// The parsing is syntactic: the instructions and the values they take are
+// not checked, only the shape of the text. The text may be a template, with
+// placeholders such as ${amount: Decimal} in place of values, which Bind
+// replaces by values.
+package manifesttext
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Manifest is parsed manifest text.
+type Manifest struct {
+	Instructions []*Instruction
+	// EndComments are the comments after the last instruction.
+	EndComments []Comment
+}
+
+// Comment is a # comment, Text being the text after the #.
+type Comment struct {
+	Pos  Position
+	Text string
+}
+
+// Instruction is an instruction: its name, e.g. CALL_METHOD, and its
+// arguments.
+type Instruction struct {
+	Pos  Position
+	Name string
+	Args []*Value
+	// BlankLineBefore reports whether a blank line separates the
+	// instruction, its comments included, from the previous one.
+	BlankLineBefore bool
+	// Comments are the comments on the lines before the instruction,
+	// LineComment the comment after its semicolon on the same line and
+	// EndComments the comments between its last argument and its semicolon.
+	Comments    []Comment
+	LineComment *Comment
+	EndComments []Comment
+}
+
+// ValueKind is the syntactic kind of a value.
+type ValueKind int
+
+const (
+	// ValueBool is true or false.
+	ValueBool ValueKind = iota
+	// ValueInteger is an integer with its type suffix, e.g. 5u8.
+	ValueInteger
+	// ValueString is a string literal, Text being its content.
+	ValueString
+	// ValueIdentifier is a bare identifier, e.g. None.
+	ValueIdentifier
+	// ValueCall is a name with type arguments or arguments, e.g.
+	// Address("..."), Enum<0u8>() or Map<String, U8>("a" => 1u8).
+	ValueCall
+	// ValueEntry is an entry of a map, its Args being the key and the
+	// value.
+	ValueEntry
+	// ValuePlaceholder is a placeholder of a manifest template, e.g.
+	// ${account: Address<GlobalAccount>}, Text being its name, Type its type
+	// and TypeArgs the arguments of its type.
+	ValuePlaceholder
+)
+
+// Value is a value of an instruction. Text is the literal, the identifier
+// or the name of a call, and TypeArgs and Args are the type arguments and the
+// arguments of a call, e.g. "0u8" for Enum<0u8> and "Metadata::String" for
+// Enum<Metadata::String>.
+type Value struct {
+	Pos      Position
+	Kind     ValueKind
+	Text     string
+	Type     string
+	TypeArgs []string
+	Args     []*Value
+	// Comments are the comments on the lines before the value, LineComment
+	// the comment after it, or after its comma, on the same line and
+	// EndComments the comments between the last argument of a call and its
+	// closing parenthesis.
+	Comments    []Comment
+	LineComment *Comment
+	EndComments []Comment
+}
+
+// Parse parses manifest text, returning a *SyntaxError if it is invalid.
+func Parse(text []byte) (*Manifest, error) {
+	tokens, err := tokens(string(text))
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	manifest := &Manifest{}
+	for {
+		blankLine := p.peek().lines > 1
+		comments := p.comments()
+		if p.peek().kind == tokenEOF {
+			manifest.EndComments = comments
+			return manifest, nil
+		}
+		instruction, err := p.instruction()
+		if err != nil {
+			return nil, err
+		}
+		instruction.BlankLineBefore = blankLine && len(manifest.Instructions) > 0
+		instruction.Comments = comments
+		manifest.Instructions = append(manifest.Instructions, instruction)
+	}
+}
+
+type parser struct {
+	tokens []token
+	index  int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.index]
+	if t.kind != tokenEOF {
+		p.index++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return &SyntaxError{Pos: t.pos, Reason: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) isPunctuation(text string) bool {
+	t := p.peek()
+	return t.kind == tokenPunctuation && t.text == text
+}
+
+func (p *parser) expect(text string) error {
+	if !p.isPunctuation(text) {
+		return p.errorf(p.peek(), "expected %q, found %s", text, describe(p.peek()))
+	}
+	p.next()
+	return nil
+}
+
+// comments consumes the comments before the next token.
+func (p *parser) comments() []Comment {
+	var comments []Comment
+	for p.peek().kind == tokenComment {
+		t := p.next()
+		comments = append(comments, Comment{Pos: t.pos, Text: t.text})
+	}
+	return comments
+}
+
+// closes reports whether the next token, comments skipped, is the ) closing
+// a call.
+func (p *parser) closes() bool {
+	index := p.index
+	for p.tokens[index].kind == tokenComment {
+		index++
+	}
+	t := p.tokens[index]
+	return t.kind == tokenPunctuation && t.text == ")"
+}
+
+// lineComment consumes the comment on the line of the previous token, if
+// any.
+func (p *parser) lineComment() *Comment {
+	if t := p.peek(); t.kind == tokenComment && t.lines == 0 {
+		p.next()
+		return &Comment{Pos: t.pos, Text: t.text}
+	}
+	return nil
+}
+
+func (p *parser) instruction() (*Instruction, error) {
+	t := p.next()
+	if t.kind != tokenIdentifier {
+		return nil, p.errorf(t, "expected an instruction, found %s", describe(t))
+	}
+	instruction := &Instruction{Pos: t.pos, Name: t.text}
+	for {
+		comments := p.comments()
+		switch {
+		case p.isPunctuation(";"):
+			p.next()
+			instruction.EndComments = comments
+			instruction.LineComment = p.lineComment()
+			return instruction, nil
+		case p.peek().kind == tokenEOF:
+			return nil, p.errorf(p.peek(), "expected \";\" after %s", instruction.Name)
+		}
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		value.Comments = comments
+		value.LineComment = p.lineComment()
+		instruction.Args = append(instruction.Args, value)
+	}
+}
+
+func (p *parser) value() (*Value, error) {
+	t := p.next()
+	value := &Value{Pos: t.pos, Text: t.text}
+	switch t.kind {
+	case tokenPunctuation:
+		if t.text == "${" {
+			return p.placeholder(t)
+		}
+		return nil, p.errorf(t, "expected a value, found %s", describe(t))
+	case tokenString:
+		value.Kind = ValueString
+		return value, nil
+	case tokenInteger:
+		value.Kind = ValueInteger
+		if _, _, err := parseInteger(t.text); err != nil {
+			return nil, p.errorf(t, "%s", err)
+		}
+		return value, nil
+	case tokenIdentifier:
+	default:
+		return nil, p.errorf(t, "expected a value, found %s", describe(t))
+	}
+
+	if t.text == "true" || t.text == "false" {
+		value.Kind = ValueBool
+		return value, nil
+	}
+	if !p.isPunctuation("<") && !p.isPunctuation("(") {
+		value.Kind = ValueIdentifier
+		return value, nil
+	}
+	value.Kind = ValueCall
+	var err error
+	if value.TypeArgs, err = p.typeArgs(); err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	for {
+		comments := p.comments()
+		if p.isPunctuation(")") {
+			p.next()
+			value.EndComments = comments
+			return value, nil
+		}
+		arg, err := p.entry()
+		if err != nil {
+			return nil, err
+		}
+		arg.Comments = comments
+		arg.LineComment = p.lineComment()
+		value.Args = append(value.Args, arg)
+		if p.closes() {
+			continue
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		if comment := p.lineComment(); comment != nil {
+			arg.LineComment = comment
+		}
+	}
+}
+
+// placeholder parses a placeholder after its ${: its name, its type and the
+// arguments of its type.
+func (p *parser) placeholder(start token) (*Value, error) {
+	t := p.next()
+	if t.kind != tokenIdentifier {
+		return nil, p.errorf(t, "expected a placeholder name, found %s", describe(t))
+	}
+	value := &Value{Pos: start.pos, Kind: ValuePlaceholder, Text: t.text}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	t = p.next()
+	if t.kind != tokenIdentifier {
+		return nil, p.errorf(t, "expected the type of placeholder %s, found %s", value.Text, describe(t))
+	}
+	value.Type = t.text
+	var err error
+	if value.TypeArgs, err = p.typeArgs(); err != nil {
+		return nil, err
+	}
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// entry parses an argument of a call, a value or the entry of a map.
+func (p *parser) entry() (*Value, error) {
+	key, err := p.value()
+	if err != nil || !p.isPunctuation("=>") {
+		return key, err
+	}
+	p.next()
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	return &Value{Pos: key.Pos, Kind: ValueEntry, Args: []*Value{key, value}}, nil
+}
+
+// typeArgs parses the type arguments between < and >, if any.
+func (p *parser) typeArgs() ([]string, error) {
+	if !p.isPunctuation("<") {
+		return nil, nil
+	}
+	p.next()
+	var typeArgs []string
+	for {
+		typeArg, err := p.typeArg()
+		if err != nil {
+			return nil, err
+		}
+		typeArgs = append(typeArgs, typeArg)
+		if !p.isPunctuation(",") {
+			break
+		}
+		p.next()
+	}
+	return typeArgs, p.expect(">")
+}
+
+// typeArg parses a type argument: a value kind, an integer or a path such
+// as Metadata::String.
+func (p *parser) typeArg() (string, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenInteger:
+		if _, _, err := parseInteger(t.text); err != nil {
+			return "", p.errorf(t, "%s", err)
+		}
+		return t.text, nil
+	case tokenIdentifier:
+	default:
+		return "", p.errorf(t, "expected a type argument, found %s", describe(t))
+	}
+	path := []string{t.text}
+	for p.isPunctuation("::") {
+		p.next()
+		t := p.next()
+		if t.kind != tokenIdentifier {
+			return "", p.errorf(t, "expected an identifier after ::, found %s", describe(t))
+		}
+		path = append(path, t.text)
+	}
+	return strings.Join(path, "::"), nil
+}
+
+func describe(t token) string {
+	switch t.kind {
+	case tokenEOF:
+		return "the end of the manifest"
+	case tokenString:
+		return "a string"
+	case tokenComment:
+		return "a comment"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// integerBits are the sizes of the integer type suffixes.
+var integerBits = map[string]uint{"8": 8, "16": 16, "32": 32, "64": 64, "128": 128}
+
+// parseInteger parses an integer literal, e.g. -5i32, into its value and
+// type suffix, checking it is in the range of its type.
+func parseInteger(text string) (*big.Int, string, error) {
+	index := strings.IndexAny(text, "iu")
+	if index < 0 {
+		return nil, "", fmt.Errorf("integer %s without a type suffix such as u32", text)
+	}
+	suffix := text[index:]
+	bits, ok := integerBits[suffix[1:]]
+	if !ok {
+		return nil, "", fmt.Errorf("invalid integer type suffix %s", suffix)
+	}
+	integer, ok := new(big.Int).SetString(text[:index], 10)
+	if !ok {
+		return nil, "", fmt.Errorf("invalid integer %s", text)
+	}
+	lower, upper := new(big.Int), new(big.Int).Lsh(big.NewInt(1), bits)
+	if suffix[0] == 'i' {
+		upper.Rsh(upper, 1)
+		lower.Neg(upper)
+	}
+	if integer.Cmp(lower) < 0 || integer.Cmp(upper) >= 0 {
+		return nil, "", fmt.Errorf("integer %s out of the range of %s", text, suffix)
+	}
+	return integer, suffix, nil
+}
@@ -0,0 +1,120 @@
+package manifesttext
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	text := `# lock the fee
+CALL_METHOD
+    Address("account_sim1")
+    "lock_fee"
+    Decimal("5") # five
+;
+
+TAKE_ALL_FROM_WORKTOP Address("resource_sim1") Bucket("xrd");
+CALL_METHOD ${account: Address<GlobalAccount>} "deposit" Map<String, Enum>("a" => Enum<Metadata::String>("b")) Bucket("xrd"); # deposit
+# the end
+`
+	manifest, err := Parse([]byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Instructions) != 3 {
+		t.Fatalf("%d instructions, want 3", len(manifest.Instructions))
+	}
+
+	lockFee := manifest.Instructions[0]
+	if lockFee.Name != "CALL_METHOD" || lockFee.Pos.String() != "2:1" || len(lockFee.Args) != 3 || lockFee.BlankLineBefore {
+		t.Errorf("instruction 0 = %s at %s with %d args", lockFee.Name, lockFee.Pos, len(lockFee.Args))
+	}
+	if len(lockFee.Comments) != 1 || lockFee.Comments[0].Text != " lock the fee" {
+		t.Errorf("instruction 0 comments %v", lockFee.Comments)
+	}
+	if address := lockFee.Args[0]; address.Kind != ValueCall || address.Text != "Address" || len(address.Args) != 1 || address.Args[0].Text != "account_sim1" {
+		t.Errorf("argument 0 = %+v", address)
+	}
+	if name := lockFee.Args[1]; name.Kind != ValueString || name.Text != "lock_fee" || name.Pos.String() != "4:5" {
+		t.Errorf("argument 1 = %+v", name)
+	}
+	if amount := lockFee.Args[2]; amount.LineComment == nil || amount.LineComment.Text != " five" {
+		t.Errorf("argument 2 line comment %v", amount.LineComment)
+	}
+
+	take := manifest.Instructions[1]
+	if !take.BlankLineBefore || take.Name != "TAKE_ALL_FROM_WORKTOP" || take.Pos.String() != "8:1" {
+		t.Errorf("instruction 1 = %s at %s, blank line %t", take.Name, take.Pos, take.BlankLineBefore)
+	}
+
+	deposit := manifest.Instructions[2]
+	account := deposit.Args[0]
+	if account.Kind != ValuePlaceholder || account.Text != "account" || account.Type != "Address" || len(account.TypeArgs) != 1 || account.TypeArgs[0] != "GlobalAccount" {
+		t.Errorf("placeholder = %+v", account)
+	}
+	entries := deposit.Args[2]
+	if len(entries.TypeArgs) != 2 || entries.TypeArgs[1] != "Enum" || len(entries.Args) != 1 || entries.Args[0].Kind != ValueEntry {
+		t.Fatalf("map = %+v", entries)
+	}
+	if value := entries.Args[0].Args[1]; value.TypeArgs[0] != "Metadata::String" || value.Args[0].Text != "b" {
+		t.Errorf("map value = %+v", value)
+	}
+	if deposit.LineComment == nil || deposit.LineComment.Text != " deposit" {
+		t.Errorf("instruction 2 line comment %v", deposit.LineComment)
+	}
+	if len(manifest.EndComments) != 1 || manifest.EndComments[0].Text != " the end" {
+		t.Errorf("end comments %v", manifest.EndComments)
+	}
+
+	empty, err := Parse(nil)
+	if err != nil || len(empty.Instructions) != 0 {
+		t.Errorf("Parse(nil) = %v, %v", empty, err)
+	}
+}
+
+func TestParseValues(t *testing.T) {
+	manifest, err := Parse([]byte(`A true None 255u8 -128i8 Tuple() Array<U8>(1u8, 2u8,);`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kinds := []ValueKind{ValueBool, ValueIdentifier, ValueInteger, ValueInteger, ValueCall, ValueCall}
+	args := manifest.Instructions[0].Args
+	if len(args) != len(kinds) {
+		t.Fatalf("%d arguments, want %d", len(args), len(kinds))
+	}
+	for index, arg := range args {
+		if arg.Kind != kinds[index] {
+			t.Errorf("argument %d %q of kind %d, want %d", index, arg.Text, arg.Kind, kinds[index])
+		}
+	}
+	if len(args[5].Args) != 2 {
+		t.Errorf("array of %d elements, want 2", len(args[5].Args))
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		text   string
+		pos    string
+		reason string
+	}{
+		{"A", "1:2", `expected ";" after A`},
+		{`"a";`, "1:1", "expected an instruction, found a string"},
+		{"A 5;", "1:3", "integer 5 without a type suffix such as u32"},
+		{"A 256u8;", "1:3", "integer 256u8 out of the range of u8"},
+		{"A 1u7;", "1:3", "invalid integer type suffix u7"},
+		{"A Tuple(1u8 2u8);", "1:13", `expected ",", found "2u8"`},
+		{"A Enum<;", "1:8", `expected a type argument, found ";"`},
+		{"A Enum<A::1u8>();", "1:11", `expected an identifier after ::, found "1u8"`},
+		{"A ${x};", "1:6", `expected ":", found "}"`},
+		{"A ${x: 1u8};", "1:8", `expected the type of placeholder x, found "1u8"`},
+		{"A );", "1:3", `expected a value, found ")"`},
+	}
+	for _, test := range tests {
+		_, err := Parse([]byte(test.text))
+		var syntaxError *SyntaxError
+		if !errors.As(err, &syntaxError) || syntaxError.Pos.String() != test.pos || syntaxError.Reason != test.reason {
+			t.Errorf("Parse(%q) = %v, want %s: %s", test.text, err, test.pos, test.reason)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package manifesttext
+
+// Placeholders returns the placeholders of the manifest in the order of their
+// positions, a placeholder used several times once per use.
+func (manifest *Manifest) Placeholders() []*Value {
+	var placeholders []*Value
+	var walk func(values []*Value)
+	walk = func(values []*Value) {
+		for _, value := range values {
+			if value.Kind == ValuePlaceholder {
+				placeholders = append(placeholders, value)
+			}
+			walk(value.Args)
+		}
+	}
+	for _, instruction := range manifest.Instructions {
+		walk(instruction.Args)
+	}
+	return placeholders
+}
+
+// Bind returns a copy of the manifest with the placeholders replaced by the
+// values of their names, keeping their positions and comments. The
+// placeholders without a value are left as is.
+func (manifest *Manifest) Bind(values map[string]*Value) *Manifest {
+	bound := &Manifest{EndComments: manifest.EndComments}
+	for _, instruction := range manifest.Instructions {
+		copied := *instruction
+		copied.Args = bind(instruction.Args, values)
+		bound.Instructions = append(bound.Instructions, &copied)
+	}
+	return bound
+}
+
+func bind(args []*Value, values map[string]*Value) []*Value {
+	if args == nil {
+		return nil
+	}
+	bound := make([]*Value, len(args))
+	for index, arg := range args {
+		copied := *arg
+		if value, ok := values[arg.Text]; ok && arg.Kind == ValuePlaceholder {
+			copied = *value
+			copied.Pos, copied.Comments, copied.LineComment = arg.Pos, arg.Comments, arg.LineComment
+		}
+		copied.Args = bind(copied.Args, values)
+		bound[index] = &copied
+	}
+	return bound
+}
@@ -0,0 +1,93 @@
+package manifesttext
+
+import (
+	"strings"
+	"testing"
+)
+
+const templateText = `CALL_METHOD
+    ${account: Address<GlobalAccount>}
+    "withdraw"
+    ${resource: Address}
+    Tuple(${amount: Decimal}, Array<Decimal>(${amount: Decimal})) # amounts
+;
+TAKE_ALL_FROM_WORKTOP ${resource: Address} ${bucket: Bucket};
+`
+
+func TestPlaceholders(t *testing.T) {
+	manifest, err := Parse([]byte(templateText))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, placeholder := range manifest.Placeholders() {
+		got = append(got, placeholder.Text+"@"+placeholder.Pos.String())
+	}
+	want := "account@2:5 resource@4:5 amount@5:11 amount@5:46 resource@7:23 bucket@7:44"
+	if strings.Join(got, " ") != want {
+		t.Errorf("Placeholders = %s, want %s", strings.Join(got, " "), want)
+	}
+
+	plain, err := Parse([]byte(`DROP_ALL_PROOFS;`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if placeholders := plain.Placeholders(); len(placeholders) != 0 {
+		t.Errorf("Placeholders of a manifest without any = %v", placeholders)
+	}
+}
+
+func TestBind(t *testing.T) {
+	manifest, err := Parse([]byte(templateText))
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := func(name, argument string) *Value {
+		return &Value{Kind: ValueCall, Text: name, Args: []*Value{{Kind: ValueString, Text: argument}}}
+	}
+	bound := manifest.Bind(map[string]*Value{
+		"amount":   call("Decimal", "10"),
+		"resource": call("Address", "resource_sim1"),
+		// Values of no placeholder are ignored.
+		"unused": call("Decimal", "1"),
+	})
+
+	// The placeholders without a value are left as is.
+	want := `CALL_METHOD
+    ${account: Address<GlobalAccount>}
+    "withdraw"
+    Address("resource_sim1")
+    Tuple(
+        Decimal("10"),
+        Array<Decimal>(
+            Decimal("10")
+        )
+    ) # amounts
+;
+TAKE_ALL_FROM_WORKTOP
+    Address("resource_sim1")
+    ${bucket: Bucket}
+;
+`
+	if got := string(bound.Format()); got != want {
+		t.Errorf("Bind =\n%s\nwant\n%s", got, want)
+	}
+	remaining := bound.Placeholders()
+	if len(remaining) != 2 || remaining[0].Text != "account" || remaining[1].Text != "bucket" {
+		t.Errorf("placeholders left after Bind = %v", remaining)
+	}
+
+	// The bound values take the positions and comments of the placeholders.
+	amounts := bound.Instructions[0].Args[3]
+	if amount := amounts.Args[0]; amount.Kind != ValueCall || amount.Pos.String() != "5:11" {
+		t.Errorf("bound amount = %+v", amount)
+	}
+	if amounts.LineComment == nil || amounts.LineComment.Text != " amounts" {
+		t.Errorf("bound amounts line comment %v", amounts.LineComment)
+	}
+
+	// The manifest bound is unchanged.
+	if placeholders := manifest.Placeholders(); len(placeholders) != 6 {
+		t.Errorf("Bind changed the manifest to %d placeholders", len(placeholders))
+	}
+}
@@ -0,0 +1,78 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// Pure Go addresses
+//
+// The address package decodes, encodes and inspects addresses without the
+// native library. The functions below are its entry points for users of this
+// package, reporting failures with the RadixEngineToolkitError variants
+// returned by NewAddress: FailedToExtractNetwork, Bech32DecodeError,
+// InvalidLength and EntityTypeMismatchError. An address.EntityType converts
+// into an EntityType, and back, with a type conversion.
+
+// ParseAddress decodes and validates an address like NewAddress, without
+// calling into the native library.
+func ParseAddress(value string) (address.Address, error) {
+	decoded, err := address.New(value)
+	return decoded, addressError(err)
+}
+
+// ParseAddressOfType decodes an address like ParseAddress, additionally
+// requiring it to be of one of the expected entity types.
+func ParseAddressOfType(value string, expected ...EntityType) (address.Address, error) {
+	expectedTypes := make([]address.EntityType, len(expected))
+	for index, entityType := range expected {
+		expectedTypes[index] = address.EntityType(entityType)
+	}
+	decoded, err := address.NewOfType(value, expectedTypes...)
+	return decoded, addressError(err)
+}
+
+// AddressFromValue converts a pure Go address into a native *Address.
+func AddressFromValue(value address.Address) (*Address, error) {
+	return AddressFromRaw(value.Bytes(), value.NetworkId())
+}
+
+// Value converts the native address into a pure Go address.
+func (_self *Address) Value() (address.Address, error) {
+	value, err := address.FromRaw(_self.Bytes(), _self.NetworkId())
+	return value, addressError(err)
+}
+
+// addressError converts an error of the address package into the
+// corresponding RadixEngineToolkitError.
+func addressError(err error) error {
+	var (
+		failedToExtractNetwork *address.FailedToExtractNetworkError
+		bech32DecodeError      *address.Bech32DecodeError
+		invalidLength          *address.InvalidLengthError
+		entityTypeMismatch     *address.EntityTypeMismatchError
+	)
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &failedToExtractNetwork):
+		return NewRadixEngineToolkitErrorFailedToExtractNetwork(failedToExtractNetwork.Address)
+	case errors.As(err, &bech32DecodeError):
+		return NewRadixEngineToolkitErrorBech32DecodeError(bech32DecodeError.Error_)
+	case errors.As(err, &invalidLength):
+		return NewRadixEngineToolkitErrorInvalidLength(invalidLength.Expected, invalidLength.Actual, invalidLength.Data)
+	case errors.As(err, &entityTypeMismatch):
+		expected := make([]EntityType, len(entityTypeMismatch.Expected))
+		for index, entityType := range entityTypeMismatch.Expected {
+			expected[index] = EntityType(entityType)
+		}
+		var actual *EntityType
+		if entityTypeMismatch.Actual != nil {
+			entityType := EntityType(*entityTypeMismatch.Actual)
+			actual = &entityType
+		}
+		return NewRadixEngineToolkitErrorEntityTypeMismatchError(expected, actual)
+	}
+	return err
+}
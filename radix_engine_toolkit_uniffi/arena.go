@@ -0,0 +1,252 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Object lifetimes
+//
+// The native memory behind an object is released by its finalizer, by
+// Destroy or by Close. An Arena releases the objects created in its scope at
+// once, without waiting for the garbage collector:
+//
+//	err := WithArena(func(arena *Arena) error {
+//		total := DecimalZero()
+//		for _, amount := range amounts {
+//			sum, err := total.Add(amount)
+//			if err != nil {
+//				return err
+//			}
+//			total = sum
+//		}
+//		result = total.AsStr()
+//		return nil
+//	})
+//
+// The scope of an arena is bound to a goroutine: every object the goroutine
+// creates while the scope is active, by a constructor, a method or a
+// function returning objects, is tracked in the arena. Goroutines spawned in
+// the scope enter it with Arena.Scope, and objects created elsewhere are
+// added with Track or Tracked. Objects which must outlive the arena are taken
+// out of it with Detach.
+//
+// The bindings are adapted to arenas by internal/cmd/objectgen, which is run
+// on the uniffi generated file by go generate.
+//
+// A function or method returning an error returns a
+// RadixEngineToolkitErrorObjectDestroyed error when it is called on an object
+// which has been destroyed, or with one as an argument. The other methods
+// panic with that error, which CatchObjectDestroyed returns instead.
+
+//go:generate go run ../internal/cmd/objectgen -in radix_engine_toolkit_uniffi.go
+
+var ErrRadixEngineToolkitErrorObjectDestroyed = fmt.Errorf("RadixEngineToolkitErrorObjectDestroyed")
+
+// RadixEngineToolkitErrorObjectDestroyed reports the use of an object, of the
+// type TypeName, after it was destroyed or closed.
+type RadixEngineToolkitErrorObjectDestroyed struct {
+	TypeName string
+}
+
+func NewRadixEngineToolkitErrorObjectDestroyed(
+	typeName string,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorObjectDestroyed{
+			TypeName: typeName,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorObjectDestroyed) Error() string {
+	return fmt.Sprint("ObjectDestroyed",
+		": ",
+
+		"TypeName=",
+		err.TypeName,
+	)
+}
+
+func (self RadixEngineToolkitErrorObjectDestroyed) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorObjectDestroyed
+}
+
+// destroyedPanicFunction is the function of the bindings panicking on the
+// use of a destroyed object.
+const destroyedPanicFunction = "(*FfiObject).incrementPointer"
+
+// CatchObjectDestroyed calls function and returns the use of a destroyed
+// object during the call, by a method of the object or by a method taking it
+// as an argument, as a RadixEngineToolkitErrorObjectDestroyed error instead of
+// a panic. Other panics are propagated unchanged.
+func CatchObjectDestroyed[T any](function func() (T, error)) (result T, err error) {
+	defer func() {
+		if name, ok := panickingFunction(); !ok || name != destroyedPanicFunction {
+			return
+		}
+		var zero T
+		result, err = zero, objectDestroyed(recover())
+	}()
+	return function()
+}
+
+// recoverObjectDestroyed is deferred by the generated functions returning an
+// error, to return the use of a destroyed object during their call as that
+// error.
+func recoverObjectDestroyed(err *error) {
+	if name, ok := panickingFunction(); !ok || name != destroyedPanicFunction {
+		return
+	}
+	*err = objectDestroyed(recover())
+}
+
+// objectDestroyed returns the value recovered from a panic of
+// incrementPointer, propagating the panic again when it does not report a
+// destroyed object.
+func objectDestroyed(recovered any) error {
+	if err, ok := recovered.(error); ok && errors.Is(err, ErrRadixEngineToolkitErrorObjectDestroyed) {
+		return err
+	}
+	panic(recovered)
+}
+
+type destroyable interface {
+	Destroy()
+}
+
+// Arena releases the native memory of the objects tracked in it when it is
+// closed. It is safe for concurrent use.
+type Arena struct {
+	lock    sync.Mutex
+	objects []destroyable
+	closed  bool
+}
+
+// NewArena returns an open arena.
+func NewArena() *Arena {
+	return &Arena{}
+}
+
+// WithArena calls function with a new arena as the scope of the calling
+// goroutine, closing the arena when function returns.
+func WithArena(function func(arena *Arena) error) error {
+	arena := NewArena()
+	defer arena.Close()
+	return arena.Scope(func() error {
+		return function(arena)
+	})
+}
+
+var (
+	scopesLock sync.Mutex
+	// scopes holds the arenas whose scope is active, innermost last, by
+	// goroutine id.
+	scopes       = map[uint64][]*Arena{}
+	activeScopes atomic.Int64
+)
+
+// Scope calls function with the arena as the scope of the calling goroutine:
+// the objects the goroutine creates until function returns are tracked in
+// the arena. Scopes nest, an object being tracked in the innermost one.
+func (arena *Arena) Scope(function func() error) error {
+	id := goroutineID()
+	scopesLock.Lock()
+	scopes[id] = append(scopes[id], arena)
+	scopesLock.Unlock()
+	activeScopes.Add(1)
+	defer func() {
+		activeScopes.Add(-1)
+		scopesLock.Lock()
+		defer scopesLock.Unlock()
+		if arenas := scopes[id][:len(scopes[id])-1]; len(arenas) > 0 {
+			scopes[id] = arenas
+		} else {
+			delete(scopes, id)
+		}
+	}()
+	return function()
+}
+
+// trackObject is called by the generated bindings with every object they
+// create, and tracks it in the scope of the calling goroutine, if any.
+func trackObject(object destroyable) {
+	if activeScopes.Load() == 0 {
+		return
+	}
+	id := goroutineID()
+	scopesLock.Lock()
+	arenas := scopes[id]
+	scopesLock.Unlock()
+	if len(arenas) > 0 {
+		arenas[len(arenas)-1].Track(object)
+	}
+}
+
+// goroutineID returns the id of the calling goroutine, which the runtime
+// only exposes in the header of its stack trace, "goroutine 18 [running]:".
+func goroutineID() uint64 {
+	buffer := make([]byte, 64)
+	buffer = bytes.TrimPrefix(buffer[:runtime.Stack(buffer, false)], []byte("goroutine "))
+	id, _, _ := bytes.Cut(buffer, []byte(" "))
+	result, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil {
+		panic(fmt.Errorf("parsing the goroutine id: %w", err))
+	}
+	return result
+}
+
+// Track records objects in the arena, skipping nil ones. Objects tracked
+// after the arena was closed are left to their finalizer.
+func (arena *Arena) Track(objects ...destroyable) {
+	arena.lock.Lock()
+	defer arena.lock.Unlock()
+	if arena.closed {
+		return
+	}
+	for _, object := range objects {
+		if value := reflect.ValueOf(object); object != nil && !(value.Kind() == reflect.Pointer && value.IsNil()) {
+			arena.objects = append(arena.objects, object)
+		}
+	}
+}
+
+// Tracked records object in arena and returns it, for tracking the result of
+// a call in place.
+func Tracked[T destroyable](arena *Arena, object T) T {
+	arena.Track(object)
+	return object
+}
+
+// Detach removes object from the arena, leaving it alive once the arena is
+// closed.
+func (arena *Arena) Detach(object destroyable) {
+	arena.lock.Lock()
+	defer arena.lock.Unlock()
+	for index := len(arena.objects) - 1; index >= 0; index-- {
+		if arena.objects[index] == object {
+			arena.objects = append(arena.objects[:index], arena.objects[index+1:]...)
+			return
+		}
+	}
+}
+
+// Close destroys the objects of the arena in the reverse order they were
+// tracked in. Closing an arena again has no effect.
+func (arena *Arena) Close() error {
+	arena.lock.Lock()
+	objects := arena.objects
+	arena.objects, arena.closed = nil, true
+	arena.lock.Unlock()
+
+	for index := len(objects) - 1; index >= 0; index-- {
+		objects[index].Destroy()
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"unsafe"
+)
+
+// countFrees returns a free function counting its calls in freed, of the type
+// of free, which the tests cannot name.
+func countFrees[S any](freed *atomic.Int32, _ func(unsafe.Pointer, S)) func(unsafe.Pointer, S) {
+	return func(unsafe.Pointer, S) {
+		freed.Add(1)
+	}
+}
+
+// liftDecimal lifts a decimal as the bindings do, with a free function
+// counting its calls in freed instead of calling the native library.
+func liftDecimal(freed *atomic.Int32) *Decimal {
+	decimal := FfiConverterDecimalINSTANCE.Lift(unsafe.Pointer(new(byte)))
+	decimal.ffiObject.freeFunction = countFrees(freed, decimal.ffiObject.freeFunction)
+	return decimal
+}
+
+func TestWithArena(t *testing.T) {
+	var freed atomic.Int32
+	outside := liftDecimal(&freed)
+	var detached, tracked *Decimal
+	err := WithArena(func(arena *Arena) error {
+		liftDecimal(&freed)
+		liftDecimal(&freed)
+		detached = liftDecimal(&freed)
+		arena.Detach(detached)
+		tracked = Tracked(arena, outside)
+		return errors.New("returned")
+	})
+	if err == nil || err.Error() != "returned" {
+		t.Errorf("WithArena = %v, want the error of the function", err)
+	}
+	if got := freed.Load(); got != 3 {
+		t.Errorf("WithArena freed %d objects, want 3", got)
+	}
+	if detached.ffiObject.destroyed.Load() || !tracked.ffiObject.destroyed.Load() {
+		t.Errorf("destroyed: detached %v, tracked %v, want false, true", detached.ffiObject.destroyed.Load(), tracked.ffiObject.destroyed.Load())
+	}
+
+	liftDecimal(&freed)
+	if got := freed.Load(); got != 3 {
+		t.Errorf("object created after the scope freed, %d objects freed, want 3", got)
+	}
+}
+
+func TestArenaScope(t *testing.T) {
+	var freed atomic.Int32
+	outer, inner := NewArena(), NewArena()
+	var spawned, joined *Decimal
+	outer.Scope(func() error {
+		liftDecimal(&freed)
+		inner.Scope(func() error {
+			liftDecimal(&freed)
+			liftDecimal(&freed)
+			return nil
+		})
+		var group sync.WaitGroup
+		group.Add(2)
+		go func() {
+			defer group.Done()
+			spawned = liftDecimal(&freed)
+		}()
+		go func() {
+			defer group.Done()
+			outer.Scope(func() error {
+				joined = liftDecimal(&freed)
+				return nil
+			})
+		}()
+		group.Wait()
+		return nil
+	})
+
+	inner.Close()
+	if got := freed.Load(); got != 2 {
+		t.Errorf("closing the inner arena freed %d objects, want 2", got)
+	}
+	outer.Close()
+	if got := freed.Load(); got != 4 {
+		t.Errorf("closing the outer arena freed %d objects, want 4", got)
+	}
+	if spawned.ffiObject.destroyed.Load() || !joined.ffiObject.destroyed.Load() {
+		t.Errorf("destroyed: spawned %v, joined %v, want false, true", spawned.ffiObject.destroyed.Load(), joined.ffiObject.destroyed.Load())
+	}
+
+	liftDecimal(&freed)
+	outer.Track(liftDecimal(&freed))
+	if got := freed.Load(); got != 4 {
+		t.Errorf("objects tracked after the scopes freed, %d objects freed, want 4", got)
+	}
+}
+
+// checkDestroyed checks that err reports the use of a destroyed *Decimal.
+func checkDestroyed(t *testing.T, call string, err error) {
+	t.Helper()
+	var destroyed *RadixEngineToolkitErrorObjectDestroyed
+	if !errors.Is(err, ErrRadixEngineToolkitErrorObjectDestroyed) || !errors.As(err, &destroyed) {
+		t.Errorf("%s = %v, want a RadixEngineToolkitErrorObjectDestroyed error", call, err)
+		return
+	}
+	if destroyed.TypeName != "*Decimal" {
+		t.Errorf("%s: TypeName %q, want %q", call, destroyed.TypeName, "*Decimal")
+	}
+}
+
+func TestUseAfterClose(t *testing.T) {
+	var freed atomic.Int32
+	closed, open := liftDecimal(&freed), liftDecimal(&freed)
+	if err := closed.Close(); err != nil {
+		t.Fatalf("Close = %v", err)
+	}
+	closed.Close()
+	if got := freed.Load(); got != 1 {
+		t.Errorf("closing twice freed %d objects, want 1", got)
+	}
+
+	result, err := closed.Add(open)
+	if result != nil {
+		t.Errorf("closed.Add(open) = %v, want nil", result)
+	}
+	checkDestroyed(t, "closed.Add(open)", err)
+
+	result, err = open.Add(closed)
+	if result != nil {
+		t.Errorf("open.Add(closed) = %v, want nil", result)
+	}
+	checkDestroyed(t, "open.Add(closed)", err)
+	if got := open.ffiObject.callCounter.Load(); got != 0 {
+		t.Errorf("open.Add(closed) left the call counter at %d, want 0", got)
+	}
+
+	text, err := CatchObjectDestroyed(func() (string, error) {
+		return closed.AsStr(), nil
+	})
+	if text != "" {
+		t.Errorf("closed.AsStr() = %q, want \"\"", text)
+	}
+	checkDestroyed(t, "closed.AsStr()", err)
+}
+
+func TestCatchObjectDestroyedPropagates(t *testing.T) {
+	value := NewRadixEngineToolkitErrorObjectDestroyed("*Decimal")
+	func() {
+		defer func() {
+			if recovered := recover(); recovered != value {
+				t.Errorf("recovered %v, want %v", recovered, value)
+			}
+		}()
+		CatchObjectDestroyed(func() (int, error) {
+			panic(value)
+		})
+	}()
+}
+
+func TestRecoverObjectDestroyedKeepsNativePanics(t *testing.T) {
+	guarded := func() (_ int, err error) {
+		defer recoverObjectDestroyed(&err)
+		return 1, checkStatus(2)
+	}
+	_, err := CatchPanic(guarded)
+	if !errors.Is(err, ErrRadixEngineToolkitErrorInternalPanic) {
+		t.Errorf("CatchPanic of a guarded function = %v, want a RadixEngineToolkitErrorInternalPanic error", err)
+	}
+}
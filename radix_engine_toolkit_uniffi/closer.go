@@ -0,0 +1,237 @@
+package radix_engine_toolkit_uniffi
+
+import "io"
+
+// Every object implements io.Closer. Close is Destroy: it releases the native
+// memory right away, later calls having no effect.
+
+var (
+	_ io.Closer = (*AccessRule)(nil)
+	_ io.Closer = (*Address)(nil)
+	_ io.Closer = (*Decimal)(nil)
+	_ io.Closer = (*Hash)(nil)
+	_ io.Closer = (*InstructionsV1)(nil)
+	_ io.Closer = (*InstructionsV2)(nil)
+	_ io.Closer = (*IntentCoreV2)(nil)
+	_ io.Closer = (*IntentV1)(nil)
+	_ io.Closer = (*ManifestV1Builder)(nil)
+	_ io.Closer = (*ManifestV2Builder)(nil)
+	_ io.Closer = (*NonFungibleGlobalId)(nil)
+	_ io.Closer = (*NotarizedTransactionV1)(nil)
+	_ io.Closer = (*NotarizedTransactionV2)(nil)
+	_ io.Closer = (*OlympiaAddress)(nil)
+	_ io.Closer = (*PartialTransactionV2)(nil)
+	_ io.Closer = (*PartialTransactionV2Builder)(nil)
+	_ io.Closer = (*PreciseDecimal)(nil)
+	_ io.Closer = (*PreviewPartialTransactionV2)(nil)
+	_ io.Closer = (*PreviewPartialTransactionV2Builder)(nil)
+	_ io.Closer = (*PreviewTransactionV2Builder)(nil)
+	_ io.Closer = (*PrivateKey)(nil)
+	_ io.Closer = (*SignedPartialTransactionV2)(nil)
+	_ io.Closer = (*SignedPartialTransactionV2Builder)(nil)
+	_ io.Closer = (*SignedPartialTransactionV2BuilderSignatureStep)(nil)
+	_ io.Closer = (*SignedTransactionIntentV1)(nil)
+	_ io.Closer = (*SignedTransactionIntentV2)(nil)
+	_ io.Closer = (*SubintentManifestV2)(nil)
+	_ io.Closer = (*SubintentV2)(nil)
+	_ io.Closer = (*TransactionHash)(nil)
+	_ io.Closer = (*TransactionIntentV2)(nil)
+	_ io.Closer = (*TransactionManifestV1)(nil)
+	_ io.Closer = (*TransactionManifestV2)(nil)
+	_ io.Closer = (*TransactionV1Builder)(nil)
+	_ io.Closer = (*TransactionV1BuilderHeaderStep)(nil)
+	_ io.Closer = (*TransactionV1BuilderIntentSignaturesStep)(nil)
+	_ io.Closer = (*TransactionV1BuilderMessageStep)(nil)
+	_ io.Closer = (*TransactionV2Builder)(nil)
+	_ io.Closer = (*TransactionV2BuilderSignatureStep)(nil)
+)
+
+func (object *AccessRule) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *Address) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *Decimal) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *Hash) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *InstructionsV1) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *InstructionsV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *IntentCoreV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *IntentV1) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *ManifestV1Builder) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *ManifestV2Builder) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *NonFungibleGlobalId) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *NotarizedTransactionV1) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *NotarizedTransactionV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *OlympiaAddress) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *PartialTransactionV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *PartialTransactionV2Builder) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *PreciseDecimal) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *PreviewPartialTransactionV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *PreviewPartialTransactionV2Builder) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *PreviewTransactionV2Builder) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *PrivateKey) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *SignedPartialTransactionV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *SignedPartialTransactionV2Builder) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *SignedPartialTransactionV2BuilderSignatureStep) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *SignedTransactionIntentV1) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *SignedTransactionIntentV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *SubintentManifestV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *SubintentV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionHash) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionIntentV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionManifestV1) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionManifestV2) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionV1Builder) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionV1BuilderHeaderStep) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionV1BuilderIntentSignaturesStep) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionV1BuilderMessageStep) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionV2Builder) Close() error {
+	object.Destroy()
+	return nil
+}
+
+func (object *TransactionV2BuilderSignatureStep) Close() error {
+	object.Destroy()
+	return nil
+}
@@ -0,0 +1,304 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"encoding/json"
+)
+
+// ToolkitReceiptFromCoreApiPreview converts the JSON response of the Core
+// API /transaction/preview endpoint into a toolkit receipt. Errors name the
+// offending field by its path in the response, e.g. receipt.fee_summary.
+//
+// A response to a preview requested with the radix_engine_toolkit_receipt
+// option holds the toolkit receipt itself, which is used as is. Otherwise the
+// receipt is rebuilt from the engine receipt of the response: its status,
+// fee summary, new global entities and events. The engine receipt holds
+// neither the worktop changes nor the newly minted non-fungibles, which are
+// left empty, nor the locked fees, which are taken to be the fees paid by the
+// fee vaults.
+func ToolkitReceiptFromCoreApiPreview(response []byte) (ToolkitReceipt, error) {
+	object, err := decodeReceiptObject(response, "")
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	if toolkitReceipt, ok := object["radix_engine_toolkit_receipt"]; ok && string(toolkitReceipt) != "null" {
+		return decodeToolkitReceipt(toolkitReceipt, "radix_engine_toolkit_receipt")
+	}
+	field, fieldPath, err := requiredReceiptField(object, "receipt", "")
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	return decodeCoreApiReceipt(field, fieldPath)
+}
+
+func decodeCoreApiReceipt(data json.RawMessage, path string) (ToolkitReceipt, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	field, fieldPath, err := requiredReceiptField(object, "status", path)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	status, err := decodeReceiptString(field, fieldPath)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	var errorMessage string
+	if field, ok := object["error_message"]; ok && string(field) != "null" {
+		if errorMessage, err = decodeReceiptString(field, receiptPathField(path, "error_message")); err != nil {
+			return ToolkitReceipt{}, err
+		}
+	}
+	switch status {
+	case "Succeeded":
+	case "Failed":
+		return ToolkitReceipt{Kind: ToolkitReceiptKindCommitFailure, Reason: errorMessage}, nil
+	case "Rejected":
+		return ToolkitReceipt{Kind: ToolkitReceiptKindReject, Reason: errorMessage}, nil
+	default:
+		return ToolkitReceipt{}, receiptFieldError(fieldPath, "unknown status %q", status)
+	}
+
+	receipt := ToolkitReceipt{
+		Kind:           ToolkitReceiptKindCommitSuccess,
+		WorktopChanges: map[uint64][]ToolkitWorktopChange{},
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "fee_summary", path); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	fees, err := decodeReceiptObject(field, fieldPath)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	for _, fee := range []struct {
+		name  string
+		value *DecimalValue
+	}{
+		{"xrd_total_execution_cost", &receipt.FeeSummary.ExecutionFeesInXrd},
+		{"xrd_total_finalization_cost", &receipt.FeeSummary.FinalizationFeesInXrd},
+		{"xrd_total_storage_cost", &receipt.FeeSummary.StorageFeesInXrd},
+		{"xrd_total_royalty_cost", &receipt.FeeSummary.RoyaltyFeesInXrd},
+	} {
+		field, feePath, err := requiredReceiptField(fees, fee.name, fieldPath)
+		if err != nil {
+			return ToolkitReceipt{}, err
+		}
+		if *fee.value, err = decodeReceiptDecimal(field, feePath); err != nil {
+			return ToolkitReceipt{}, err
+		}
+	}
+
+	if field, ok := object["fee_source"]; ok && string(field) != "null" {
+		if receipt.LockedFees.NonContingent, err = decodeCoreApiFeeSource(field, receiptPathField(path, "fee_source")); err != nil {
+			return ToolkitReceipt{}, err
+		}
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "state_updates", path); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	stateUpdates, err := decodeReceiptObject(field, fieldPath)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	if field, fieldPath, err = requiredReceiptField(stateUpdates, "new_global_entities", fieldPath); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	entities, err := decodeReceiptArray(field, fieldPath)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	for index, entity := range entities {
+		entityPath := receiptPathIndex(fieldPath, index)
+		entityObject, err := decodeReceiptObject(entity, entityPath)
+		if err != nil {
+			return ToolkitReceipt{}, err
+		}
+		field, addressPath, err := requiredReceiptField(entityObject, "entity_address", entityPath)
+		if err != nil {
+			return ToolkitReceipt{}, err
+		}
+		entityAddress, err := decodeReceiptAddress(field, addressPath)
+		if err != nil {
+			return ToolkitReceipt{}, err
+		}
+		receipt.StateUpdatesSummary.NewEntities = append(receipt.StateUpdatesSummary.NewEntities, entityAddress)
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "events", path); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	events, err := decodeReceiptArray(field, fieldPath)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	receipt.Events = make([]ToolkitEvent, len(events))
+	for index, event := range events {
+		if receipt.Events[index], err = decodeCoreApiEvent(event, receiptPathIndex(fieldPath, index)); err != nil {
+			return ToolkitReceipt{}, err
+		}
+	}
+	return receipt, nil
+}
+
+// decodeCoreApiFeeSource returns the total of the fees paid by the vaults of
+// a fee_source.
+func decodeCoreApiFeeSource(data json.RawMessage, path string) (DecimalValue, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return DecimalValue{}, err
+	}
+	field, fieldPath, err := requiredReceiptField(object, "from_vaults", path)
+	if err != nil {
+		return DecimalValue{}, err
+	}
+	vaults, err := decodeReceiptArray(field, fieldPath)
+	if err != nil {
+		return DecimalValue{}, err
+	}
+	total := DecimalValue{}
+	for index, vault := range vaults {
+		vaultPath := receiptPathIndex(fieldPath, index)
+		vaultObject, err := decodeReceiptObject(vault, vaultPath)
+		if err != nil {
+			return DecimalValue{}, err
+		}
+		field, amountPath, err := requiredReceiptField(vaultObject, "xrd_amount", vaultPath)
+		if err != nil {
+			return DecimalValue{}, err
+		}
+		amount, err := decodeReceiptDecimal(field, amountPath)
+		if err != nil {
+			return DecimalValue{}, err
+		}
+		if total, err = total.Add(amount); err != nil {
+			return DecimalValue{}, receiptFieldError(amountPath, "%v", err)
+		}
+	}
+	return total, nil
+}
+
+func decodeCoreApiEvent(data json.RawMessage, path string) (ToolkitEvent, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitEvent{}, err
+	}
+	field, typePath, err := requiredReceiptField(object, "type", path)
+	if err != nil {
+		return ToolkitEvent{}, err
+	}
+	eventType, err := decodeReceiptObject(field, typePath)
+	if err != nil {
+		return ToolkitEvent{}, err
+	}
+	field, fieldPath, err := requiredReceiptField(eventType, "name", typePath)
+	if err != nil {
+		return ToolkitEvent{}, err
+	}
+	var event ToolkitEvent
+	if event.TypeIdentifier.EventName, err = decodeReceiptString(field, fieldPath); err != nil {
+		return ToolkitEvent{}, err
+	}
+	if field, fieldPath, err = requiredReceiptField(eventType, "emitter", typePath); err != nil {
+		return ToolkitEvent{}, err
+	}
+	if event.TypeIdentifier.Emitter, err = decodeCoreApiEmitter(field, fieldPath); err != nil {
+		return ToolkitEvent{}, err
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "data", path); err != nil {
+		return ToolkitEvent{}, err
+	}
+	eventData, err := decodeReceiptObject(field, fieldPath)
+	if err != nil {
+		return ToolkitEvent{}, err
+	}
+	if field, fieldPath, err = requiredReceiptField(eventData, "hex", fieldPath); err != nil {
+		return ToolkitEvent{}, err
+	}
+	if event.Data, err = decodeReceiptHex(field, fieldPath); err != nil {
+		return ToolkitEvent{}, err
+	}
+	return event, nil
+}
+
+// decodeCoreApiEmitter decodes a Method emitter, {"type": "Method",
+// "entity": {"entity_address": ...}, "object_module_id": "Main"}, or a
+// Function emitter, {"type": "Function", "package_address": ...,
+// "blueprint_name": ...}.
+func decodeCoreApiEmitter(data json.RawMessage, path string) (Emitter, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return nil, err
+	}
+	field, typePath, err := requiredReceiptField(object, "type", path)
+	if err != nil {
+		return nil, err
+	}
+	emitterType, err := decodeReceiptString(field, typePath)
+	if err != nil {
+		return nil, err
+	}
+	nativeAddress := func(field json.RawMessage, fieldPath string) (*Address, error) {
+		value, err := decodeReceiptAddress(field, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		converted, err := AddressFromValue(value)
+		if err != nil {
+			return nil, receiptFieldError(fieldPath, "%v", err)
+		}
+		return converted, nil
+	}
+
+	switch emitterType {
+	case "Method":
+		field, entityPath, err := requiredReceiptField(object, "entity", path)
+		if err != nil {
+			return nil, err
+		}
+		entity, err := decodeReceiptObject(field, entityPath)
+		if err != nil {
+			return nil, err
+		}
+		field, fieldPath, err := requiredReceiptField(entity, "entity_address", entityPath)
+		if err != nil {
+			return nil, err
+		}
+		emitterAddress, err := nativeAddress(field, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		if field, fieldPath, err = requiredReceiptField(object, "object_module_id", path); err != nil {
+			return nil, err
+		}
+		moduleName, err := decodeReceiptString(field, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		var moduleId ModuleId
+		if err := moduleId.UnmarshalText([]byte(moduleName)); err != nil {
+			return nil, receiptFieldError(fieldPath, "unknown module %q", moduleName)
+		}
+		return EmitterMethod{Address: emitterAddress, ObjectModuleId: moduleId}, nil
+	case "Function":
+		field, fieldPath, err := requiredReceiptField(object, "package_address", path)
+		if err != nil {
+			return nil, err
+		}
+		packageAddress, err := nativeAddress(field, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		if field, fieldPath, err = requiredReceiptField(object, "blueprint_name", path); err != nil {
+			return nil, err
+		}
+		blueprintName, err := decodeReceiptString(field, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		return EmitterFunction{Address: packageAddress, BlueprintName: blueprintName}, nil
+	}
+	return nil, receiptFieldError(typePath, "unknown emitter type %q", emitterType)
+}
@@ -0,0 +1,236 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const decimalValueLength = 24
+
+// DecimalValue is a pure Go Decimal: 18 decimal places over a 192 bit two's
+// complement integer, bit-exact with the engine. Unlike *Decimal it lives on
+// the Go heap (or stack) only, may be compared with == and needs no Destroy.
+type DecimalValue struct {
+	subunits [3]uint64
+}
+
+// ParseDecimalValue parses a decimal in the format accepted by NewDecimal.
+func ParseDecimalValue(value string) (DecimalValue, error) {
+	subunits, err := decimalValueSpec.parse(value)
+	if err != nil {
+		return DecimalValue{}, err
+	}
+	return decimalValueFromBig(subunits), nil
+}
+
+// DecimalValueFromInt64 returns the decimal with the integral value value.
+func DecimalValueFromInt64(value int64) DecimalValue {
+	return decimalValueFromBig(new(big.Int).Mul(big.NewInt(value), decimalValueSpec.one))
+}
+
+// DecimalValueFromLeBytes is the counterpart of DecimalFromLeBytes, reading
+// the 24 byte little endian two's complement subunits.
+func DecimalValueFromLeBytes(value []byte) (DecimalValue, error) {
+	if len(value) != decimalValueLength {
+		return DecimalValue{}, NewRadixEngineToolkitErrorInvalidLength(decimalValueLength, uint64(len(value)), value)
+	}
+	var decimal DecimalValue
+	limbsFromLeBytes(decimal.subunits[:], value)
+	return decimal, nil
+}
+
+func DecimalValueZero() DecimalValue {
+	return DecimalValue{}
+}
+
+func DecimalValueOne() DecimalValue {
+	return decimalValueFromBig(decimalValueSpec.one)
+}
+
+func DecimalValueMax() DecimalValue {
+	return decimalValueFromBig(decimalValueSpec.max)
+}
+
+func DecimalValueMin() DecimalValue {
+	return decimalValueFromBig(decimalValueSpec.min)
+}
+
+func decimalValueFromBig(subunits *big.Int) DecimalValue {
+	var decimal DecimalValue
+	decimalValueSpec.fromBig(decimal.subunits[:], subunits)
+	return decimal
+}
+
+func (d DecimalValue) big() *big.Int {
+	return decimalValueSpec.toBig(d.subunits[:])
+}
+
+func decimalValueResult(subunits *big.Int, err error) (DecimalValue, error) {
+	if err != nil {
+		return DecimalValue{}, err
+	}
+	return decimalValueFromBig(subunits), nil
+}
+
+// Value converts the native decimal into a DecimalValue.
+func (_self *Decimal) Value() DecimalValue {
+	decimal, err := DecimalValueFromLeBytes(_self.ToLeBytes())
+	if err != nil {
+		panic(fmt.Sprintf("invalid native decimal: %v", err))
+	}
+	return decimal
+}
+
+// ToDecimal converts the value into a native *Decimal.
+func (d DecimalValue) ToDecimal() *Decimal {
+	return DecimalFromLeBytes(d.ToLeBytes())
+}
+
+func (d DecimalValue) ToLeBytes() []byte {
+	return limbsToLeBytes(d.subunits[:])
+}
+
+func (d DecimalValue) Add(other DecimalValue) (DecimalValue, error) {
+	var sum DecimalValue
+	if limbsAdd(sum.subunits[:], d.subunits[:], other.subunits[:]) {
+		return DecimalValue{}, NewRadixEngineToolkitErrorDecimalError()
+	}
+	return sum, nil
+}
+
+func (d DecimalValue) Sub(other DecimalValue) (DecimalValue, error) {
+	var difference DecimalValue
+	if limbsSub(difference.subunits[:], d.subunits[:], other.subunits[:]) {
+		return DecimalValue{}, NewRadixEngineToolkitErrorDecimalError()
+	}
+	return difference, nil
+}
+
+func (d DecimalValue) Mul(other DecimalValue) (DecimalValue, error) {
+	return decimalValueResult(decimalValueSpec.mul(d.big(), other.big()))
+}
+
+func (d DecimalValue) Div(other DecimalValue) (DecimalValue, error) {
+	return decimalValueResult(decimalValueSpec.div(d.big(), other.big()))
+}
+
+func (d DecimalValue) Neg() (DecimalValue, error) {
+	return DecimalValue{}.Sub(d)
+}
+
+func (d DecimalValue) Abs() (DecimalValue, error) {
+	if d.IsNegative() {
+		return d.Neg()
+	}
+	return d, nil
+}
+
+func (d DecimalValue) Powi(exp int64) (DecimalValue, error) {
+	return decimalValueResult(decimalValueSpec.powi(d.big(), exp))
+}
+
+// Sqrt returns the square root, ok being false for negative values.
+func (d DecimalValue) Sqrt() (root DecimalValue, ok bool) {
+	subunits, ok := decimalValueSpec.sqrt(d.big())
+	if !ok {
+		return DecimalValue{}, false
+	}
+	return decimalValueFromBig(subunits), true
+}
+
+// Cbrt returns the cube root, which exists for every value and never
+// overflows.
+func (d DecimalValue) Cbrt() DecimalValue {
+	subunits, _ := decimalValueSpec.nthRoot(d.big(), 3)
+	return decimalValueFromBig(subunits)
+}
+
+// NthRoot returns the nth root, ok being false for n = 0 and for even roots
+// of negative values.
+func (d DecimalValue) NthRoot(n uint32) (root DecimalValue, ok bool) {
+	subunits, ok := decimalValueSpec.nthRoot(d.big(), n)
+	if !ok {
+		return DecimalValue{}, false
+	}
+	return decimalValueFromBig(subunits), true
+}
+
+func (d DecimalValue) Round(decimalPlaces int32, roundingMode RoundingMode) (DecimalValue, error) {
+	return decimalValueResult(decimalValueSpec.round(d.big(), decimalPlaces, roundingMode))
+}
+
+func (d DecimalValue) Floor() (DecimalValue, error) {
+	return d.Round(0, RoundingModeToNegativeInfinity)
+}
+
+func (d DecimalValue) Ceiling() (DecimalValue, error) {
+	return d.Round(0, RoundingModeToPositiveInfinity)
+}
+
+// Cmp returns -1, 0 or +1 depending on whether d is less than, equal to or
+// greater than other.
+func (d DecimalValue) Cmp(other DecimalValue) int {
+	return limbsCmp(d.subunits[:], other.subunits[:])
+}
+
+func (d DecimalValue) Equal(other DecimalValue) bool {
+	return d == other
+}
+
+func (d DecimalValue) NotEqual(other DecimalValue) bool {
+	return d != other
+}
+
+func (d DecimalValue) GreaterThan(other DecimalValue) bool {
+	return d.Cmp(other) > 0
+}
+
+func (d DecimalValue) GreaterThanOrEqual(other DecimalValue) bool {
+	return d.Cmp(other) >= 0
+}
+
+func (d DecimalValue) LessThan(other DecimalValue) bool {
+	return d.Cmp(other) < 0
+}
+
+func (d DecimalValue) LessThanOrEqual(other DecimalValue) bool {
+	return d.Cmp(other) <= 0
+}
+
+func (d DecimalValue) IsZero() bool {
+	return limbsIsZero(d.subunits[:])
+}
+
+func (d DecimalValue) IsNegative() bool {
+	return limbsNegative(d.subunits[:])
+}
+
+func (d DecimalValue) IsPositive() bool {
+	return !d.IsNegative() && !d.IsZero()
+}
+
+// Mantissa returns the subunits, the value multiplied by 10^18.
+func (d DecimalValue) Mantissa() string {
+	return d.big().String()
+}
+
+func (d DecimalValue) AsStr() string {
+	return decimalValueSpec.format(d.big())
+}
+
+func (d DecimalValue) String() string {
+	return d.AsStr()
+}
+
+func (d DecimalValue) MarshalText() ([]byte, error) {
+	return []byte(d.AsStr()), nil
+}
+
+func (d *DecimalValue) UnmarshalText(text []byte) error {
+	decimal, err := ParseDecimalValue(string(text))
+	if err != nil {
+		return err
+	}
+	*d = decimal
+	return nil
+}
@@ -0,0 +1,304 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+	"testing"
+)
+
+const (
+	decimalMax        = "3138550867693340381917894711603833208051.177722232017256447"
+	decimalMin        = "-3138550867693340381917894711603833208051.177722232017256448"
+	preciseDecimalMax = "57896044618658097711785492504343953926634.992332820282019728792003956564819967"
+	preciseDecimalMin = "-57896044618658097711785492504343953926634.992332820282019728792003956564819968"
+)
+
+func mustDecimalValue(t *testing.T, value string) DecimalValue {
+	t.Helper()
+	decimal, err := ParseDecimalValue(value)
+	if err != nil {
+		t.Fatalf("ParseDecimalValue(%q): %v", value, err)
+	}
+	return decimal
+}
+
+func mustPreciseDecimalValue(t *testing.T, value string) PreciseDecimalValue {
+	t.Helper()
+	decimal, err := ParsePreciseDecimalValue(value)
+	if err != nil {
+		t.Fatalf("ParsePreciseDecimalValue(%q): %v", value, err)
+	}
+	return decimal
+}
+
+func TestParseDecimalValue(t *testing.T) {
+	tests := []struct {
+		input, output string
+	}{
+		{"0", "0"},
+		{"-0", "0"},
+		{"+1", "1"},
+		{"1.50", "1.5"},
+		{"-0.1", "-0.1"},
+		{"0.000000000000000001", "0.000000000000000001"},
+		{"-12345.678900000000000000", "-12345.6789"},
+		{decimalMax, decimalMax},
+		{decimalMin, decimalMin},
+	}
+	for _, test := range tests {
+		decimal, err := ParseDecimalValue(test.input)
+		if err != nil || decimal.String() != test.output {
+			t.Errorf("ParseDecimalValue(%q) = %v, %v, want %s", test.input, decimal, err, test.output)
+		}
+	}
+
+	for _, invalid := range []string{
+		"",
+		"-",
+		".5",
+		"1.",
+		"1.2.3",
+		"1e3",
+		"0x10",
+		"0.0000000000000000001",
+		"3138550867693340381917894711603833208051.177722232017256448",
+		"-3138550867693340381917894711603833208051.177722232017256449",
+	} {
+		if _, err := ParseDecimalValue(invalid); !errors.Is(err, ErrRadixEngineToolkitErrorParseError) {
+			t.Errorf("ParseDecimalValue(%q) = %v, want a ParseError", invalid, err)
+		}
+	}
+}
+
+func TestParsePreciseDecimalValue(t *testing.T) {
+	tests := []struct {
+		input, output string
+	}{
+		{"1.50", "1.5"},
+		{"-0.000000000000000000000000000000000001", "-0.000000000000000000000000000000000001"},
+		{preciseDecimalMax, preciseDecimalMax},
+		{preciseDecimalMin, preciseDecimalMin},
+	}
+	for _, test := range tests {
+		decimal, err := ParsePreciseDecimalValue(test.input)
+		if err != nil || decimal.String() != test.output {
+			t.Errorf("ParsePreciseDecimalValue(%q) = %v, %v, want %s", test.input, decimal, err, test.output)
+		}
+	}
+
+	for _, invalid := range []string{
+		"0.0000000000000000000000000000000000001",
+		"57896044618658097711785492504343953926634.992332820282019728792003956564819968",
+	} {
+		if _, err := ParsePreciseDecimalValue(invalid); !errors.Is(err, ErrRadixEngineToolkitErrorParseError) {
+			t.Errorf("ParsePreciseDecimalValue(%q) = %v, want a ParseError", invalid, err)
+		}
+	}
+}
+
+func TestDecimalValueLeBytes(t *testing.T) {
+	for _, value := range []string{"0", "1", "-1", "0.000000000000000001", decimalMax, decimalMin} {
+		decimal := mustDecimalValue(t, value)
+		roundTrip, err := DecimalValueFromLeBytes(decimal.ToLeBytes())
+		if err != nil || roundTrip != decimal {
+			t.Errorf("DecimalValueFromLeBytes(%s.ToLeBytes()) = %v, %v", value, roundTrip, err)
+		}
+	}
+	if one := DecimalValueOne().ToLeBytes(); one[0] != 0x00 || one[1] != 0x00 || one[7] != 0x0d || one[6] != 0xe0 {
+		t.Errorf("DecimalValueOne().ToLeBytes() = %x, want 10^18 little endian", one)
+	}
+	if _, err := DecimalValueFromLeBytes(make([]byte, 23)); !errors.Is(err, ErrRadixEngineToolkitErrorInvalidLength) {
+		t.Errorf("DecimalValueFromLeBytes of 23 bytes = %v, want an InvalidLength error", err)
+	}
+}
+
+func TestDecimalValueArithmetic(t *testing.T) {
+	tests := []struct {
+		operation string
+		a, b      string
+		result    string // empty for a DecimalError
+	}{
+		{"add", "1.5", "2.25", "3.75"},
+		{"add", decimalMax, "0.000000000000000001", ""},
+		{"sub", "1", "1.000000000000000001", "-0.000000000000000001"},
+		{"sub", decimalMin, "0.000000000000000001", ""},
+		{"mul", "1.5", "2", "3"},
+		{"mul", "-1.5", "1.5", "-2.25"},
+		{"mul", "0.000000000000000001", "0.1", "0"},
+		{"mul", "-0.000000000000000001", "0.5", "0"},
+		{"mul", decimalMax, "2", ""},
+		{"div", "1", "3", "0.333333333333333333"},
+		{"div", "2", "3", "0.666666666666666666"},
+		{"div", "-1", "3", "-0.333333333333333333"},
+		{"div", "1", "0.000000000000000001", "1000000000000000000"},
+		{"div", "1", "0", ""},
+		{"div", decimalMax, "0.5", ""},
+	}
+	for _, test := range tests {
+		a, b := mustDecimalValue(t, test.a), mustDecimalValue(t, test.b)
+		var result DecimalValue
+		var err error
+		switch test.operation {
+		case "add":
+			result, err = a.Add(b)
+		case "sub":
+			result, err = a.Sub(b)
+		case "mul":
+			result, err = a.Mul(b)
+		case "div":
+			result, err = a.Div(b)
+		}
+		if test.result == "" {
+			if !errors.Is(err, ErrRadixEngineToolkitErrorDecimalError) {
+				t.Errorf("%s %s %s = %v, %v, want a DecimalError", test.a, test.operation, test.b, result, err)
+			}
+			continue
+		}
+		if err != nil || result.String() != test.result {
+			t.Errorf("%s %s %s = %v, %v, want %s", test.a, test.operation, test.b, result, err, test.result)
+		}
+	}
+}
+
+func TestDecimalValuePowersAndRoots(t *testing.T) {
+	tests := []struct {
+		name   string
+		result func() (DecimalValue, bool)
+		want   string // empty when the operation fails
+	}{
+		{"2^10", decimalOk(mustDecimalValue(t, "2").Powi(10)), "1024"},
+		{"2^-1", decimalOk(mustDecimalValue(t, "2").Powi(-1)), "0.5"},
+		{"3^-1", decimalOk(mustDecimalValue(t, "3").Powi(-1)), "0.333333333333333333"},
+		{"0^-1", decimalOk(mustDecimalValue(t, "0").Powi(-1)), ""},
+		{"1.1^2", decimalOk(mustDecimalValue(t, "1.1").Powi(2)), "1.21"},
+		{"10^40", decimalOk(mustDecimalValue(t, "10").Powi(40)), ""},
+		{"sqrt 2", func() (DecimalValue, bool) { return mustDecimalValue(t, "2").Sqrt() }, "1.414213562373095048"},
+		{"sqrt 0.01", func() (DecimalValue, bool) { return mustDecimalValue(t, "0.01").Sqrt() }, "0.1"},
+		{"sqrt -1", func() (DecimalValue, bool) { return mustDecimalValue(t, "-1").Sqrt() }, ""},
+		{"cbrt 2", func() (DecimalValue, bool) { return mustDecimalValue(t, "2").Cbrt(), true }, "1.259921049894873164"},
+		{"cbrt -8", func() (DecimalValue, bool) { return mustDecimalValue(t, "-8").Cbrt(), true }, "-2"},
+		{"cbrt max", func() (DecimalValue, bool) { return DecimalValueMax().Cbrt(), true }, "14641190473997.345813510937532903"},
+		{"4th root 16", func() (DecimalValue, bool) { return mustDecimalValue(t, "16").NthRoot(4) }, "2"},
+		{"0th root", func() (DecimalValue, bool) { return mustDecimalValue(t, "16").NthRoot(0) }, ""},
+		{"2nd root -4", func() (DecimalValue, bool) { return mustDecimalValue(t, "-4").NthRoot(2) }, ""},
+	}
+	for _, test := range tests {
+		result, ok := test.result()
+		if test.want == "" {
+			if ok {
+				t.Errorf("%s = %v, want a failure", test.name, result)
+			}
+			continue
+		}
+		if !ok || result.String() != test.want {
+			t.Errorf("%s = %v, %v, want %s", test.name, result, ok, test.want)
+		}
+	}
+}
+
+func decimalOk(result DecimalValue, err error) func() (DecimalValue, bool) {
+	return func() (DecimalValue, bool) { return result, err == nil }
+}
+
+func TestDecimalValueRound(t *testing.T) {
+	modes := []RoundingMode{
+		RoundingModeToPositiveInfinity,
+		RoundingModeToNegativeInfinity,
+		RoundingModeToZero,
+		RoundingModeAwayFromZero,
+		RoundingModeToNearestMidpointTowardZero,
+		RoundingModeToNearestMidpointAwayFromZero,
+		RoundingModeToNearestMidpointToEven,
+	}
+	// results in the order of modes
+	tests := []struct {
+		value   string
+		results [7]string
+	}{
+		{"5.5", [7]string{"6", "5", "5", "6", "5", "6", "6"}},
+		{"2.5", [7]string{"3", "2", "2", "3", "2", "3", "2"}},
+		{"1.6", [7]string{"2", "1", "1", "2", "2", "2", "2"}},
+		{"1.1", [7]string{"2", "1", "1", "2", "1", "1", "1"}},
+		{"1", [7]string{"1", "1", "1", "1", "1", "1", "1"}},
+		{"-1.1", [7]string{"-1", "-2", "-1", "-2", "-1", "-1", "-1"}},
+		{"-1.6", [7]string{"-1", "-2", "-1", "-2", "-2", "-2", "-2"}},
+		{"-2.5", [7]string{"-2", "-3", "-2", "-3", "-2", "-3", "-2"}},
+		{"-5.5", [7]string{"-5", "-6", "-5", "-6", "-5", "-6", "-6"}},
+	}
+	for _, test := range tests {
+		for index, mode := range modes {
+			result, err := mustDecimalValue(t, test.value).Round(0, mode)
+			if err != nil || result.String() != test.results[index] {
+				t.Errorf("Round(%s, 0, %d) = %v, %v, want %s", test.value, mode, result, err, test.results[index])
+			}
+		}
+	}
+
+	if result, err := mustDecimalValue(t, "1.23456").Round(3, RoundingModeToNearestMidpointAwayFromZero); err != nil || result.String() != "1.235" {
+		t.Errorf("Round(1.23456, 3) = %v, %v, want 1.235", result, err)
+	}
+	for _, decimalPlaces := range []int32{-1, 19} {
+		if _, err := DecimalValueOne().Round(decimalPlaces, RoundingModeToZero); !errors.Is(err, ErrRadixEngineToolkitErrorDecimalError) {
+			t.Errorf("Round to %d places = %v, want a DecimalError", decimalPlaces, err)
+		}
+	}
+	if _, err := DecimalValueMax().Ceiling(); !errors.Is(err, ErrRadixEngineToolkitErrorDecimalError) {
+		t.Errorf("Ceiling of the maximum = %v, want a DecimalError", err)
+	}
+	if result, err := DecimalValueMax().Floor(); err != nil || result.String() != "3138550867693340381917894711603833208051" {
+		t.Errorf("Floor of the maximum = %v, %v", result, err)
+	}
+
+	// Rounding first rounds down, which leaves the range close to the
+	// minimum whatever the mode.
+	for _, mode := range modes {
+		for _, decimalPlaces := range []int32{0, 17} {
+			if result, err := DecimalValueMin().Round(decimalPlaces, mode); !errors.Is(err, ErrRadixEngineToolkitErrorDecimalError) {
+				t.Errorf("Round(min, %d, %d) = %v, %v, want a DecimalError", decimalPlaces, mode, result, err)
+			}
+		}
+		if result, err := DecimalValueMin().Round(18, mode); err != nil || result.String() != decimalMin {
+			t.Errorf("Round(min, 18, %d) = %v, %v, want the minimum", mode, result, err)
+		}
+		if result, err := mustDecimalValue(t, "-3138550867693340381917894711603833208051.17772223201725644").Round(16, mode); !errors.Is(err, ErrRadixEngineToolkitErrorDecimalError) {
+			t.Errorf("Round(min + 8e-18, 16, %d) = %v, %v, want a DecimalError", mode, result, err)
+		}
+	}
+	if _, err := DecimalValueMin().Ceiling(); !errors.Is(err, ErrRadixEngineToolkitErrorDecimalError) {
+		t.Errorf("Ceiling of the minimum = %v, want a DecimalError", err)
+	}
+	if result, err := mustDecimalValue(t, "-3138550867693340381917894711603833208050.5").Ceiling(); err != nil || result.String() != "-3138550867693340381917894711603833208050" {
+		t.Errorf("Ceiling(min + 0.677722232017256448) = %v, %v", result, err)
+	}
+}
+
+func TestPreciseDecimalValueArithmetic(t *testing.T) {
+	third, err := PreciseDecimalValueOne().Div(mustPreciseDecimalValue(t, "3"))
+	if err != nil || third.String() != "0.333333333333333333333333333333333333" {
+		t.Errorf("1 / 3 = %v, %v", third, err)
+	}
+	product, err := mustPreciseDecimalValue(t, "0.000000000000000000000000000000000001").Mul(mustPreciseDecimalValue(t, "0.5"))
+	if err != nil || !product.IsZero() {
+		t.Errorf("1e-36 * 0.5 = %v, %v, want 0", product, err)
+	}
+	if _, err := PreciseDecimalValueMax().Mul(mustPreciseDecimalValue(t, "1.000000000000000000000000000000000001")); !errors.Is(err, ErrRadixEngineToolkitErrorDecimalError) {
+		t.Errorf("max * (1 + 1e-36) = %v, want a DecimalError", err)
+	}
+	if root, ok := mustPreciseDecimalValue(t, "2").Sqrt(); !ok || root.String() != "1.414213562373095048801688724209698078" {
+		t.Errorf("sqrt 2 = %v, %v", root, ok)
+	}
+	if root := mustPreciseDecimalValue(t, "2").Cbrt(); root.String() != "1.25992104989487316476721060727822835" {
+		t.Errorf("cbrt 2 = %v", root)
+	}
+	rounded, err := mustPreciseDecimalValue(t, "-2.5").Round(0, RoundingModeToNearestMidpointToEven)
+	if err != nil || rounded.String() != "-2" {
+		t.Errorf("Round(-2.5, 0, ToEven) = %v, %v, want -2", rounded, err)
+	}
+	for _, mode := range []RoundingMode{RoundingModeToPositiveInfinity, RoundingModeToZero, RoundingModeAwayFromZero} {
+		if result, err := PreciseDecimalValueMin().Round(0, mode); !errors.Is(err, ErrRadixEngineToolkitErrorDecimalError) {
+			t.Errorf("Round(min, 0, %d) = %v, %v, want a DecimalError", mode, result, err)
+		}
+	}
+	if result, err := PreciseDecimalValueMin().Round(36, RoundingModeToZero); err != nil || result.String() != preciseDecimalMin {
+		t.Errorf("Round(min, 36) = %v, %v, want the minimum", result, err)
+	}
+}
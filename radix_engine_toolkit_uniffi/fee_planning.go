@@ -0,0 +1,229 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// Fee planning
+//
+// PlanFee turns the fee consumption of a preview into the amount of XRD to
+// lock. The tip applies to the execution and finalization costs only, and the
+// margin to the whole fee:
+//
+//	lock = ((execution + finalization) × (1 + tip) + storage + royalty) × (1 + margin)
+//
+// WithFeeLock previews nothing itself: it takes the receipt of a preview of
+// the manifest, plans the fee from its dynamic analysis and returns the
+// manifest locking that amount on the fee payer account. The amount of the
+// first lock_fee, lock_fee_and_withdraw or lock_fee_and_withdraw_non_fungibles
+// call on the fee payer is replaced; without one, a lock_fee call is
+// inserted as the first instruction:
+//
+//	manifest, plan, err := manifest.WithFeeLock(networkId, receipt, FeePlanOptions{
+//		FeePayer:          account,
+//		TipBasisPoints:    header.TipBasisPoints,
+//		MarginBasisPoints: DefaultFeeMarginBasisPoints,
+//	})
+
+// DefaultFeeMarginBasisPoints is a margin of 20%, which covers the lock_fee
+// call inserted in a previewed manifest which had none and the variations
+// of the fees between the preview and the submission.
+const DefaultFeeMarginBasisPoints = 2000
+
+// FeePlanOptions are the options of PlanFee and WithFeeLock.
+type FeePlanOptions struct {
+	// FeePayer is the account the fee is locked on.
+	FeePayer *Address
+	// TipBasisPoints is the tip of the transaction: the TipBasisPoints of a
+	// TransactionHeaderV2, or 100 times the TipPercentage of a
+	// TransactionHeaderV1.
+	TipBasisPoints uint32
+	// MarginBasisPoints is the safety margin added to the fee.
+	MarginBasisPoints uint32
+}
+
+// FeePlan is the breakdown of the fee to lock for a transaction.
+type FeePlan struct {
+	ExecutionCost        DecimalValue
+	FinalizationCost     DecimalValue
+	StorageExpansionCost DecimalValue
+	RoyaltyCost          DecimalValue
+	Tip                  DecimalValue
+	Margin               DecimalValue
+	// Lock is the amount to lock, the sum of all of the above.
+	Lock DecimalValue
+	// PreviewLock is the fee the previewed manifest locked.
+	PreviewLock DecimalValue
+}
+
+// PlanFee plans the fee of a transaction from the fee consumption and the
+// fee locks of its preview.
+func PlanFee(consumption FeeSummary, locks FeeLocks, options FeePlanOptions) (FeePlan, error) {
+	return planFee(FeePlan{
+		ExecutionCost:        decimalValueOrZero(consumption.ExecutionCost),
+		FinalizationCost:     decimalValueOrZero(consumption.FinalizationCost),
+		StorageExpansionCost: decimalValueOrZero(consumption.StorageExpansionCost),
+		RoyaltyCost:          decimalValueOrZero(consumption.RoyaltyCost),
+		PreviewLock:          decimalValueOrZero(locks.Lock),
+	}, options)
+}
+
+// planFee fills in the tip, the margin and the lock of plan from its costs.
+func planFee(plan FeePlan, options FeePlanOptions) (FeePlan, error) {
+	tipped, err := plan.ExecutionCost.Add(plan.FinalizationCost)
+	if err != nil {
+		return FeePlan{}, err
+	}
+	if plan.Tip, err = basisPointsOf(tipped, options.TipBasisPoints); err != nil {
+		return FeePlan{}, err
+	}
+	fee := tipped
+	for _, cost := range []DecimalValue{plan.Tip, plan.StorageExpansionCost, plan.RoyaltyCost} {
+		if fee, err = fee.Add(cost); err != nil {
+			return FeePlan{}, err
+		}
+	}
+	if plan.Margin, err = basisPointsOf(fee, options.MarginBasisPoints); err != nil {
+		return FeePlan{}, err
+	}
+	if plan.Lock, err = fee.Add(plan.Margin); err != nil {
+		return FeePlan{}, err
+	}
+	return plan, nil
+}
+
+func decimalValueOrZero(value *Decimal) DecimalValue {
+	if value == nil {
+		return DecimalValueZero()
+	}
+	return value.Value()
+}
+
+// basisPointsOf returns basisPoints ten-thousandths of value.
+func basisPointsOf(value DecimalValue, basisPoints uint32) (DecimalValue, error) {
+	product, err := value.Mul(DecimalValueFromInt64(int64(basisPoints)))
+	if err != nil {
+		return DecimalValue{}, err
+	}
+	return product.Div(DecimalValueFromInt64(10_000))
+}
+
+// WithFeeLock plans the fee of the manifest from the receipt of its preview
+// and returns the manifest locking it on options.FeePayer.
+func (_self *TransactionManifestV1) WithFeeLock(networkId uint8, receipt ToolkitReceipt, options FeePlanOptions) (*TransactionManifestV1, FeePlan, error) {
+	plan, payer, err := planManifestFee(networkId, receipt, options, _self.DynamicallyAnalyzeReceipt)
+	if err != nil {
+		return nil, FeePlan{}, err
+	}
+	instructions := _self.Instructions()
+	defer instructions.Destroy()
+	manifest, err := _self.WithInstructions(lockFeeInstructionsV1(instructions.InstructionsList(), options.FeePayer, payer, plan.Lock))
+	return manifest, plan, err
+}
+
+// WithFeeLock plans the fee of the manifest from the receipt of its preview
+// and returns the manifest locking it on options.FeePayer.
+func (_self *TransactionManifestV2) WithFeeLock(networkId uint8, receipt ToolkitReceipt, options FeePlanOptions) (*TransactionManifestV2, FeePlan, error) {
+	plan, payer, err := planManifestFee(networkId, receipt, options, _self.DynamicallyAnalyzeReceipt)
+	if err != nil {
+		return nil, FeePlan{}, err
+	}
+	instructions := _self.Instructions()
+	defer instructions.Destroy()
+	manifest, err := _self.WithInstructions(lockFeeInstructionsV2(instructions.InstructionsList(), options.FeePayer, payer, plan.Lock))
+	return manifest, plan, err
+}
+
+// lockFeeInstructionsV1 returns list with the amount of the first lock fee
+// call on payer replaced by lock, or with a lock_fee call on feePayer
+// inserted first.
+func lockFeeInstructionsV1(list []InstructionV1, feePayer *Address, payer address.Address, lock DecimalValue) []InstructionV1 {
+	list = slices.Clone(list)
+	for index, instruction := range list {
+		call, ok := instruction.(InstructionV1CallMethod)
+		if !ok {
+			continue
+		}
+		if args, ok := lockFeeArgs(call.Address, call.MethodName, call.Args, payer, lock); ok {
+			call.Args = args
+			list[index] = call
+			return list
+		}
+	}
+	return append([]InstructionV1{InstructionV1CallMethod{
+		Address:    ManifestAddressStatic{StaticAddress: feePayer},
+		MethodName: "lock_fee",
+		Args:       ManifestValueTupleValue{Fields: []ManifestValue{ManifestValueDecimalValue{Value: lock.ToDecimal()}}},
+	}}, list...)
+}
+
+// lockFeeInstructionsV2 is lockFeeInstructionsV1 for the instructions of a
+// TransactionManifestV2.
+func lockFeeInstructionsV2(list []InstructionV2, feePayer *Address, payer address.Address, lock DecimalValue) []InstructionV2 {
+	list = slices.Clone(list)
+	for index, instruction := range list {
+		call, ok := instruction.(InstructionV2CallMethod)
+		if !ok {
+			continue
+		}
+		if args, ok := lockFeeArgs(call.Address, call.MethodName, call.Args, payer, lock); ok {
+			call.Args = args
+			list[index] = call
+			return list
+		}
+	}
+	return append([]InstructionV2{InstructionV2CallMethod{
+		Address:    ManifestAddressStatic{StaticAddress: feePayer},
+		MethodName: "lock_fee",
+		Args:       ManifestValueTupleValue{Fields: []ManifestValue{ManifestValueDecimalValue{Value: lock.ToDecimal()}}},
+	}}, list...)
+}
+
+func planManifestFee(networkId uint8, receipt ToolkitReceipt, options FeePlanOptions, analyze func(uint8, ToolkitReceipt) (DynamicAnalysis, error)) (FeePlan, address.Address, error) {
+	if options.FeePayer == nil {
+		return FeePlan{}, address.Address{}, fmt.Errorf("WithFeeLock: no fee payer")
+	}
+	payer, err := options.FeePayer.Value()
+	if err != nil {
+		return FeePlan{}, address.Address{}, err
+	}
+	if !isAccount(payer) {
+		return FeePlan{}, address.Address{}, fmt.Errorf("WithFeeLock: the fee payer %s is not an account", payer)
+	}
+	if receipt.Kind != ToolkitReceiptKindCommitSuccess {
+		return FeePlan{}, address.Address{}, fmt.Errorf("WithFeeLock: the preview did not succeed: %s", receipt.Kind)
+	}
+	analysis, err := analyze(networkId, receipt)
+	if err != nil {
+		return FeePlan{}, address.Address{}, err
+	}
+	plan, err := PlanFee(analysis.FeeConsumptionSummary, analysis.FeeLocksSummary, options)
+	return plan, payer, err
+}
+
+// lockFeeArgs returns the args of a call with the amount to lock replaced,
+// if the call locks a fee on payer.
+func lockFeeArgs(callee ManifestAddress, methodName string, args ManifestValue, payer address.Address, amount DecimalValue) (ManifestValue, bool) {
+	switch methodName {
+	case "lock_fee", "lock_fee_and_withdraw", "lock_fee_and_withdraw_non_fungibles":
+	default:
+		return nil, false
+	}
+	static, ok := callee.(ManifestAddressStatic)
+	if !ok || static.StaticAddress == nil {
+		return nil, false
+	}
+	if calleeAddress, err := static.StaticAddress.Value(); err != nil || calleeAddress != payer {
+		return nil, false
+	}
+	tuple, ok := args.(ManifestValueTupleValue)
+	if !ok || len(tuple.Fields) == 0 {
+		return nil, false
+	}
+	fields := append([]ManifestValue(nil), tuple.Fields...)
+	fields[0] = ManifestValueDecimalValue{Value: amount.ToDecimal()}
+	return ManifestValueTupleValue{Fields: fields}, true
+}
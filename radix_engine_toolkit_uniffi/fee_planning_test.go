@@ -0,0 +1,136 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+func TestPlanFee(t *testing.T) {
+	costs := FeePlan{
+		ExecutionCost:        mustDecimalValue(t, "10"),
+		FinalizationCost:     mustDecimalValue(t, "2"),
+		StorageExpansionCost: mustDecimalValue(t, "3"),
+		RoyaltyCost:          mustDecimalValue(t, "1"),
+	}
+	tests := []struct {
+		name                string
+		tip, margin         uint32
+		wantTip, wantMargin string
+		wantLock            string
+	}{
+		{"no tip and no margin", 0, 0, "0", "0", "16"},
+		// The tip is 1% of 10 + 2, not of the storage and royalty costs.
+		{"tip", 100, 0, "0.12", "0", "16.12"},
+		// The margin is 20% of the tipped fee.
+		{"tip and margin", 100, DefaultFeeMarginBasisPoints, "0.12", "3.224", "19.344"},
+		{"margin", 0, 5000, "0", "8", "24"},
+	}
+	for _, test := range tests {
+		plan, err := planFee(costs, FeePlanOptions{TipBasisPoints: test.tip, MarginBasisPoints: test.margin})
+		if err != nil {
+			t.Errorf("%s: planFee = %v", test.name, err)
+			continue
+		}
+		if plan.Tip.String() != test.wantTip || plan.Margin.String() != test.wantMargin || plan.Lock.String() != test.wantLock {
+			t.Errorf("%s: planFee tip, margin, lock = %s, %s, %s, want %s, %s, %s",
+				test.name, plan.Tip, plan.Margin, plan.Lock, test.wantTip, test.wantMargin, test.wantLock)
+		}
+		if !plan.ExecutionCost.Equal(costs.ExecutionCost) || !plan.RoyaltyCost.Equal(costs.RoyaltyCost) {
+			t.Errorf("%s: planFee changed the costs to %+v", test.name, plan)
+		}
+	}
+
+	if _, err := planFee(FeePlan{ExecutionCost: DecimalValueMax()}, FeePlanOptions{MarginBasisPoints: 1}); err == nil {
+		t.Error("planFee overflowing the lock succeeded")
+	}
+}
+
+// lockedAmount returns the amount locked by the args of a lock fee call.
+func lockedAmount(t *testing.T, args ManifestValue) string {
+	t.Helper()
+	tuple, ok := args.(ManifestValueTupleValue)
+	if !ok || len(tuple.Fields) == 0 {
+		t.Fatalf("lock fee args %#v, want a tuple", args)
+	}
+	amount, ok := tuple.Fields[0].(ManifestValueDecimalValue)
+	if !ok {
+		t.Fatalf("lock fee amount %#v, want a decimal", tuple.Fields[0])
+	}
+	return amount.Value.AsStr()
+}
+
+func TestLockFeeInstructions(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	payer, err := addresses.sender.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lock := mustDecimalValue(t, "19.344")
+	call := func(callee *Address, methodName string, fields ...ManifestValue) InstructionV2CallMethod {
+		return InstructionV2CallMethod{
+			Address:    ManifestAddressStatic{StaticAddress: callee},
+			MethodName: methodName,
+			Args:       ManifestValueTupleValue{Fields: fields},
+		}
+	}
+	amount := func(value string) ManifestValue {
+		return ManifestValueDecimalValue{Value: testDecimal(t, value)}
+	}
+	withdraw := call(addresses.sender, "withdraw", ManifestValueAddressValue{Value: ManifestAddressStatic{StaticAddress: addresses.xrd}}, amount("1"))
+
+	// The lock_fee_and_withdraw of the payer keeps its withdrawal.
+	list := []InstructionV2{
+		call(addresses.alice, "lock_fee", amount("5")),
+		call(addresses.sender, "lock_fee_and_withdraw", amount("5"), ManifestValueAddressValue{Value: ManifestAddressStatic{StaticAddress: addresses.xrd}}, amount("1")),
+		withdraw,
+	}
+	replaced := lockFeeInstructionsV2(list, addresses.sender, payer, lock)
+	if len(replaced) != len(list) {
+		t.Fatalf("replacing a lock fee returned %d instructions, want %d", len(replaced), len(list))
+	}
+	if got := lockedAmount(t, replaced[0].(InstructionV2CallMethod).Args); got != "5" {
+		t.Errorf("lock fee of another account changed to %s", got)
+	}
+	lockAndWithdraw := replaced[1].(InstructionV2CallMethod)
+	if got := lockedAmount(t, lockAndWithdraw.Args); lockAndWithdraw.MethodName != "lock_fee_and_withdraw" || got != lock.String() {
+		t.Errorf("replaced %s of %s, want lock_fee_and_withdraw of %s", lockAndWithdraw.MethodName, got, lock)
+	}
+	if fields := lockAndWithdraw.Args.(ManifestValueTupleValue).Fields; len(fields) != 3 {
+		t.Errorf("replaced lock_fee_and_withdraw has %d args, want 3", len(fields))
+	}
+	if got := lockedAmount(t, list[1].(InstructionV2CallMethod).Args); got != "5" {
+		t.Errorf("lockFeeInstructionsV2 changed its argument to %s", got)
+	}
+
+	inserted := lockFeeInstructionsV2([]InstructionV2{withdraw}, addresses.sender, payer, lock)
+	if len(inserted) != 2 {
+		t.Fatalf("inserting a lock fee returned %d instructions, want 2", len(inserted))
+	}
+	lockFee, ok := inserted[0].(InstructionV2CallMethod)
+	if !ok || lockFee.MethodName != "lock_fee" || lockedAmount(t, lockFee.Args) != lock.String() {
+		t.Errorf("inserted %#v, want lock_fee of %s", inserted[0], lock)
+	}
+
+	insertedV1 := lockFeeInstructionsV1([]InstructionV1{InstructionV1DropAllProofs{}}, addresses.sender, payer, lock)
+	if lockFee, ok := insertedV1[0].(InstructionV1CallMethod); len(insertedV1) != 2 || !ok || lockFee.MethodName != "lock_fee" {
+		t.Errorf("lockFeeInstructionsV1 = %#v, want a lock_fee call first", insertedV1)
+	}
+}
+
+func TestPlanManifestFeePayer(t *testing.T) {
+	analyze := func(uint8, ToolkitReceipt) (DynamicAnalysis, error) {
+		t.Fatal("the receipt of an invalid fee payer was analyzed")
+		return DynamicAnalysis{}, nil
+	}
+	receipt := ToolkitReceipt{Kind: ToolkitReceiptKindCommitSuccess}
+	for _, entityType := range []address.EntityType{address.EntityTypeGlobalIdentity, address.EntityTypeGlobalFungibleResourceManager} {
+		payer := testAddress(t, entityType, 1)
+		if _, _, err := planManifestFee(1, receipt, FeePlanOptions{FeePayer: payer}, analyze); err == nil {
+			t.Errorf("planManifestFee with the fee payer %s succeeded", payer.AsStr())
+		}
+	}
+	if _, _, err := planManifestFee(1, receipt, FeePlanOptions{}, analyze); err == nil {
+		t.Error("planManifestFee without a fee payer succeeded")
+	}
+}
@@ -0,0 +1,386 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+	"strings"
+)
+
+// Fixed point decimals
+//
+// DecimalValue and PreciseDecimalValue are Go value types with the semantics
+// of the engine's Decimal and PreciseDecimal: a two's complement I192 or I256
+// holding the value multiplied by 10^18 or 10^36. Additions, subtractions and
+// comparisons work on the limbs directly; the remaining operations follow the
+// engine's algorithms on big.Int, including its wider intermediates, truncating
+// divisions and overflow rules, so that results are bit-exact with the
+// native library. Operations which fail in the engine return a
+// RadixEngineToolkitErrorDecimalError.
+
+// decimalSpec describes one of the engine's fixed point decimal types.
+type decimalSpec struct {
+	typeName   string
+	scale      int
+	limbs      int
+	one        *big.Int
+	min, max   *big.Int
+	modulus    *big.Int
+	tooPrecise string
+}
+
+func newDecimalSpec(typeName string, scale int, limbs int, tooPrecise string) *decimalSpec {
+	width := uint(64 * limbs)
+	max := new(big.Int).Lsh(big.NewInt(1), width-1)
+	return &decimalSpec{
+		typeName:   typeName,
+		scale:      scale,
+		limbs:      limbs,
+		one:        new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil),
+		min:        new(big.Int).Neg(max),
+		max:        max.Sub(max, big.NewInt(1)),
+		modulus:    new(big.Int).Lsh(big.NewInt(1), width),
+		tooPrecise: tooPrecise,
+	}
+}
+
+var (
+	decimalValueSpec        = newDecimalSpec("Decimal", 18, 3, "MoreThanEighteenDecimalPlaces")
+	preciseDecimalValueSpec = newDecimalSpec("PreciseDecimal", 36, 4, "MoreThanThirtySixDecimalPlaces")
+)
+
+func (spec *decimalSpec) fits(value *big.Int) bool {
+	return value.Cmp(spec.min) >= 0 && value.Cmp(spec.max) <= 0
+}
+
+func (spec *decimalSpec) checked(value *big.Int) (*big.Int, error) {
+	if !spec.fits(value) {
+		return nil, NewRadixEngineToolkitErrorDecimalError()
+	}
+	return value, nil
+}
+
+func (spec *decimalSpec) parseError(reason string) error {
+	return NewRadixEngineToolkitErrorParseError(spec.typeName, reason)
+}
+
+// parse follows the engine's FromStr: an optionally signed integral part and
+// up to scale fractional digits.
+func (spec *decimalSpec) parse(value string) (*big.Int, error) {
+	parts := strings.Split(value, ".")
+	if len(parts) > 2 {
+		return nil, spec.parseError("MoreThanOneDecimalPoint")
+	}
+	integral, negative := parts[0], false
+	if strings.HasPrefix(integral, "-") || strings.HasPrefix(integral, "+") {
+		integral, negative = integral[1:], integral[0] == '-'
+	}
+	if integral == "" {
+		return nil, spec.parseError("EmptyIntegralPart")
+	}
+	if !isDecimalDigits(integral) {
+		return nil, spec.parseError("InvalidDigit")
+	}
+	subunits, _ := new(big.Int).SetString(integral, 10)
+	subunits.Mul(subunits, spec.one)
+	if len(parts) == 2 {
+		fractional := parts[1]
+		if len(fractional) > spec.scale {
+			return nil, spec.parseError(spec.tooPrecise)
+		}
+		if fractional == "" {
+			return nil, spec.parseError("EmptyFractionalPart")
+		}
+		if !isDecimalDigits(fractional) {
+			return nil, spec.parseError("InvalidDigit")
+		}
+		fractionalSubunits, _ := new(big.Int).SetString(fractional+strings.Repeat("0", spec.scale-len(fractional)), 10)
+		subunits.Add(subunits, fractionalSubunits)
+	}
+	if negative {
+		subunits.Neg(subunits)
+	}
+	if !spec.fits(subunits) {
+		return nil, spec.parseError("Overflow")
+	}
+	return subunits, nil
+}
+
+func isDecimalDigits(value string) bool {
+	for _, character := range value {
+		if character < '0' || character > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// format follows the engine's Display: no trailing fractional zeros and no
+// decimal point for whole numbers.
+func (spec *decimalSpec) format(subunits *big.Int) string {
+	quotient, remainder := new(big.Int).QuoRem(subunits, spec.one, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient.String()
+	}
+	sign := ""
+	if remainder.Sign() < 0 && quotient.Sign() == 0 {
+		sign = "-"
+	}
+	fractional := remainder.Abs(remainder).String()
+	fractional = strings.Repeat("0", spec.scale-len(fractional)) + fractional
+	return sign + quotient.String() + "." + strings.TrimRight(fractional, "0")
+}
+
+func (spec *decimalSpec) mul(a, b *big.Int) (*big.Int, error) {
+	product := new(big.Int).Mul(a, b)
+	return spec.checked(product.Quo(product, spec.one))
+}
+
+func (spec *decimalSpec) div(a, b *big.Int) (*big.Int, error) {
+	if b.Sign() == 0 {
+		return nil, NewRadixEngineToolkitErrorDecimalError()
+	}
+	quotient := new(big.Int).Mul(a, spec.one)
+	return spec.checked(quotient.Quo(quotient, b))
+}
+
+// powi is the engine's exponentiation by squaring, truncating after every
+// squaring.
+func (spec *decimalSpec) powi(base *big.Int, exp int64) (*big.Int, error) {
+	switch {
+	case exp < 0:
+		if base.Sign() == 0 || exp == math.MinInt64 {
+			return nil, NewRadixEngineToolkitErrorDecimalError()
+		}
+		inverse := new(big.Int).Mul(spec.one, spec.one)
+		inverse, err := spec.checked(inverse.Quo(inverse, base))
+		if err != nil {
+			return nil, err
+		}
+		return spec.powi(inverse, -exp)
+	case exp == 0:
+		return new(big.Int).Set(spec.one), nil
+	case exp == 1:
+		return base, nil
+	}
+	square := new(big.Int).Mul(base, base)
+	square, err := spec.checked(square.Quo(square, spec.one))
+	if err != nil {
+		return nil, err
+	}
+	if exp%2 == 0 {
+		return spec.powi(square, exp/2)
+	}
+	power, err := spec.powi(square, (exp-1)/2)
+	if err != nil {
+		return nil, err
+	}
+	return spec.mul(base, power)
+}
+
+func (spec *decimalSpec) sqrt(value *big.Int) (*big.Int, bool) {
+	if value.Sign() < 0 {
+		return nil, false
+	}
+	scaled := new(big.Int).Mul(value, spec.one)
+	return scaled.Sqrt(scaled), true
+}
+
+func (spec *decimalSpec) nthRoot(value *big.Int, n uint32) (*big.Int, bool) {
+	switch {
+	case n == 0 || (value.Sign() < 0 && n%2 == 0):
+		return nil, false
+	case n == 1:
+		return value, true
+	}
+	scale := new(big.Int).Exp(spec.one, big.NewInt(int64(n-1)), nil)
+	scaled := scale.Mul(scale, value)
+	root := integerNthRoot(new(big.Int).Abs(scaled), uint(n))
+	if value.Sign() < 0 {
+		root.Neg(root)
+	}
+	return root, true
+}
+
+// integerNthRoot returns the floor of the nth root of a non-negative value.
+func integerNthRoot(value *big.Int, n uint) *big.Int {
+	if value.Sign() == 0 {
+		return new(big.Int)
+	}
+	root := new(big.Int).Lsh(big.NewInt(1), (uint(value.BitLen())+n-1)/n)
+	bigN, bigNMinusOne := new(big.Int).SetUint64(uint64(n)), new(big.Int).SetUint64(uint64(n-1))
+	for {
+		next := new(big.Int).Exp(root, bigNMinusOne, nil)
+		next.Quo(value, next)
+		next.Add(next, new(big.Int).Mul(root, bigNMinusOne))
+		next.Quo(next, bigN)
+		if next.Cmp(root) >= 0 {
+			return root
+		}
+		root = next
+	}
+}
+
+type roundingStrategy int
+
+const (
+	roundUp roundingStrategy = iota
+	roundDown
+	roundToEven
+)
+
+func resolveRoundingStrategy(mode RoundingMode, isPositive bool, compareToMidpoint func() int) (roundingStrategy, error) {
+	towardZero, awayFromZero := roundUp, roundDown
+	if isPositive {
+		towardZero, awayFromZero = roundDown, roundUp
+	}
+	nearest := func(tie roundingStrategy) roundingStrategy {
+		switch compareToMidpoint() {
+		case -1:
+			return roundDown
+		case 1:
+			return roundUp
+		}
+		return tie
+	}
+	switch mode {
+	case RoundingModeToPositiveInfinity:
+		return roundUp, nil
+	case RoundingModeToNegativeInfinity:
+		return roundDown, nil
+	case RoundingModeToZero:
+		return towardZero, nil
+	case RoundingModeAwayFromZero:
+		return awayFromZero, nil
+	case RoundingModeToNearestMidpointTowardZero:
+		return nearest(towardZero), nil
+	case RoundingModeToNearestMidpointAwayFromZero:
+		return nearest(awayFromZero), nil
+	case RoundingModeToNearestMidpointToEven:
+		return nearest(roundToEven), nil
+	}
+	return 0, fmt.Errorf("invalid rounding mode %d", mode)
+}
+
+func (spec *decimalSpec) round(value *big.Int, decimalPlaces int32, mode RoundingMode) (*big.Int, error) {
+	if decimalPlaces < 0 || int(decimalPlaces) > spec.scale {
+		return nil, NewRadixEngineToolkitErrorDecimalError()
+	}
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(spec.scale-int(decimalPlaces))), nil)
+	remainder := new(big.Int).Rem(value, divisor)
+	switch remainder.Sign() {
+	case 0:
+		return value, nil
+	case -1:
+		remainder.Add(remainder, divisor)
+	}
+	strategy, err := resolveRoundingStrategy(mode, value.Sign() > 0, func() int {
+		return remainder.Cmp(new(big.Int).Rsh(divisor, 1))
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The engine rounds down first and checks every step, so rounding up a
+	// value close to the minimum fails even when the result would fit.
+	rounded, err := spec.checked(new(big.Int).Sub(value, remainder))
+	if err != nil {
+		return nil, err
+	}
+	switch strategy {
+	case roundUp:
+		rounded.Add(rounded, divisor)
+	case roundToEven:
+		doubleDivisor := new(big.Int).Lsh(divisor, 1)
+		if new(big.Int).Rem(rounded, doubleDivisor).Sign() != 0 {
+			rounded.Add(rounded, divisor)
+		}
+	}
+	return spec.checked(rounded)
+}
+
+// Two's complement limbs, least significant first.
+
+func limbsNegative(x []uint64) bool {
+	return x[len(x)-1]>>63 == 1
+}
+
+func limbsIsZero(x []uint64) bool {
+	for _, limb := range x {
+		if limb != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// limbsAdd sets z to x + y, reporting a signed overflow.
+func limbsAdd(z, x, y []uint64) bool {
+	xNegative, yNegative := limbsNegative(x), limbsNegative(y)
+	var carry uint64
+	for i := range z {
+		z[i], carry = bits.Add64(x[i], y[i], carry)
+	}
+	return xNegative == yNegative && limbsNegative(z) != xNegative
+}
+
+// limbsSub sets z to x - y, reporting a signed overflow.
+func limbsSub(z, x, y []uint64) bool {
+	xNegative, yNegative := limbsNegative(x), limbsNegative(y)
+	var borrow uint64
+	for i := range z {
+		z[i], borrow = bits.Sub64(x[i], y[i], borrow)
+	}
+	return xNegative != yNegative && limbsNegative(z) != xNegative
+}
+
+func limbsCmp(x, y []uint64) int {
+	xNegative, yNegative := limbsNegative(x), limbsNegative(y)
+	switch {
+	case xNegative && !yNegative:
+		return -1
+	case !xNegative && yNegative:
+		return 1
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		switch {
+		case x[i] < y[i]:
+			return -1
+		case x[i] > y[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+func (spec *decimalSpec) toBig(x []uint64) *big.Int {
+	value := new(big.Int).SetBytes(reverseBytes(limbsToLeBytes(x)))
+	if limbsNegative(x) {
+		value.Sub(value, spec.modulus)
+	}
+	return value
+}
+
+// fromBig sets z to value, which must fit the type.
+func (spec *decimalSpec) fromBig(z []uint64, value *big.Int) {
+	unsigned := new(big.Int).Set(value)
+	if unsigned.Sign() < 0 {
+		unsigned.Add(unsigned, spec.modulus)
+	}
+	limbsFromLeBytes(z, reverseBytes(unsigned.FillBytes(make([]byte, 8*spec.limbs))))
+}
+
+func limbsToLeBytes(x []uint64) []byte {
+	bytes := make([]byte, 8*len(x))
+	for i, limb := range x {
+		binary.LittleEndian.PutUint64(bytes[8*i:], limb)
+	}
+	return bytes
+}
+
+func limbsFromLeBytes(z []uint64, bytes []byte) {
+	for i := range z {
+		z[i] = binary.LittleEndian.Uint64(bytes[8*i:])
+	}
+}
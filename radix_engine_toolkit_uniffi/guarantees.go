@@ -0,0 +1,220 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Guarantees
+//
+// WithGuarantees turns the predicted fungible deposits of a dynamic analysis
+// into assertions, so that the transaction fails rather than depositing less
+// than the predicted amount minus the slippage tolerance. The assertion of a
+// resource is inserted right after the instruction which put the predicted
+// amount on the worktop, the CreatedAt of the prediction:
+//
+//   - after a take_all_from_worktop, a V2 manifest asserts the contents of
+//     the bucket with assert_bucket_contents, and a V1 manifest asserts the
+//     worktop before the instruction instead;
+//   - after any other instruction, a V2 manifest asserts the resources with
+//     assert_worktop_resources_include, or with assert_worktop_contains when
+//     there is a single one, and a V1 manifest with assert_worktop_contains.
+//
+// Deposits of the same resource predicted at the same instruction are
+// asserted as one sum. Non fungible deposits are not asserted, their ids
+// being guaranteed or not at all:
+//
+//	analysis, err := manifest.DynamicallyAnalyzeReceipt(networkId, receipt)
+//	guaranteed, guarantees, err := manifest.WithGuarantees(analysis, GuaranteeOptions{
+//		DefaultSlippageBasisPoints: 100,
+//		SlippageBasisPoints:        map[string]uint32{volatileResource: 500},
+//	})
+
+// GuaranteeOptions are the options of WithGuarantees.
+type GuaranteeOptions struct {
+	// DefaultSlippageBasisPoints is the slippage tolerance of the resources
+	// missing from SlippageBasisPoints.
+	DefaultSlippageBasisPoints uint32
+	// SlippageBasisPoints are the slippage tolerances by resource address.
+	SlippageBasisPoints map[string]uint32
+}
+
+// Guarantee is an assertion inserted by WithGuarantees.
+type Guarantee struct {
+	ResourceAddress *Address
+	// CreatedAt is the index in the analyzed manifest of the instruction
+	// which put the resource on the worktop.
+	CreatedAt uint64
+	// InstructionIndex is the index of the assertion in the guaranteed
+	// manifest, shared by the resources of an
+	// assert_worktop_resources_include.
+	InstructionIndex uint64
+	Predicted        DecimalValue
+	Minimum          DecimalValue
+}
+
+// WithGuarantees returns the manifest with assertions of the predicted
+// deposits of its dynamic analysis, and the assertions inserted.
+func (_self *TransactionManifestV1) WithGuarantees(analysis DynamicAnalysis, options GuaranteeOptions) (*TransactionManifestV1, []Guarantee, error) {
+	instructions := _self.Instructions()
+	defer instructions.Destroy()
+	list := instructions.InstructionsList()
+	guarantees, err := planGuarantees(analysis, options, len(list))
+	if err != nil {
+		return nil, nil, err
+	}
+	rewritten := make([]InstructionV1, 0, len(list)+len(guarantees))
+	assert := func(group []Guarantee) {
+		for i := range group {
+			group[i].InstructionIndex = uint64(len(rewritten))
+			rewritten = append(rewritten, InstructionV1AssertWorktopContains{
+				ResourceAddress: group[i].ResourceAddress,
+				Amount:          group[i].Minimum.ToDecimal(),
+			})
+		}
+	}
+	next := 0
+	for index, instruction := range list {
+		group := guaranteeGroup(guarantees, &next, index)
+		if _, ok := instruction.(InstructionV1TakeAllFromWorktop); ok {
+			assert(group)
+			rewritten = append(rewritten, instruction)
+		} else {
+			rewritten = append(rewritten, instruction)
+			assert(group)
+		}
+	}
+	manifest, err := _self.WithInstructions(rewritten)
+	if err != nil {
+		return nil, nil, err
+	}
+	return manifest, guarantees, nil
+}
+
+// WithGuarantees returns the manifest with assertions of the predicted
+// deposits of its dynamic analysis, and the assertions inserted.
+func (_self *TransactionManifestV2) WithGuarantees(analysis DynamicAnalysis, options GuaranteeOptions) (*TransactionManifestV2, []Guarantee, error) {
+	instructions := _self.Instructions()
+	defer instructions.Destroy()
+	list := instructions.InstructionsList()
+	guarantees, err := planGuarantees(analysis, options, len(list))
+	if err != nil {
+		return nil, nil, err
+	}
+	rewritten := make([]InstructionV2, 0, len(list)+len(guarantees))
+	next := 0
+	buckets := uint32(0)
+	for index, instruction := range list {
+		rewritten = append(rewritten, instruction)
+		group := guaranteeGroup(guarantees, &next, index)
+		switch instruction.(type) {
+		case InstructionV2TakeAllFromWorktop:
+			for i := range group {
+				group[i].InstructionIndex = uint64(len(rewritten))
+				rewritten = append(rewritten, InstructionV2AssertBucketContents{
+					BucketId:   ManifestBucket{Value: buckets},
+					Constraint: ManifestResourceConstraintAtLeastAmount{Value: group[i].Minimum.ToDecimal()},
+				})
+			}
+		default:
+			switch len(group) {
+			case 0:
+			case 1:
+				group[0].InstructionIndex = uint64(len(rewritten))
+				rewritten = append(rewritten, InstructionV2AssertWorktopContains{
+					ResourceAddress: group[0].ResourceAddress,
+					Amount:          group[0].Minimum.ToDecimal(),
+				})
+			default:
+				constraints := make(map[string]ManifestResourceConstraint, len(group))
+				for i := range group {
+					group[i].InstructionIndex = uint64(len(rewritten))
+					constraints[group[i].ResourceAddress.AsStr()] = ManifestResourceConstraintAtLeastAmount{Value: group[i].Minimum.ToDecimal()}
+				}
+				rewritten = append(rewritten, InstructionV2AssertWorktopResourcesInclude{Constraints: constraints})
+			}
+		}
+		switch instruction.(type) {
+		case InstructionV2TakeFromWorktop, InstructionV2TakeAllFromWorktop, InstructionV2TakeNonFungiblesFromWorktop:
+			buckets++
+		}
+	}
+	manifest, err := _self.WithInstructions(rewritten)
+	if err != nil {
+		return nil, nil, err
+	}
+	return manifest, guarantees, nil
+}
+
+// planGuarantees returns the guarantees of the predicted fungible deposits of
+// the analysis, in the order of their CreatedAt and resource addresses.
+func planGuarantees(analysis DynamicAnalysis, options GuaranteeOptions, instructionCount int) ([]Guarantee, error) {
+	type key struct {
+		createdAt uint64
+		resource  string
+	}
+	predicted := map[key]*Guarantee{}
+	for account, deposits := range analysis.AccountDynamicResourceMovementsSummary.AccountDeposits {
+		for _, deposit := range deposits {
+			fungible, ok := deposit.(InvocationIoItemFungible)
+			if !ok {
+				continue
+			}
+			amount, ok := fungible.Amount.(EitherGuaranteedOrPredictedDecimalPredicted)
+			if !ok || amount.Value == nil || fungible.Address == nil {
+				continue
+			}
+			if amount.CreatedAt.Index >= uint64(instructionCount) {
+				return nil, fmt.Errorf("WithGuarantees: the deposit of %s to %s is predicted at instruction %d of a manifest of %d", fungible.Address.AsStr(), account, amount.CreatedAt.Index, instructionCount)
+			}
+			k := key{amount.CreatedAt.Index, fungible.Address.AsStr()}
+			guarantee, ok := predicted[k]
+			if !ok {
+				guarantee = &Guarantee{ResourceAddress: fungible.Address, CreatedAt: k.createdAt, Predicted: DecimalValueZero()}
+				predicted[k] = guarantee
+			}
+			sum, err := guarantee.Predicted.Add(amount.Value.Value())
+			if err != nil {
+				return nil, err
+			}
+			guarantee.Predicted = sum
+		}
+	}
+	guarantees := make([]Guarantee, 0, len(predicted))
+	for k, guarantee := range predicted {
+		slippage, ok := options.SlippageBasisPoints[k.resource]
+		if !ok {
+			slippage = options.DefaultSlippageBasisPoints
+		}
+		if slippage > 10_000 {
+			return nil, fmt.Errorf("WithGuarantees: slippage of %d basis points for %s", slippage, k.resource)
+		}
+		tolerated, err := basisPointsOf(guarantee.Predicted, slippage)
+		if err != nil {
+			return nil, err
+		}
+		if guarantee.Minimum, err = guarantee.Predicted.Sub(tolerated); err != nil {
+			return nil, err
+		}
+		if guarantee.Minimum.IsPositive() {
+			guarantees = append(guarantees, *guarantee)
+		}
+	}
+	sort.Slice(guarantees, func(i, j int) bool {
+		if guarantees[i].CreatedAt != guarantees[j].CreatedAt {
+			return guarantees[i].CreatedAt < guarantees[j].CreatedAt
+		}
+		return guarantees[i].ResourceAddress.AsStr() < guarantees[j].ResourceAddress.AsStr()
+	})
+	return guarantees, nil
+}
+
+// guaranteeGroup returns the guarantees created at index, starting at *next,
+// and moves *next past them.
+func guaranteeGroup(guarantees []Guarantee, next *int, index int) []Guarantee {
+	start := *next
+	for *next < len(guarantees) && guarantees[*next].CreatedAt == uint64(index) {
+		*next++
+	}
+	return guarantees[start:*next]
+}
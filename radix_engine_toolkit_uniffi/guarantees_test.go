@@ -0,0 +1,245 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// guaranteeFixture is the manifest of the guarantee tests
+//
+//	0: CALL_METHOD dex "swap"
+//	1: TAKE_FROM_WORKTOP xrd 1 bucket "xrd"
+//	2: CALL_METHOD dex "claim"
+//	3: TAKE_ALL_FROM_WORKTOP token bucket "token"
+//	4: DEPOSIT alice "xrd"
+//	5: DEPOSIT alice "token"
+//
+// and the deposits predicted by its analysis: 100 XRD and 50 + 25 tokens by
+// the swap, 10 XRD by the claim and 20 tokens by the take.
+type guaranteeFixture struct {
+	transferAddresses
+	dex, token *Address
+	analysis   DynamicAnalysis
+}
+
+func newGuaranteeFixture(t *testing.T) guaranteeFixture {
+	fixture := guaranteeFixture{
+		transferAddresses: newTransferAddresses(t),
+		dex:               testAddress(t, address.EntityTypeGlobalGenericComponent, 6),
+		token:             testAddress(t, address.EntityTypeGlobalFungibleResourceManager, 7),
+	}
+	predicted := func(resource *Address, amount string, createdAt uint64) InvocationIoItem {
+		return InvocationIoItemFungible{
+			Address: resource,
+			Amount:  EitherGuaranteedOrPredictedDecimalPredicted{Value: testDecimal(t, amount), CreatedAt: InstructionIndex{Index: createdAt}},
+		}
+	}
+	fixture.analysis.AccountDynamicResourceMovementsSummary.AccountDeposits = map[string][]InvocationIoItem{
+		fixture.alice.AsStr(): {
+			predicted(fixture.xrd, "100", 0),
+			predicted(fixture.token, "50", 0),
+			predicted(fixture.xrd, "10", 2),
+			predicted(fixture.token, "20", 3),
+			// Guaranteed amounts and non fungibles are not asserted.
+			InvocationIoItemFungible{Address: fixture.xrd, Amount: EitherGuaranteedOrPredictedDecimalGuaranteed{Value: testDecimal(t, "1")}},
+			InvocationIoItemNonFungible{Address: fixture.badge},
+		},
+		fixture.bob.AsStr(): {
+			predicted(fixture.token, "25", 0),
+		},
+	}
+	return fixture
+}
+
+func (fixture guaranteeFixture) manifestV1(t *testing.T) *TransactionManifestV1 {
+	t.Helper()
+	builder, err := NewManifestV1Builder().CallMethod(ManifestBuilderAddressStatic{Value: fixture.dex}, "swap", nil)
+	if err == nil {
+		builder, err = builder.TakeFromWorktop(fixture.xrd, testDecimal(t, "1"), ManifestBuilderBucket{Name: "xrd"})
+	}
+	if err == nil {
+		builder, err = builder.CallMethod(ManifestBuilderAddressStatic{Value: fixture.dex}, "claim", nil)
+	}
+	if err == nil {
+		builder, err = builder.TakeAllFromWorktop(fixture.token, ManifestBuilderBucket{Name: "token"})
+	}
+	if err == nil {
+		builder, err = builder.AccountDeposit(fixture.alice, ManifestBuilderBucket{Name: "xrd"})
+	}
+	if err == nil {
+		builder, err = builder.AccountDeposit(fixture.alice, ManifestBuilderBucket{Name: "token"})
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return builder.Build(1)
+}
+
+func (fixture guaranteeFixture) manifestV2(t *testing.T) *TransactionManifestV2 {
+	t.Helper()
+	builder, err := NewManifestV2Builder(1).CallMethod(ManifestBuilderAddressStatic{Value: fixture.dex}, "swap", nil)
+	if err == nil {
+		builder, err = builder.TakeFromWorktop(fixture.xrd, testDecimal(t, "1"), ManifestBuilderBucket{Name: "xrd"})
+	}
+	if err == nil {
+		builder, err = builder.CallMethod(ManifestBuilderAddressStatic{Value: fixture.dex}, "claim", nil)
+	}
+	if err == nil {
+		builder, err = builder.TakeAllFromWorktop(fixture.token, ManifestBuilderBucket{Name: "token"})
+	}
+	if err == nil {
+		builder, err = builder.AccountDeposit(fixture.alice, ManifestBuilderBucket{Name: "xrd"})
+	}
+	if err == nil {
+		builder, err = builder.AccountDeposit(fixture.alice, ManifestBuilderBucket{Name: "token"})
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return builder.Build()
+}
+
+// options are 1% of slippage, 5% for the token.
+func (fixture guaranteeFixture) options() GuaranteeOptions {
+	return GuaranteeOptions{
+		DefaultSlippageBasisPoints: 100,
+		SlippageBasisPoints:        map[string]uint32{fixture.token.AsStr(): 500},
+	}
+}
+
+// wantGuarantee checks the guarantee of resource created at createdAt.
+func wantGuarantee(t *testing.T, guarantees []Guarantee, resource *Address, createdAt uint64, predicted, minimum string, instructionIndex uint64) {
+	t.Helper()
+	for _, guarantee := range guarantees {
+		if guarantee.ResourceAddress.AsStr() != resource.AsStr() || guarantee.CreatedAt != createdAt {
+			continue
+		}
+		if guarantee.Predicted.String() != predicted || guarantee.Minimum.String() != minimum || guarantee.InstructionIndex != instructionIndex {
+			t.Errorf("guarantee of %s at %d = %s, minimum %s, at %d, want %s, minimum %s, at %d",
+				resource.AsStr(), createdAt, guarantee.Predicted, guarantee.Minimum, guarantee.InstructionIndex, predicted, minimum, instructionIndex)
+		}
+		return
+	}
+	t.Errorf("no guarantee of %s at %d", resource.AsStr(), createdAt)
+}
+
+func TestPlanGuarantees(t *testing.T) {
+	fixture := newGuaranteeFixture(t)
+	guarantees, err := planGuarantees(fixture.analysis, fixture.options(), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(guarantees) != 4 {
+		t.Fatalf("planGuarantees returned %d guarantees, want 4", len(guarantees))
+	}
+	for index := 1; index < len(guarantees); index++ {
+		if guarantees[index-1].CreatedAt > guarantees[index].CreatedAt {
+			t.Errorf("guarantees not in the order of their instructions: %+v", guarantees)
+		}
+	}
+	wantGuarantee(t, guarantees, fixture.xrd, 0, "100", "99", 0)
+	wantGuarantee(t, guarantees, fixture.token, 0, "75", "71.25", 0)
+	wantGuarantee(t, guarantees, fixture.xrd, 2, "10", "9.9", 0)
+	wantGuarantee(t, guarantees, fixture.token, 3, "20", "19", 0)
+
+	// A slippage of 100% guarantees nothing.
+	all := GuaranteeOptions{DefaultSlippageBasisPoints: 10_000}
+	if guarantees, err := planGuarantees(fixture.analysis, all, 6); err != nil || len(guarantees) != 0 {
+		t.Errorf("planGuarantees with a slippage of 100%% = %+v, %v, want no guarantees", guarantees, err)
+	}
+	for _, invalid := range []struct {
+		name             string
+		options          GuaranteeOptions
+		instructionCount int
+	}{
+		{"slippage over 100%", GuaranteeOptions{DefaultSlippageBasisPoints: 10_001}, 6},
+		{"prediction past the last instruction", fixture.options(), 3},
+	} {
+		if _, err := planGuarantees(fixture.analysis, invalid.options, invalid.instructionCount); err == nil {
+			t.Errorf("planGuarantees with a %s succeeded", invalid.name)
+		}
+	}
+}
+
+func TestWithGuaranteesV2(t *testing.T) {
+	fixture := newGuaranteeFixture(t)
+	manifest, guarantees, err := fixture.manifestV2(t).WithGuarantees(fixture.analysis, fixture.options())
+	if err != nil {
+		t.Fatal(err)
+	}
+	instructions := manifest.Instructions()
+	defer instructions.Destroy()
+	list := instructions.InstructionsList()
+	if len(list) != 9 {
+		t.Fatalf("guaranteed manifest has %d instructions, want 9", len(list))
+	}
+
+	// The two resources of the swap are asserted together.
+	include, ok := list[1].(InstructionV2AssertWorktopResourcesInclude)
+	if !ok || len(include.Constraints) != 2 {
+		t.Fatalf("instruction 1 = %#v, want assert_worktop_resources_include of 2 resources", list[1])
+	}
+	for resource, minimum := range map[*Address]string{fixture.xrd: "99", fixture.token: "71.25"} {
+		constraint, ok := include.Constraints[resource.AsStr()].(ManifestResourceConstraintAtLeastAmount)
+		if !ok || constraint.Value.AsStr() != minimum {
+			t.Errorf("assert_worktop_resources_include of %s = %#v, want at least %s", resource.AsStr(), include.Constraints[resource.AsStr()], minimum)
+		}
+	}
+	contains, ok := list[4].(InstructionV2AssertWorktopContains)
+	if !ok || contains.ResourceAddress.AsStr() != fixture.xrd.AsStr() || contains.Amount.AsStr() != "9.9" {
+		t.Errorf("instruction 4 = %#v, want assert_worktop_contains of 9.9 XRD", list[4])
+	}
+	// The take_all_from_worktop fills the second bucket of the manifest.
+	if _, ok := list[5].(InstructionV2TakeAllFromWorktop); !ok {
+		t.Errorf("instruction 5 = %#v, want take_all_from_worktop", list[5])
+	}
+	bucket, ok := list[6].(InstructionV2AssertBucketContents)
+	if !ok || bucket.BucketId.Value != 1 {
+		t.Fatalf("instruction 6 = %#v, want assert_bucket_contents of bucket 1", list[6])
+	}
+	if constraint, ok := bucket.Constraint.(ManifestResourceConstraintAtLeastAmount); !ok || constraint.Value.AsStr() != "19" {
+		t.Errorf("assert_bucket_contents constraint = %#v, want at least 19", bucket.Constraint)
+	}
+
+	wantGuarantee(t, guarantees, fixture.xrd, 0, "100", "99", 1)
+	wantGuarantee(t, guarantees, fixture.token, 0, "75", "71.25", 1)
+	wantGuarantee(t, guarantees, fixture.xrd, 2, "10", "9.9", 4)
+	wantGuarantee(t, guarantees, fixture.token, 3, "20", "19", 6)
+}
+
+func TestWithGuaranteesV1(t *testing.T) {
+	fixture := newGuaranteeFixture(t)
+	manifest, guarantees, err := fixture.manifestV1(t).WithGuarantees(fixture.analysis, fixture.options())
+	if err != nil {
+		t.Fatal(err)
+	}
+	instructions := manifest.Instructions()
+	defer instructions.Destroy()
+	list := instructions.InstructionsList()
+	if len(list) != 10 {
+		t.Fatalf("guaranteed manifest has %d instructions, want 10", len(list))
+	}
+
+	for _, index := range []int{1, 2, 5} {
+		if _, ok := list[index].(InstructionV1AssertWorktopContains); !ok {
+			t.Errorf("instruction %d = %#v, want assert_worktop_contains", index, list[index])
+		}
+	}
+	// The worktop is asserted before the take_all_from_worktop empties it.
+	contains, ok := list[6].(InstructionV1AssertWorktopContains)
+	if !ok || contains.ResourceAddress.AsStr() != fixture.token.AsStr() || contains.Amount.AsStr() != "19" {
+		t.Errorf("instruction 6 = %#v, want assert_worktop_contains of 19 tokens", list[6])
+	}
+	if _, ok := list[7].(InstructionV1TakeAllFromWorktop); !ok {
+		t.Errorf("instruction 7 = %#v, want take_all_from_worktop", list[7])
+	}
+
+	wantGuarantee(t, guarantees, fixture.xrd, 2, "10", "9.9", 5)
+	wantGuarantee(t, guarantees, fixture.token, 3, "20", "19", 6)
+	for _, guarantee := range guarantees[:2] {
+		if guarantee.CreatedAt != 0 || guarantee.InstructionIndex != 1 && guarantee.InstructionIndex != 2 {
+			t.Errorf("guarantee of the swap %+v, want it at instruction 1 or 2", guarantee)
+		}
+	}
+}
@@ -0,0 +1,891 @@
+package radix_engine_toolkit_uniffi
+
+//go:generate go run ../internal/cmd/jsongen -in radix_engine_toolkit_uniffi.go -out radix_engine_toolkit_uniffi_json.go
+
+// JSON encoding
+//
+// Every record, enum and tagged union of the package implements
+// json.Marshaler and json.Unmarshaler (enums implement encoding.TextMarshaler
+// and encoding.TextUnmarshaler so that they can also be used as map keys).
+// The encoding is stable and round-trips:
+//
+//   - records are objects keyed by the snake_case form of their field names,
+//     e.g. TransactionHeaderV1.NetworkId is encoded as "network_id";
+//   - enums are strings holding the variant name, e.g. "GlobalAccount";
+//   - tagged unions are objects holding the variant name under "kind" and the
+//     variant fields alongside it, e.g. {"kind":"Fungible","resource_address":...};
+//   - byte arrays and PublicKeyFingerprint values are lowercase hex strings;
+//   - maps are objects, their enum and numeric keys encoded as strings.
+//
+// Object wrappers are encoded as follows:
+//
+//   - *Address, *NonFungibleGlobalId, *OlympiaAddress and *TransactionHash as
+//     their string (bech32) representation;
+//   - *Decimal and *PreciseDecimal as decimal strings;
+//   - *Hash as a hex string;
+//   - *InstructionsV1 and *InstructionsV2 as {"network_id", "instructions"};
+//   - *TransactionManifestV1, *TransactionManifestV2 and *SubintentManifestV2
+//     as {"network_id", "payload"}, the payload being the hex encoded
+//     compiled manifest;
+//   - *IntentCoreV2 and *PreviewPartialTransactionV2 as objects of their parts;
+//   - intents and transactions which have a payload form as the hex encoding
+//     of their compiled payload.
+//
+// Builders, *AccessRule, *PrivateKey and *SubintentV2 have no JSON encoding.
+// A tagged union can be decoded into its interface type with the generated
+// <Union>FromJSON functions, e.g. InstructionV2FromJSON.
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+type jsonVariant struct {
+	name string
+	typ  reflect.Type
+}
+
+var (
+	jsonMarshalerType       = reflect.TypeFor[json.Marshaler]()
+	jsonTextMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonTextUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	jsonFfiObjectType       = reflect.TypeFor[FfiObject]()
+	jsonVariantNames        = map[reflect.Type]string{}
+)
+
+func init() {
+	for _, variants := range jsonUnions {
+		for _, variant := range variants {
+			jsonVariantNames[variant.typ] = variant.name
+		}
+	}
+}
+
+func marshalJSON(value any) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := encodeJSONValue(&buffer, reflect.ValueOf(value)); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func unmarshalJSON(data []byte, target any) error {
+	return decodeJSONValue(data, reflect.ValueOf(target).Elem())
+}
+
+// jsonFieldName converts a Go field name into the snake_case key it is
+// encoded under, e.g. "StartEpochInclusive" to "start_epoch_inclusive".
+func jsonFieldName(name string) string {
+	var builder strings.Builder
+	runes := []rune(name)
+	for index, r := range runes {
+		if unicode.IsUpper(r) {
+			if index > 0 && (unicode.IsLower(runes[index-1]) || unicode.IsDigit(runes[index-1])) {
+				builder.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+func isFfiObjectType(t reflect.Type) bool {
+	return t.Kind() == reflect.Pointer &&
+		t.Elem().Kind() == reflect.Struct &&
+		t.Elem().NumField() == 1 &&
+		t.Elem().Field(0).Type == jsonFfiObjectType
+}
+
+func encodeJSONValue(buffer *bytes.Buffer, value reflect.Value) error {
+	if !value.IsValid() {
+		buffer.WriteString("null")
+		return nil
+	}
+	t := value.Type()
+	if (t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface) && value.IsNil() {
+		buffer.WriteString("null")
+		return nil
+	}
+
+	switch {
+	case isFfiObjectType(t):
+		if !t.Implements(jsonMarshalerType) && !t.Implements(jsonTextMarshalerType) {
+			return fmt.Errorf("%v has no JSON encoding", t)
+		}
+		return encodeJSONLeaf(buffer, value)
+	case t.Kind() != reflect.Pointer && t.Implements(jsonTextMarshalerType):
+		return encodeJSONLeaf(buffer, value)
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return encodeJSONValue(buffer, value.Elem())
+	case reflect.Struct:
+		buffer.WriteByte('{')
+		first := true
+		if name, ok := jsonVariantNames[t]; ok {
+			buffer.WriteString(`"kind":`)
+			encodeJSONString(buffer, name)
+			first = false
+		}
+		for index := 0; index < t.NumField(); index++ {
+			field := t.Field(index)
+			if !field.IsExported() {
+				continue
+			}
+			if !first {
+				buffer.WriteByte(',')
+			}
+			first = false
+			encodeJSONString(buffer, jsonFieldName(field.Name))
+			buffer.WriteByte(':')
+			if err := encodeJSONValue(buffer, value.Field(index)); err != nil {
+				return fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err)
+			}
+		}
+		buffer.WriteByte('}')
+		return nil
+	case reflect.Slice:
+		if value.IsNil() {
+			buffer.WriteString("null")
+			return nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			encodeJSONString(buffer, hex.EncodeToString(value.Bytes()))
+			return nil
+		}
+		buffer.WriteByte('[')
+		for index := 0; index < value.Len(); index++ {
+			if index > 0 {
+				buffer.WriteByte(',')
+			}
+			if err := encodeJSONValue(buffer, value.Index(index)); err != nil {
+				return fmt.Errorf("[%d]: %w", index, err)
+			}
+		}
+		buffer.WriteByte(']')
+		return nil
+	case reflect.Map:
+		if value.IsNil() {
+			buffer.WriteString("null")
+			return nil
+		}
+		type entry struct {
+			key   string
+			value reflect.Value
+		}
+		entries := make([]entry, 0, value.Len())
+		iterator := value.MapRange()
+		for iterator.Next() {
+			key, err := encodeJSONMapKey(iterator.Key())
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry{key, iterator.Value()})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+		buffer.WriteByte('{')
+		for index, entry := range entries {
+			if index > 0 {
+				buffer.WriteByte(',')
+			}
+			encodeJSONString(buffer, entry.key)
+			buffer.WriteByte(':')
+			if err := encodeJSONValue(buffer, entry.value); err != nil {
+				return fmt.Errorf("[%q]: %w", entry.key, err)
+			}
+		}
+		buffer.WriteByte('}')
+		return nil
+	default:
+		return encodeJSONLeaf(buffer, value)
+	}
+}
+
+func encodeJSONLeaf(buffer *bytes.Buffer, value reflect.Value) error {
+	data, err := json.Marshal(value.Interface())
+	if err != nil {
+		return err
+	}
+	buffer.Write(data)
+	return nil
+}
+
+func encodeJSONString(buffer *bytes.Buffer, value string) {
+	data, _ := json.Marshal(value)
+	buffer.Write(data)
+}
+
+func encodeJSONMapKey(key reflect.Value) (string, error) {
+	if marshaler, ok := key.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+	switch key.Kind() {
+	case reflect.String:
+		return key.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(key.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("unsupported map key type %v", key.Type())
+}
+
+func decodeJSONValue(data []byte, value reflect.Value) error {
+	t := value.Type()
+	isNull := bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+
+	switch {
+	case isFfiObjectType(t):
+		if isNull {
+			value.SetZero()
+			return nil
+		}
+		return decodeJSONLeaf(data, value)
+	case t.Kind() != reflect.Pointer && reflect.PointerTo(t).Implements(jsonTextUnmarshalerType):
+		return decodeJSONLeaf(data, value)
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if isNull {
+			value.SetZero()
+			return nil
+		}
+		element := reflect.New(t.Elem())
+		if err := decodeJSONValue(data, element.Elem()); err != nil {
+			return err
+		}
+		value.Set(element)
+		return nil
+	case reflect.Interface:
+		if isNull {
+			value.SetZero()
+			return nil
+		}
+		variants, ok := jsonUnions[t]
+		if !ok {
+			return fmt.Errorf("%v has no JSON encoding", t)
+		}
+		var tagged struct {
+			Kind string `json:"kind"`
+		}
+		if err := json.Unmarshal(data, &tagged); err != nil {
+			return fmt.Errorf("%s: %w", t.Name(), err)
+		}
+		for _, variant := range variants {
+			if variant.name == tagged.Kind {
+				element := reflect.New(variant.typ).Elem()
+				if err := decodeJSONValue(data, element); err != nil {
+					return err
+				}
+				value.Set(element)
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: unknown kind %q", t.Name(), tagged.Kind)
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return fmt.Errorf("%s: %w", t.Name(), err)
+		}
+		for index := 0; index < t.NumField(); index++ {
+			field := t.Field(index)
+			if !field.IsExported() {
+				continue
+			}
+			raw, ok := fields[jsonFieldName(field.Name)]
+			if !ok {
+				continue
+			}
+			if err := decodeJSONValue(raw, value.Field(index)); err != nil {
+				return fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err)
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if isNull {
+			value.SetZero()
+			return nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			var text string
+			if err := json.Unmarshal(data, &text); err != nil {
+				return err
+			}
+			decoded, err := hex.DecodeString(text)
+			if err != nil {
+				return err
+			}
+			value.SetBytes(decoded)
+			return nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		slice := reflect.MakeSlice(t, len(items), len(items))
+		for index, item := range items {
+			if err := decodeJSONValue(item, slice.Index(index)); err != nil {
+				return fmt.Errorf("[%d]: %w", index, err)
+			}
+		}
+		value.Set(slice)
+		return nil
+	case reflect.Map:
+		if isNull {
+			value.SetZero()
+			return nil
+		}
+		var entries map[string]json.RawMessage
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return err
+		}
+		result := reflect.MakeMapWithSize(t, len(entries))
+		for rawKey, rawValue := range entries {
+			key := reflect.New(t.Key()).Elem()
+			if err := decodeJSONMapKey(rawKey, key); err != nil {
+				return err
+			}
+			element := reflect.New(t.Elem()).Elem()
+			if err := decodeJSONValue(rawValue, element); err != nil {
+				return fmt.Errorf("[%q]: %w", rawKey, err)
+			}
+			result.SetMapIndex(key, element)
+		}
+		value.Set(result)
+		return nil
+	default:
+		return decodeJSONLeaf(data, value)
+	}
+}
+
+func decodeJSONLeaf(data []byte, value reflect.Value) error {
+	target := reflect.New(value.Type())
+	if err := json.Unmarshal(data, target.Interface()); err != nil {
+		return err
+	}
+	value.Set(target.Elem())
+	return nil
+}
+
+func decodeJSONMapKey(text string, key reflect.Value) error {
+	if unmarshaler, ok := key.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+	switch key.Kind() {
+	case reflect.String:
+		key.SetString(text)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, key.Type().Bits())
+		key.SetInt(parsed)
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(text, 10, key.Type().Bits())
+		key.SetUint(parsed)
+		return err
+	}
+	return fmt.Errorf("unsupported map key type %v", key.Type())
+}
+
+// moveFrom transfers the ownership of the native object held by other to
+// ffiObject, which is used to decode JSON into an existing object wrapper.
+func (ffiObject *FfiObject) moveFrom(other *FfiObject) {
+	if ffiObject.pointer != nil {
+		ffiObject.destroy()
+	}
+	ffiObject.pointer = other.pointer
+	ffiObject.freeFunction = other.freeFunction
+	ffiObject.callCounter.Store(0)
+	ffiObject.destroyed.Store(false)
+	other.pointer = nil
+	other.callCounter.Store(-1)
+	other.destroyed.Store(true)
+}
+
+func (object *Address) MarshalText() ([]byte, error) {
+	return []byte(object.AsStr()), nil
+}
+
+func (object *Address) UnmarshalText(text []byte) error {
+	decoded, err := NewAddress(string(text))
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*Address).Destroy)
+	return nil
+}
+
+func (object *Decimal) MarshalText() ([]byte, error) {
+	return []byte(object.AsStr()), nil
+}
+
+func (object *Decimal) UnmarshalText(text []byte) error {
+	decoded, err := NewDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*Decimal).Destroy)
+	return nil
+}
+
+func (object *PreciseDecimal) MarshalText() ([]byte, error) {
+	return []byte(object.AsStr()), nil
+}
+
+func (object *PreciseDecimal) UnmarshalText(text []byte) error {
+	decoded, err := NewPreciseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*PreciseDecimal).Destroy)
+	return nil
+}
+
+func (object *Hash) MarshalText() ([]byte, error) {
+	return []byte(object.AsStr()), nil
+}
+
+func (object *Hash) UnmarshalText(text []byte) error {
+	decoded, err := HashFromHexString(string(text))
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*Hash).Destroy)
+	return nil
+}
+
+func (object *NonFungibleGlobalId) MarshalText() ([]byte, error) {
+	return []byte(object.AsStr()), nil
+}
+
+func (object *NonFungibleGlobalId) UnmarshalText(text []byte) error {
+	decoded, err := NewNonFungibleGlobalId(string(text))
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*NonFungibleGlobalId).Destroy)
+	return nil
+}
+
+func (object *OlympiaAddress) MarshalText() ([]byte, error) {
+	return []byte(object.AsStr()), nil
+}
+
+func (object *OlympiaAddress) UnmarshalText(text []byte) error {
+	decoded := NewOlympiaAddress(string(text))
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*OlympiaAddress).Destroy)
+	return nil
+}
+
+func (object *TransactionHash) MarshalText() ([]byte, error) {
+	return []byte(object.AsStr()), nil
+}
+
+func (object *TransactionHash) UnmarshalText(text []byte) error {
+	networkId, ok := address.NetworkIdFromBech32(string(text))
+	if !ok {
+		return NewRadixEngineToolkitErrorFailedToExtractNetwork(string(text))
+	}
+	decoded, err := TransactionHashFromStr(string(text), networkId)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*TransactionHash).Destroy)
+	return nil
+}
+
+func (r PublicKeyFingerprint) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString([]byte(r.Bytes))), nil
+}
+
+func (r *PublicKeyFingerprint) UnmarshalText(text []byte) error {
+	decoded, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	r.Bytes = HashableBytes(decoded)
+	return nil
+}
+
+func (r PublicKeyFingerprintV1) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString([]byte(r.Bytes))), nil
+}
+
+func (r *PublicKeyFingerprintV1) UnmarshalText(text []byte) error {
+	decoded, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	r.Bytes = HashableBytes(decoded)
+	return nil
+}
+
+type instructionsJSON[Instruction any] struct {
+	NetworkId    uint8
+	Instructions []Instruction
+}
+
+func (object *InstructionsV1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(instructionsJSON[InstructionV1]{object.NetworkId(), object.InstructionsList()})
+}
+
+func (object *InstructionsV1) UnmarshalJSON(data []byte) error {
+	var parts instructionsJSON[InstructionV1]
+	if err := unmarshalJSON(data, &parts); err != nil {
+		return err
+	}
+	decoded, err := InstructionsV1FromInstructions(parts.Instructions, parts.NetworkId)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*InstructionsV1).Destroy)
+	return nil
+}
+
+func (object *InstructionsV2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(instructionsJSON[InstructionV2]{object.NetworkId(), object.InstructionsList()})
+}
+
+func (object *InstructionsV2) UnmarshalJSON(data []byte) error {
+	var parts instructionsJSON[InstructionV2]
+	if err := unmarshalJSON(data, &parts); err != nil {
+		return err
+	}
+	decoded, err := InstructionsV2FromInstructions(parts.Instructions, parts.NetworkId)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*InstructionsV2).Destroy)
+	return nil
+}
+
+type manifestJSON struct {
+	NetworkId uint8
+	Payload   []byte
+}
+
+func (object *TransactionManifestV1) MarshalJSON() ([]byte, error) {
+	payload, err := object.ToPayloadBytes()
+	if err != nil {
+		return nil, err
+	}
+	return marshalJSON(manifestJSON{object.Instructions().NetworkId(), payload})
+}
+
+func (object *TransactionManifestV1) UnmarshalJSON(data []byte) error {
+	var parts manifestJSON
+	if err := unmarshalJSON(data, &parts); err != nil {
+		return err
+	}
+	decoded, err := TransactionManifestV1FromPayloadBytes(parts.Payload, parts.NetworkId)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*TransactionManifestV1).Destroy)
+	return nil
+}
+
+func (object *TransactionManifestV2) MarshalJSON() ([]byte, error) {
+	payload, err := object.ToPayloadBytes()
+	if err != nil {
+		return nil, err
+	}
+	return marshalJSON(manifestJSON{object.Instructions().NetworkId(), payload})
+}
+
+func (object *TransactionManifestV2) UnmarshalJSON(data []byte) error {
+	var parts manifestJSON
+	if err := unmarshalJSON(data, &parts); err != nil {
+		return err
+	}
+	decoded, err := TransactionManifestV2FromPayloadBytes(parts.Payload, parts.NetworkId)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*TransactionManifestV2).Destroy)
+	return nil
+}
+
+func (object *SubintentManifestV2) MarshalJSON() ([]byte, error) {
+	payload, err := object.ToPayloadBytes()
+	if err != nil {
+		return nil, err
+	}
+	return marshalJSON(manifestJSON{object.Instructions().NetworkId(), payload})
+}
+
+func (object *SubintentManifestV2) UnmarshalJSON(data []byte) error {
+	var parts manifestJSON
+	if err := unmarshalJSON(data, &parts); err != nil {
+		return err
+	}
+	decoded, err := SubintentManifestV2FromPayloadBytes(parts.Payload, parts.NetworkId)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*SubintentManifestV2).Destroy)
+	return nil
+}
+
+type intentCoreV2JSON struct {
+	Header       IntentHeaderV2
+	Blobs        [][]byte
+	Message      MessageV2
+	Children     []*Hash
+	Instructions *InstructionsV2
+}
+
+func (object *IntentCoreV2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(intentCoreV2JSON{
+		object.Header(),
+		object.Blobs(),
+		object.Message(),
+		object.Children(),
+		object.Instructions(),
+	})
+}
+
+func (object *IntentCoreV2) UnmarshalJSON(data []byte) error {
+	var parts intentCoreV2JSON
+	if err := unmarshalJSON(data, &parts); err != nil {
+		return err
+	}
+	if parts.Instructions == nil || parts.Message == nil {
+		return fmt.Errorf("IntentCoreV2: missing instructions or message")
+	}
+	decoded := NewIntentCoreV2(parts.Header, parts.Blobs, parts.Message, parts.Children, parts.Instructions)
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*IntentCoreV2).Destroy)
+	return nil
+}
+
+type previewPartialTransactionV2JSON struct {
+	PartialTransaction      *PartialTransactionV2
+	RootSubintentSigners    []PublicKey
+	NonRootSubintentSigners [][]PublicKey
+}
+
+func (object *PreviewPartialTransactionV2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(previewPartialTransactionV2JSON{
+		object.PartialTransaction(),
+		object.RootSubintentSigners(),
+		object.NonRootSubintentSigners(),
+	})
+}
+
+func (object *PreviewPartialTransactionV2) UnmarshalJSON(data []byte) error {
+	var parts previewPartialTransactionV2JSON
+	if err := unmarshalJSON(data, &parts); err != nil {
+		return err
+	}
+	if parts.PartialTransaction == nil {
+		return fmt.Errorf("PreviewPartialTransactionV2: missing partial transaction")
+	}
+	decoded := NewPreviewPartialTransactionV2(parts.PartialTransaction, parts.RootSubintentSigners, parts.NonRootSubintentSigners)
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*PreviewPartialTransactionV2).Destroy)
+	return nil
+}
+
+// payloadMarshalText hex encodes the compiled payload of an intent or
+// transaction.
+func payloadMarshalText(toPayloadBytes func() ([]byte, error)) ([]byte, error) {
+	payload, err := toPayloadBytes()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(hex.EncodeToString(payload)), nil
+}
+
+func (object *IntentV1) MarshalText() ([]byte, error) {
+	return payloadMarshalText(object.ToPayloadBytes)
+}
+
+func (object *IntentV1) UnmarshalText(text []byte) error {
+	payload, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	decoded, err := IntentV1FromPayloadBytes(payload)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*IntentV1).Destroy)
+	return nil
+}
+
+func (object *SignedTransactionIntentV1) MarshalText() ([]byte, error) {
+	return payloadMarshalText(object.ToPayloadBytes)
+}
+
+func (object *SignedTransactionIntentV1) UnmarshalText(text []byte) error {
+	payload, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	decoded, err := SignedTransactionIntentV1FromPayloadBytes(payload)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*SignedTransactionIntentV1).Destroy)
+	return nil
+}
+
+func (object *NotarizedTransactionV1) MarshalText() ([]byte, error) {
+	return payloadMarshalText(object.ToPayloadBytes)
+}
+
+func (object *NotarizedTransactionV1) UnmarshalText(text []byte) error {
+	payload, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	decoded, err := NotarizedTransactionV1FromPayloadBytes(payload)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*NotarizedTransactionV1).Destroy)
+	return nil
+}
+
+func (object *TransactionIntentV2) MarshalText() ([]byte, error) {
+	return payloadMarshalText(object.ToPayloadBytes)
+}
+
+func (object *TransactionIntentV2) UnmarshalText(text []byte) error {
+	payload, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	decoded, err := TransactionIntentV2FromPayloadBytes(payload)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*TransactionIntentV2).Destroy)
+	return nil
+}
+
+func (object *SignedTransactionIntentV2) MarshalText() ([]byte, error) {
+	return payloadMarshalText(object.ToPayloadBytes)
+}
+
+func (object *SignedTransactionIntentV2) UnmarshalText(text []byte) error {
+	payload, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	decoded, err := SignedTransactionIntentV2FromPayloadBytes(payload)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*SignedTransactionIntentV2).Destroy)
+	return nil
+}
+
+func (object *NotarizedTransactionV2) MarshalText() ([]byte, error) {
+	return payloadMarshalText(object.ToPayloadBytes)
+}
+
+func (object *NotarizedTransactionV2) UnmarshalText(text []byte) error {
+	payload, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	decoded, err := NotarizedTransactionV2FromPayloadBytes(payload)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*NotarizedTransactionV2).Destroy)
+	return nil
+}
+
+func (object *PartialTransactionV2) MarshalText() ([]byte, error) {
+	return payloadMarshalText(object.ToPayloadBytes)
+}
+
+func (object *PartialTransactionV2) UnmarshalText(text []byte) error {
+	payload, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	decoded, err := PartialTransactionV2FromPayloadBytes(payload)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*PartialTransactionV2).Destroy)
+	return nil
+}
+
+func (object *SignedPartialTransactionV2) MarshalText() ([]byte, error) {
+	return payloadMarshalText(object.ToPayloadBytes)
+}
+
+func (object *SignedPartialTransactionV2) UnmarshalText(text []byte) error {
+	payload, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	decoded, err := SignedPartialTransactionV2FromPayloadBytes(payload)
+	if err != nil {
+		return err
+	}
+	runtime.SetFinalizer(decoded, nil)
+	object.ffiObject.moveFrom(&decoded.ffiObject)
+	runtime.SetFinalizer(object, (*SignedPartialTransactionV2).Destroy)
+	return nil
+}
@@ -0,0 +1,117 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestJsonFieldName(t *testing.T) {
+	tests := map[string]string{
+		"NetworkId":                     "network_id",
+		"StartEpochInclusive":           "start_epoch_inclusive",
+		"Value":                         "value",
+		"Secp256k1":                     "secp256k1",
+		"MaxProposerTimestampExclusive": "max_proposer_timestamp_exclusive",
+	}
+	for name, want := range tests {
+		if got := jsonFieldName(name); got != want {
+			t.Errorf("jsonFieldName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestJSONRecord(t *testing.T) {
+	header := TransactionHeaderV1{
+		NetworkId:           2,
+		StartEpochInclusive: 10,
+		EndEpochExclusive:   20,
+		Nonce:               7,
+		NotaryPublicKey:     PublicKeySecp256k1{Value: []byte{0x02, 0xab}},
+		NotaryIsSignatory:   true,
+		TipPercentage:       5,
+	}
+	const encoded = `{"network_id":2,"start_epoch_inclusive":10,"end_epoch_exclusive":20,"nonce":7,` +
+		`"notary_public_key":{"kind":"Secp256k1","value":"02ab"},"notary_is_signatory":true,"tip_percentage":5}`
+	data, err := json.Marshal(header)
+	if err != nil || string(data) != encoded {
+		t.Fatalf("json.Marshal = %s, %v, want %s", data, err, encoded)
+	}
+	var decoded TransactionHeaderV1
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, header) {
+		t.Errorf("json.Unmarshal = %+v, %v, want %+v", decoded, err, header)
+	}
+}
+
+func TestJSONOptional(t *testing.T) {
+	timestamp := int64(-5)
+	header := IntentHeaderV2{
+		NetworkId:                     1,
+		MaxProposerTimestampExclusive: &timestamp,
+		IntentDiscriminator:           3,
+	}
+	const encoded = `{"network_id":1,"start_epoch_inclusive":0,"end_epoch_exclusive":0,` +
+		`"min_proposer_timestamp_inclusive":null,"max_proposer_timestamp_exclusive":-5,"intent_discriminator":3}`
+	data, err := json.Marshal(header)
+	if err != nil || string(data) != encoded {
+		t.Fatalf("json.Marshal = %s, %v, want %s", data, err, encoded)
+	}
+	decoded := IntentHeaderV2{MinProposerTimestampInclusive: &timestamp}
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, header) {
+		t.Errorf("json.Unmarshal = %+v, %v, want %+v", decoded, err, header)
+	}
+}
+
+func TestJSONUnion(t *testing.T) {
+	messages := []MessageV1{
+		MessageV1None{},
+		MessageV1PlainText{Value: PlainTextMessageV1{MimeType: "text/plain", Message: MessageContentV1Str{Value: "hello"}}},
+		MessageV1PlainText{Value: PlainTextMessageV1{MimeType: "application/octet-stream", Message: MessageContentV1Bytes{Value: []byte{1, 2}}}},
+	}
+	encoded := []string{
+		`{"kind":"None"}`,
+		`{"kind":"PlainText","value":{"mime_type":"text/plain","message":{"kind":"Str","value":"hello"}}}`,
+		`{"kind":"PlainText","value":{"mime_type":"application/octet-stream","message":{"kind":"Bytes","value":"0102"}}}`,
+	}
+	for index, message := range messages {
+		data, err := json.Marshal(message)
+		if err != nil || string(data) != encoded[index] {
+			t.Errorf("json.Marshal(%+v) = %s, %v, want %s", message, data, err, encoded[index])
+			continue
+		}
+		decoded, err := MessageV1FromJSON(data)
+		if err != nil || !reflect.DeepEqual(decoded, message) {
+			t.Errorf("MessageV1FromJSON(%s) = %+v, %v, want %+v", data, decoded, err, message)
+		}
+	}
+
+	for _, invalid := range []string{`{"kind":"Encrypted2"}`, `{"kind":"PlainText","value":{"message":{"kind":7}}}`, `[]`} {
+		if _, err := MessageV1FromJSON([]byte(invalid)); err == nil {
+			t.Errorf("MessageV1FromJSON(%s) succeeded", invalid)
+		}
+	}
+}
+
+func TestJSONEnum(t *testing.T) {
+	entityTypes := map[EntityType][]Curve{
+		EntityTypeGlobalAccount:   {CurveEd25519, CurveSecp256k1},
+		EntityTypeGlobalValidator: nil,
+	}
+	const encoded = `{"GlobalAccount":["Ed25519","Secp256k1"],"GlobalValidator":null}`
+	data, err := marshalJSON(entityTypes)
+	if err != nil || string(data) != encoded {
+		t.Fatalf("marshalJSON = %s, %v, want %s", data, err, encoded)
+	}
+	var decoded map[EntityType][]Curve
+	if err := unmarshalJSON(data, &decoded); err != nil || !reflect.DeepEqual(decoded, entityTypes) {
+		t.Errorf("unmarshalJSON = %v, %v, want %v", decoded, err, entityTypes)
+	}
+
+	var curve Curve
+	if err := json.Unmarshal([]byte(`"Ed25518"`), &curve); err == nil {
+		t.Error("json.Unmarshal of an unknown Curve variant succeeded")
+	}
+	if _, err := json.Marshal(Curve(9)); err == nil {
+		t.Error("json.Marshal of an invalid Curve succeeded")
+	}
+}
@@ -0,0 +1,250 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// LedgerState is the in-memory ledger a LocalPreviewEngine executes
+// manifests against: the resources, the vaults of the accounts, the
+// validators and the pools of a network.
+type LedgerState struct {
+	NetworkId uint8
+
+	Resources  map[address.Address]*LedgerResource
+	Accounts   map[address.Address]*LedgerAccount
+	Validators map[address.Address]*LedgerValidator
+	Pools      map[address.Address]*LedgerPool
+}
+
+// LedgerResource is a resource manager.
+type LedgerResource struct {
+	NonFungible bool
+	// decimal places of the amounts of a fungible resource, at most 18
+	Divisibility uint8
+	TotalSupply  DecimalValue
+}
+
+// LedgerAccount holds the vaults of an account by resource.
+type LedgerAccount struct {
+	Vaults map[address.Address]*LedgerVault
+}
+
+// LedgerVault holds the Amount of a fungible resource or the Ids of
+// non-fungibles, in the format of NonFungibleLocalIdAsStr.
+type LedgerVault struct {
+	Amount DecimalValue
+	Ids    []string
+}
+
+// LedgerValidator is a validator with its stake unit and claim NFT
+// resources. Unstaked XRD can be claimed right away: the ledger has no
+// epochs.
+type LedgerValidator struct {
+	StakeUnitResource address.Address
+	ClaimNftResource  address.Address
+	StakedXrd         DecimalValue
+	PendingXrd        DecimalValue
+	// claimable XRD by the local id of its claim NFT
+	Claims      map[string]DecimalValue
+	NextClaimId uint64
+}
+
+// LedgerPool is a one, two or multi resource pool, depending on the entity
+// type of its address.
+type LedgerPool struct {
+	PoolUnitResource address.Address
+	// the resources of the pool, in the order of its contributions
+	Resources []address.Address
+	Reserves  map[address.Address]DecimalValue
+}
+
+// NewLedgerState returns an empty ledger of the network holding only XRD.
+func NewLedgerState(networkId uint8) *LedgerState {
+	state := &LedgerState{
+		NetworkId:  networkId,
+		Resources:  map[address.Address]*LedgerResource{},
+		Accounts:   map[address.Address]*LedgerAccount{},
+		Validators: map[address.Address]*LedgerValidator{},
+		Pools:      map[address.Address]*LedgerPool{},
+	}
+	state.AddFungibleResource(state.Xrd(), 18)
+	return state
+}
+
+const mainnetXrdAddress = "resource_rdx1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxradxrd"
+
+// Xrd returns the address of XRD on the network of the ledger.
+func (state *LedgerState) Xrd() address.Address {
+	mainnetXrd, err := address.New(mainnetXrdAddress)
+	if err != nil {
+		panic(err)
+	}
+	xrd, err := address.FromRaw(mainnetXrd.Bytes(), state.NetworkId)
+	if err != nil {
+		panic(err)
+	}
+	return xrd
+}
+
+// AddFungibleResource adds a fungible resource with no supply.
+func (state *LedgerState) AddFungibleResource(resource address.Address, divisibility uint8) {
+	state.Resources[resource] = &LedgerResource{Divisibility: divisibility}
+}
+
+// AddNonFungibleResource adds a non-fungible resource with no supply.
+func (state *LedgerState) AddNonFungibleResource(resource address.Address) {
+	state.Resources[resource] = &LedgerResource{NonFungible: true}
+}
+
+// AddAccount adds an account without vaults, returning the existing one if
+// the account is already on the ledger.
+func (state *LedgerState) AddAccount(account address.Address) *LedgerAccount {
+	if existing, ok := state.Accounts[account]; ok {
+		return existing
+	}
+	added := &LedgerAccount{Vaults: map[address.Address]*LedgerVault{}}
+	state.Accounts[account] = added
+	return added
+}
+
+// Mint adds amount of a fungible resource to the account, increasing its
+// total supply.
+func (state *LedgerState) Mint(account, resource address.Address, amount DecimalValue) error {
+	resourceManager := state.Resources[resource]
+	if resourceManager == nil || resourceManager.NonFungible {
+		return fmt.Errorf("resource %s is not a fungible resource of the ledger", resource)
+	}
+	totalSupply, err := resourceManager.TotalSupply.Add(amount)
+	if err != nil {
+		return err
+	}
+	resourceManager.TotalSupply = totalSupply
+	return state.AddAccount(account).vault(resource).put(amount, nil)
+}
+
+// MintNonFungibles adds non-fungibles to the account, increasing the total
+// supply of their resource.
+func (state *LedgerState) MintNonFungibles(account, resource address.Address, ids ...string) error {
+	resourceManager := state.Resources[resource]
+	if resourceManager == nil || !resourceManager.NonFungible {
+		return fmt.Errorf("resource %s is not a non-fungible resource of the ledger", resource)
+	}
+	totalSupply, err := resourceManager.TotalSupply.Add(DecimalValueFromInt64(int64(len(ids))))
+	if err != nil {
+		return err
+	}
+	resourceManager.TotalSupply = totalSupply
+	return state.AddAccount(account).vault(resource).put(DecimalValue{}, ids)
+}
+
+// Balance returns the amount of a resource held by the account.
+func (state *LedgerState) Balance(account, resource address.Address) DecimalValue {
+	if ledgerAccount := state.Accounts[account]; ledgerAccount != nil {
+		if vault := ledgerAccount.Vaults[resource]; vault != nil {
+			if state.Resources[resource] != nil && state.Resources[resource].NonFungible {
+				return DecimalValueFromInt64(int64(len(vault.Ids)))
+			}
+			return vault.Amount
+		}
+	}
+	return DecimalValue{}
+}
+
+// NonFungibles returns the local ids of the non-fungibles of a resource held
+// by the account.
+func (state *LedgerState) NonFungibles(account, resource address.Address) []string {
+	if ledgerAccount := state.Accounts[account]; ledgerAccount != nil {
+		if vault := ledgerAccount.Vaults[resource]; vault != nil {
+			return slices.Clone(vault.Ids)
+		}
+	}
+	return nil
+}
+
+// AddValidator adds a validator without stake, along with its stake unit and
+// claim NFT resources.
+func (state *LedgerState) AddValidator(validator, stakeUnitResource, claimNftResource address.Address) *LedgerValidator {
+	state.AddFungibleResource(stakeUnitResource, 18)
+	state.AddNonFungibleResource(claimNftResource)
+	added := &LedgerValidator{
+		StakeUnitResource: stakeUnitResource,
+		ClaimNftResource:  claimNftResource,
+		Claims:            map[string]DecimalValue{},
+		NextClaimId:       1,
+	}
+	state.Validators[validator] = added
+	return added
+}
+
+// AddPool adds an empty pool of the resources, along with its pool unit
+// resource.
+func (state *LedgerState) AddPool(pool, poolUnitResource address.Address, resources ...address.Address) *LedgerPool {
+	state.AddFungibleResource(poolUnitResource, 18)
+	added := &LedgerPool{
+		PoolUnitResource: poolUnitResource,
+		Resources:        slices.Clone(resources),
+		Reserves:         map[address.Address]DecimalValue{},
+	}
+	state.Pools[pool] = added
+	return added
+}
+
+// Clone returns a deep copy of the ledger.
+func (state *LedgerState) Clone() *LedgerState {
+	clone := &LedgerState{
+		NetworkId:  state.NetworkId,
+		Resources:  make(map[address.Address]*LedgerResource, len(state.Resources)),
+		Accounts:   make(map[address.Address]*LedgerAccount, len(state.Accounts)),
+		Validators: make(map[address.Address]*LedgerValidator, len(state.Validators)),
+		Pools:      make(map[address.Address]*LedgerPool, len(state.Pools)),
+	}
+	for resource, resourceManager := range state.Resources {
+		copied := *resourceManager
+		clone.Resources[resource] = &copied
+	}
+	for account, ledgerAccount := range state.Accounts {
+		vaults := make(map[address.Address]*LedgerVault, len(ledgerAccount.Vaults))
+		for resource, vault := range ledgerAccount.Vaults {
+			vaults[resource] = &LedgerVault{Amount: vault.Amount, Ids: slices.Clone(vault.Ids)}
+		}
+		clone.Accounts[account] = &LedgerAccount{Vaults: vaults}
+	}
+	for validator, ledgerValidator := range state.Validators {
+		copied := *ledgerValidator
+		copied.Claims = maps.Clone(ledgerValidator.Claims)
+		clone.Validators[validator] = &copied
+	}
+	for pool, ledgerPool := range state.Pools {
+		copied := *ledgerPool
+		copied.Resources = slices.Clone(ledgerPool.Resources)
+		copied.Reserves = maps.Clone(ledgerPool.Reserves)
+		clone.Pools[pool] = &copied
+	}
+	return clone
+}
+
+func (account *LedgerAccount) vault(resource address.Address) *LedgerVault {
+	if account.Vaults == nil {
+		account.Vaults = map[address.Address]*LedgerVault{}
+	}
+	vault := account.Vaults[resource]
+	if vault == nil {
+		vault = &LedgerVault{}
+		account.Vaults[resource] = vault
+	}
+	return vault
+}
+
+func (vault *LedgerVault) put(amount DecimalValue, ids []string) error {
+	total, err := vault.Amount.Add(amount)
+	if err != nil {
+		return err
+	}
+	vault.Amount = total
+	vault.Ids = append(vault.Ids, ids...)
+	return nil
+}
@@ -0,0 +1,686 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// LocalPreviewEngine is a PreviewBackend executing manifests against an
+// in-memory LedgerState instead of a node, for tests and offline tooling.
+//
+// It understands the worktop, bucket and assertion instructions and calls to
+// the methods of the native blueprints most manifests use:
+//
+//   - accounts: lock_fee, lock_contingent_fee, withdraw,
+//     withdraw_non_fungibles, lock_fee_and_withdraw,
+//     lock_fee_and_withdraw_non_fungibles, deposit, deposit_batch, the
+//     try_deposit_* methods, create_proof_of_amount and
+//     create_proof_of_non_fungibles;
+//   - one, two and multi resource pools: contribute and redeem;
+//   - validators: stake, unstake and claim_xrd.
+//
+// Authorization and deposit rules are not checked, and proofs are accepted
+// without being tracked. Fees follow FeeModel rather than the engine's
+// costing. Anything else, such as function calls, named addresses or
+// subintents, fails the preview with an error wrapping
+// ErrLocalPreviewUnsupported.
+type LocalPreviewEngine struct {
+	State    *LedgerState
+	FeeModel LocalFeeModel
+}
+
+// LocalFeeModel prices the transactions executed by a LocalPreviewEngine.
+type LocalFeeModel struct {
+	ExecutionCostPerInstruction DecimalValue
+	FinalizationCost            DecimalValue
+	// storage cost of each vault written to by a committed transaction
+	StorageCostPerVault DecimalValue
+	// storage cost of each entity created by a committed transaction
+	StorageCostPerNewEntity DecimalValue
+}
+
+// ErrLocalPreviewUnsupported reports a manifest using an instruction or a
+// method which LocalPreviewEngine cannot execute.
+var ErrLocalPreviewUnsupported = errors.New("not supported by the local preview engine")
+
+// NewLocalPreviewEngine returns an engine over state using
+// DefaultLocalFeeModel.
+func NewLocalPreviewEngine(state *LedgerState) *LocalPreviewEngine {
+	return &LocalPreviewEngine{State: state, FeeModel: DefaultLocalFeeModel()}
+}
+
+// DefaultLocalFeeModel returns a fee model in the order of magnitude of the
+// fees of simple transactions on the mainnet.
+func DefaultLocalFeeModel() LocalFeeModel {
+	parse := func(value string) DecimalValue {
+		decimal, err := ParseDecimalValue(value)
+		if err != nil {
+			panic(err)
+		}
+		return decimal
+	}
+	return LocalFeeModel{
+		ExecutionCostPerInstruction: parse("0.05"),
+		FinalizationCost:            parse("0.02"),
+		StorageCostPerVault:         parse("0.01"),
+		StorageCostPerNewEntity:     parse("0.1"),
+	}
+}
+
+// PreviewManifestV1 executes manifest against a copy of the ledger, leaving
+// the ledger unchanged.
+func (engine *LocalPreviewEngine) PreviewManifestV1(ctx context.Context, manifest *TransactionManifestV1, options PreviewOptions) (ToolkitReceipt, error) {
+	receipt, _, err := engine.executeV1(ctx, manifest)
+	return receipt, err
+}
+
+// PreviewManifestV2 executes manifest against a copy of the ledger, leaving
+// the ledger unchanged.
+func (engine *LocalPreviewEngine) PreviewManifestV2(ctx context.Context, manifest *TransactionManifestV2, options PreviewOptions) (ToolkitReceipt, error) {
+	receipt, _, err := engine.executeV2(ctx, manifest)
+	return receipt, err
+}
+
+// ExecuteManifestV1 executes manifest and commits its outcome to the ledger:
+// all of its changes for a CommitSuccess and its fees for a CommitFailure.
+func (engine *LocalPreviewEngine) ExecuteManifestV1(ctx context.Context, manifest *TransactionManifestV1) (ToolkitReceipt, error) {
+	receipt, state, err := engine.executeV1(ctx, manifest)
+	if err == nil && state != nil {
+		engine.State = state
+	}
+	return receipt, err
+}
+
+// ExecuteManifestV2 is the TransactionManifestV2 counterpart of
+// ExecuteManifestV1.
+func (engine *LocalPreviewEngine) ExecuteManifestV2(ctx context.Context, manifest *TransactionManifestV2) (ToolkitReceipt, error) {
+	receipt, state, err := engine.executeV2(ctx, manifest)
+	if err == nil && state != nil {
+		engine.State = state
+	}
+	return receipt, err
+}
+
+func (engine *LocalPreviewEngine) executeV1(ctx context.Context, manifest *TransactionManifestV1) (ToolkitReceipt, *LedgerState, error) {
+	instructionsV1 := manifest.Instructions().InstructionsList()
+	instructions := make([]InstructionV2, len(instructionsV1))
+	for index, instruction := range instructionsV1 {
+		instructions[index] = instructionV1AsV2(instruction)
+	}
+	return engine.execute(ctx, instructions)
+}
+
+func (engine *LocalPreviewEngine) executeV2(ctx context.Context, manifest *TransactionManifestV2) (ToolkitReceipt, *LedgerState, error) {
+	return engine.execute(ctx, manifest.Instructions().InstructionsList())
+}
+
+// instructionV1AsV2 returns the V2 instruction of a V1 instruction; every V1
+// instruction has a V2 counterpart with the same fields.
+func instructionV1AsV2(instruction InstructionV1) InstructionV2 {
+	switch instruction := instruction.(type) {
+	case InstructionV1TakeAllFromWorktop:
+		return InstructionV2TakeAllFromWorktop(instruction)
+	case InstructionV1TakeFromWorktop:
+		return InstructionV2TakeFromWorktop(instruction)
+	case InstructionV1TakeNonFungiblesFromWorktop:
+		return InstructionV2TakeNonFungiblesFromWorktop(instruction)
+	case InstructionV1ReturnToWorktop:
+		return InstructionV2ReturnToWorktop(instruction)
+	case InstructionV1AssertWorktopContains:
+		return InstructionV2AssertWorktopContains(instruction)
+	case InstructionV1AssertWorktopContainsAny:
+		return InstructionV2AssertWorktopContainsAny(instruction)
+	case InstructionV1AssertWorktopContainsNonFungibles:
+		return InstructionV2AssertWorktopContainsNonFungibles(instruction)
+	case InstructionV1PopFromAuthZone:
+		return InstructionV2PopFromAuthZone(instruction)
+	case InstructionV1PushToAuthZone:
+		return InstructionV2PushToAuthZone(instruction)
+	case InstructionV1CreateProofFromAuthZoneOfAmount:
+		return InstructionV2CreateProofFromAuthZoneOfAmount(instruction)
+	case InstructionV1CreateProofFromAuthZoneOfNonFungibles:
+		return InstructionV2CreateProofFromAuthZoneOfNonFungibles(instruction)
+	case InstructionV1CreateProofFromAuthZoneOfAll:
+		return InstructionV2CreateProofFromAuthZoneOfAll(instruction)
+	case InstructionV1DropAllProofs:
+		return InstructionV2DropAllProofs(instruction)
+	case InstructionV1DropNamedProofs:
+		return InstructionV2DropNamedProofs(instruction)
+	case InstructionV1DropAuthZoneProofs:
+		return InstructionV2DropAuthZoneProofs(instruction)
+	case InstructionV1DropAuthZoneRegularProofs:
+		return InstructionV2DropAuthZoneRegularProofs(instruction)
+	case InstructionV1DropAuthZoneSignatureProofs:
+		return InstructionV2DropAuthZoneSignatureProofs(instruction)
+	case InstructionV1CreateProofFromBucketOfAmount:
+		return InstructionV2CreateProofFromBucketOfAmount(instruction)
+	case InstructionV1CreateProofFromBucketOfNonFungibles:
+		return InstructionV2CreateProofFromBucketOfNonFungibles(instruction)
+	case InstructionV1CreateProofFromBucketOfAll:
+		return InstructionV2CreateProofFromBucketOfAll(instruction)
+	case InstructionV1BurnResource:
+		return InstructionV2BurnResource(instruction)
+	case InstructionV1CloneProof:
+		return InstructionV2CloneProof(instruction)
+	case InstructionV1DropProof:
+		return InstructionV2DropProof(instruction)
+	case InstructionV1CallFunction:
+		return InstructionV2CallFunction(instruction)
+	case InstructionV1CallMethod:
+		return InstructionV2CallMethod(instruction)
+	case InstructionV1CallRoyaltyMethod:
+		return InstructionV2CallRoyaltyMethod(instruction)
+	case InstructionV1CallMetadataMethod:
+		return InstructionV2CallMetadataMethod(instruction)
+	case InstructionV1CallRoleAssignmentMethod:
+		return InstructionV2CallRoleAssignmentMethod(instruction)
+	case InstructionV1CallDirectVaultMethod:
+		return InstructionV2CallDirectVaultMethod(instruction)
+	case InstructionV1AllocateGlobalAddress:
+		return InstructionV2AllocateGlobalAddress(instruction)
+	}
+	panic(fmt.Sprintf("unknown instruction %T", instruction))
+}
+
+// localPreviewFailure is an error of the executed transaction, turning the
+// receipt into a CommitFailure or a Reject.
+type localPreviewFailure struct {
+	reason string
+}
+
+func (failure *localPreviewFailure) Error() string {
+	return failure.reason
+}
+
+func newLocalPreviewFailure(format string, args ...any) error {
+	return &localPreviewFailure{reason: fmt.Sprintf(format, args...)}
+}
+
+func localPreviewUnsupported(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrLocalPreviewUnsupported, fmt.Sprintf(format, args...))
+}
+
+type localFeeLock struct {
+	account    address.Address
+	amount     DecimalValue
+	contingent bool
+}
+
+type localVaultKey struct {
+	account  address.Address
+	resource address.Address
+}
+
+// localExecution is the state of one manifest being executed.
+type localExecution struct {
+	engine *LocalPreviewEngine
+	state  *LedgerState
+	xrd    address.Address
+
+	instructionIndex uint64
+	worktop          localWorktop
+	buckets          map[uint32]*localResources
+	nextBucketId     uint32
+	// the constraints of a pending AssertNextCallReturnsOnly or
+	// AssertNextCallReturnsInclude
+	returnsAssertion *localReturnsAssertion
+
+	feeLocks      []localFeeLock
+	updatedVaults map[localVaultKey]struct{}
+	receipt       ToolkitReceipt
+}
+
+func (engine *LocalPreviewEngine) execute(ctx context.Context, instructions []InstructionV2) (ToolkitReceipt, *LedgerState, error) {
+	execution := &localExecution{
+		engine:        engine,
+		state:         engine.State.Clone(),
+		xrd:           engine.State.Xrd(),
+		buckets:       map[uint32]*localResources{},
+		updatedVaults: map[localVaultKey]struct{}{},
+		receipt: ToolkitReceipt{
+			Kind:           ToolkitReceiptKindCommitSuccess,
+			WorktopChanges: map[uint64][]ToolkitWorktopChange{},
+		},
+	}
+	for index, instruction := range instructions {
+		if err := ctx.Err(); err != nil {
+			return ToolkitReceipt{}, nil, err
+		}
+		execution.instructionIndex = uint64(index)
+		err := execution.executeInstruction(instruction)
+		var failure *localPreviewFailure
+		if errors.As(err, &failure) {
+			return execution.fail(failure.reason, uint64(index)+1)
+		}
+		if err != nil {
+			return ToolkitReceipt{}, nil, fmt.Errorf("instruction %d: %w", index, err)
+		}
+	}
+	if !execution.worktop.isEmpty() {
+		return execution.fail("the worktop is not empty at the end of the transaction", uint64(len(instructions)))
+	}
+	// As in the engine, an empty bucket left over is dropped, only one
+	// holding resources fails the transaction.
+	for _, bucket := range execution.buckets {
+		if !bucket.amount.IsZero() {
+			return execution.fail("a bucket is not empty at the end of the transaction", uint64(len(instructions)))
+		}
+	}
+	return execution.commit(uint64(len(instructions)))
+}
+
+// commit charges the fees of a successful execution, turning it into a
+// Reject if the locked fees do not cover them.
+func (execution *localExecution) commit(executedInstructions uint64) (ToolkitReceipt, *LedgerState, error) {
+	feeModel := execution.engine.FeeModel
+	fees := ToolkitFeeSummary{}
+	var err error
+	fees.ExecutionFeesInXrd, err = feeModel.ExecutionCostPerInstruction.Mul(DecimalValueFromInt64(int64(executedInstructions)))
+	if err != nil {
+		return ToolkitReceipt{}, nil, err
+	}
+	fees.FinalizationFeesInXrd = feeModel.FinalizationCost
+	vaultsCost, err := feeModel.StorageCostPerVault.Mul(DecimalValueFromInt64(int64(len(execution.updatedVaults))))
+	if err != nil {
+		return ToolkitReceipt{}, nil, err
+	}
+	entitiesCost, err := feeModel.StorageCostPerNewEntity.Mul(DecimalValueFromInt64(int64(len(execution.receipt.StateUpdatesSummary.NewEntities))))
+	if err != nil {
+		return ToolkitReceipt{}, nil, err
+	}
+	if fees.StorageFeesInXrd, err = vaultsCost.Add(entitiesCost); err != nil {
+		return ToolkitReceipt{}, nil, err
+	}
+
+	// the locked fees were taken from the vaults when locked, the unused
+	// part of each lock is returned
+	remaining, err := totalFees(fees)
+	if err != nil {
+		return ToolkitReceipt{}, nil, err
+	}
+	for _, lock := range execution.feeLocks {
+		used := lock.amount
+		if used.GreaterThan(remaining) {
+			used = remaining
+		}
+		if remaining, err = remaining.Sub(used); err != nil {
+			return ToolkitReceipt{}, nil, err
+		}
+		refund, err := lock.amount.Sub(used)
+		if err != nil {
+			return ToolkitReceipt{}, nil, err
+		}
+		if err := execution.state.AddAccount(lock.account).vault(execution.xrd).put(refund, nil); err != nil {
+			return ToolkitReceipt{}, nil, err
+		}
+	}
+	if remaining.IsPositive() {
+		return rejected("the locked fees do not cover the fees of the transaction"), nil, nil
+	}
+
+	execution.receipt.FeeSummary = fees
+	if execution.receipt.LockedFees, err = lockedFees(execution.feeLocks); err != nil {
+		return ToolkitReceipt{}, nil, err
+	}
+	return execution.receipt, execution.state, nil
+}
+
+// fail turns a failed execution into a CommitFailure charging its execution
+// and finalization fees to its non-contingent fee locks, or into a Reject if
+// they do not cover the fees.
+func (execution *localExecution) fail(reason string, executedInstructions uint64) (ToolkitReceipt, *LedgerState, error) {
+	feeModel := execution.engine.FeeModel
+	executionFees, err := feeModel.ExecutionCostPerInstruction.Mul(DecimalValueFromInt64(int64(executedInstructions)))
+	if err != nil {
+		return ToolkitReceipt{}, nil, err
+	}
+	remaining, err := executionFees.Add(feeModel.FinalizationCost)
+	if err != nil {
+		return ToolkitReceipt{}, nil, err
+	}
+
+	state := execution.engine.State.Clone()
+	for _, lock := range execution.feeLocks {
+		if lock.contingent || !remaining.IsPositive() {
+			continue
+		}
+		used := lock.amount
+		if used.GreaterThan(remaining) {
+			used = remaining
+		}
+		vault := state.AddAccount(lock.account).vault(execution.xrd)
+		if vault.Amount.LessThan(used) {
+			continue
+		}
+		if vault.Amount, err = vault.Amount.Sub(used); err != nil {
+			return ToolkitReceipt{}, nil, err
+		}
+		if remaining, err = remaining.Sub(used); err != nil {
+			return ToolkitReceipt{}, nil, err
+		}
+	}
+	if remaining.IsPositive() {
+		return rejected(reason), nil, nil
+	}
+	return ToolkitReceipt{Kind: ToolkitReceiptKindCommitFailure, Reason: reason}, state, nil
+}
+
+func rejected(reason string) ToolkitReceipt {
+	return ToolkitReceipt{Kind: ToolkitReceiptKindReject, Reason: reason}
+}
+
+func totalFees(fees ToolkitFeeSummary) (DecimalValue, error) {
+	total := DecimalValue{}
+	for _, fee := range []DecimalValue{fees.ExecutionFeesInXrd, fees.FinalizationFeesInXrd, fees.StorageFeesInXrd, fees.RoyaltyFeesInXrd} {
+		var err error
+		if total, err = total.Add(fee); err != nil {
+			return DecimalValue{}, err
+		}
+	}
+	return total, nil
+}
+
+func lockedFees(locks []localFeeLock) (ToolkitLockedFees, error) {
+	var locked ToolkitLockedFees
+	for _, lock := range locks {
+		var err error
+		if lock.contingent {
+			locked.Contingent, err = locked.Contingent.Add(lock.amount)
+		} else {
+			locked.NonContingent, err = locked.NonContingent.Add(lock.amount)
+		}
+		if err != nil {
+			return ToolkitLockedFees{}, err
+		}
+	}
+	return locked, nil
+}
+
+func (execution *localExecution) executeInstruction(instruction InstructionV2) error {
+	switch instruction := instruction.(type) {
+	case InstructionV2TakeAllFromWorktop:
+		resource, err := staticAddressValue(instruction.ResourceAddress)
+		if err != nil {
+			return err
+		}
+		taken := execution.worktop.takeAll(resource)
+		execution.recordWorktopChange(ToolkitWorktopChangeKindTake, taken)
+		execution.newBucket(taken)
+	case InstructionV2TakeFromWorktop:
+		resource, err := staticAddressValue(instruction.ResourceAddress)
+		if err != nil {
+			return err
+		}
+		taken, err := execution.worktop.take(resource, instruction.Amount.Value())
+		if err != nil {
+			return err
+		}
+		execution.recordWorktopChange(ToolkitWorktopChangeKindTake, taken)
+		execution.newBucket(taken)
+	case InstructionV2TakeNonFungiblesFromWorktop:
+		resource, err := staticAddressValue(instruction.ResourceAddress)
+		if err != nil {
+			return err
+		}
+		taken, err := execution.worktop.takeIds(resource, nonFungibleLocalIdStrings(instruction.Ids))
+		if err != nil {
+			return err
+		}
+		execution.recordWorktopChange(ToolkitWorktopChangeKindTake, taken)
+		execution.newBucket(taken)
+	case InstructionV2ReturnToWorktop:
+		bucket, err := execution.takeBucket(instruction.BucketId)
+		if err != nil {
+			return err
+		}
+		execution.recordWorktopChange(ToolkitWorktopChangeKindPut, bucket)
+		return execution.worktop.put(bucket)
+	case InstructionV2AssertWorktopContains:
+		resource, err := staticAddressValue(instruction.ResourceAddress)
+		if err != nil {
+			return err
+		}
+		if execution.worktop.amountOf(resource).amount.LessThan(instruction.Amount.Value()) {
+			return newLocalPreviewFailure("WorktopError(AssertionFailed): the worktop holds less than %s of %s", instruction.Amount.Value(), resource)
+		}
+	case InstructionV2AssertWorktopContainsAny:
+		resource, err := staticAddressValue(instruction.ResourceAddress)
+		if err != nil {
+			return err
+		}
+		if !execution.worktop.amountOf(resource).amount.IsPositive() {
+			return newLocalPreviewFailure("WorktopError(AssertionFailed): the worktop holds no %s", resource)
+		}
+	case InstructionV2AssertWorktopContainsNonFungibles:
+		resource, err := staticAddressValue(instruction.ResourceAddress)
+		if err != nil {
+			return err
+		}
+		held := execution.worktop.amountOf(resource).ids
+		for _, id := range nonFungibleLocalIdStrings(instruction.Ids) {
+			if !slices.Contains(held, id) {
+				return newLocalPreviewFailure("WorktopError(AssertionFailed): the worktop does not hold %s of %s", id, resource)
+			}
+		}
+	case InstructionV2AssertWorktopResourcesOnly:
+		return execution.assertResources("the worktop", execution.worktop.contents(), instruction.Constraints, true)
+	case InstructionV2AssertWorktopResourcesInclude:
+		return execution.assertResources("the worktop", execution.worktop.contents(), instruction.Constraints, false)
+	case InstructionV2AssertNextCallReturnsOnly:
+		execution.returnsAssertion = &localReturnsAssertion{constraints: instruction.Constraints, exact: true}
+	case InstructionV2AssertNextCallReturnsInclude:
+		execution.returnsAssertion = &localReturnsAssertion{constraints: instruction.Constraints}
+	case InstructionV2AssertBucketContents:
+		bucket, ok := execution.buckets[instruction.BucketId.Value]
+		if !ok {
+			return newLocalPreviewFailure("bucket %d does not exist", instruction.BucketId.Value)
+		}
+		satisfied, err := constraintSatisfied(*bucket, instruction.Constraint)
+		if err != nil {
+			return err
+		}
+		if !satisfied {
+			return newLocalPreviewFailure("AssertionFailed: the contents of bucket %d do not satisfy the constraint", instruction.BucketId.Value)
+		}
+	case InstructionV2BurnResource:
+		bucket, err := execution.takeBucket(instruction.BucketId)
+		if err != nil {
+			return err
+		}
+		return execution.burn(bucket)
+	case InstructionV2CreateProofFromBucketOfAmount, InstructionV2CreateProofFromBucketOfNonFungibles, InstructionV2CreateProofFromBucketOfAll:
+		var bucketId ManifestBucket
+		switch instruction := instruction.(type) {
+		case InstructionV2CreateProofFromBucketOfAmount:
+			bucketId = instruction.BucketId
+		case InstructionV2CreateProofFromBucketOfNonFungibles:
+			bucketId = instruction.BucketId
+		case InstructionV2CreateProofFromBucketOfAll:
+			bucketId = instruction.BucketId
+		}
+		if _, ok := execution.buckets[bucketId.Value]; !ok {
+			return newLocalPreviewFailure("bucket %d does not exist", bucketId.Value)
+		}
+	case InstructionV2PopFromAuthZone, InstructionV2PushToAuthZone,
+		InstructionV2CreateProofFromAuthZoneOfAmount, InstructionV2CreateProofFromAuthZoneOfNonFungibles, InstructionV2CreateProofFromAuthZoneOfAll,
+		InstructionV2DropAllProofs, InstructionV2DropNamedProofs, InstructionV2DropAuthZoneProofs,
+		InstructionV2DropAuthZoneRegularProofs, InstructionV2DropAuthZoneSignatureProofs,
+		InstructionV2CloneProof, InstructionV2DropProof:
+		// proofs are not tracked
+	case InstructionV2CallMethod:
+		return execution.callMethod(instruction)
+	default:
+		return localPreviewUnsupported("instruction %T", instruction)
+	}
+	return nil
+}
+
+func (execution *localExecution) newBucket(resources localResources) {
+	execution.buckets[execution.nextBucketId] = &resources
+	execution.nextBucketId++
+}
+
+func (execution *localExecution) takeBucket(bucketId ManifestBucket) (localResources, error) {
+	bucket, ok := execution.buckets[bucketId.Value]
+	if !ok {
+		return localResources{}, newLocalPreviewFailure("bucket %d does not exist", bucketId.Value)
+	}
+	delete(execution.buckets, bucketId.Value)
+	return *bucket, nil
+}
+
+func (execution *localExecution) recordWorktopChange(kind ToolkitWorktopChangeKind, resources localResources) {
+	if !resources.amount.IsPositive() {
+		return
+	}
+	specifier := ToolkitResourceSpecifier{ResourceAddress: resources.resource}
+	if resources.nonFungible {
+		specifier.Ids = slices.Clone(resources.ids)
+	} else {
+		amount := resources.amount
+		specifier.Amount = &amount
+	}
+	execution.receipt.WorktopChanges[execution.instructionIndex] = append(
+		execution.receipt.WorktopChanges[execution.instructionIndex],
+		ToolkitWorktopChange{Kind: kind, ResourceSpecifier: specifier},
+	)
+}
+
+// resources returns empty resources of a resource of the ledger.
+func (execution *localExecution) resources(resource address.Address) (localResources, error) {
+	resourceManager := execution.state.Resources[resource]
+	if resourceManager == nil {
+		return localResources{}, newLocalPreviewFailure("resource %s does not exist", resource)
+	}
+	return localResources{resource: resource, nonFungible: resourceManager.NonFungible}, nil
+}
+
+// mint returns amount of a fungible resource, increasing its total supply.
+func (execution *localExecution) mint(resource address.Address, amount DecimalValue) (localResources, error) {
+	minted, err := execution.resources(resource)
+	if err != nil {
+		return localResources{}, err
+	}
+	resourceManager := execution.state.Resources[resource]
+	if resourceManager.TotalSupply, err = resourceManager.TotalSupply.Add(amount); err != nil {
+		return localResources{}, err
+	}
+	minted.amount = amount
+	return minted, nil
+}
+
+// burn destroys resources, decreasing the total supply of their resource.
+func (execution *localExecution) burn(resources localResources) error {
+	resourceManager := execution.state.Resources[resources.resource]
+	if resourceManager == nil {
+		return newLocalPreviewFailure("resource %s does not exist", resources.resource)
+	}
+	var err error
+	resourceManager.TotalSupply, err = resourceManager.TotalSupply.Sub(resources.amount)
+	return err
+}
+
+// roundToDivisibility rounds amount down to the divisibility of a resource.
+func (execution *localExecution) roundToDivisibility(resource address.Address, amount DecimalValue) (DecimalValue, error) {
+	resourceManager := execution.state.Resources[resource]
+	if resourceManager == nil {
+		return DecimalValue{}, newLocalPreviewFailure("resource %s does not exist", resource)
+	}
+	divisibility := int32(resourceManager.Divisibility)
+	if resourceManager.NonFungible {
+		divisibility = 0
+	}
+	return amount.Round(divisibility, RoundingModeToZero)
+}
+
+// staticAddressValue returns the pure Go form of an address of a manifest.
+func staticAddressValue(value *Address) (address.Address, error) {
+	converted, err := value.Value()
+	if err != nil {
+		return address.Address{}, newLocalPreviewFailure("invalid address: %v", err)
+	}
+	return converted, nil
+}
+
+func manifestAddressValue(value ManifestAddress) (address.Address, error) {
+	static, ok := value.(ManifestAddressStatic)
+	if !ok {
+		return address.Address{}, localPreviewUnsupported("named address %v", value)
+	}
+	return staticAddressValue(static.StaticAddress)
+}
+
+type localReturnsAssertion struct {
+	constraints map[string]ManifestResourceConstraint
+	exact       bool
+}
+
+// assertResources checks resources against the constraints of an Assert*Only
+// (exact) or Assert*Include instruction.
+func (execution *localExecution) assertResources(holder string, resources []localResources, constraints map[string]ManifestResourceConstraint, exact bool) error {
+	held := make(map[address.Address]localResources, len(resources))
+	for _, resources := range resources {
+		held[resources.resource] = resources
+	}
+	constrained := make(map[address.Address]struct{}, len(constraints))
+	for resourceAddress, constraint := range constraints {
+		resource, err := address.New(resourceAddress)
+		if err != nil {
+			return newLocalPreviewFailure("invalid address %q: %v", resourceAddress, err)
+		}
+		constrained[resource] = struct{}{}
+		resources, ok := held[resource]
+		if !ok {
+			resources = localResources{resource: resource}
+		}
+		satisfied, err := constraintSatisfied(resources, constraint)
+		if err != nil {
+			return err
+		}
+		if !satisfied {
+			return newLocalPreviewFailure("AssertionFailed: the %s held by %s do not satisfy the constraint", resource, holder)
+		}
+	}
+	if exact {
+		for resource, resources := range held {
+			if _, ok := constrained[resource]; !ok && resources.amount.IsPositive() {
+				return newLocalPreviewFailure("AssertionFailed: %s holds unexpected %s", holder, resource)
+			}
+		}
+	}
+	return nil
+}
+
+func constraintSatisfied(resources localResources, constraint ManifestResourceConstraint) (bool, error) {
+	switch constraint := constraint.(type) {
+	case ManifestResourceConstraintNonZeroAmount:
+		return resources.amount.IsPositive(), nil
+	case ManifestResourceConstraintExactAmount:
+		return resources.amount.Equal(constraint.Value.Value()), nil
+	case ManifestResourceConstraintAtLeastAmount:
+		return resources.amount.GreaterThanOrEqual(constraint.Value.Value()), nil
+	case ManifestResourceConstraintExactNonFungibles:
+		ids := nonFungibleLocalIdStrings(constraint.Value)
+		if len(ids) != len(resources.ids) {
+			return false, nil
+		}
+		for _, id := range ids {
+			if !slices.Contains(resources.ids, id) {
+				return false, nil
+			}
+		}
+		return true, nil
+	case ManifestResourceConstraintAtLeastNonFungibles:
+		for _, id := range nonFungibleLocalIdStrings(constraint.Value) {
+			if !slices.Contains(resources.ids, id) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+	return false, localPreviewUnsupported("resource constraint %T", constraint)
+}
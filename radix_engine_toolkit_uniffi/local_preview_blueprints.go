@@ -0,0 +1,626 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// callMethod executes a method call, putting what it returns onto the
+// worktop.
+func (execution *localExecution) callMethod(instruction InstructionV2CallMethod) error {
+	receiver, err := manifestAddressValue(instruction.Address)
+	if err != nil {
+		return err
+	}
+	tuple, ok := instruction.Args.(ManifestValueTupleValue)
+	if !ok {
+		return newLocalPreviewFailure("the arguments of %s are not a tuple", instruction.MethodName)
+	}
+	args := localArgs{execution: execution, method: instruction.MethodName, fields: tuple.Fields}
+
+	var returned []localResources
+	switch entityType := receiver.EntityType(); {
+	case entityType == address.EntityTypeGlobalAccount || entityType == address.EntityTypeGlobalPreallocatedSecp256k1Account || entityType == address.EntityTypeGlobalPreallocatedEd25519Account:
+		returned, err = execution.callAccount(receiver, args)
+	case entityType == address.EntityTypeGlobalValidator:
+		returned, err = execution.callValidator(receiver, args)
+	case entityType == address.EntityTypeGlobalOneResourcePool || entityType == address.EntityTypeGlobalTwoResourcePool || entityType == address.EntityTypeGlobalMultiResourcePool:
+		returned, err = execution.callPool(receiver, args)
+	default:
+		return localPreviewUnsupported("method %s of %s", instruction.MethodName, receiver)
+	}
+	if err != nil {
+		return err
+	}
+
+	if assertion := execution.returnsAssertion; assertion != nil {
+		execution.returnsAssertion = nil
+		if err := execution.assertResources("the call", returned, assertion.constraints, assertion.exact); err != nil {
+			return err
+		}
+	}
+	for _, resources := range returned {
+		if !resources.amount.IsPositive() {
+			continue
+		}
+		execution.recordWorktopChange(ToolkitWorktopChangeKindPut, resources)
+		if err := execution.worktop.put(resources); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// localArgs reads the fields of the arguments of a method call.
+type localArgs struct {
+	execution *localExecution
+	method    string
+	fields    []ManifestValue
+}
+
+func (args localArgs) invalid() error {
+	return newLocalPreviewFailure("invalid arguments of %s", args.method)
+}
+
+func (args localArgs) count(counts ...int) error {
+	if !slices.Contains(counts, len(args.fields)) {
+		return args.invalid()
+	}
+	return nil
+}
+
+func (args localArgs) decimal(index int) (DecimalValue, error) {
+	if index < len(args.fields) {
+		if value, ok := args.fields[index].(ManifestValueDecimalValue); ok {
+			return value.Value.Value(), nil
+		}
+	}
+	return DecimalValue{}, args.invalid()
+}
+
+func (args localArgs) address(index int) (address.Address, error) {
+	if index < len(args.fields) {
+		if value, ok := args.fields[index].(ManifestValueAddressValue); ok {
+			return manifestAddressValue(value.Value)
+		}
+	}
+	return address.Address{}, args.invalid()
+}
+
+func (args localArgs) localIds(index int) ([]string, error) {
+	if index < len(args.fields) {
+		if array, ok := args.fields[index].(ManifestValueArrayValue); ok {
+			ids := make([]string, 0, len(array.Elements))
+			for _, element := range array.Elements {
+				id, ok := element.(ManifestValueNonFungibleLocalIdValue)
+				if !ok {
+					return nil, args.invalid()
+				}
+				ids = append(ids, nonFungibleLocalIdString(id.Value))
+			}
+			return ids, nil
+		}
+	}
+	return nil, args.invalid()
+}
+
+// buckets takes the buckets passed in a field: a bucket, a tuple or an array
+// of buckets, or the ENTIRE_WORKTOP expression.
+func (args localArgs) buckets(index int) ([]localResources, error) {
+	if index >= len(args.fields) {
+		return nil, args.invalid()
+	}
+	var taken []localResources
+	var take func(value ManifestValue) error
+	take = func(value ManifestValue) error {
+		switch value := value.(type) {
+		case ManifestValueBucketValue:
+			bucket, err := args.execution.takeBucket(value.Value)
+			if err != nil {
+				return err
+			}
+			taken = append(taken, bucket)
+		case ManifestValueExpressionValue:
+			if value.Value != ManifestExpressionEntireWorktop {
+				return args.invalid()
+			}
+			for _, resources := range args.execution.worktop.drain() {
+				args.execution.recordWorktopChange(ToolkitWorktopChangeKindTake, resources)
+				taken = append(taken, resources)
+			}
+		case ManifestValueArrayValue:
+			for _, element := range value.Elements {
+				if err := take(element); err != nil {
+					return err
+				}
+			}
+		case ManifestValueTupleValue:
+			for _, field := range value.Fields {
+				if err := take(field); err != nil {
+					return err
+				}
+			}
+		default:
+			return args.invalid()
+		}
+		return nil
+	}
+	return taken, take(args.fields[index])
+}
+
+// bucketOf takes the single bucket passed in a field, which must hold the
+// resource.
+func (args localArgs) bucketOf(index int, resource address.Address) (localResources, error) {
+	if index < len(args.fields) {
+		if value, ok := args.fields[index].(ManifestValueBucketValue); ok {
+			bucket, err := args.execution.takeBucket(value.Value)
+			if err != nil {
+				return localResources{}, err
+			}
+			if bucket.resource != resource {
+				return localResources{}, newLocalPreviewFailure("%s takes a bucket of %s, not of %s", args.method, resource, bucket.resource)
+			}
+			return bucket, nil
+		}
+	}
+	return localResources{}, args.invalid()
+}
+
+func (execution *localExecution) callAccount(account address.Address, args localArgs) ([]localResources, error) {
+	switch args.method {
+	case "lock_fee", "lock_contingent_fee":
+		if err := args.count(1); err != nil {
+			return nil, err
+		}
+		amount, err := args.decimal(0)
+		if err != nil {
+			return nil, err
+		}
+		return nil, execution.lockFee(account, amount, args.method == "lock_contingent_fee")
+	case "withdraw", "lock_fee_and_withdraw":
+		offset := 0
+		if args.method == "lock_fee_and_withdraw" {
+			if err := execution.lockFeeArg(account, args); err != nil {
+				return nil, err
+			}
+			offset = 1
+		}
+		if err := args.count(offset + 2); err != nil {
+			return nil, err
+		}
+		resource, err := args.address(offset)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := args.decimal(offset + 1)
+		if err != nil {
+			return nil, err
+		}
+		withdrawn, err := execution.withdraw(account, resource, func(vault *localResources) (localResources, error) {
+			return vault.take(amount)
+		})
+		return []localResources{withdrawn}, err
+	case "withdraw_non_fungibles", "lock_fee_and_withdraw_non_fungibles":
+		offset := 0
+		if args.method == "lock_fee_and_withdraw_non_fungibles" {
+			if err := execution.lockFeeArg(account, args); err != nil {
+				return nil, err
+			}
+			offset = 1
+		}
+		if err := args.count(offset + 2); err != nil {
+			return nil, err
+		}
+		resource, err := args.address(offset)
+		if err != nil {
+			return nil, err
+		}
+		ids, err := args.localIds(offset + 1)
+		if err != nil {
+			return nil, err
+		}
+		withdrawn, err := execution.withdraw(account, resource, func(vault *localResources) (localResources, error) {
+			return vault.takeIds(ids)
+		})
+		return []localResources{withdrawn}, err
+	case "deposit", "deposit_batch", "try_deposit_or_abort", "try_deposit_batch_or_abort", "try_deposit_or_refund", "try_deposit_batch_or_refund":
+		// the try_deposit methods take the badge of an authorized depositor,
+		// which is not needed as deposit rules are not checked
+		if err := args.count(1, 2); err != nil {
+			return nil, err
+		}
+		buckets, err := args.buckets(0)
+		if err != nil {
+			return nil, err
+		}
+		return nil, execution.deposit(account, buckets)
+	case "create_proof_of_amount":
+		if err := args.count(2); err != nil {
+			return nil, err
+		}
+		resource, err := args.address(0)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := args.decimal(1)
+		if err != nil {
+			return nil, err
+		}
+		if balance := execution.state.Balance(account, resource); balance.LessThan(amount) {
+			return nil, newLocalPreviewFailure("InsufficientBalance: %s of %s requested, %s available", amount, resource, balance)
+		}
+		return nil, nil
+	case "create_proof_of_non_fungibles":
+		if err := args.count(2); err != nil {
+			return nil, err
+		}
+		resource, err := args.address(0)
+		if err != nil {
+			return nil, err
+		}
+		ids, err := args.localIds(1)
+		if err != nil {
+			return nil, err
+		}
+		held := execution.state.NonFungibles(account, resource)
+		for _, id := range ids {
+			if !slices.Contains(held, id) {
+				return nil, newLocalPreviewFailure("InsufficientBalance: %s of %s is not available", id, resource)
+			}
+		}
+		return nil, nil
+	}
+	return nil, localPreviewUnsupported("account method %s", args.method)
+}
+
+func (execution *localExecution) lockFeeArg(account address.Address, args localArgs) error {
+	amount, err := args.decimal(0)
+	if err != nil {
+		return err
+	}
+	return execution.lockFee(account, amount, false)
+}
+
+// lockFee takes the locked amount of XRD out of the account; commit returns
+// whatever the fees leave of it.
+func (execution *localExecution) lockFee(account address.Address, amount DecimalValue, contingent bool) error {
+	if _, err := execution.withdrawFromVault(account, execution.xrd, func(vault *localResources) (localResources, error) {
+		return vault.take(amount)
+	}); err != nil {
+		return err
+	}
+	execution.feeLocks = append(execution.feeLocks, localFeeLock{account: account, amount: amount, contingent: contingent})
+	return nil
+}
+
+func (execution *localExecution) withdraw(account, resource address.Address, take func(vault *localResources) (localResources, error)) (localResources, error) {
+	withdrawn, err := execution.withdrawFromVault(account, resource, take)
+	if err != nil {
+		return localResources{}, err
+	}
+	return withdrawn, execution.emitResourcesEvent(account, "WithdrawEvent", withdrawn)
+}
+
+func (execution *localExecution) withdrawFromVault(account, resource address.Address, take func(vault *localResources) (localResources, error)) (localResources, error) {
+	held, err := execution.resources(resource)
+	if err != nil {
+		return localResources{}, err
+	}
+	ledgerAccount := execution.state.Accounts[account]
+	if ledgerAccount == nil {
+		if !account.IsGlobalPreallocated() {
+			return localResources{}, newLocalPreviewFailure("account %s does not exist", account)
+		}
+		ledgerAccount = &LedgerAccount{}
+	}
+	vault := ledgerAccount.Vaults[resource]
+	if vault != nil {
+		held.ids = slices.Clone(vault.Ids)
+		held.amount = vault.Amount
+		if held.nonFungible {
+			held.amount = DecimalValueFromInt64(int64(len(vault.Ids)))
+		}
+	}
+	withdrawn, err := take(&held)
+	if err != nil {
+		return localResources{}, err
+	}
+	if vault != nil {
+		vault.Ids = held.ids
+		if !held.nonFungible {
+			vault.Amount = held.amount
+		}
+	}
+	execution.updatedVaults[localVaultKey{account: account, resource: resource}] = struct{}{}
+	return withdrawn, nil
+}
+
+// deposit puts resources into the vaults of an account, creating a
+// preallocated account on its first deposit.
+func (execution *localExecution) deposit(account address.Address, buckets []localResources) error {
+	ledgerAccount := execution.state.Accounts[account]
+	if ledgerAccount == nil {
+		if !account.IsGlobalPreallocated() {
+			return newLocalPreviewFailure("account %s does not exist", account)
+		}
+		ledgerAccount = execution.state.AddAccount(account)
+		summary := &execution.receipt.StateUpdatesSummary
+		summary.NewEntities = append(summary.NewEntities, account)
+	}
+	for _, bucket := range buckets {
+		if !bucket.amount.IsPositive() {
+			continue
+		}
+		// the vaults of non-fungibles only hold their ids
+		amount := bucket.amount
+		if bucket.nonFungible {
+			amount = DecimalValue{}
+		}
+		if err := ledgerAccount.vault(bucket.resource).put(amount, bucket.ids); err != nil {
+			return err
+		}
+		execution.updatedVaults[localVaultKey{account: account, resource: bucket.resource}] = struct{}{}
+		if err := execution.emitResourcesEvent(account, "DepositEvent", bucket); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (execution *localExecution) callValidator(validatorAddress address.Address, args localArgs) ([]localResources, error) {
+	validator := execution.state.Validators[validatorAddress]
+	if validator == nil {
+		return nil, newLocalPreviewFailure("validator %s does not exist", validatorAddress)
+	}
+	if !slices.Contains([]string{"stake", "unstake", "claim_xrd"}, args.method) {
+		return nil, localPreviewUnsupported("validator method %s", args.method)
+	}
+	if err := args.count(1); err != nil {
+		return nil, err
+	}
+	stakeUnits := execution.state.Resources[validator.StakeUnitResource]
+	switch args.method {
+	case "stake":
+		xrd, err := args.bucketOf(0, execution.xrd)
+		if err != nil {
+			return nil, err
+		}
+		minted := xrd.amount
+		if validator.StakedXrd.IsPositive() && stakeUnits.TotalSupply.IsPositive() {
+			if minted, err = mulDiv(xrd.amount, stakeUnits.TotalSupply, validator.StakedXrd); err != nil {
+				return nil, err
+			}
+		}
+		if validator.StakedXrd, err = validator.StakedXrd.Add(xrd.amount); err != nil {
+			return nil, err
+		}
+		units, err := execution.mint(validator.StakeUnitResource, minted)
+		if err != nil {
+			return nil, err
+		}
+		return []localResources{units}, execution.emit(validatorAddress, "StakeEvent", sborDecimalFields(xrd.amount))
+	case "unstake":
+		units, err := args.bucketOf(0, validator.StakeUnitResource)
+		if err != nil {
+			return nil, err
+		}
+		if !stakeUnits.TotalSupply.IsPositive() {
+			return nil, newLocalPreviewFailure("validator %s has no stake", validatorAddress)
+		}
+		unstaked, err := mulDiv(units.amount, validator.StakedXrd, stakeUnits.TotalSupply)
+		if err != nil {
+			return nil, err
+		}
+		if err := execution.burn(units); err != nil {
+			return nil, err
+		}
+		if validator.StakedXrd, err = validator.StakedXrd.Sub(unstaked); err != nil {
+			return nil, err
+		}
+		if validator.PendingXrd, err = validator.PendingXrd.Add(unstaked); err != nil {
+			return nil, err
+		}
+
+		id := "#" + strconv.FormatUint(validator.NextClaimId, 10) + "#"
+		validator.NextClaimId++
+		if validator.Claims == nil {
+			validator.Claims = map[string]DecimalValue{}
+		}
+		validator.Claims[id] = unstaked
+		claim, err := execution.mint(validator.ClaimNftResource, DecimalValueOne())
+		if err != nil {
+			return nil, err
+		}
+		claim.ids = []string{id}
+		summary := &execution.receipt.StateUpdatesSummary
+		summary.NewlyMintedNonFungibles = append(summary.NewlyMintedNonFungibles, validator.ClaimNftResource.AddressString()+":"+id)
+		return []localResources{claim}, execution.emit(validatorAddress, "UnstakeEvent", sborDecimalFields(units.amount))
+	case "claim_xrd":
+		claims, err := args.bucketOf(0, validator.ClaimNftResource)
+		if err != nil {
+			return nil, err
+		}
+		claimed := DecimalValue{}
+		for _, id := range claims.ids {
+			amount, ok := validator.Claims[id]
+			if !ok {
+				return nil, newLocalPreviewFailure("claim %s of validator %s does not exist", id, validatorAddress)
+			}
+			delete(validator.Claims, id)
+			if claimed, err = claimed.Add(amount); err != nil {
+				return nil, err
+			}
+		}
+		if err := execution.burn(claims); err != nil {
+			return nil, err
+		}
+		if validator.PendingXrd, err = validator.PendingXrd.Sub(claimed); err != nil {
+			return nil, err
+		}
+		xrd := localResources{resource: execution.xrd, amount: claimed}
+		return []localResources{xrd}, execution.emit(validatorAddress, "ClaimXrdEvent", sborDecimalFields(claimed))
+	}
+	panic("unreachable")
+}
+
+func (execution *localExecution) callPool(poolAddress address.Address, args localArgs) ([]localResources, error) {
+	pool := execution.state.Pools[poolAddress]
+	if pool == nil {
+		return nil, newLocalPreviewFailure("pool %s does not exist", poolAddress)
+	}
+	switch args.method {
+	case "contribute":
+		if err := args.count(1); err != nil {
+			return nil, err
+		}
+		buckets, err := args.buckets(0)
+		if err != nil {
+			return nil, err
+		}
+		return execution.contribute(poolAddress, pool, buckets)
+	case "redeem":
+		if err := args.count(1); err != nil {
+			return nil, err
+		}
+		units, err := args.bucketOf(0, pool.PoolUnitResource)
+		if err != nil {
+			return nil, err
+		}
+		return execution.redeem(poolAddress, pool, units)
+	}
+	return nil, localPreviewUnsupported("pool method %s", args.method)
+}
+
+// contribute adds the contributed resources to the pool in the proportion of
+// its reserves, returning the minted pool units and the change. The first
+// contribution to an empty pool mints the geometric mean of the contributed
+// amounts.
+func (execution *localExecution) contribute(poolAddress address.Address, pool *LedgerPool, buckets []localResources) ([]localResources, error) {
+	contributed := make(map[address.Address]DecimalValue, len(pool.Resources))
+	for _, bucket := range buckets {
+		if !slices.Contains(pool.Resources, bucket.resource) {
+			return nil, newLocalPreviewFailure("pool %s does not hold %s", poolAddress, bucket.resource)
+		}
+		amount, err := contributed[bucket.resource].Add(bucket.amount)
+		if err != nil {
+			return nil, err
+		}
+		contributed[bucket.resource] = amount
+	}
+
+	supply := execution.state.Resources[pool.PoolUnitResource].TotalSupply
+	var minted DecimalValue
+	accepted := make(map[address.Address]DecimalValue, len(pool.Resources))
+	if !supply.IsPositive() {
+		product := DecimalValueOne()
+		for _, resource := range pool.Resources {
+			amount := contributed[resource]
+			if !amount.IsPositive() {
+				return nil, newLocalPreviewFailure("the first contribution to pool %s must include all of its resources", poolAddress)
+			}
+			var err error
+			if product, err = product.Mul(amount); err != nil {
+				return nil, err
+			}
+			accepted[resource] = amount
+		}
+		root, ok := product.NthRoot(uint32(len(pool.Resources)))
+		if !ok {
+			return nil, newLocalPreviewFailure("the contribution to pool %s overflows", poolAddress)
+		}
+		minted = root
+	} else {
+		// the share of the pool contributed is that of the scarcest resource
+		var share *DecimalValue
+		for _, resource := range pool.Resources {
+			reserve := pool.Reserves[resource]
+			if !reserve.IsPositive() {
+				continue
+			}
+			ratio, err := contributed[resource].Div(reserve)
+			if err != nil {
+				return nil, err
+			}
+			if share == nil || ratio.LessThan(*share) {
+				share = &ratio
+			}
+		}
+		if share == nil {
+			return nil, newLocalPreviewFailure("pool %s has no reserves", poolAddress)
+		}
+		for _, resource := range pool.Resources {
+			amount, err := pool.Reserves[resource].Mul(*share)
+			if err != nil {
+				return nil, err
+			}
+			if accepted[resource], err = execution.roundToDivisibility(resource, amount); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if minted, err = supply.Mul(*share); err != nil {
+			return nil, err
+		}
+	}
+
+	returned := make([]localResources, 0, len(pool.Resources)+1)
+	units, err := execution.mint(pool.PoolUnitResource, minted)
+	if err != nil {
+		return nil, err
+	}
+	returned = append(returned, units)
+	contributions := make([]localResources, 0, len(pool.Resources))
+	for _, resource := range pool.Resources {
+		reserve, err := pool.Reserves[resource].Add(accepted[resource])
+		if err != nil {
+			return nil, err
+		}
+		pool.Reserves[resource] = reserve
+		contributions = append(contributions, localResources{resource: resource, amount: accepted[resource]})
+		change, err := contributed[resource].Sub(accepted[resource])
+		if err != nil {
+			return nil, err
+		}
+		if change.IsPositive() {
+			returned = append(returned, localResources{resource: resource, amount: change})
+		}
+	}
+	return returned, execution.emitPoolEvent(poolAddress, "ContributionEvent", minted, contributions)
+}
+
+// redeem burns pool units for their share of each reserve of the pool.
+func (execution *localExecution) redeem(poolAddress address.Address, pool *LedgerPool, units localResources) ([]localResources, error) {
+	supply := execution.state.Resources[pool.PoolUnitResource].TotalSupply
+	if !supply.IsPositive() {
+		return nil, newLocalPreviewFailure("pool %s has no pool units", poolAddress)
+	}
+	redeemed := make([]localResources, 0, len(pool.Resources))
+	for _, resource := range pool.Resources {
+		amount, err := mulDiv(pool.Reserves[resource], units.amount, supply)
+		if err != nil {
+			return nil, err
+		}
+		if amount, err = execution.roundToDivisibility(resource, amount); err != nil {
+			return nil, err
+		}
+		if pool.Reserves[resource], err = pool.Reserves[resource].Sub(amount); err != nil {
+			return nil, err
+		}
+		redeemed = append(redeemed, localResources{resource: resource, amount: amount})
+	}
+	if err := execution.burn(units); err != nil {
+		return nil, err
+	}
+	return redeemed, execution.emitPoolEvent(poolAddress, "RedemptionEvent", units.amount, redeemed)
+}
+
+// mulDiv returns value * numerator / denominator.
+func mulDiv(value, numerator, denominator DecimalValue) (DecimalValue, error) {
+	product, err := value.Mul(numerator)
+	if err != nil {
+		return DecimalValue{}, err
+	}
+	return product.Div(denominator)
+}
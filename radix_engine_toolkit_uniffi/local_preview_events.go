@@ -0,0 +1,110 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// The events of the native blueprints emitted by LocalPreviewEngine, as
+// Scrypto SBOR payloads.
+
+func sborReference(value address.Address) sbor.Value {
+	return sbor.Value{Kind: sbor.KindScryptoReference, Custom: value.Bytes()}
+}
+
+func sborDecimal(value DecimalValue) sbor.Value {
+	return sbor.Value{Kind: sbor.KindScryptoDecimal, Custom: value.ToLeBytes()}
+}
+
+// sborDecimalFields returns a struct of decimal fields.
+func sborDecimalFields(values ...DecimalValue) sbor.Value {
+	fields := make([]sbor.Value, len(values))
+	for index, value := range values {
+		fields[index] = sborDecimal(value)
+	}
+	return sbor.Value{Kind: sbor.KindTuple, Elements: fields}
+}
+
+// sborResourceAmounts returns an IndexMap<ResourceAddress, Decimal>.
+func sborResourceAmounts(resources []localResources) sbor.Value {
+	entries := make([]sbor.Entry, len(resources))
+	for index, resources := range resources {
+		entries[index] = sbor.Entry{Key: sborReference(resources.resource), Value: sborDecimal(resources.amount)}
+	}
+	return sbor.Value{Kind: sbor.KindMap, KeyKind: sbor.KindScryptoReference, ValueKind: sbor.KindScryptoDecimal, Entries: entries}
+}
+
+// sborResourcesEvent returns the account WithdrawEvent and DepositEvent:
+// Fungible(ResourceAddress, Decimal) or NonFungible(ResourceAddress,
+// IndexSet<NonFungibleLocalId>).
+func sborResourcesEvent(resources localResources) (sbor.Value, error) {
+	if !resources.nonFungible {
+		return sbor.Value{Kind: sbor.KindEnum, Discriminator: 0, Elements: []sbor.Value{
+			sborReference(resources.resource), sborDecimal(resources.amount),
+		}}, nil
+	}
+	ids := make([]sbor.Value, len(resources.ids))
+	for index, id := range resources.ids {
+		body, err := nonFungibleLocalIdSbor(id)
+		if err != nil {
+			return sbor.Value{}, err
+		}
+		ids[index] = sbor.Value{Kind: sbor.KindScryptoNonFungibleLocalId, Custom: body}
+	}
+	return sbor.Value{Kind: sbor.KindEnum, Discriminator: 1, Elements: []sbor.Value{
+		sborReference(resources.resource),
+		{Kind: sbor.KindArray, ElementKind: sbor.KindScryptoNonFungibleLocalId, Elements: ids},
+	}}, nil
+}
+
+// emit records an event of the main module of a global entity.
+func (execution *localExecution) emit(emitter address.Address, eventName string, event sbor.Value) error {
+	data, err := sbor.Encode(event, sbor.Scrypto)
+	if err != nil {
+		return err
+	}
+	emitterAddress, err := AddressFromValue(emitter)
+	if err != nil {
+		return err
+	}
+	execution.receipt.Events = append(execution.receipt.Events, ToolkitEvent{
+		TypeIdentifier: EventTypeIdentifier{
+			Emitter:   EmitterMethod{Address: emitterAddress, ObjectModuleId: ModuleIdMain},
+			EventName: eventName,
+		},
+		Data: data,
+	})
+	return nil
+}
+
+func (execution *localExecution) emitResourcesEvent(account address.Address, eventName string, resources localResources) error {
+	event, err := sborResourcesEvent(resources)
+	if err != nil {
+		return err
+	}
+	return execution.emit(account, eventName, event)
+}
+
+// emitPoolEvent records the ContributionEvent or RedemptionEvent of a pool.
+// A one resource pool reports the amount of its resource, the other pools
+// the amounts by resource; the pool units come first in the RedemptionEvent.
+func (execution *localExecution) emitPoolEvent(pool address.Address, eventName string, poolUnits DecimalValue, resources []localResources) error {
+	var event sbor.Value
+	switch {
+	case pool.EntityType() == address.EntityTypeGlobalOneResourcePool:
+		amount := DecimalValue{}
+		if len(resources) > 0 {
+			amount = resources[0].amount
+		}
+		if eventName == "ContributionEvent" {
+			event = sborDecimalFields(amount, poolUnits)
+		} else {
+			event = sborDecimalFields(poolUnits, amount)
+		}
+	case eventName == "ContributionEvent":
+		event = sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{sborResourceAmounts(resources), sborDecimal(poolUnits)}}
+	default:
+		event = sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{sborDecimal(poolUnits), sborResourceAmounts(resources)}}
+	}
+	return execution.emit(pool, eventName, event)
+}
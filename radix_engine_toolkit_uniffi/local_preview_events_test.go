@@ -0,0 +1,68 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+func TestLocalPreviewEvents(t *testing.T) {
+	xrd, err := address.New("resource_rdx1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxradxrd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	join := func(parts ...[]byte) []byte {
+		return bytes.Join(parts, nil)
+	}
+	one, two := DecimalValueFromInt64(1), DecimalValueFromInt64(2)
+
+	nonFungible, err := sborResourcesEvent(localResources{resource: xrd, nonFungible: true, amount: two, ids: []string{"#1#", "<a>"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fungible, err := sborResourcesEvent(localResources{resource: xrd, amount: one})
+	if err != nil {
+		t.Fatal(err)
+	}
+	amounts := []localResources{{resource: xrd, amount: one}}
+	tests := []struct {
+		name  string
+		event sbor.Value
+		want  []byte
+	}{
+		{
+			"fungible",
+			fungible,
+			join([]byte{0x5c, 0x22, 0, 2, 0x80}, xrd.Bytes(), []byte{0xa0}, one.ToLeBytes()),
+		},
+		{
+			"non-fungible",
+			nonFungible,
+			join([]byte{0x5c, 0x22, 1, 2, 0x80}, xrd.Bytes(), []byte{0x20, 0xc0, 2},
+				binary.BigEndian.AppendUint64([]byte{1}, 1), []byte{0, 1, 'a'}),
+		},
+		{
+			"decimal fields",
+			sborDecimalFields(one, two),
+			join([]byte{0x5c, 0x21, 2, 0xa0}, one.ToLeBytes(), []byte{0xa0}, two.ToLeBytes()),
+		},
+		{
+			"resource amounts",
+			sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{sborResourceAmounts(amounts), sborDecimal(two)}},
+			join([]byte{0x5c, 0x21, 2, 0x23, 0x80, 0xa0, 1}, xrd.Bytes(), one.ToLeBytes(), []byte{0xa0}, two.ToLeBytes()),
+		},
+	}
+	for _, test := range tests {
+		got, err := sbor.Encode(test.event, sbor.Scrypto)
+		if err != nil || !bytes.Equal(got, test.want) {
+			t.Errorf("%s: encoding = %x, %v, want %x", test.name, got, err, test.want)
+		}
+	}
+
+	if _, err := sborResourcesEvent(localResources{resource: xrd, nonFungible: true, ids: []string{"1"}}); err == nil {
+		t.Error("sborResourcesEvent with an invalid local id succeeded")
+	}
+}
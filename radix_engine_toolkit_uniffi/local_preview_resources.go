@@ -0,0 +1,255 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// localResources is an amount of a resource in a bucket or on the worktop.
+// For a non-fungible resource amount is the number of ids.
+type localResources struct {
+	resource    address.Address
+	nonFungible bool
+	amount      DecimalValue
+	ids         []string
+}
+
+func (resources *localResources) put(other localResources) error {
+	amount, err := resources.amount.Add(other.amount)
+	if err != nil {
+		return err
+	}
+	resources.amount = amount
+	resources.ids = append(resources.ids, other.ids...)
+	return nil
+}
+
+// take takes amount out of the resources, the first ids of a non-fungible
+// resource.
+func (resources *localResources) take(amount DecimalValue) (localResources, error) {
+	if amount.IsNegative() {
+		return localResources{}, newLocalPreviewFailure("invalid amount %s", amount)
+	}
+	if resources.amount.LessThan(amount) {
+		return localResources{}, newLocalPreviewFailure("InsufficientBalance: %s of %s requested, %s available", amount, resources.resource, resources.amount)
+	}
+	taken := localResources{resource: resources.resource, nonFungible: resources.nonFungible, amount: amount}
+	if resources.nonFungible {
+		count, err := strconv.Atoi(amount.AsStr())
+		if err != nil {
+			return localResources{}, newLocalPreviewFailure("InvalidAmount: %s of the non-fungible resource %s", amount, resources.resource)
+		}
+		taken.ids = slices.Clone(resources.ids[:count])
+		resources.ids = slices.Delete(resources.ids, 0, count)
+	}
+	remaining, err := resources.amount.Sub(amount)
+	if err != nil {
+		return localResources{}, err
+	}
+	resources.amount = remaining
+	return taken, nil
+}
+
+func (resources *localResources) takeIds(ids []string) (localResources, error) {
+	if !resources.nonFungible {
+		return localResources{}, newLocalPreviewFailure("NotANonFungibleResource: %s", resources.resource)
+	}
+	for _, id := range ids {
+		index := slices.Index(resources.ids, id)
+		if index < 0 {
+			return localResources{}, newLocalPreviewFailure("InsufficientBalance: %s of %s is not available", id, resources.resource)
+		}
+		resources.ids = slices.Delete(resources.ids, index, index+1)
+	}
+	count := DecimalValueFromInt64(int64(len(ids)))
+	remaining, err := resources.amount.Sub(count)
+	if err != nil {
+		return localResources{}, err
+	}
+	resources.amount = remaining
+	return localResources{resource: resources.resource, nonFungible: true, amount: count, ids: slices.Clone(ids)}, nil
+}
+
+// localWorktop holds resources by resource, in the order they were first
+// put on it.
+type localWorktop struct {
+	order     []address.Address
+	resources map[address.Address]*localResources
+}
+
+func (worktop *localWorktop) put(resources localResources) error {
+	if worktop.resources == nil {
+		worktop.resources = map[address.Address]*localResources{}
+	}
+	held, ok := worktop.resources[resources.resource]
+	if !ok {
+		held = &localResources{resource: resources.resource, nonFungible: resources.nonFungible}
+		worktop.resources[resources.resource] = held
+		worktop.order = append(worktop.order, resources.resource)
+	}
+	return held.put(resources)
+}
+
+func (worktop *localWorktop) amountOf(resource address.Address) localResources {
+	if held, ok := worktop.resources[resource]; ok {
+		return *held
+	}
+	return localResources{resource: resource}
+}
+
+func (worktop *localWorktop) take(resource address.Address, amount DecimalValue) (localResources, error) {
+	held, ok := worktop.resources[resource]
+	if !ok {
+		if amount.IsZero() {
+			return localResources{resource: resource}, nil
+		}
+		return localResources{}, newLocalPreviewFailure("WorktopError(InsufficientBalance): the worktop holds no %s", resource)
+	}
+	taken, err := held.take(amount)
+	if err != nil {
+		return localResources{}, newLocalPreviewFailure("WorktopError(%v)", err)
+	}
+	worktop.removeIfEmpty(resource)
+	return taken, nil
+}
+
+func (worktop *localWorktop) takeIds(resource address.Address, ids []string) (localResources, error) {
+	held, ok := worktop.resources[resource]
+	if !ok {
+		return localResources{}, newLocalPreviewFailure("WorktopError(InsufficientBalance): the worktop holds no %s", resource)
+	}
+	taken, err := held.takeIds(ids)
+	if err != nil {
+		return localResources{}, newLocalPreviewFailure("WorktopError(%v)", err)
+	}
+	worktop.removeIfEmpty(resource)
+	return taken, nil
+}
+
+func (worktop *localWorktop) takeAll(resource address.Address) localResources {
+	held, ok := worktop.resources[resource]
+	if !ok {
+		return localResources{resource: resource}
+	}
+	delete(worktop.resources, resource)
+	worktop.order = slices.DeleteFunc(worktop.order, func(other address.Address) bool { return other == resource })
+	return *held
+}
+
+// drain takes everything off the worktop.
+func (worktop *localWorktop) drain() []localResources {
+	drained := worktop.contents()
+	worktop.order, worktop.resources = nil, nil
+	return drained
+}
+
+func (worktop *localWorktop) contents() []localResources {
+	contents := make([]localResources, 0, len(worktop.order))
+	for _, resource := range worktop.order {
+		contents = append(contents, *worktop.resources[resource])
+	}
+	return contents
+}
+
+func (worktop *localWorktop) removeIfEmpty(resource address.Address) {
+	if held := worktop.resources[resource]; held != nil && held.amount.IsZero() {
+		worktop.takeAll(resource)
+	}
+}
+
+func (worktop *localWorktop) isEmpty() bool {
+	for _, held := range worktop.resources {
+		if !held.amount.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// nonFungibleLocalIdString returns a local id in the format of
+// NonFungibleLocalIdAsStr: #1#, <name>, [0a0b] or {...-...-...-...}.
+func nonFungibleLocalIdString(id NonFungibleLocalId) string {
+	switch id := id.(type) {
+	case NonFungibleLocalIdInteger:
+		return "#" + strconv.FormatUint(id.Value, 10) + "#"
+	case NonFungibleLocalIdStr:
+		return "<" + id.Value + ">"
+	case NonFungibleLocalIdBytes:
+		return "[" + hex.EncodeToString(id.Value) + "]"
+	case NonFungibleLocalIdRuid:
+		if len(id.Value) == 32 {
+			return fmt.Sprintf("{%x-%x-%x-%x}", id.Value[0:8], id.Value[8:16], id.Value[16:24], id.Value[24:32])
+		}
+		return "{" + hex.EncodeToString(id.Value) + "}"
+	}
+	panic(fmt.Sprintf("unknown non-fungible local id %T", id))
+}
+
+func nonFungibleLocalIdStrings(ids []NonFungibleLocalId) []string {
+	strings := make([]string, len(ids))
+	for index, id := range ids {
+		strings[index] = nonFungibleLocalIdString(id)
+	}
+	return strings
+}
+
+// nonFungibleLocalIdFromString parses a local id formatted by
+// nonFungibleLocalIdString.
+func nonFungibleLocalIdFromString(value string) (NonFungibleLocalId, error) {
+	if len(value) >= 2 {
+		body := value[1 : len(value)-1]
+		switch value[0:1] + value[len(value)-1:] {
+		case "##":
+			integer, err := strconv.ParseUint(body, 10, 64)
+			if err == nil {
+				return NonFungibleLocalIdInteger{Value: integer}, nil
+			}
+		case "<>":
+			return NonFungibleLocalIdStr{Value: body}, nil
+		case "[]":
+			bytes, err := hex.DecodeString(body)
+			if err == nil {
+				return NonFungibleLocalIdBytes{Value: bytes}, nil
+			}
+		case "{}":
+			if len(body) == 67 && body[16] == '-' && body[33] == '-' && body[50] == '-' {
+				ruid, err := hex.DecodeString(body[0:16] + body[17:33] + body[34:50] + body[51:67])
+				if err == nil {
+					return NonFungibleLocalIdRuid{Value: ruid}, nil
+				}
+			}
+		}
+	}
+	return nil, NewRadixEngineToolkitErrorParseError("NonFungibleLocalId", value)
+}
+
+// nonFungibleLocalIdSbor returns the SBOR encoding of the body of a local id
+// formatted by nonFungibleLocalIdString.
+func nonFungibleLocalIdSbor(value string) ([]byte, error) {
+	id, err := nonFungibleLocalIdFromString(value)
+	if err != nil {
+		return nil, err
+	}
+	return nonFungibleLocalIdBody(id), nil
+}
+
+// nonFungibleLocalIdBody returns the SBOR encoding of the body of a local id,
+// the reverse of sborNonFungibleLocalId.
+func nonFungibleLocalIdBody(id NonFungibleLocalId) []byte {
+	switch id := id.(type) {
+	case NonFungibleLocalIdStr:
+		return append(binary.AppendUvarint([]byte{0}, uint64(len(id.Value))), id.Value...)
+	case NonFungibleLocalIdInteger:
+		return binary.BigEndian.AppendUint64([]byte{1}, id.Value)
+	case NonFungibleLocalIdBytes:
+		return append(binary.AppendUvarint([]byte{2}, uint64(len(id.Value))), id.Value...)
+	case NonFungibleLocalIdRuid:
+		return append([]byte{3}, id.Value...)
+	}
+	panic(fmt.Sprintf("unknown non-fungible local id %T", id))
+}
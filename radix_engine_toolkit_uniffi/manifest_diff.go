@@ -0,0 +1,554 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Manifest diffs
+//
+// DiffInstructionsV1 and DiffInstructionsV2 compare two lists of
+// instructions, such as a manifest received from a dApp and the manifest
+// re-rendered after the user edited its guarantees. The instructions are
+// aligned on their longest common subsequence; the instructions of the same
+// kind left between two aligned ones are reported as changed, with the paths
+// of the values which differ, and the others as removed or added:
+//
+//	diff := DiffInstructionsV2(received.Instructions(), edited.Instructions())
+//	for _, change := range diff.Changes {
+//		// changed 3 -> 3: args[1]: Decimal("10") -> Decimal("9.5")
+//		fmt.Println(change)
+//	}
+//
+// Buckets, proofs, address reservations and named addresses are compared by
+// the instructions which created them rather than by their ids, so that
+// renaming or renumbering them is not a change.
+
+// InstructionChangeKind is the kind of an InstructionChange.
+type InstructionChangeKind int
+
+const (
+	InstructionAdded InstructionChangeKind = iota
+	InstructionRemoved
+	InstructionChanged
+)
+
+func (kind InstructionChangeKind) String() string {
+	switch kind {
+	case InstructionAdded:
+		return "added"
+	case InstructionRemoved:
+		return "removed"
+	case InstructionChanged:
+		return "changed"
+	}
+	return "InstructionChangeKind(" + strconv.Itoa(int(kind)) + ")"
+}
+
+// InstructionChange is an instruction which differs between two lists of
+// instructions.
+type InstructionChange struct {
+	Kind InstructionChangeKind
+	// OldIndex and NewIndex are the indices of the instruction in the old and
+	// new instructions, -1 for an added or a removed instruction.
+	OldIndex int
+	NewIndex int
+	// Old and New are the instructions, Old being empty for an added
+	// instruction and New for a removed one.
+	Old string
+	New string
+	// Values are the values which changed, for a changed instruction.
+	Values []ValueChange
+}
+
+func (change InstructionChange) String() string {
+	switch change.Kind {
+	case InstructionAdded:
+		return fmt.Sprintf("added %d: %s", change.NewIndex, change.New)
+	case InstructionRemoved:
+		return fmt.Sprintf("removed %d: %s", change.OldIndex, change.Old)
+	}
+	values := make([]string, len(change.Values))
+	for index, value := range change.Values {
+		values[index] = value.String()
+	}
+	return fmt.Sprintf("changed %d -> %d: %s", change.OldIndex, change.NewIndex, strings.Join(values, ", "))
+}
+
+// ValueChange is a value of an instruction which changed, Path being its path
+// in the instruction, e.g. args[1] or resource_address. Old or New is empty
+// for an element which was added to or removed from a list.
+type ValueChange struct {
+	Path string
+	Old  string
+	New  string
+}
+
+func (change ValueChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", change.Path, change.Old, change.New)
+}
+
+// ManifestDiff is the difference between two lists of instructions.
+type ManifestDiff struct {
+	Changes []InstructionChange
+}
+
+// Empty reports whether the instructions are equivalent.
+func (diff ManifestDiff) Empty() bool {
+	return len(diff.Changes) == 0
+}
+
+// DiffInstructionsV1 compares the instructions old and new.
+func DiffInstructionsV1(old *InstructionsV1, new *InstructionsV1) ManifestDiff {
+	return diffInstructions(diffNodes(old.InstructionsList()), diffNodes(new.InstructionsList()))
+}
+
+// DiffInstructionsV2 compares the instructions old and new.
+func DiffInstructionsV2(old *InstructionsV2, new *InstructionsV2) ManifestDiff {
+	return diffInstructions(diffNodes(old.InstructionsList()), diffNodes(new.InstructionsList()))
+}
+
+// SemanticallyEqual reports whether the manifests have equivalent
+// instructions and the same blobs, whatever their names and order. It
+// returns an error like the SemanticallyEqual of TransactionManifestV2,
+// which reads the children of the manifests.
+func (_self *TransactionManifestV1) SemanticallyEqual(other *TransactionManifestV1) (bool, error) {
+	instructions, otherInstructions := _self.Instructions(), other.Instructions()
+	defer instructions.Destroy()
+	defer otherInstructions.Destroy()
+	return DiffInstructionsV1(instructions, otherInstructions).Empty() && sameBlobs(_self.Blobs(), other.Blobs()), nil
+}
+
+// SemanticallyEqual reports whether the manifests have equivalent
+// instructions, the same children and the same blobs, whatever their names
+// and order.
+func (_self *TransactionManifestV2) SemanticallyEqual(other *TransactionManifestV2) (bool, error) {
+	children, err := _self.Children()
+	if err != nil {
+		return false, err
+	}
+	otherChildren, err := other.Children()
+	if err != nil {
+		return false, err
+	}
+	if !slices.EqualFunc(children, otherChildren, func(child, otherChild *Hash) bool {
+		return child.AsStr() == otherChild.AsStr()
+	}) {
+		return false, nil
+	}
+	instructions, otherInstructions := _self.Instructions(), other.Instructions()
+	defer instructions.Destroy()
+	defer otherInstructions.Destroy()
+	return DiffInstructionsV2(instructions, otherInstructions).Empty() && sameBlobs(_self.Blobs(), other.Blobs()), nil
+}
+
+func sameBlobs(blobs [][]byte, otherBlobs [][]byte) bool {
+	blobs, otherBlobs = slices.Clone(blobs), slices.Clone(otherBlobs)
+	slices.SortFunc(blobs, bytes.Compare)
+	slices.SortFunc(otherBlobs, bytes.Compare)
+	return slices.EqualFunc(blobs, otherBlobs, bytes.Equal)
+}
+
+// diffObjectKind is the kind of the objects a manifest names.
+type diffObjectKind int
+
+const (
+	diffNoObject diffObjectKind = iota
+	diffBucket
+	diffProof
+	diffAddressReservation
+	diffNamedAddress
+	diffObjectKinds
+)
+
+// diffNode is an instruction or a value of one, in a form which compares
+// values of different Go types alike: a leaf, such as Decimal("1"), a
+// reference to an object, or a composite of named fields.
+type diffNode struct {
+	typ    string
+	leaf   string
+	object diffObjectKind
+	id     uint32
+	fields []diffField
+}
+
+type diffField struct {
+	name string
+	node diffNode
+}
+
+func (node diffNode) isLeaf() bool {
+	return node.fields == nil && node.typ == ""
+}
+
+// render returns the node in a syntax close to the one of manifests, object
+// ids included or not.
+func (node diffNode) render(ids bool) string {
+	if node.object != diffNoObject {
+		if !ids {
+			return node.leaf + "(_)"
+		}
+		return fmt.Sprintf("%s(%d)", node.leaf, node.id)
+	}
+	if node.isLeaf() {
+		return node.leaf
+	}
+	fields := make([]string, len(node.fields))
+	for index, field := range node.fields {
+		fields[index] = field.node.render(ids)
+		if !strings.HasPrefix(field.name, "[") {
+			fields[index] = field.name + ": " + fields[index]
+		}
+	}
+	return node.typ + "(" + strings.Join(fields, ", ") + ")"
+}
+
+func diffNodes[I any](instructions []I) []diffNode {
+	nodes := make([]diffNode, len(instructions))
+	for index, instruction := range instructions {
+		nodes[index] = diffNodeOf(reflect.ValueOf(&instruction).Elem())
+	}
+	return nodes
+}
+
+var diffLeafTypes = map[reflect.Type]string{
+	reflect.TypeFor[*Address]():        "Address",
+	reflect.TypeFor[*Decimal]():        "Decimal",
+	reflect.TypeFor[*PreciseDecimal](): "PreciseDecimal",
+	reflect.TypeFor[*Hash]():           "Hash",
+}
+
+func diffNodeOf(value reflect.Value) diffNode {
+	if value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return diffNode{leaf: "None"}
+		}
+	}
+	if name, ok := diffLeafTypes[value.Type()]; ok {
+		return diffNode{leaf: fmt.Sprintf("%s(%q)", name, value.Interface().(interface{ AsStr() string }).AsStr())}
+	}
+	switch value := value.Interface().(type) {
+	case ManifestBucket:
+		return diffNode{leaf: "Bucket", object: diffBucket, id: value.Value}
+	case ManifestProof:
+		return diffNode{leaf: "Proof", object: diffProof, id: value.Value}
+	case ManifestAddressReservation:
+		return diffNode{leaf: "AddressReservation", object: diffAddressReservation, id: value.Value}
+	case ManifestAddressNamed:
+		return diffNode{leaf: "NamedAddress", object: diffNamedAddress, id: value.NamedAddressId}
+	case ManifestAddressStatic:
+		return diffNodeOf(reflect.ValueOf(value.StaticAddress))
+	case ManifestBlobRef:
+		return diffNode{leaf: fmt.Sprintf("Blob(%q)", value.Value.AsStr())}
+	case []byte:
+		return diffNode{leaf: fmt.Sprintf("Bytes(%q)", hex.EncodeToString(value))}
+	case ManifestValueTupleValue:
+		return diffNode{typ: "Tuple", fields: diffElements(value.Fields)}
+	case ManifestValueEnumValue:
+		return diffNode{typ: fmt.Sprintf("Enum<%d>", value.Discriminator), fields: diffElements(value.Fields)}
+	case ManifestValueArrayValue:
+		return diffNode{typ: fmt.Sprintf("Array<%s>", diffValueKind(value.ElementValueKind)), fields: diffElements(value.Elements)}
+	case ManifestValueMapValue:
+		fields := []diffField{}
+		for index, entry := range value.Entries {
+			fields = append(fields,
+				diffField{name: fmt.Sprintf("[%d].key", index), node: diffNodeOf(reflect.ValueOf(&entry.Key).Elem())},
+				diffField{name: fmt.Sprintf("[%d].value", index), node: diffNodeOf(reflect.ValueOf(&entry.Value).Elem())},
+			)
+		}
+		typ := fmt.Sprintf("Map<%s, %s>", diffValueKind(value.KeyValueKind), diffValueKind(value.ValueValueKind))
+		return diffNode{typ: typ, fields: fields}
+	}
+
+	switch value.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		return diffNodeOf(value.Elem())
+	case reflect.Slice, reflect.Array:
+		fields := []diffField{}
+		for index := 0; index < value.Len(); index++ {
+			fields = append(fields, diffField{name: fmt.Sprintf("[%d]", index), node: diffNodeOf(value.Index(index))})
+		}
+		return diffNode{typ: "List", fields: fields}
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		fields := []diffField{}
+		for _, key := range keys {
+			fields = append(fields, diffField{name: fmt.Sprintf("[%q]", fmt.Sprint(key)), node: diffNodeOf(value.MapIndex(key))})
+		}
+		return diffNode{typ: "Map", fields: fields}
+	case reflect.Struct:
+		name := diffTypeName(value.Type())
+		if value.NumField() == 1 && !strings.HasPrefix(value.Type().Name(), "Instruction") {
+			// Wrappers, such as the variants of ManifestValue, take the name of
+			// their type around their value unless it already has it.
+			inner := diffNodeOf(value.Field(0))
+			if inner.isLeaf() && inner.object == diffNoObject && !strings.HasPrefix(inner.leaf, name+"(") {
+				inner.leaf = name + "(" + inner.leaf + ")"
+			}
+			return inner
+		}
+		fields := []diffField{}
+		for index := 0; index < value.NumField(); index++ {
+			fields = append(fields, diffField{name: snakeCase(value.Type().Field(index).Name), node: diffNodeOf(value.Field(index))})
+		}
+		return diffNode{typ: name, fields: fields}
+	case reflect.String:
+		return diffNode{leaf: strconv.Quote(value.String())}
+	}
+	if value.Type().PkgPath() != "" {
+		// Named basic types are enums, e.g. ManifestExpression.
+		return diffNode{leaf: fmt.Sprintf("%s(%v)", diffTypeName(value.Type()), value.Interface())}
+	}
+	return diffNode{leaf: fmt.Sprint(value.Interface())}
+}
+
+// diffValueKind returns the name of a value kind, e.g. Tuple.
+func diffValueKind(kind ManifestValueKind) string {
+	text, err := kind.MarshalText()
+	if err != nil {
+		return fmt.Sprint(uint(kind))
+	}
+	return strings.TrimSuffix(string(text), "Value")
+}
+
+func diffElements(values []ManifestValue) []diffField {
+	fields := make([]diffField, len(values))
+	for index := range values {
+		fields[index] = diffField{name: fmt.Sprintf("[%d]", index), node: diffNodeOf(reflect.ValueOf(&values[index]).Elem())}
+	}
+	return fields
+}
+
+// diffTypeName returns the name of a generated type without the name of the
+// union it is a variant of, e.g. CallMethod for InstructionV2CallMethod.
+func diffTypeName(typ reflect.Type) string {
+	name := typ.Name()
+	for _, prefix := range []string{"InstructionV1", "InstructionV2", "ManifestValue", "ManifestAddress", "NonFungibleLocalId"} {
+		if trimmed, ok := strings.CutPrefix(name, prefix); ok && trimmed != "" {
+			name = trimmed
+			break
+		}
+	}
+	if trimmed, ok := strings.CutSuffix(name, "Value"); ok && trimmed != "" {
+		name = trimmed
+	}
+	return name
+}
+
+func snakeCase(name string) string {
+	var builder strings.Builder
+	for index, r := range name {
+		if unicode.IsUpper(r) {
+			if index > 0 {
+				builder.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
+
+// diffCreatedObjects returns the kinds of the objects each instruction
+// creates, in the order the manifest numbers them.
+func diffCreatedObjects(node diffNode) []diffObjectKind {
+	switch node.typ {
+	case "TakeFromWorktop", "TakeAllFromWorktop", "TakeNonFungiblesFromWorktop":
+		return []diffObjectKind{diffBucket}
+	case "PopFromAuthZone", "CreateProofFromAuthZoneOfAmount", "CreateProofFromAuthZoneOfNonFungibles",
+		"CreateProofFromAuthZoneOfAll", "CreateProofFromBucketOfAmount", "CreateProofFromBucketOfNonFungibles",
+		"CreateProofFromBucketOfAll", "CloneProof":
+		return []diffObjectKind{diffProof}
+	case "AllocateGlobalAddress":
+		return []diffObjectKind{diffAddressReservation, diffNamedAddress}
+	}
+	return nil
+}
+
+// diffIds numbers the objects each instruction creates.
+func diffIds(nodes []diffNode) [][]uint32 {
+	var next [diffObjectKinds]uint32
+	ids := make([][]uint32, len(nodes))
+	for index, node := range nodes {
+		for _, kind := range diffCreatedObjects(node) {
+			ids[index] = append(ids[index], next[kind])
+			next[kind]++
+		}
+	}
+	return ids
+}
+
+type diffPair struct {
+	old, new int
+}
+
+func diffInstructions(old []diffNode, new []diffNode) ManifestDiff {
+	pairs := diffAlign(old, new)
+	oldIds, newIds := diffIds(old), diffIds(new)
+	var mapping [diffObjectKinds]map[uint32]uint32
+	for kind := range mapping {
+		mapping[kind] = map[uint32]uint32{}
+	}
+
+	var diff ManifestDiff
+	oldNext, newNext := 0, 0
+	flush := func(oldEnd, newEnd int) {
+		for ; oldNext < oldEnd; oldNext++ {
+			diff.Changes = append(diff.Changes, InstructionChange{Kind: InstructionRemoved, OldIndex: oldNext, NewIndex: -1, Old: old[oldNext].render(true)})
+		}
+		for ; newNext < newEnd; newNext++ {
+			diff.Changes = append(diff.Changes, InstructionChange{Kind: InstructionAdded, OldIndex: -1, NewIndex: newNext, New: new[newNext].render(true)})
+		}
+	}
+	for _, pair := range pairs {
+		flush(pair.old, pair.new)
+		oldNext, newNext = pair.old+1, pair.new+1
+		if values := diffValues(old[pair.old], new[pair.new], "", &mapping); len(values) > 0 {
+			diff.Changes = append(diff.Changes, InstructionChange{
+				Kind:     InstructionChanged,
+				OldIndex: pair.old,
+				NewIndex: pair.new,
+				Old:      old[pair.old].render(true),
+				New:      new[pair.new].render(true),
+				Values:   values,
+			})
+		}
+		kinds := diffCreatedObjects(old[pair.old])
+		if slices.Equal(kinds, diffCreatedObjects(new[pair.new])) {
+			for index, kind := range kinds {
+				mapping[kind][oldIds[pair.old][index]] = newIds[pair.new][index]
+			}
+		}
+	}
+	flush(len(old), len(new))
+	return diff
+}
+
+// diffAlign pairs the instructions which are equal but for their object ids
+// along their longest common subsequence, then the instructions of the same
+// type left between two pairs.
+func diffAlign(old []diffNode, new []diffNode) []diffPair {
+	oldKeys, newKeys := make([]string, len(old)), make([]string, len(new))
+	for index, node := range old {
+		oldKeys[index] = node.render(false)
+	}
+	for index, node := range new {
+		newKeys[index] = node.render(false)
+	}
+	lengths := make([][]int, len(old)+1)
+	for index := range lengths {
+		lengths[index] = make([]int, len(new)+1)
+	}
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(new) - 1; j >= 0; j-- {
+			if oldKeys[i] == newKeys[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	var common []diffPair
+	for i, j := 0, 0; i < len(old) && j < len(new); {
+		switch {
+		case oldKeys[i] == newKeys[j]:
+			common = append(common, diffPair{i, j})
+			i, j = i+1, j+1
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	var pairs []diffPair
+	oldStart, newStart := 0, 0
+	for _, pair := range append(common, diffPair{len(old), len(new)}) {
+		next := newStart
+		for i := oldStart; i < pair.old; i++ {
+			for j := next; j < pair.new; j++ {
+				if diffInstructionType(old[i]) == diffInstructionType(new[j]) {
+					pairs = append(pairs, diffPair{i, j})
+					next = j + 1
+					break
+				}
+			}
+		}
+		if pair.old < len(old) {
+			pairs = append(pairs, pair)
+		}
+		oldStart, newStart = pair.old+1, pair.new+1
+	}
+	return pairs
+}
+
+// diffInstructionType returns the type of an instruction, with the method or
+// function it calls.
+func diffInstructionType(node diffNode) string {
+	typ := node.typ
+	for _, field := range node.fields {
+		if field.name == "method_name" || field.name == "function_name" {
+			typ += "." + field.node.leaf
+		}
+	}
+	return typ
+}
+
+// diffValues returns the values which differ between old and new, the ids
+// of old objects being mapped to the ids of new ones.
+func diffValues(old diffNode, new diffNode, path string, mapping *[diffObjectKinds]map[uint32]uint32) []ValueChange {
+	if old.object != diffNoObject || new.object != diffNoObject {
+		if old.object == new.object && old.leaf == new.leaf {
+			if id, ok := mapping[old.object][old.id]; ok && id == new.id {
+				return nil
+			}
+		}
+		return []ValueChange{{Path: diffPath(path), Old: old.render(true), New: new.render(true)}}
+	}
+	if old.isLeaf() || new.isLeaf() || old.typ != new.typ {
+		if old.render(true) == new.render(true) {
+			return nil
+		}
+		return []ValueChange{{Path: diffPath(path), Old: old.render(true), New: new.render(true)}}
+	}
+
+	var changes []ValueChange
+	for index := 0; index < max(len(old.fields), len(new.fields)); index++ {
+		switch {
+		case index >= len(new.fields):
+			field := old.fields[index]
+			changes = append(changes, ValueChange{Path: diffFieldPath(path, field.name), Old: field.node.render(true)})
+		case index >= len(old.fields):
+			field := new.fields[index]
+			changes = append(changes, ValueChange{Path: diffFieldPath(path, field.name), New: field.node.render(true)})
+		case old.fields[index].name != new.fields[index].name:
+			changes = append(changes, ValueChange{Path: diffPath(path), Old: old.render(true), New: new.render(true)})
+			return changes
+		default:
+			changes = append(changes, diffValues(old.fields[index].node, new.fields[index].node, diffFieldPath(path, old.fields[index].name), mapping)...)
+		}
+	}
+	return changes
+}
+
+func diffFieldPath(path string, name string) string {
+	if path == "" || strings.HasPrefix(name, "[") {
+		return path + name
+	}
+	return path + "." + name
+}
+
+func diffPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
@@ -0,0 +1,167 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// The instructions of the diff tests name no native objects, whose values
+// the diff reads from the native library.
+
+func diffTake(id uint64) InstructionV2 {
+	return InstructionV2TakeNonFungiblesFromWorktop{Ids: []NonFungibleLocalId{NonFungibleLocalIdInteger{Value: id}}}
+}
+
+func diffCall(methodName string, args ...ManifestValue) InstructionV2 {
+	return InstructionV2CallMethod{
+		Address:    ManifestAddressNamed{NamedAddressId: 0},
+		MethodName: methodName,
+		Args:       ManifestValueTupleValue{Fields: args},
+	}
+}
+
+func diffBucketArg(id uint32) ManifestValue {
+	return ManifestValueBucketValue{Value: ManifestBucket{Value: id}}
+}
+
+func TestDiffInstructions(t *testing.T) {
+	allocate := InstructionV2AllocateGlobalAddress{BlueprintName: "Dex"}
+	tests := []struct {
+		name     string
+		old, new []InstructionV2
+		want     []InstructionChange
+	}{
+		{
+			"equal",
+			[]InstructionV2{allocate, diffTake(1), diffCall("deposit", diffBucketArg(0))},
+			[]InstructionV2{allocate, diffTake(1), diffCall("deposit", diffBucketArg(0))},
+			nil,
+		},
+		{
+			// Removing the first take renumbers the bucket of the second.
+			"renamed bucket",
+			[]InstructionV2{allocate, diffTake(1), diffTake(2), diffCall("deposit", diffBucketArg(1)), InstructionV2ReturnToWorktop{BucketId: ManifestBucket{Value: 0}}},
+			[]InstructionV2{allocate, diffTake(2), diffCall("deposit", diffBucketArg(0))},
+			[]InstructionChange{
+				{Kind: InstructionRemoved, OldIndex: 1, NewIndex: -1},
+				{Kind: InstructionRemoved, OldIndex: 4, NewIndex: -1},
+			},
+		},
+		{
+			"swapped buckets",
+			[]InstructionV2{allocate, diffTake(1), diffTake(2), diffCall("deposit", diffBucketArg(0))},
+			[]InstructionV2{allocate, diffTake(1), diffTake(2), diffCall("deposit", diffBucketArg(1))},
+			[]InstructionChange{{Kind: InstructionChanged, OldIndex: 3, NewIndex: 3, Values: []ValueChange{{"args[0]", "Bucket(0)", "Bucket(1)"}}}},
+		},
+		{
+			"changed args",
+			[]InstructionV2{allocate, diffCall("swap", ManifestValueU32Value{Value: 1}, ManifestValueStringValue{Value: "a"})},
+			[]InstructionV2{allocate, diffCall("swap", ManifestValueU32Value{Value: 2}, ManifestValueStringValue{Value: "a"})},
+			[]InstructionChange{{Kind: InstructionChanged, OldIndex: 1, NewIndex: 1, Values: []ValueChange{{"args[0]", "U32(1)", "U32(2)"}}}},
+		},
+		{
+			"nested args",
+			[]InstructionV2{allocate, diffCall("swap", ManifestValueTupleValue{Fields: []ManifestValue{ManifestValueStringValue{Value: "a"}}})},
+			[]InstructionV2{allocate, diffCall("swap", ManifestValueTupleValue{Fields: []ManifestValue{ManifestValueStringValue{Value: "b"}}}, ManifestValueU32Value{Value: 3})},
+			[]InstructionChange{{Kind: InstructionChanged, OldIndex: 1, NewIndex: 1, Values: []ValueChange{
+				{"args[0][0]", `String("a")`, `String("b")`},
+				{"args[1]", "", "U32(3)"},
+			}}},
+		},
+		{
+			"added and removed instructions",
+			[]InstructionV2{allocate, diffCall("swap"), InstructionV2DropAllProofs{}},
+			[]InstructionV2{allocate, InstructionV2DropAuthZoneProofs{}, diffCall("swap"), diffCall("withdraw")},
+			[]InstructionChange{
+				{Kind: InstructionAdded, OldIndex: -1, NewIndex: 1},
+				{Kind: InstructionRemoved, OldIndex: 2, NewIndex: -1},
+				{Kind: InstructionAdded, OldIndex: -1, NewIndex: 3},
+			},
+		},
+		{
+			// Calls of different methods are not changes of one another.
+			"renamed method",
+			[]InstructionV2{allocate, diffCall("swap")},
+			[]InstructionV2{allocate, diffCall("trade")},
+			[]InstructionChange{
+				{Kind: InstructionRemoved, OldIndex: 1, NewIndex: -1},
+				{Kind: InstructionAdded, OldIndex: -1, NewIndex: 1},
+			},
+		},
+	}
+	for _, test := range tests {
+		diff := diffInstructions(diffNodes(test.old), diffNodes(test.new))
+		if diff.Empty() != (len(test.want) == 0) {
+			t.Errorf("%s: Empty() = %v for %v", test.name, diff.Empty(), diff.Changes)
+		}
+		got := make([]InstructionChange, len(diff.Changes))
+		for index, change := range diff.Changes {
+			if change.Kind != InstructionAdded && change.Old == "" || change.Kind != InstructionRemoved && change.New == "" {
+				t.Errorf("%s: change %s without its instructions", test.name, change)
+			}
+			got[index] = InstructionChange{Kind: change.Kind, OldIndex: change.OldIndex, NewIndex: change.NewIndex, Values: change.Values}
+		}
+		if len(got) != len(test.want) || len(got) > 0 && !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: diff =\n%v\nwant\n%v", test.name, diff.Changes, test.want)
+		}
+	}
+}
+
+func TestDiffRender(t *testing.T) {
+	diff := diffInstructions(
+		diffNodes([]InstructionV2{diffTake(1), diffCall("deposit", diffBucketArg(0))}),
+		diffNodes([]InstructionV2{diffTake(1)}),
+	)
+	want := `removed 1: CallMethod(address: NamedAddress(0), method_name: "deposit", args: Tuple(Bucket(0)))`
+	if len(diff.Changes) != 1 || diff.Changes[0].String() != want {
+		t.Errorf("diff = %v, want %s", diff.Changes, want)
+	}
+}
+
+func TestSameBlobs(t *testing.T) {
+	tests := []struct {
+		blobs, otherBlobs [][]byte
+		want              bool
+	}{
+		{nil, nil, true},
+		{[][]byte{{1}, {2, 3}}, [][]byte{{2, 3}, {1}}, true},
+		{[][]byte{{1}, {1}}, [][]byte{{1}}, false},
+		{[][]byte{{1}, {2}}, [][]byte{{1}, {3}}, false},
+	}
+	for _, test := range tests {
+		blobs := slices.Clone(test.blobs)
+		if got := sameBlobs(test.blobs, test.otherBlobs); got != test.want {
+			t.Errorf("sameBlobs(%v, %v) = %v, want %v", test.blobs, test.otherBlobs, got, test.want)
+		}
+		if !reflect.DeepEqual(blobs, test.blobs) {
+			t.Errorf("sameBlobs reordered its argument %v", test.blobs)
+		}
+	}
+}
+
+func TestSemanticallyEqualBlobs(t *testing.T) {
+	blobs, reordered, other := [][]byte{{1}, {2}}, [][]byte{{2}, {1}}, [][]byte{{1}, {3}}
+
+	instructionsV1 := NewManifestV1Builder().Build(1).Instructions()
+	for _, test := range []struct {
+		blobs [][]byte
+		want  bool
+	}{{reordered, true}, {other, false}} {
+		equal, err := NewTransactionManifestV1(instructionsV1, blobs).SemanticallyEqual(NewTransactionManifestV1(instructionsV1, test.blobs))
+		if err != nil || equal != test.want {
+			t.Errorf("V1 SemanticallyEqual with blobs %v and %v = %v, %v, want %v", blobs, test.blobs, equal, err, test.want)
+		}
+	}
+
+	instructionsV2 := NewManifestV2Builder(1).Build().Instructions()
+	for _, test := range []struct {
+		blobs [][]byte
+		want  bool
+	}{{reordered, true}, {other, false}} {
+		equal, err := NewTransactionManifestV2(instructionsV2, blobs, nil).SemanticallyEqual(NewTransactionManifestV2(instructionsV2, test.blobs, nil))
+		if err != nil || equal != test.want {
+			t.Errorf("V2 SemanticallyEqual with blobs %v and %v = %v, %v, want %v", blobs, test.blobs, equal, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,837 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Manifest handles
+//
+// The buckets, proofs, address reservations and named addresses of a
+// manifest builder are referred to by name, and a name which is taken twice
+// or which no longer exists is only reported when the instruction using it is
+// added. A ManifestHandles allocates the names instead: the Handle variants
+// of the builder methods which create an object return a typed handle to it,
+// and the Handle variants of the methods which consume one fail right away if
+// it was already consumed, naming the instruction which consumed it:
+//
+//	handles := NewManifestHandles()
+//	builder, bucket, err := builder.TakeFromWorktopHandle(handles, xrd, amount)
+//	builder, err = builder.AccountDepositHandle(account, bucket)
+//	builder, err = builder.BurnResourceHandle(bucket)
+//	// RadixEngineToolkitErrorHandleConsumed: Name=bucket1, Instruction=1
+//
+// A handle converts to the plain name of the builder methods without a Handle
+// variant with Bucket, Proof, AddressReservation or NamedAddress; those
+// methods do not mark it consumed.
+//
+// CallMethodHandle and CallFunctionHandle consume the buckets, proofs and
+// address reservations of the handles passed anywhere in their arguments,
+// and DropAllProofsHandle every proof of the handles not consumed yet.
+// DropAuthZoneProofs only drops the proofs of the auth zone, so it consumes
+// no handle. The other instructions taking arguments, such as YieldToParent,
+// YieldToChild or CallDirectVaultMethod, have no Handle variant.
+
+var ErrRadixEngineToolkitErrorHandleConsumed = fmt.Errorf("RadixEngineToolkitErrorHandleConsumed")
+
+// RadixEngineToolkitErrorHandleConsumed reports the use of the handle Name
+// after it was consumed by the instruction of index Instruction.
+type RadixEngineToolkitErrorHandleConsumed struct {
+	Name        string
+	Instruction uint64
+}
+
+func NewRadixEngineToolkitErrorHandleConsumed(
+	name string,
+	instruction uint64,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorHandleConsumed{
+			Name:        name,
+			Instruction: instruction,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorHandleConsumed) Error() string {
+	return fmt.Sprint("HandleConsumed",
+		": ",
+
+		"Name=",
+		err.Name,
+		", ",
+		"Instruction=",
+		err.Instruction,
+	)
+}
+
+func (self RadixEngineToolkitErrorHandleConsumed) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorHandleConsumed
+}
+
+var ErrRadixEngineToolkitErrorInvalidHandle = fmt.Errorf("RadixEngineToolkitErrorInvalidHandle")
+
+// RadixEngineToolkitErrorInvalidHandle reports a handle which was not
+// allocated by a ManifestHandles, such as the zero value of a handle type.
+type RadixEngineToolkitErrorInvalidHandle struct {
+	Name string
+}
+
+func NewRadixEngineToolkitErrorInvalidHandle(
+	name string,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorInvalidHandle{
+			Name: name,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorInvalidHandle) Error() string {
+	return fmt.Sprint("InvalidHandle",
+		": ",
+
+		"Name=",
+		err.Name,
+	)
+}
+
+func (self RadixEngineToolkitErrorInvalidHandle) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorInvalidHandle
+}
+
+// ManifestHandles allocates the names of the objects of a manifest and
+// records which of them were consumed. A ManifestHandles belongs to one
+// manifest and may be used by several goroutines.
+type ManifestHandles struct {
+	mutex  sync.Mutex
+	counts map[string]int
+	// the kind of the allocated names, "bucket", "proof", "reservation" or
+	// "address", and "" for the reserved ones
+	names    map[string]string
+	consumed map[string]uint64
+	// the builder a Handle method last returned and its number of
+	// instructions, or nil if unknown
+	builder          any
+	instructionCount int
+}
+
+func NewManifestHandles() *ManifestHandles {
+	return &ManifestHandles{
+		counts:   map[string]int{},
+		names:    map[string]string{},
+		consumed: map[string]uint64{},
+	}
+}
+
+// Reserve marks name as taken, for the objects named without the handles.
+func (handles *ManifestHandles) Reserve(name string) {
+	handles.mutex.Lock()
+	defer handles.mutex.Unlock()
+	if _, ok := handles.names[name]; !ok {
+		handles.names[name] = ""
+	}
+}
+
+// NewBucket allocates the name of a bucket.
+func (handles *ManifestHandles) NewBucket() BucketHandle {
+	return BucketHandle{handles.allocate("bucket")}
+}
+
+// NewProof allocates the name of a proof.
+func (handles *ManifestHandles) NewProof() ProofHandle {
+	return ProofHandle{handles.allocate("proof")}
+}
+
+// NewAddressReservation allocates the name of an address reservation.
+func (handles *ManifestHandles) NewAddressReservation() AddressReservationHandle {
+	return AddressReservationHandle{handles.allocate("reservation")}
+}
+
+// NewNamedAddress allocates the name of a named address.
+func (handles *ManifestHandles) NewNamedAddress() NamedAddressHandle {
+	return NamedAddressHandle{handles.allocate("address")}
+}
+
+func (handles *ManifestHandles) allocate(prefix string) manifestHandle {
+	handles.mutex.Lock()
+	defer handles.mutex.Unlock()
+	for {
+		handles.counts[prefix]++
+		name := fmt.Sprintf("%s%d", prefix, handles.counts[prefix])
+		if _, ok := handles.names[name]; !ok {
+			handles.names[name] = prefix
+			return manifestHandle{handles: handles, name: name}
+		}
+	}
+}
+
+// handle returns the handle of an allocated name of the kind, or false if
+// the handles did not allocate the name.
+func (handles *ManifestHandles) handle(name string, kind string) (manifestHandle, bool) {
+	handles.mutex.Lock()
+	defer handles.mutex.Unlock()
+	if handles.names[name] != kind {
+		return manifestHandle{}, false
+	}
+	return manifestHandle{handles: handles, name: name}, true
+}
+
+// liveProofs returns the proofs allocated by the handles which were not
+// consumed.
+func (handles *ManifestHandles) liveProofs() []manifestHandle {
+	if handles == nil {
+		return nil
+	}
+	handles.mutex.Lock()
+	defer handles.mutex.Unlock()
+	var proofs []manifestHandle
+	for name, kind := range handles.names {
+		if _, consumed := handles.consumed[name]; kind == "proof" && !consumed {
+			proofs = append(proofs, manifestHandle{handles: handles, name: name})
+		}
+	}
+	return proofs
+}
+
+// argHandles returns the handles of the buckets, proofs and address
+// reservations found in args, which the instruction taking args consumes.
+func (handles *ManifestHandles) argHandles(args []ManifestBuilderValue) []manifestHandle {
+	if handles == nil {
+		return nil
+	}
+	var found []manifestHandle
+	var walk func(values []ManifestBuilderValue)
+	walk = func(values []ManifestBuilderValue) {
+		for _, value := range values {
+			var handle manifestHandle
+			ok := false
+			switch value := value.(type) {
+			case ManifestBuilderValueBucketValue:
+				handle, ok = handles.handle(value.Value.Name, "bucket")
+			case ManifestBuilderValueProofValue:
+				handle, ok = handles.handle(value.Value.Name, "proof")
+			case ManifestBuilderValueAddressReservationValue:
+				handle, ok = handles.handle(value.Value.Name, "reservation")
+			case ManifestBuilderValueEnumValue:
+				walk(value.Fields)
+			case ManifestBuilderValueArrayValue:
+				walk(value.Elements)
+			case ManifestBuilderValueTupleValue:
+				walk(value.Fields)
+			case ManifestBuilderValueMapValue:
+				for _, entry := range value.Entries {
+					walk([]ManifestBuilderValue{entry.Key, entry.Value})
+				}
+			}
+			if ok {
+				found = append(found, handle)
+			}
+		}
+	}
+	walk(args)
+	return found
+}
+
+// manifestHandle is the name of an object of a manifest and the handles
+// which allocated it.
+type manifestHandle struct {
+	handles *ManifestHandles
+	name    string
+}
+
+// Name returns the name the handle was allocated.
+func (handle manifestHandle) Name() string {
+	return handle.name
+}
+
+// check returns an error if the handle was not allocated or was consumed.
+func (handle manifestHandle) check() error {
+	if handle.handles == nil {
+		return NewRadixEngineToolkitErrorInvalidHandle(handle.name)
+	}
+	handle.handles.mutex.Lock()
+	defer handle.handles.mutex.Unlock()
+	if instruction, ok := handle.handles.consumed[handle.name]; ok {
+		return NewRadixEngineToolkitErrorHandleConsumed(handle.name, instruction)
+	}
+	return nil
+}
+
+func (handle manifestHandle) consume(instruction uint64) {
+	handle.handles.mutex.Lock()
+	defer handle.handles.mutex.Unlock()
+	handle.handles.consumed[handle.name] = instruction
+}
+
+// BucketHandle is a bucket allocated by a ManifestHandles.
+type BucketHandle struct {
+	manifestHandle
+}
+
+func (handle BucketHandle) Bucket() ManifestBuilderBucket {
+	return ManifestBuilderBucket{Name: handle.name}
+}
+
+// ProofHandle is a proof allocated by a ManifestHandles.
+type ProofHandle struct {
+	manifestHandle
+}
+
+func (handle ProofHandle) Proof() ManifestBuilderProof {
+	return ManifestBuilderProof{Name: handle.name}
+}
+
+// AddressReservationHandle is an address reservation allocated by a
+// ManifestHandles.
+type AddressReservationHandle struct {
+	manifestHandle
+}
+
+func (handle AddressReservationHandle) AddressReservation() ManifestBuilderAddressReservation {
+	return ManifestBuilderAddressReservation{Name: handle.name}
+}
+
+// NamedAddressHandle is a named address allocated by a ManifestHandles.
+type NamedAddressHandle struct {
+	manifestHandle
+}
+
+func (handle NamedAddressHandle) NamedAddress() ManifestBuilderNamedAddress {
+	return ManifestBuilderNamedAddress{Name: handle.name}
+}
+
+// Address returns the named address as the address argument of a call.
+func (handle NamedAddressHandle) Address() ManifestBuilderAddress {
+	return ManifestBuilderAddressNamed{Value: handle.NamedAddress()}
+}
+
+// instructionCounter is a builder which knows how many instructions it has.
+type instructionCounter interface {
+	instructionCount() int
+}
+
+func (_self *ManifestV1Builder) instructionCount() int {
+	// The network id of a manifest only matters to its string representation.
+	manifest := _self.Build(0)
+	defer manifest.Destroy()
+	instructions := manifest.Instructions()
+	defer instructions.Destroy()
+	return len(instructions.InstructionsList())
+}
+
+func (_self *ManifestV2Builder) instructionCount() int {
+	manifest := _self.Build()
+	defer manifest.Destroy()
+	instructions := manifest.Instructions()
+	defer instructions.Destroy()
+	return len(instructions.InstructionsList())
+}
+
+// advance records that the Handle method which returned builder added one
+// instruction to previous. The instruction count of builder is known if that
+// of previous is.
+func (handles *ManifestHandles) advance(previous any, builder any, err error) {
+	if err != nil {
+		return
+	}
+	handles.mutex.Lock()
+	defer handles.mutex.Unlock()
+	if handles.builder != nil && handles.builder == previous {
+		handles.builder = builder
+		handles.instructionCount++
+	} else {
+		handles.builder = nil
+	}
+}
+
+// countInstructions returns the number of instructions of builder, which
+// has one instruction more than previous. A chain of Handle methods counts on
+// from the builder the last one returned; only after the methods without
+// handles is the manifest built to count its instructions.
+func (handles *ManifestHandles) countInstructions(previous any, builder instructionCounter) int {
+	handles.mutex.Lock()
+	known := handles.builder != nil && handles.builder == previous
+	count := handles.instructionCount + 1
+	handles.mutex.Unlock()
+	if !known {
+		count = builder.instructionCount()
+	}
+
+	handles.mutex.Lock()
+	defer handles.mutex.Unlock()
+	handles.builder, handles.instructionCount = builder, count
+	return count
+}
+
+// consumeHandle adds the instruction add, which consumes handle, to
+// previous and records its index as the instruction which consumed the
+// handle.
+func consumeHandle[B instructionCounter](previous B, add func() (B, error), handle manifestHandle) (B, error) {
+	var zero B
+	if err := handle.check(); err != nil {
+		return zero, err
+	}
+	return consumeHandles(handle.handles, previous, add, handle)
+}
+
+// consumeHandles adds the instruction add, which consumes the handles of
+// handles in consumed, to previous and records its index as the instruction
+// which consumed them. A nil handles only adds the instruction.
+// A handle of another ManifestHandles is invalid.
+func consumeHandles[B instructionCounter](handles *ManifestHandles, previous B, add func() (B, error), consumed ...manifestHandle) (B, error) {
+	var zero B
+	for _, handle := range consumed {
+		if err := handle.check(); err != nil {
+			return zero, err
+		}
+		if handle.handles != handles {
+			return zero, NewRadixEngineToolkitErrorInvalidHandle(handle.name)
+		}
+	}
+	builder, err := add()
+	if err != nil {
+		return zero, err
+	}
+	if handles == nil {
+		return builder, nil
+	}
+	if len(consumed) == 0 {
+		handles.advance(previous, builder, nil)
+		return builder, nil
+	}
+	instruction := uint64(handles.countInstructions(previous, builder) - 1)
+	for _, handle := range consumed {
+		handle.consume(instruction)
+	}
+	return builder, nil
+}
+
+// bucketHandles returns the handles of buckets and the ManifestHandles which
+// allocated them, or nil if there are no buckets.
+func bucketHandles(buckets []BucketHandle) (*ManifestHandles, []manifestHandle, []ManifestBuilderBucket) {
+	var handles *ManifestHandles
+	consumed := make([]manifestHandle, len(buckets))
+	names := make([]ManifestBuilderBucket, len(buckets))
+	for index, bucket := range buckets {
+		if handles == nil {
+			handles = bucket.handles
+		}
+		consumed[index], names[index] = bucket.manifestHandle, bucket.Bucket()
+	}
+	return handles, consumed, names
+}
+
+// TakeFromWorktopHandle is TakeFromWorktop into a new bucket.
+func (_self *ManifestV1Builder) TakeFromWorktopHandle(handles *ManifestHandles, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, BucketHandle, error) {
+	bucket := handles.NewBucket()
+	builder, err := _self.TakeFromWorktop(resourceAddress, amount, bucket.Bucket())
+	handles.advance(_self, builder, err)
+	return builder, bucket, err
+}
+
+// TakeAllFromWorktopHandle is TakeAllFromWorktop into a new bucket.
+func (_self *ManifestV1Builder) TakeAllFromWorktopHandle(handles *ManifestHandles, resourceAddress *Address) (*ManifestV1Builder, BucketHandle, error) {
+	bucket := handles.NewBucket()
+	builder, err := _self.TakeAllFromWorktop(resourceAddress, bucket.Bucket())
+	handles.advance(_self, builder, err)
+	return builder, bucket, err
+}
+
+// TakeNonFungiblesFromWorktopHandle is TakeNonFungiblesFromWorktop into a
+// new bucket.
+func (_self *ManifestV1Builder) TakeNonFungiblesFromWorktopHandle(handles *ManifestHandles, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, BucketHandle, error) {
+	bucket := handles.NewBucket()
+	builder, err := _self.TakeNonFungiblesFromWorktop(resourceAddress, ids, bucket.Bucket())
+	handles.advance(_self, builder, err)
+	return builder, bucket, err
+}
+
+// CreateProofFromAuthZoneOfAmountHandle is CreateProofFromAuthZoneOfAmount
+// into a new proof.
+func (_self *ManifestV1Builder) CreateProofFromAuthZoneOfAmountHandle(handles *ManifestHandles, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, ProofHandle, error) {
+	proof := handles.NewProof()
+	builder, err := _self.CreateProofFromAuthZoneOfAmount(resourceAddress, amount, proof.Proof())
+	handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CreateProofFromAuthZoneOfAllHandle is CreateProofFromAuthZoneOfAll into a
+// new proof.
+func (_self *ManifestV1Builder) CreateProofFromAuthZoneOfAllHandle(handles *ManifestHandles, resourceAddress *Address) (*ManifestV1Builder, ProofHandle, error) {
+	proof := handles.NewProof()
+	builder, err := _self.CreateProofFromAuthZoneOfAll(resourceAddress, proof.Proof())
+	handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CreateProofFromAuthZoneOfNonFungiblesHandle is
+// CreateProofFromAuthZoneOfNonFungibles into a new proof.
+func (_self *ManifestV1Builder) CreateProofFromAuthZoneOfNonFungiblesHandle(handles *ManifestHandles, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, ProofHandle, error) {
+	proof := handles.NewProof()
+	builder, err := _self.CreateProofFromAuthZoneOfNonFungibles(resourceAddress, ids, proof.Proof())
+	handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CreateProofFromBucketOfAmountHandle is CreateProofFromBucketOfAmount into a
+// new proof.
+func (_self *ManifestV1Builder) CreateProofFromBucketOfAmountHandle(amount *Decimal, bucket BucketHandle) (*ManifestV1Builder, ProofHandle, error) {
+	if err := bucket.check(); err != nil {
+		return nil, ProofHandle{}, err
+	}
+	proof := bucket.handles.NewProof()
+	builder, err := _self.CreateProofFromBucketOfAmount(amount, bucket.Bucket(), proof.Proof())
+	bucket.handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CreateProofFromBucketOfAllHandle is CreateProofFromBucketOfAll into a new
+// proof.
+func (_self *ManifestV1Builder) CreateProofFromBucketOfAllHandle(bucket BucketHandle) (*ManifestV1Builder, ProofHandle, error) {
+	if err := bucket.check(); err != nil {
+		return nil, ProofHandle{}, err
+	}
+	proof := bucket.handles.NewProof()
+	builder, err := _self.CreateProofFromBucketOfAll(bucket.Bucket(), proof.Proof())
+	bucket.handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// PopFromAuthZoneHandle is PopFromAuthZone into a new proof.
+func (_self *ManifestV1Builder) PopFromAuthZoneHandle(handles *ManifestHandles) (*ManifestV1Builder, ProofHandle, error) {
+	proof := handles.NewProof()
+	builder, err := _self.PopFromAuthZone(proof.Proof())
+	handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CloneProofHandle is CloneProof into a new proof.
+func (_self *ManifestV1Builder) CloneProofHandle(proof ProofHandle) (*ManifestV1Builder, ProofHandle, error) {
+	if err := proof.check(); err != nil {
+		return nil, ProofHandle{}, err
+	}
+	clone := proof.handles.NewProof()
+	builder, err := _self.CloneProof(proof.Proof(), clone.Proof())
+	proof.handles.advance(_self, builder, err)
+	return builder, clone, err
+}
+
+// AllocateGlobalAddressHandle is AllocateGlobalAddress into a new address
+// reservation and named address.
+func (_self *ManifestV1Builder) AllocateGlobalAddressHandle(handles *ManifestHandles, packageAddress *Address, blueprintName string) (*ManifestV1Builder, AddressReservationHandle, NamedAddressHandle, error) {
+	reservation, named := handles.NewAddressReservation(), handles.NewNamedAddress()
+	builder, err := _self.AllocateGlobalAddress(packageAddress, blueprintName, reservation.AddressReservation(), named.NamedAddress())
+	handles.advance(_self, builder, err)
+	return builder, reservation, named, err
+}
+
+// AccountDepositHandle is AccountDeposit of a bucket handle, which it
+// consumes.
+func (_self *ManifestV1Builder) AccountDepositHandle(address *Address, bucket BucketHandle) (*ManifestV1Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV1Builder, error) {
+		return _self.AccountDeposit(address, bucket.Bucket())
+	}, bucket.manifestHandle)
+}
+
+// AccountTryDepositOrAbortHandle is AccountTryDepositOrAbort of a bucket
+// handle, which it consumes.
+func (_self *ManifestV1Builder) AccountTryDepositOrAbortHandle(address *Address, bucket BucketHandle, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV1Builder, error) {
+		return _self.AccountTryDepositOrAbort(address, bucket.Bucket(), authorizedDepositorBadge)
+	}, bucket.manifestHandle)
+}
+
+// AccountTryDepositOrRefundHandle is AccountTryDepositOrRefund of a bucket
+// handle, which it consumes.
+func (_self *ManifestV1Builder) AccountTryDepositOrRefundHandle(address *Address, bucket BucketHandle, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV1Builder, error) {
+		return _self.AccountTryDepositOrRefund(address, bucket.Bucket(), authorizedDepositorBadge)
+	}, bucket.manifestHandle)
+}
+
+// BurnResourceHandle is BurnResource of a bucket handle, which it consumes.
+func (_self *ManifestV1Builder) BurnResourceHandle(bucket BucketHandle) (*ManifestV1Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV1Builder, error) {
+		return _self.BurnResource(bucket.Bucket())
+	}, bucket.manifestHandle)
+}
+
+// ReturnToWorktopHandle is ReturnToWorktop of a bucket handle, which it
+// consumes.
+func (_self *ManifestV1Builder) ReturnToWorktopHandle(bucket BucketHandle) (*ManifestV1Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV1Builder, error) {
+		return _self.ReturnToWorktop(bucket.Bucket())
+	}, bucket.manifestHandle)
+}
+
+// DropProofHandle is DropProof of a proof handle, which it consumes.
+func (_self *ManifestV1Builder) DropProofHandle(proof ProofHandle) (*ManifestV1Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV1Builder, error) {
+		return _self.DropProof(proof.Proof())
+	}, proof.manifestHandle)
+}
+
+// PushToAuthZoneHandle is PushToAuthZone of a proof handle, which it
+// consumes.
+func (_self *ManifestV1Builder) PushToAuthZoneHandle(proof ProofHandle) (*ManifestV1Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV1Builder, error) {
+		return _self.PushToAuthZone(proof.Proof())
+	}, proof.manifestHandle)
+}
+
+// AccountDepositBatchHandle is AccountDepositBatch of bucket handles, which
+// it consumes.
+func (_self *ManifestV1Builder) AccountDepositBatchHandle(address *Address, buckets []BucketHandle) (*ManifestV1Builder, error) {
+	handles, consumed, names := bucketHandles(buckets)
+	return consumeHandles(handles, _self, func() (*ManifestV1Builder, error) {
+		return _self.AccountDepositBatch(address, names)
+	}, consumed...)
+}
+
+// AccountTryDepositBatchOrAbortHandle is AccountTryDepositBatchOrAbort of
+// bucket handles, which it consumes.
+func (_self *ManifestV1Builder) AccountTryDepositBatchOrAbortHandle(address *Address, buckets []BucketHandle, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+	handles, consumed, names := bucketHandles(buckets)
+	return consumeHandles(handles, _self, func() (*ManifestV1Builder, error) {
+		return _self.AccountTryDepositBatchOrAbort(address, names, authorizedDepositorBadge)
+	}, consumed...)
+}
+
+// AccountTryDepositBatchOrRefundHandle is AccountTryDepositBatchOrRefund of
+// bucket handles, which it consumes.
+func (_self *ManifestV1Builder) AccountTryDepositBatchOrRefundHandle(address *Address, buckets []BucketHandle, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+	handles, consumed, names := bucketHandles(buckets)
+	return consumeHandles(handles, _self, func() (*ManifestV1Builder, error) {
+		return _self.AccountTryDepositBatchOrRefund(address, names, authorizedDepositorBadge)
+	}, consumed...)
+}
+
+// CallMethodHandle is CallMethod consuming the bucket, proof and address
+// reservation handles of handles found in args.
+func (_self *ManifestV1Builder) CallMethodHandle(handles *ManifestHandles, address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV1Builder, error) {
+	return consumeHandles(handles, _self, func() (*ManifestV1Builder, error) {
+		return _self.CallMethod(address, methodName, args)
+	}, handles.argHandles(args)...)
+}
+
+// CallFunctionHandle is CallFunction consuming the bucket, proof and address
+// reservation handles of handles found in args.
+func (_self *ManifestV1Builder) CallFunctionHandle(handles *ManifestHandles, address ManifestBuilderAddress, blueprintName string, functionName string, args []ManifestBuilderValue) (*ManifestV1Builder, error) {
+	return consumeHandles(handles, _self, func() (*ManifestV1Builder, error) {
+		return _self.CallFunction(address, blueprintName, functionName, args)
+	}, handles.argHandles(args)...)
+}
+
+// DropAllProofsHandle is DropAllProofs, consuming the proof handles of
+// handles.
+func (_self *ManifestV1Builder) DropAllProofsHandle(handles *ManifestHandles) (*ManifestV1Builder, error) {
+	return consumeHandles(handles, _self, _self.DropAllProofs, handles.liveProofs()...)
+}
+
+// TakeFromWorktopHandle is TakeFromWorktop into a new bucket.
+func (_self *ManifestV2Builder) TakeFromWorktopHandle(handles *ManifestHandles, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, BucketHandle, error) {
+	bucket := handles.NewBucket()
+	builder, err := _self.TakeFromWorktop(resourceAddress, amount, bucket.Bucket())
+	handles.advance(_self, builder, err)
+	return builder, bucket, err
+}
+
+// TakeAllFromWorktopHandle is TakeAllFromWorktop into a new bucket.
+func (_self *ManifestV2Builder) TakeAllFromWorktopHandle(handles *ManifestHandles, resourceAddress *Address) (*ManifestV2Builder, BucketHandle, error) {
+	bucket := handles.NewBucket()
+	builder, err := _self.TakeAllFromWorktop(resourceAddress, bucket.Bucket())
+	handles.advance(_self, builder, err)
+	return builder, bucket, err
+}
+
+// TakeNonFungiblesFromWorktopHandle is TakeNonFungiblesFromWorktop into a
+// new bucket.
+func (_self *ManifestV2Builder) TakeNonFungiblesFromWorktopHandle(handles *ManifestHandles, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, BucketHandle, error) {
+	bucket := handles.NewBucket()
+	builder, err := _self.TakeNonFungiblesFromWorktop(resourceAddress, ids, bucket.Bucket())
+	handles.advance(_self, builder, err)
+	return builder, bucket, err
+}
+
+// CreateProofFromAuthZoneOfAmountHandle is CreateProofFromAuthZoneOfAmount
+// into a new proof.
+func (_self *ManifestV2Builder) CreateProofFromAuthZoneOfAmountHandle(handles *ManifestHandles, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, ProofHandle, error) {
+	proof := handles.NewProof()
+	builder, err := _self.CreateProofFromAuthZoneOfAmount(resourceAddress, amount, proof.Proof())
+	handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CreateProofFromAuthZoneOfAllHandle is CreateProofFromAuthZoneOfAll into a
+// new proof.
+func (_self *ManifestV2Builder) CreateProofFromAuthZoneOfAllHandle(handles *ManifestHandles, resourceAddress *Address) (*ManifestV2Builder, ProofHandle, error) {
+	proof := handles.NewProof()
+	builder, err := _self.CreateProofFromAuthZoneOfAll(resourceAddress, proof.Proof())
+	handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CreateProofFromAuthZoneOfNonFungiblesHandle is
+// CreateProofFromAuthZoneOfNonFungibles into a new proof.
+func (_self *ManifestV2Builder) CreateProofFromAuthZoneOfNonFungiblesHandle(handles *ManifestHandles, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, ProofHandle, error) {
+	proof := handles.NewProof()
+	builder, err := _self.CreateProofFromAuthZoneOfNonFungibles(resourceAddress, ids, proof.Proof())
+	handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CreateProofFromBucketOfAmountHandle is CreateProofFromBucketOfAmount into a
+// new proof.
+func (_self *ManifestV2Builder) CreateProofFromBucketOfAmountHandle(amount *Decimal, bucket BucketHandle) (*ManifestV2Builder, ProofHandle, error) {
+	if err := bucket.check(); err != nil {
+		return nil, ProofHandle{}, err
+	}
+	proof := bucket.handles.NewProof()
+	builder, err := _self.CreateProofFromBucketOfAmount(amount, bucket.Bucket(), proof.Proof())
+	bucket.handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CreateProofFromBucketOfAllHandle is CreateProofFromBucketOfAll into a new
+// proof.
+func (_self *ManifestV2Builder) CreateProofFromBucketOfAllHandle(bucket BucketHandle) (*ManifestV2Builder, ProofHandle, error) {
+	if err := bucket.check(); err != nil {
+		return nil, ProofHandle{}, err
+	}
+	proof := bucket.handles.NewProof()
+	builder, err := _self.CreateProofFromBucketOfAll(bucket.Bucket(), proof.Proof())
+	bucket.handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// PopFromAuthZoneHandle is PopFromAuthZone into a new proof.
+func (_self *ManifestV2Builder) PopFromAuthZoneHandle(handles *ManifestHandles) (*ManifestV2Builder, ProofHandle, error) {
+	proof := handles.NewProof()
+	builder, err := _self.PopFromAuthZone(proof.Proof())
+	handles.advance(_self, builder, err)
+	return builder, proof, err
+}
+
+// CloneProofHandle is CloneProof into a new proof.
+func (_self *ManifestV2Builder) CloneProofHandle(proof ProofHandle) (*ManifestV2Builder, ProofHandle, error) {
+	if err := proof.check(); err != nil {
+		return nil, ProofHandle{}, err
+	}
+	clone := proof.handles.NewProof()
+	builder, err := _self.CloneProof(proof.Proof(), clone.Proof())
+	proof.handles.advance(_self, builder, err)
+	return builder, clone, err
+}
+
+// AllocateGlobalAddressHandle is AllocateGlobalAddress into a new address
+// reservation and named address.
+func (_self *ManifestV2Builder) AllocateGlobalAddressHandle(handles *ManifestHandles, packageAddress *Address, blueprintName string) (*ManifestV2Builder, AddressReservationHandle, NamedAddressHandle, error) {
+	reservation, named := handles.NewAddressReservation(), handles.NewNamedAddress()
+	builder, err := _self.AllocateGlobalAddress(packageAddress, blueprintName, reservation.AddressReservation(), named.NamedAddress())
+	handles.advance(_self, builder, err)
+	return builder, reservation, named, err
+}
+
+// AccountDepositHandle is AccountDeposit of a bucket handle, which it
+// consumes.
+func (_self *ManifestV2Builder) AccountDepositHandle(address *Address, bucket BucketHandle) (*ManifestV2Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV2Builder, error) {
+		return _self.AccountDeposit(address, bucket.Bucket())
+	}, bucket.manifestHandle)
+}
+
+// AccountTryDepositOrAbortHandle is AccountTryDepositOrAbort of a bucket
+// handle, which it consumes.
+func (_self *ManifestV2Builder) AccountTryDepositOrAbortHandle(address *Address, bucket BucketHandle, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV2Builder, error) {
+		return _self.AccountTryDepositOrAbort(address, bucket.Bucket(), authorizedDepositorBadge)
+	}, bucket.manifestHandle)
+}
+
+// AccountTryDepositOrRefundHandle is AccountTryDepositOrRefund of a bucket
+// handle, which it consumes.
+func (_self *ManifestV2Builder) AccountTryDepositOrRefundHandle(address *Address, bucket BucketHandle, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV2Builder, error) {
+		return _self.AccountTryDepositOrRefund(address, bucket.Bucket(), authorizedDepositorBadge)
+	}, bucket.manifestHandle)
+}
+
+// BurnResourceHandle is BurnResource of a bucket handle, which it consumes.
+func (_self *ManifestV2Builder) BurnResourceHandle(bucket BucketHandle) (*ManifestV2Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV2Builder, error) {
+		return _self.BurnResource(bucket.Bucket())
+	}, bucket.manifestHandle)
+}
+
+// ReturnToWorktopHandle is ReturnToWorktop of a bucket handle, which it
+// consumes.
+func (_self *ManifestV2Builder) ReturnToWorktopHandle(bucket BucketHandle) (*ManifestV2Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV2Builder, error) {
+		return _self.ReturnToWorktop(bucket.Bucket())
+	}, bucket.manifestHandle)
+}
+
+// DropProofHandle is DropProof of a proof handle, which it consumes.
+func (_self *ManifestV2Builder) DropProofHandle(proof ProofHandle) (*ManifestV2Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV2Builder, error) {
+		return _self.DropProof(proof.Proof())
+	}, proof.manifestHandle)
+}
+
+// PushToAuthZoneHandle is PushToAuthZone of a proof handle, which it
+// consumes.
+func (_self *ManifestV2Builder) PushToAuthZoneHandle(proof ProofHandle) (*ManifestV2Builder, error) {
+	return consumeHandle(_self, func() (*ManifestV2Builder, error) {
+		return _self.PushToAuthZone(proof.Proof())
+	}, proof.manifestHandle)
+}
+
+// AccountDepositBatchHandle is AccountDepositBatch of bucket handles, which
+// it consumes.
+func (_self *ManifestV2Builder) AccountDepositBatchHandle(address *Address, buckets []BucketHandle) (*ManifestV2Builder, error) {
+	handles, consumed, names := bucketHandles(buckets)
+	return consumeHandles(handles, _self, func() (*ManifestV2Builder, error) {
+		return _self.AccountDepositBatch(address, names)
+	}, consumed...)
+}
+
+// AccountTryDepositBatchOrAbortHandle is AccountTryDepositBatchOrAbort of
+// bucket handles, which it consumes.
+func (_self *ManifestV2Builder) AccountTryDepositBatchOrAbortHandle(address *Address, buckets []BucketHandle, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+	handles, consumed, names := bucketHandles(buckets)
+	return consumeHandles(handles, _self, func() (*ManifestV2Builder, error) {
+		return _self.AccountTryDepositBatchOrAbort(address, names, authorizedDepositorBadge)
+	}, consumed...)
+}
+
+// AccountTryDepositBatchOrRefundHandle is AccountTryDepositBatchOrRefund of
+// bucket handles, which it consumes.
+func (_self *ManifestV2Builder) AccountTryDepositBatchOrRefundHandle(address *Address, buckets []BucketHandle, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+	handles, consumed, names := bucketHandles(buckets)
+	return consumeHandles(handles, _self, func() (*ManifestV2Builder, error) {
+		return _self.AccountTryDepositBatchOrRefund(address, names, authorizedDepositorBadge)
+	}, consumed...)
+}
+
+// CallMethodHandle is CallMethod consuming the bucket, proof and address
+// reservation handles of handles found in args.
+func (_self *ManifestV2Builder) CallMethodHandle(handles *ManifestHandles, address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+	return consumeHandles(handles, _self, func() (*ManifestV2Builder, error) {
+		return _self.CallMethod(address, methodName, args)
+	}, handles.argHandles(args)...)
+}
+
+// CallFunctionHandle is CallFunction consuming the bucket, proof and address
+// reservation handles of handles found in args.
+func (_self *ManifestV2Builder) CallFunctionHandle(handles *ManifestHandles, address ManifestBuilderAddress, blueprintName string, functionName string, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+	return consumeHandles(handles, _self, func() (*ManifestV2Builder, error) {
+		return _self.CallFunction(address, blueprintName, functionName, args)
+	}, handles.argHandles(args)...)
+}
+
+// DropAllProofsHandle is DropAllProofs, consuming the proof handles of
+// handles.
+func (_self *ManifestV2Builder) DropAllProofsHandle(handles *ManifestHandles) (*ManifestV2Builder, error) {
+	return consumeHandles(handles, _self, _self.DropAllProofs, handles.liveProofs()...)
+}
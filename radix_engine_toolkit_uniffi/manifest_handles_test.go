@@ -0,0 +1,170 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+	"testing"
+)
+
+// wantConsumed checks that err reports the handle name consumed by the
+// instruction of index instruction.
+func wantConsumed(t *testing.T, what string, err error, name string, instruction uint64) {
+	t.Helper()
+	var consumed *RadixEngineToolkitErrorHandleConsumed
+	if !errors.Is(err, ErrRadixEngineToolkitErrorHandleConsumed) || !errors.As(err, &consumed) {
+		t.Errorf("%s = %v, want a RadixEngineToolkitErrorHandleConsumed error", what, err)
+		return
+	}
+	if consumed.Name != name || consumed.Instruction != instruction {
+		t.Errorf("%s: %s consumed at %d, want %s at %d", what, consumed.Name, consumed.Instruction, name, instruction)
+	}
+}
+
+func TestHandleConsumedInstruction(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	one := testDecimal(t, "1")
+	handles := NewManifestHandles()
+
+	// The withdrawal has no Handle variant, so the count of the take is not
+	// known and the manifest is built to count it.
+	builder, err := NewManifestV2Builder(1).AccountWithdraw(addresses.sender, addresses.xrd, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder, bucket, err := builder.TakeFromWorktopHandle(handles, addresses.xrd, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder, proof, err := builder.CreateProofFromAuthZoneOfAllHandle(handles, addresses.badge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if builder, err = builder.AccountDepositHandle(addresses.alice, bucket); err != nil {
+		t.Fatal(err)
+	}
+	if builder, err = builder.DropProofHandle(proof); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = builder.AccountDepositHandle(addresses.alice, bucket)
+	wantConsumed(t, "depositing a deposited bucket", err, bucket.Name(), 3)
+	_, err = builder.PushToAuthZoneHandle(proof)
+	wantConsumed(t, "pushing a dropped proof", err, proof.Name(), 4)
+}
+
+func TestHandleBatchDeposit(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	one := testDecimal(t, "1")
+	handles := NewManifestHandles()
+
+	builder, first, err := NewManifestV1Builder().TakeFromWorktopHandle(handles, addresses.xrd, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder, second, err := builder.TakeAllFromWorktopHandle(handles, addresses.badge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if builder, err = builder.AccountDepositBatchHandle(addresses.alice, []BucketHandle{first, second}); err != nil {
+		t.Fatal(err)
+	}
+	for _, bucket := range []BucketHandle{first, second} {
+		_, err = builder.BurnResourceHandle(bucket)
+		wantConsumed(t, "burning a batch deposited bucket", err, bucket.Name(), 2)
+	}
+
+	_, err = builder.AccountDepositBatchHandle(addresses.alice, []BucketHandle{first})
+	wantConsumed(t, "depositing a batch twice", err, first.Name(), 2)
+
+	other := NewManifestHandles()
+	builder, mixed, err := builder.TakeFromWorktopHandle(other, addresses.xrd, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder, own, err := builder.TakeFromWorktopHandle(handles, addresses.xrd, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = builder.AccountTryDepositBatchOrAbortHandle(addresses.alice, []BucketHandle{own, mixed}, nil); !errors.Is(err, ErrRadixEngineToolkitErrorInvalidHandle) {
+		t.Errorf("batch deposit of buckets of two ManifestHandles = %v, want a RadixEngineToolkitErrorInvalidHandle error", err)
+	}
+	if _, err = builder.AccountTryDepositBatchOrRefundHandle(addresses.alice, []BucketHandle{{}}, nil); !errors.Is(err, ErrRadixEngineToolkitErrorInvalidHandle) {
+		t.Errorf("batch deposit of a zero BucketHandle = %v, want a RadixEngineToolkitErrorInvalidHandle error", err)
+	}
+}
+
+func TestHandleDropProofs(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	one := testDecimal(t, "1")
+	handles := NewManifestHandles()
+
+	builder, first, err := NewManifestV2Builder(1).CreateProofFromAuthZoneOfAllHandle(handles, addresses.badge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder, second, err := builder.CreateProofFromAuthZoneOfAmountHandle(handles, addresses.xrd, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if builder, err = builder.DropProofHandle(first); err != nil {
+		t.Fatal(err)
+	}
+
+	// Dropping the proofs of the auth zone leaves the named proofs.
+	if builder, err = builder.DropAuthZoneProofs(); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = builder.CloneProofHandle(second); err != nil {
+		t.Errorf("cloning a proof after DropAuthZoneProofs = %v", err)
+	}
+
+	if builder, err = builder.DropAllProofsHandle(handles); err != nil {
+		t.Fatal(err)
+	}
+	_, err = builder.PushToAuthZoneHandle(second)
+	wantConsumed(t, "pushing a proof after DropAllProofsHandle", err, second.Name(), 4)
+	_, err = builder.DropProofHandle(first)
+	wantConsumed(t, "dropping a dropped proof", err, first.Name(), 2)
+}
+
+func TestHandleCallArgs(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	one := testDecimal(t, "1")
+	handles := NewManifestHandles()
+
+	builder, bucket, err := NewManifestV2Builder(1).TakeFromWorktopHandle(handles, addresses.xrd, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder, proof, err := builder.CreateProofFromAuthZoneOfAllHandle(handles, addresses.badge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder, kept, err := builder.TakeAllFromWorktopHandle(handles, addresses.xrd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A bucket of the names the handles did not allocate is not consumed.
+	args := []ManifestBuilderValue{
+		ManifestBuilderValueTupleValue{Fields: []ManifestBuilderValue{
+			ManifestBuilderValueBucketValue{Value: bucket.Bucket()},
+			ManifestBuilderValueArrayValue{ElementValueKind: ManifestBuilderValueKindProofValue, Elements: []ManifestBuilderValue{
+				ManifestBuilderValueProofValue{Value: proof.Proof()},
+			}},
+		}},
+		ManifestBuilderValueBucketValue{Value: ManifestBuilderBucket{Name: "unknown"}},
+	}
+	component := ManifestBuilderAddressStatic{Value: addresses.alice}
+	if builder, err = builder.CallMethodHandle(handles, component, "swap", args); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = builder.ReturnToWorktopHandle(bucket)
+	wantConsumed(t, "returning a bucket passed to a method", err, bucket.Name(), 3)
+	_, err = builder.DropProofHandle(proof)
+	wantConsumed(t, "dropping a proof passed to a method", err, proof.Name(), 3)
+	_, err = builder.CallMethodHandle(handles, component, "swap", args)
+	wantConsumed(t, "passing a bucket twice", err, bucket.Name(), 3)
+	if _, err = builder.ReturnToWorktopHandle(kept); err != nil {
+		t.Errorf("returning a bucket not passed to the method = %v", err)
+	}
+}
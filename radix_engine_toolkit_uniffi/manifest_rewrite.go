@@ -0,0 +1,72 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// WithInstructions returns a manifest with the blobs of the manifest and the
+// instructions, on the network of its instructions.
+func (_self *TransactionManifestV1) WithInstructions(instructions []InstructionV1) (*TransactionManifestV1, error) {
+	current := _self.Instructions()
+	defer current.Destroy()
+	rewritten, err := InstructionsV1FromInstructions(instructions, current.NetworkId())
+	if err != nil {
+		return nil, err
+	}
+	return NewTransactionManifestV1(rewritten, _self.Blobs()), nil
+}
+
+// WithInstructions returns a manifest with the blobs and the children of the
+// manifest and the instructions, on the network of its instructions.
+func (_self *TransactionManifestV2) WithInstructions(instructions []InstructionV2) (*TransactionManifestV2, error) {
+	children, err := _self.Children()
+	if err != nil {
+		return nil, err
+	}
+	current := _self.Instructions()
+	defer current.Destroy()
+	rewritten, err := InstructionsV2FromInstructions(instructions, current.NetworkId())
+	if err != nil {
+		return nil, err
+	}
+	return NewTransactionManifestV2(rewritten, _self.Blobs(), children), nil
+}
+
+// Children returns the hashes of the subintents the manifest yields to, in
+// the order of their ManifestNamedIntent indices.
+func (_self *TransactionManifestV2) Children() ([]*Hash, error) {
+	payload, err := _self.ToPayloadBytes()
+	if err != nil {
+		return nil, err
+	}
+	// TransactionManifestV2 { instructions, blobs, children, object_names },
+	// a child being a ChildSubintentSpecifier { hash: SubintentHash }.
+	value, err := sbor.Decode(payload, sbor.Manifest)
+	if err != nil {
+		return nil, NewRadixEngineToolkitErrorManifestSborError(err.Error())
+	}
+	children, err := value.Field(2)
+	if err != nil || children.Kind != sbor.KindArray {
+		return nil, NewRadixEngineToolkitErrorManifestSborError("the manifest has no children")
+	}
+	hashes := make([]*Hash, len(children.Elements))
+	for index, child := range children.Elements {
+		bytes, ok := sborHashBytes(child)
+		if !ok {
+			return nil, NewRadixEngineToolkitErrorManifestSborError(fmt.Sprintf("child %d is not a subintent hash", index))
+		}
+		if hashes[index], err = NewHash(bytes); err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+// sborHashBytes returns the bytes of a hash, which is encoded as an array of
+// 32 U8 in as many single field tuples as it has wrappers.
+func sborHashBytes(value sbor.Value) ([]byte, bool) {
+	bytes, ok := value.Unwrap().Bytes()
+	return bytes, ok && len(bytes) == 32
+}
@@ -0,0 +1,386 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/manifesttext"
+)
+
+// Manifest templates
+//
+// A ManifestTemplate is manifest text with typed placeholders in place of
+// values, ${name: Type}, the type being one of:
+//
+//   - Address, optionally restricted to entity types, e.g.
+//     ${account: Address<GlobalAccount, GlobalPreallocatedEd25519Account>},
+//     bound to an *Address or an address.Address;
+//   - Decimal, bound to a *Decimal or a DecimalValue;
+//   - NonFungibleLocalId, bound to a NonFungibleLocalId;
+//   - Bucket, bound to the name of a bucket as a string.
+//
+// A placeholder may be used several times, always with the same type. The
+// bindings are checked against the types of the placeholders before the
+// text is compiled, and every placeholder left unbound or bound to a value
+// of another type, another entity type or another network is reported with
+// its position in the template:
+//
+//	template, err := ParseManifestTemplate(`
+//	CALL_METHOD
+//	    ${account: Address<GlobalAccount>}
+//	    "withdraw"
+//	    ${resource: Address<GlobalFungibleResourceManager>}
+//	    ${amount: Decimal}
+//	;
+//	TAKE_ALL_FROM_WORKTOP
+//	    ${resource: Address<GlobalFungibleResourceManager>}
+//	    ${bucket: Bucket}
+//	;
+//	...`)
+//	manifest, err := template.BindManifestV2(networkId, map[string]any{
+//		"account":  account,
+//		"resource": xrd,
+//		"amount":   amount,
+//		"bucket":   "xrd",
+//	}, nil, nil)
+
+var ErrRadixEngineToolkitErrorInvalidManifestTemplate = fmt.Errorf("RadixEngineToolkitErrorInvalidManifestTemplate")
+
+// RadixEngineToolkitErrorInvalidManifestTemplate reports a manifest template
+// which cannot be parsed, or bindings which do not fit its placeholders.
+type RadixEngineToolkitErrorInvalidManifestTemplate struct {
+	Problems []ManifestTemplateProblem
+}
+
+func NewRadixEngineToolkitErrorInvalidManifestTemplate(
+	problems []ManifestTemplateProblem,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorInvalidManifestTemplate{
+			Problems: problems,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorInvalidManifestTemplate) Error() string {
+	problems := make([]string, len(err.Problems))
+	for index, problem := range err.Problems {
+		problems[index] = problem.String()
+	}
+	return fmt.Sprint("InvalidManifestTemplate",
+		": ",
+
+		"Problems=",
+		strings.Join(problems, "; "),
+	)
+}
+
+func (self RadixEngineToolkitErrorInvalidManifestTemplate) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorInvalidManifestTemplate
+}
+
+// ManifestTemplateProblem is a problem of a manifest template or of its
+// bindings, Placeholder being the name of the placeholder at fault, if any,
+// and Pos its position in the template, or the zero Position for a binding
+// of no placeholder.
+type ManifestTemplateProblem struct {
+	Placeholder string
+	Pos         manifesttext.Position
+	Reason      string
+}
+
+func (problem ManifestTemplateProblem) String() string {
+	var prefix string
+	if problem.Pos.Line > 0 {
+		prefix = problem.Pos.String() + ": "
+	}
+	if problem.Placeholder != "" {
+		prefix += problem.Placeholder + ": "
+	}
+	return prefix + problem.Reason
+}
+
+// ManifestTemplateType is the type of a placeholder.
+type ManifestTemplateType int
+
+const (
+	ManifestTemplateAddress ManifestTemplateType = iota
+	ManifestTemplateDecimal
+	ManifestTemplateNonFungibleLocalId
+	ManifestTemplateBucket
+)
+
+var manifestTemplateTypes = map[string]ManifestTemplateType{
+	"Address":            ManifestTemplateAddress,
+	"Decimal":            ManifestTemplateDecimal,
+	"NonFungibleLocalId": ManifestTemplateNonFungibleLocalId,
+	"Bucket":             ManifestTemplateBucket,
+}
+
+func (t ManifestTemplateType) String() string {
+	switch t {
+	case ManifestTemplateAddress:
+		return "Address"
+	case ManifestTemplateDecimal:
+		return "Decimal"
+	case ManifestTemplateNonFungibleLocalId:
+		return "NonFungibleLocalId"
+	case ManifestTemplateBucket:
+		return "Bucket"
+	default:
+		return fmt.Sprintf("ManifestTemplateType(%d)", int(t))
+	}
+}
+
+// ManifestTemplatePlaceholder is a placeholder of a manifest template.
+type ManifestTemplatePlaceholder struct {
+	Name string
+	Type ManifestTemplateType
+	// EntityTypes are the entity types the address of an Address
+	// placeholder must be of, any if empty.
+	EntityTypes []EntityType
+	// Positions are the positions of the uses of the placeholder in the
+	// template.
+	Positions []manifesttext.Position
+}
+
+// declaration returns the placeholder as written in the template, without
+// its name.
+func (placeholder ManifestTemplatePlaceholder) declaration() string {
+	if len(placeholder.EntityTypes) == 0 {
+		return placeholder.Type.String()
+	}
+	return placeholder.Type.String() + "<" + placeholder.entityTypes() + ">"
+}
+
+// entityTypes returns the names of the entity types of the placeholder,
+// separated by commas.
+func (placeholder ManifestTemplatePlaceholder) entityTypes() string {
+	names := make([]string, len(placeholder.EntityTypes))
+	for index, entityType := range placeholder.EntityTypes {
+		names[index] = address.EntityType(entityType).String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// ManifestTemplate is parsed manifest text with placeholders. It is safe for
+// concurrent use.
+type ManifestTemplate struct {
+	manifest *manifesttext.Manifest
+	// placeholders are in the order of their first uses.
+	placeholders []ManifestTemplatePlaceholder
+}
+
+// ParseManifestTemplate parses a manifest template, returning a
+// RadixEngineToolkitErrorInvalidManifestTemplate if its text or one of its
+// placeholders is invalid. The instructions are only compiled once bound.
+func ParseManifestTemplate(text string) (*ManifestTemplate, error) {
+	manifest, err := manifesttext.Parse([]byte(text))
+	if err != nil {
+		problem := ManifestTemplateProblem{Reason: err.Error()}
+		var syntaxError *manifesttext.SyntaxError
+		if errors.As(err, &syntaxError) {
+			problem = ManifestTemplateProblem{Pos: syntaxError.Pos, Reason: syntaxError.Reason}
+		}
+		return nil, NewRadixEngineToolkitErrorInvalidManifestTemplate([]ManifestTemplateProblem{problem})
+	}
+	template := &ManifestTemplate{manifest: manifest}
+	indices := map[string]int{}
+	var problems []ManifestTemplateProblem
+	for _, value := range manifest.Placeholders() {
+		placeholder, reason := parsePlaceholder(value)
+		if reason != "" {
+			problems = append(problems, ManifestTemplateProblem{Placeholder: value.Text, Pos: value.Pos, Reason: reason})
+			continue
+		}
+		index, ok := indices[value.Text]
+		if !ok {
+			indices[value.Text] = len(template.placeholders)
+			template.placeholders = append(template.placeholders, placeholder)
+			continue
+		}
+		first := &template.placeholders[index]
+		if first.declaration() != placeholder.declaration() {
+			problems = append(problems, ManifestTemplateProblem{
+				Placeholder: value.Text,
+				Pos:         value.Pos,
+				Reason:      fmt.Sprintf("%s here, but %s at %s", placeholder.declaration(), first.declaration(), first.Positions[0]),
+			})
+			continue
+		}
+		first.Positions = append(first.Positions, value.Pos)
+	}
+	if len(problems) > 0 {
+		return nil, NewRadixEngineToolkitErrorInvalidManifestTemplate(problems)
+	}
+	return template, nil
+}
+
+// parsePlaceholder returns the placeholder of a use, or the reason it is
+// invalid.
+func parsePlaceholder(value *manifesttext.Value) (ManifestTemplatePlaceholder, string) {
+	placeholder := ManifestTemplatePlaceholder{Name: value.Text, Positions: []manifesttext.Position{value.Pos}}
+	t, ok := manifestTemplateTypes[value.Type]
+	if !ok {
+		return placeholder, fmt.Sprintf("unknown type %s, expected Address, Decimal, NonFungibleLocalId or Bucket", value.Type)
+	}
+	placeholder.Type = t
+	if len(value.TypeArgs) > 0 && t != ManifestTemplateAddress {
+		return placeholder, fmt.Sprintf("%s takes no type arguments", t)
+	}
+	for _, name := range value.TypeArgs {
+		var entityType EntityType
+		if err := entityType.UnmarshalText([]byte(name)); err != nil {
+			return placeholder, fmt.Sprintf("unknown entity type %s", name)
+		}
+		placeholder.EntityTypes = append(placeholder.EntityTypes, entityType)
+	}
+	return placeholder, ""
+}
+
+// Placeholders returns the placeholders of the template in the order of
+// their first uses.
+func (template *ManifestTemplate) Placeholders() []ManifestTemplatePlaceholder {
+	return slices.Clone(template.placeholders)
+}
+
+// BindText returns the text of the template with the placeholders replaced by
+// the bindings, keyed by placeholder name, in the canonical layout of
+// manifesttext.Format. The text compiles with InstructionsV2FromString, or
+// InstructionsV1FromString for a template of V1 instructions.
+//
+// Every placeholder must be bound to a value of its type, and every binding
+// must be of a placeholder; a RadixEngineToolkitErrorInvalidManifestTemplate
+// reports all the bindings which are missing or invalid. The addresses must
+// be on the network.
+func (template *ManifestTemplate) BindText(networkId uint8, bindings map[string]any) (string, error) {
+	values := make(map[string]*manifesttext.Value, len(bindings))
+	var problems []ManifestTemplateProblem
+	for _, placeholder := range template.placeholders {
+		problem := ManifestTemplateProblem{Placeholder: placeholder.Name, Pos: placeholder.Positions[0]}
+		binding, ok := bindings[placeholder.Name]
+		if !ok {
+			problem.Reason = fmt.Sprintf("unbound %s placeholder", placeholder.declaration())
+			problems = append(problems, problem)
+			continue
+		}
+		value, reason := placeholder.bind(networkId, binding)
+		if reason != "" {
+			problem.Reason = reason
+			problems = append(problems, problem)
+			continue
+		}
+		values[placeholder.Name] = value
+	}
+	var unknown []string
+	for name := range bindings {
+		if !template.hasPlaceholder(name) {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		problems = append(problems, ManifestTemplateProblem{Placeholder: name, Reason: "the template has no such placeholder"})
+	}
+	if len(problems) > 0 {
+		return "", NewRadixEngineToolkitErrorInvalidManifestTemplate(problems)
+	}
+	return string(template.manifest.Bind(values).Format()), nil
+}
+
+// BindInstructionsV2 binds the placeholders like BindText and compiles the
+// text into instructions on the network.
+func (template *ManifestTemplate) BindInstructionsV2(networkId uint8, bindings map[string]any) (*InstructionsV2, error) {
+	text, err := template.BindText(networkId, bindings)
+	if err != nil {
+		return nil, err
+	}
+	return InstructionsV2FromString(text, networkId)
+}
+
+// BindManifestV2 binds the placeholders like BindText and returns the manifest
+// of the compiled instructions, the blobs and the children.
+func (template *ManifestTemplate) BindManifestV2(networkId uint8, bindings map[string]any, blobs [][]byte, children []*Hash) (*TransactionManifestV2, error) {
+	instructions, err := template.BindInstructionsV2(networkId, bindings)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransactionManifestV2(instructions, blobs, children), nil
+}
+
+func (template *ManifestTemplate) hasPlaceholder(name string) bool {
+	return slices.ContainsFunc(template.placeholders, func(placeholder ManifestTemplatePlaceholder) bool {
+		return placeholder.Name == name
+	})
+}
+
+// bind returns the manifest value of a binding of the placeholder, or the
+// reason the binding does not fit it.
+func (placeholder ManifestTemplatePlaceholder) bind(networkId uint8, binding any) (*manifesttext.Value, string) {
+	switch placeholder.Type {
+	case ManifestTemplateAddress:
+		var value address.Address
+		switch binding := binding.(type) {
+		case address.Address:
+			value = binding
+		case *Address:
+			if binding == nil {
+				return nil, "nil *Address"
+			}
+			var err error
+			if value, err = binding.Value(); err != nil {
+				return nil, err.Error()
+			}
+		default:
+			return nil, fmt.Sprintf("expected an *Address or an address.Address, found %T", binding)
+		}
+		if value.NetworkId() != networkId {
+			return nil, fmt.Sprintf("address %s of network %d, the manifest is on network %d", value, value.NetworkId(), networkId)
+		}
+		entityType := EntityType(value.EntityType())
+		if len(placeholder.EntityTypes) > 0 && !slices.Contains(placeholder.EntityTypes, entityType) {
+			return nil, fmt.Sprintf("expected an address of %s, found %s of %s", placeholder.entityTypes(), value, value.EntityType())
+		}
+		return manifestTemplateCall("Address", value.String()), ""
+	case ManifestTemplateDecimal:
+		switch binding := binding.(type) {
+		case DecimalValue:
+			return manifestTemplateCall("Decimal", binding.AsStr()), ""
+		case *Decimal:
+			if binding == nil {
+				return nil, "nil *Decimal"
+			}
+			return manifestTemplateCall("Decimal", binding.Value().AsStr()), ""
+		}
+		return nil, fmt.Sprintf("expected a *Decimal or a DecimalValue, found %T", binding)
+	case ManifestTemplateNonFungibleLocalId:
+		switch binding := binding.(type) {
+		case NonFungibleLocalIdInteger, NonFungibleLocalIdStr, NonFungibleLocalIdBytes, NonFungibleLocalIdRuid:
+			return manifestTemplateCall("NonFungibleLocalId", nonFungibleLocalIdString(binding.(NonFungibleLocalId))), ""
+		}
+		return nil, fmt.Sprintf("expected a NonFungibleLocalId, found %T", binding)
+	case ManifestTemplateBucket:
+		name, ok := binding.(string)
+		if !ok {
+			return nil, fmt.Sprintf("expected the name of a bucket as a string, found %T", binding)
+		}
+		if name == "" {
+			return nil, "empty bucket name"
+		}
+		return manifestTemplateCall("Bucket", name), ""
+	}
+	return nil, fmt.Sprintf("unknown type %s", placeholder.Type)
+}
+
+// manifestTemplateCall returns the manifest value name("argument").
+func manifestTemplateCall(name, argument string) *manifesttext.Value {
+	return &manifesttext.Value{
+		Kind: manifesttext.ValueCall,
+		Text: name,
+		Args: []*manifesttext.Value{{Kind: manifesttext.ValueString, Text: argument}},
+	}
+}
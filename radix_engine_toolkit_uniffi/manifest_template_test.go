@@ -0,0 +1,195 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+const templateText = `CALL_METHOD
+    ${account: Address<GlobalAccount>}
+    "withdraw"
+    ${resource: Address<GlobalFungibleResourceManager>}
+    ${amount: Decimal}
+;
+TAKE_ALL_FROM_WORKTOP ${resource: Address<GlobalFungibleResourceManager>} ${bucket: Bucket};
+CALL_METHOD ${account: Address<GlobalAccount>} "deposit_id" ${id: NonFungibleLocalId};
+`
+
+// templateAddress is an address of the entity type on the network, built in
+// pure Go unlike testAddress.
+func templateAddress(t *testing.T, entityType address.EntityType, networkId uint8, fill byte) address.Address {
+	t.Helper()
+	nodeId := bytes.Repeat([]byte{fill}, address.NodeIdLength)
+	nodeId[0] = entityType.Byte()
+	value, err := address.FromRaw(nodeId, networkId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+// templateProblems returns the problems of an InvalidManifestTemplate error.
+func templateProblems(t *testing.T, err error) []string {
+	t.Helper()
+	var invalid *RadixEngineToolkitErrorInvalidManifestTemplate
+	if !errors.Is(err, ErrRadixEngineToolkitErrorInvalidManifestTemplate) || !errors.As(err, &invalid) {
+		t.Fatalf("error %v, want an InvalidManifestTemplate error", err)
+	}
+	problems := make([]string, len(invalid.Problems))
+	for index, problem := range invalid.Problems {
+		problems[index] = problem.String()
+	}
+	return problems
+}
+
+func TestParseManifestTemplate(t *testing.T) {
+	template, err := ParseManifestTemplate(templateText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, placeholder := range template.Placeholders() {
+		got = append(got, fmt.Sprintf("%s %s %v", placeholder.Name, placeholder.declaration(), placeholder.Positions))
+	}
+	want := []string{
+		"account Address<GlobalAccount> [2:5 8:13]",
+		"resource Address<GlobalFungibleResourceManager> [4:5 7:23]",
+		"amount Decimal [5:5]",
+		"bucket Bucket [7:75]",
+		"id NonFungibleLocalId [8:61]",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Placeholders =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{`CALL_METHOD ${account Address};`, []string{"1:23: expected \":\", found \"Address\""}},
+		{`CALL_METHOD ${amount: Integer};`, []string{"1:13: amount: unknown type Integer, expected Address, Decimal, NonFungibleLocalId or Bucket"}},
+		{`CALL_METHOD ${amount: Decimal<GlobalAccount>};`, []string{"1:13: amount: Decimal takes no type arguments"}},
+		{`CALL_METHOD ${account: Address<Account>};`, []string{"1:13: account: unknown entity type Account"}},
+		{
+			`CALL_METHOD ${a: Address} "x" ${a: Decimal} ${a: Address<GlobalAccount>};`,
+			[]string{"1:31: a: Decimal here, but Address at 1:13", "1:45: a: Address<GlobalAccount> here, but Address at 1:13"},
+		},
+	}
+	for _, test := range tests {
+		_, err := ParseManifestTemplate(test.text)
+		if got := templateProblems(t, err); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseManifestTemplate(%s) problems %q, want %q", test.text, got, test.want)
+		}
+	}
+}
+
+func TestManifestTemplatePlaceholderBind(t *testing.T) {
+	account := templateAddress(t, address.EntityTypeGlobalAccount, 1, 1)
+	resource := templateAddress(t, address.EntityTypeGlobalFungibleResourceManager, 1, 2)
+	anyAddress := ManifestTemplatePlaceholder{Name: "any", Type: ManifestTemplateAddress}
+	accountAddress := ManifestTemplatePlaceholder{Name: "account", Type: ManifestTemplateAddress, EntityTypes: []EntityType{EntityTypeGlobalAccount}}
+	decimal := ManifestTemplatePlaceholder{Name: "amount", Type: ManifestTemplateDecimal}
+	id := ManifestTemplatePlaceholder{Name: "id", Type: ManifestTemplateNonFungibleLocalId}
+	bucket := ManifestTemplatePlaceholder{Name: "bucket", Type: ManifestTemplateBucket}
+
+	tests := []struct {
+		placeholder ManifestTemplatePlaceholder
+		binding     any
+		want        string
+		reason      string
+	}{
+		{anyAddress, resource, fmt.Sprintf("Address(%q)", resource), ""},
+		{accountAddress, account, fmt.Sprintf("Address(%q)", account), ""},
+		{accountAddress, resource, "", fmt.Sprintf("expected an address of GlobalAccount, found %s of GlobalFungibleResourceManager", resource)},
+		{accountAddress, templateAddress(t, address.EntityTypeGlobalAccount, 2, 1), "", fmt.Sprintf("address %s of network 2, the manifest is on network 1", templateAddress(t, address.EntityTypeGlobalAccount, 2, 1))},
+		{accountAddress, (*Address)(nil), "", "nil *Address"},
+		{accountAddress, account.String(), "", "expected an *Address or an address.Address, found string"},
+		{decimal, mustDecimalValue(t, "10.5"), `Decimal("10.5")`, ""},
+		{decimal, (*Decimal)(nil), "", "nil *Decimal"},
+		{decimal, "10.5", "", "expected a *Decimal or a DecimalValue, found string"},
+		{id, NonFungibleLocalIdInteger{Value: 7}, `NonFungibleLocalId("#7#")`, ""},
+		{id, NonFungibleLocalIdStr{Value: "gold"}, `NonFungibleLocalId("<gold>")`, ""},
+		{id, NonFungibleLocalIdBytes{Value: []byte{1, 2}}, `NonFungibleLocalId("[0102]")`, ""},
+		{id, uint64(7), "", "expected a NonFungibleLocalId, found uint64"},
+		{bucket, "xrd", `Bucket("xrd")`, ""},
+		{bucket, "", "", "empty bucket name"},
+		{bucket, ManifestBuilderBucket{Name: "xrd"}, "", "expected the name of a bucket as a string, found radix_engine_toolkit_uniffi.ManifestBuilderBucket"},
+		{ManifestTemplatePlaceholder{Name: "other", Type: 9}, "x", "", "unknown type ManifestTemplateType(9)"},
+	}
+	for _, test := range tests {
+		value, reason := test.placeholder.bind(1, test.binding)
+		var got string
+		if value != nil {
+			got = fmt.Sprintf("%s(%q)", value.Text, value.Args[0].Text)
+		}
+		if got != test.want || reason != test.reason {
+			t.Errorf("bind of %s to %#v = %s, %q, want %s, %q", test.placeholder.declaration(), test.binding, got, reason, test.want, test.reason)
+		}
+	}
+}
+
+func TestManifestTemplateBindText(t *testing.T) {
+	template, err := ParseManifestTemplate(templateText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := templateAddress(t, address.EntityTypeGlobalAccount, 1, 1)
+	resource := templateAddress(t, address.EntityTypeGlobalFungibleResourceManager, 1, 2)
+
+	text, err := template.BindText(1, map[string]any{
+		"account":  account,
+		"resource": resource,
+		"amount":   mustDecimalValue(t, "10.5"),
+		"bucket":   "xrd",
+		"id":       NonFungibleLocalIdInteger{Value: 7},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf(`CALL_METHOD
+    Address("%[1]s")
+    "withdraw"
+    Address("%[2]s")
+    Decimal("10.5")
+;
+TAKE_ALL_FROM_WORKTOP
+    Address("%[2]s")
+    Bucket("xrd")
+;
+CALL_METHOD
+    Address("%[1]s")
+    "deposit_id"
+    NonFungibleLocalId("#7#")
+;
+`, account, resource)
+	if text != want {
+		t.Errorf("BindText =\n%s\nwant\n%s", text, want)
+	}
+
+	otherNetwork := templateAddress(t, address.EntityTypeGlobalAccount, 2, 1)
+	_, err = template.BindText(1, map[string]any{
+		"account":  otherNetwork,
+		"resource": account,
+		"amount":   "10.5",
+		"id":       NonFungibleLocalIdInteger{Value: 7},
+		"buckets":  "xrd",
+		"amounts":  mustDecimalValue(t, "1"),
+	})
+	wantProblems := []string{
+		fmt.Sprintf("2:5: account: address %s of network 2, the manifest is on network 1", otherNetwork),
+		fmt.Sprintf("4:5: resource: expected an address of GlobalFungibleResourceManager, found %s of GlobalAccount", account),
+		"5:5: amount: expected a *Decimal or a DecimalValue, found string",
+		"7:75: bucket: unbound Bucket placeholder",
+		"amounts: the template has no such placeholder",
+		"buckets: the template has no such placeholder",
+	}
+	if got := templateProblems(t, err); !reflect.DeepEqual(got, wantProblems) {
+		t.Errorf("BindText problems =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(wantProblems, "\n"))
+	}
+}
@@ -0,0 +1,568 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// Manifest value codec
+//
+// MarshalManifestBuilderValue converts Go values into the ManifestBuilderValue
+// trees taken by CallMethod and CallFunction, and UnmarshalManifestValue
+// converts the ManifestValue trees of decompiled instructions back into Go
+// values, much like encoding/json:
+//
+//   - bool, int8 to int64 and uint8 to uint64 are the integer values of the
+//     same width, int and uint being I64 and U64; big.Int is I128, or U128
+//     with the u128 tag option;
+//   - strings are String values and []byte an Array of U8;
+//   - slices and arrays are Arrays, maps are Maps;
+//   - structs are Tuples of their exported fields, in order;
+//   - *Decimal and DecimalValue are Decimals, *PreciseDecimal and
+//     PreciseDecimalValue PreciseDecimals;
+//   - *Address and address.Address are static addresses and
+//     ManifestBuilderNamedAddress a named one;
+//   - ManifestBuilderBucket, ManifestBuilderProof,
+//     ManifestBuilderAddressReservation, ManifestExpression, ManifestBlobRef
+//     and the NonFungibleLocalId variants are the values of the same name;
+//   - types implementing ManifestEnum are Enums, their discriminator being
+//     the one of the type and their fields the fields of the struct;
+//   - pointers are the values they point to, and ManifestBuilderValues are
+//     used as is.
+//
+// Decoding is the reverse, with buckets, proofs, address reservations and
+// addresses decoding into ManifestBucket, ManifestProof,
+// ManifestAddressReservation and ManifestAddress, or *Address and
+// address.Address for static addresses. Integers decode into any Go integer
+// type they fit in; an interface{} receives the ManifestValue as is.
+//
+// Struct fields are tagged with comma separated options under the manifest
+// key:
+//
+//	type Args struct {
+//		Amount  *Decimal
+//		Limit   *uint32  `manifest:"option"`
+//		Supply  *big.Int `manifest:"u128"`
+//		Ignored string   `manifest:"-"`
+//	}
+//
+// "-" skips the field, "option" encodes it as an Option: None (discriminator
+// 0) for a nil pointer or interface and Some (discriminator 1) otherwise,
+// and "u128" encodes a big.Int as a U128.
+
+// ManifestEnum is implemented by the Go types of enum variants. Variants of
+// the same enum implement a common interface, registered with
+// RegisterManifestEnum so that values can be decoded into it.
+type ManifestEnum interface {
+	ManifestEnumDiscriminator() uint8
+}
+
+var (
+	manifestEnumType      = reflect.TypeFor[ManifestEnum]()
+	manifestEnumsLock     sync.RWMutex
+	manifestEnumsVariants = map[reflect.Type]map[uint8]reflect.Type{}
+)
+
+// RegisterManifestEnum registers the variants of the enum interface T, each
+// of a distinct discriminator. Decoding an Enum into a T yields the variant
+// of its discriminator. RegisterManifestEnum panics if T is not an interface
+// or if a variant does not implement ManifestEnum.
+func RegisterManifestEnum[T any](variants ...T) {
+	enumType := reflect.TypeFor[T]()
+	if enumType.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("RegisterManifestEnum: %v is not an interface", enumType))
+	}
+	registered := make(map[uint8]reflect.Type, len(variants))
+	for _, variant := range variants {
+		enum, ok := any(variant).(ManifestEnum)
+		if !ok {
+			panic(fmt.Sprintf("RegisterManifestEnum: %T does not implement ManifestEnum", variant))
+		}
+		discriminator := enum.ManifestEnumDiscriminator()
+		if previous, ok := registered[discriminator]; ok {
+			panic(fmt.Sprintf("RegisterManifestEnum: %T and %v have the same discriminator %d", variant, previous, discriminator))
+		}
+		registered[discriminator] = reflect.TypeOf(variant)
+	}
+
+	manifestEnumsLock.Lock()
+	defer manifestEnumsLock.Unlock()
+	manifestEnumsVariants[enumType] = registered
+}
+
+// isManifestEnumType reports whether t, or a pointer to t, implements
+// ManifestEnum.
+func isManifestEnumType(t reflect.Type) bool {
+	return t.Implements(manifestEnumType) || (t.Kind() != reflect.Pointer && t.Kind() != reflect.Interface && reflect.PointerTo(t).Implements(manifestEnumType))
+}
+
+func manifestEnumVariant(enumType reflect.Type, discriminator uint8) (variant reflect.Type, registered bool, ok bool) {
+	manifestEnumsLock.RLock()
+	defer manifestEnumsLock.RUnlock()
+	variants, registered := manifestEnumsVariants[enumType]
+	variant, ok = variants[discriminator]
+	return variant, registered, ok
+}
+
+// MarshalManifestBuilderValue converts value into a ManifestBuilderValue.
+func MarshalManifestBuilderValue(value any) (ManifestBuilderValue, error) {
+	return encodeManifestBuilderValue(reflect.ValueOf(value), manifestFieldOptions{})
+}
+
+// MarshalManifestArgs converts each of args into a ManifestBuilderValue, for
+// the args of CallMethod, CallFunction and the like:
+//
+//	args, err := MarshalManifestArgs(resourceAddress, amount)
+//	builder, err = builder.CallMethod(account, "withdraw", args)
+func MarshalManifestArgs(args ...any) ([]ManifestBuilderValue, error) {
+	values := make([]ManifestBuilderValue, len(args))
+	for index, arg := range args {
+		value, err := MarshalManifestBuilderValue(arg)
+		if err != nil {
+			return nil, fmt.Errorf("args[%d]: %w", index, err)
+		}
+		values[index] = value
+	}
+	return values, nil
+}
+
+// MarshalManifestArgsStruct converts the fields of the struct args into
+// ManifestBuilderValues, for args which need tag options:
+//
+//	args, err := MarshalManifestArgsStruct(struct {
+//		Amount *big.Int `manifest:"u128"`
+//	}{amount})
+func MarshalManifestArgsStruct(args any) ([]ManifestBuilderValue, error) {
+	value := reflect.Indirect(reflect.ValueOf(args))
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("MarshalManifestArgsStruct: args must be a struct, not %T", args)
+	}
+	return encodeManifestFields(value)
+}
+
+type manifestFieldOptions struct {
+	skip   bool
+	option bool
+	u128   bool
+}
+
+func parseManifestFieldOptions(field reflect.StructField) (manifestFieldOptions, error) {
+	var options manifestFieldOptions
+	tag, ok := field.Tag.Lookup("manifest")
+	if !ok || tag == "" {
+		return options, nil
+	}
+	for _, option := range strings.Split(tag, ",") {
+		switch option {
+		case "-":
+			options.skip = true
+		case "option":
+			options.option = true
+		case "u128":
+			options.u128 = true
+		default:
+			return options, fmt.Errorf("%s: unknown manifest tag option %q", field.Name, option)
+		}
+	}
+	return options, nil
+}
+
+// manifestFields returns the indices and options of the fields of the struct
+// type t which are encoded.
+func manifestFields(t reflect.Type) ([]int, []manifestFieldOptions, error) {
+	var (
+		indices []int
+		options []manifestFieldOptions
+	)
+	for index := 0; index < t.NumField(); index++ {
+		field := t.Field(index)
+		if !field.IsExported() {
+			continue
+		}
+		fieldOptions, err := parseManifestFieldOptions(field)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%v.%w", t, err)
+		}
+		if fieldOptions.skip {
+			continue
+		}
+		indices = append(indices, index)
+		options = append(options, fieldOptions)
+	}
+	return indices, options, nil
+}
+
+var (
+	maxManifestI128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	minManifestI128 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	maxManifestU128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+)
+
+func encodeManifestBigInt(value *big.Int, u128 bool) (ManifestBuilderValue, error) {
+	if u128 {
+		if value.Sign() < 0 || value.Cmp(maxManifestU128) > 0 {
+			return nil, fmt.Errorf("%v overflows a U128", value)
+		}
+		return ManifestBuilderValueU128Value{Value: value.String()}, nil
+	}
+	if value.Cmp(minManifestI128) < 0 || value.Cmp(maxManifestI128) > 0 {
+		return nil, fmt.Errorf("%v overflows an I128", value)
+	}
+	return ManifestBuilderValueI128Value{Value: value.String()}, nil
+}
+
+func encodeManifestBuilderValue(value reflect.Value, options manifestFieldOptions) (ManifestBuilderValue, error) {
+	if options.option {
+		if !value.IsValid() || ((value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) && value.IsNil()) {
+			return ManifestBuilderValueEnumValue{Discriminator: 0}, nil
+		}
+		options.option = false
+		some, err := encodeManifestBuilderValue(value, options)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestBuilderValueEnumValue{Discriminator: 1, Fields: []ManifestBuilderValue{some}}, nil
+	}
+
+	if !value.IsValid() {
+		return nil, fmt.Errorf("nil value")
+	}
+	t := value.Type()
+	if (t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface) && value.IsNil() {
+		return nil, fmt.Errorf("nil %v", t)
+	}
+
+	switch leaf := value.Interface().(type) {
+	case *Decimal:
+		return ManifestBuilderValueDecimalValue{Value: leaf}, nil
+	case DecimalValue:
+		return ManifestBuilderValueDecimalValue{Value: leaf.ToDecimal()}, nil
+	case *PreciseDecimal:
+		return ManifestBuilderValuePreciseDecimalValue{Value: leaf}, nil
+	case PreciseDecimalValue:
+		return ManifestBuilderValuePreciseDecimalValue{Value: leaf.ToPreciseDecimal()}, nil
+	case *Address:
+		return ManifestBuilderValueAddressValue{Value: ManifestBuilderAddressStatic{Value: leaf}}, nil
+	case address.Address:
+		converted, err := AddressFromValue(leaf)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestBuilderValueAddressValue{Value: ManifestBuilderAddressStatic{Value: converted}}, nil
+	case ManifestBuilderNamedAddress:
+		return ManifestBuilderValueAddressValue{Value: ManifestBuilderAddressNamed{Value: leaf}}, nil
+	case ManifestBuilderAddressStatic:
+		return ManifestBuilderValueAddressValue{Value: leaf}, nil
+	case ManifestBuilderAddressNamed:
+		return ManifestBuilderValueAddressValue{Value: leaf}, nil
+	case ManifestBuilderBucket:
+		return ManifestBuilderValueBucketValue{Value: leaf}, nil
+	case ManifestBuilderProof:
+		return ManifestBuilderValueProofValue{Value: leaf}, nil
+	case ManifestBuilderAddressReservation:
+		return ManifestBuilderValueAddressReservationValue{Value: leaf}, nil
+	case ManifestExpression:
+		return ManifestBuilderValueExpressionValue{Value: leaf}, nil
+	case ManifestBlobRef:
+		return ManifestBuilderValueBlobValue{Value: leaf}, nil
+	case NonFungibleLocalIdInteger, NonFungibleLocalIdStr, NonFungibleLocalIdBytes, NonFungibleLocalIdRuid:
+		return ManifestBuilderValueNonFungibleLocalIdValue{Value: leaf.(NonFungibleLocalId)}, nil
+	case *big.Int:
+		return encodeManifestBigInt(leaf, options.u128)
+	case big.Int:
+		return encodeManifestBigInt(&leaf, options.u128)
+	case []byte:
+		elements := make([]ManifestBuilderValue, len(leaf))
+		for index, element := range leaf {
+			elements[index] = ManifestBuilderValueU8Value{Value: element}
+		}
+		return ManifestBuilderValueArrayValue{ElementValueKind: ManifestBuilderValueKindU8Value, Elements: elements}, nil
+	case ManifestEnum:
+		variant := reflect.Indirect(value)
+		if variant.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("enum variant %v is not a struct", t)
+		}
+		fields, err := encodeManifestFields(variant)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestBuilderValueEnumValue{Discriminator: leaf.ManifestEnumDiscriminator(), Fields: fields}, nil
+	}
+	if t.Kind() == reflect.Struct && isManifestEnumType(t) {
+		// The variant implements ManifestEnum with pointer receivers.
+		variant := reflect.New(t)
+		variant.Elem().Set(value)
+		return encodeManifestBuilderValue(variant, options)
+	}
+	if builderValue, ok := value.Interface().(ManifestBuilderValue); ok {
+		if _, ok := manifestBuilderValueKind(builderValue); ok {
+			return builderValue, nil
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return encodeManifestBuilderValue(value.Elem(), options)
+	case reflect.Bool:
+		return ManifestBuilderValueBoolValue{Value: value.Bool()}, nil
+	case reflect.Int8:
+		return ManifestBuilderValueI8Value{Value: int8(value.Int())}, nil
+	case reflect.Int16:
+		return ManifestBuilderValueI16Value{Value: int16(value.Int())}, nil
+	case reflect.Int32:
+		return ManifestBuilderValueI32Value{Value: int32(value.Int())}, nil
+	case reflect.Int64, reflect.Int:
+		return ManifestBuilderValueI64Value{Value: value.Int()}, nil
+	case reflect.Uint8:
+		return ManifestBuilderValueU8Value{Value: uint8(value.Uint())}, nil
+	case reflect.Uint16:
+		return ManifestBuilderValueU16Value{Value: uint16(value.Uint())}, nil
+	case reflect.Uint32:
+		return ManifestBuilderValueU32Value{Value: uint32(value.Uint())}, nil
+	case reflect.Uint64, reflect.Uint:
+		return ManifestBuilderValueU64Value{Value: value.Uint()}, nil
+	case reflect.String:
+		return ManifestBuilderValueStringValue{Value: value.String()}, nil
+	case reflect.Slice, reflect.Array:
+		elements := make([]ManifestBuilderValue, value.Len())
+		for index := range elements {
+			element, err := encodeManifestBuilderValue(value.Index(index), manifestFieldOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", index, err)
+			}
+			elements[index] = element
+		}
+		elementKind, err := manifestElementKind(t.Elem(), elements)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestBuilderValueArrayValue{ElementValueKind: elementKind, Elements: elements}, nil
+	case reflect.Map:
+		return encodeManifestMap(value)
+	case reflect.Struct:
+		fields, err := encodeManifestFields(value)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestBuilderValueTupleValue{Fields: fields}, nil
+	}
+	return nil, fmt.Errorf("%v has no manifest encoding", t)
+}
+
+func encodeManifestFields(value reflect.Value) ([]ManifestBuilderValue, error) {
+	t := value.Type()
+	indices, options, err := manifestFields(t)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]ManifestBuilderValue, len(indices))
+	for position, index := range indices {
+		field, err := encodeManifestBuilderValue(value.Field(index), options[position])
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t.Name(), t.Field(index).Name, err)
+		}
+		fields[position] = field
+	}
+	return fields, nil
+}
+
+func encodeManifestMap(value reflect.Value) (ManifestBuilderValue, error) {
+	t := value.Type()
+	keys := value.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return lessManifestMapKey(keys[i], keys[j]) })
+
+	entries := make([]ManifestBuilderMapEntry, len(keys))
+	encodedKeys := make([]ManifestBuilderValue, len(keys))
+	encodedValues := make([]ManifestBuilderValue, len(keys))
+	for index, key := range keys {
+		encodedKey, err := encodeManifestBuilderValue(key, manifestFieldOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("[%v]: %w", key, err)
+		}
+		encodedValue, err := encodeManifestBuilderValue(value.MapIndex(key), manifestFieldOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("[%v]: %w", key, err)
+		}
+		entries[index] = ManifestBuilderMapEntry{Key: encodedKey, Value: encodedValue}
+		encodedKeys[index] = encodedKey
+		encodedValues[index] = encodedValue
+	}
+	keyKind, err := manifestElementKind(t.Key(), encodedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("keys: %w", err)
+	}
+	valueKind, err := manifestElementKind(t.Elem(), encodedValues)
+	if err != nil {
+		return nil, fmt.Errorf("values: %w", err)
+	}
+	return ManifestBuilderValueMapValue{KeyValueKind: keyKind, ValueValueKind: valueKind, Entries: entries}, nil
+}
+
+// lessManifestMapKey orders map keys so that maps are encoded
+// deterministically.
+func lessManifestMapKey(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	}
+	return fmt.Sprint(a.Interface()) < fmt.Sprint(b.Interface())
+}
+
+// manifestElementKind returns the value kind of the elements of an array or
+// map, of the Go type t and encoded as elements. All elements must be of the
+// same kind; the kind of an empty array is derived from t.
+func manifestElementKind(t reflect.Type, elements []ManifestBuilderValue) (ManifestBuilderValueKind, error) {
+	if len(elements) == 0 {
+		kind, ok := manifestBuilderValueKindOfType(t)
+		if !ok {
+			return 0, fmt.Errorf("the value kind of an empty collection of %v is unknown", t)
+		}
+		return kind, nil
+	}
+	kind, _ := manifestBuilderValueKind(elements[0])
+	for index, element := range elements[1:] {
+		if elementKind, _ := manifestBuilderValueKind(element); elementKind != kind {
+			return 0, fmt.Errorf("[%d]: %v element among %v elements", index+1, elementKind, kind)
+		}
+	}
+	return kind, nil
+}
+
+var manifestLeafKinds = map[reflect.Type]ManifestBuilderValueKind{
+	reflect.TypeFor[*Decimal]():                          ManifestBuilderValueKindDecimalValue,
+	reflect.TypeFor[DecimalValue]():                      ManifestBuilderValueKindDecimalValue,
+	reflect.TypeFor[*PreciseDecimal]():                   ManifestBuilderValueKindPreciseDecimalValue,
+	reflect.TypeFor[PreciseDecimalValue]():               ManifestBuilderValueKindPreciseDecimalValue,
+	reflect.TypeFor[*Address]():                          ManifestBuilderValueKindAddressValue,
+	reflect.TypeFor[address.Address]():                   ManifestBuilderValueKindAddressValue,
+	reflect.TypeFor[ManifestBuilderNamedAddress]():       ManifestBuilderValueKindAddressValue,
+	reflect.TypeFor[ManifestBuilderAddress]():            ManifestBuilderValueKindAddressValue,
+	reflect.TypeFor[ManifestBuilderBucket]():             ManifestBuilderValueKindBucketValue,
+	reflect.TypeFor[ManifestBuilderProof]():              ManifestBuilderValueKindProofValue,
+	reflect.TypeFor[ManifestBuilderAddressReservation](): ManifestBuilderValueKindAddressReservationValue,
+	reflect.TypeFor[ManifestExpression]():                ManifestBuilderValueKindExpressionValue,
+	reflect.TypeFor[ManifestBlobRef]():                   ManifestBuilderValueKindBlobValue,
+	reflect.TypeFor[NonFungibleLocalId]():                ManifestBuilderValueKindNonFungibleLocalIdValue,
+	reflect.TypeFor[*big.Int]():                          ManifestBuilderValueKindI128Value,
+	reflect.TypeFor[big.Int]():                           ManifestBuilderValueKindI128Value,
+	reflect.TypeFor[[]byte]():                            ManifestBuilderValueKindArrayValue,
+}
+
+// manifestBuilderValueKindOfType returns the value kind the values of the Go
+// type t are encoded as, if it does not depend on the values.
+func manifestBuilderValueKindOfType(t reflect.Type) (ManifestBuilderValueKind, bool) {
+	if kind, ok := manifestLeafKinds[t]; ok {
+		return kind, true
+	}
+	if isManifestEnumType(t) {
+		return ManifestBuilderValueKindEnumValue, true
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return manifestBuilderValueKindOfType(t.Elem())
+	case reflect.Interface:
+		manifestEnumsLock.RLock()
+		defer manifestEnumsLock.RUnlock()
+		if _, ok := manifestEnumsVariants[t]; ok {
+			return ManifestBuilderValueKindEnumValue, true
+		}
+		return 0, false
+	case reflect.Bool:
+		return ManifestBuilderValueKindBoolValue, true
+	case reflect.Int8:
+		return ManifestBuilderValueKindI8Value, true
+	case reflect.Int16:
+		return ManifestBuilderValueKindI16Value, true
+	case reflect.Int32:
+		return ManifestBuilderValueKindI32Value, true
+	case reflect.Int64, reflect.Int:
+		return ManifestBuilderValueKindI64Value, true
+	case reflect.Uint8:
+		return ManifestBuilderValueKindU8Value, true
+	case reflect.Uint16:
+		return ManifestBuilderValueKindU16Value, true
+	case reflect.Uint32:
+		return ManifestBuilderValueKindU32Value, true
+	case reflect.Uint64, reflect.Uint:
+		return ManifestBuilderValueKindU64Value, true
+	case reflect.String:
+		return ManifestBuilderValueKindStringValue, true
+	case reflect.Slice, reflect.Array:
+		return ManifestBuilderValueKindArrayValue, true
+	case reflect.Map:
+		return ManifestBuilderValueKindMapValue, true
+	case reflect.Struct:
+		return ManifestBuilderValueKindTupleValue, true
+	}
+	return 0, false
+}
+
+// manifestBuilderValueKind returns the kind of value, or false if value is
+// not one of the ManifestBuilderValue variants.
+func manifestBuilderValueKind(value ManifestBuilderValue) (ManifestBuilderValueKind, bool) {
+	switch value.(type) {
+	case ManifestBuilderValueBoolValue:
+		return ManifestBuilderValueKindBoolValue, true
+	case ManifestBuilderValueI8Value:
+		return ManifestBuilderValueKindI8Value, true
+	case ManifestBuilderValueI16Value:
+		return ManifestBuilderValueKindI16Value, true
+	case ManifestBuilderValueI32Value:
+		return ManifestBuilderValueKindI32Value, true
+	case ManifestBuilderValueI64Value:
+		return ManifestBuilderValueKindI64Value, true
+	case ManifestBuilderValueI128Value:
+		return ManifestBuilderValueKindI128Value, true
+	case ManifestBuilderValueU8Value:
+		return ManifestBuilderValueKindU8Value, true
+	case ManifestBuilderValueU16Value:
+		return ManifestBuilderValueKindU16Value, true
+	case ManifestBuilderValueU32Value:
+		return ManifestBuilderValueKindU32Value, true
+	case ManifestBuilderValueU64Value:
+		return ManifestBuilderValueKindU64Value, true
+	case ManifestBuilderValueU128Value:
+		return ManifestBuilderValueKindU128Value, true
+	case ManifestBuilderValueStringValue:
+		return ManifestBuilderValueKindStringValue, true
+	case ManifestBuilderValueEnumValue:
+		return ManifestBuilderValueKindEnumValue, true
+	case ManifestBuilderValueArrayValue:
+		return ManifestBuilderValueKindArrayValue, true
+	case ManifestBuilderValueTupleValue:
+		return ManifestBuilderValueKindTupleValue, true
+	case ManifestBuilderValueMapValue:
+		return ManifestBuilderValueKindMapValue, true
+	case ManifestBuilderValueAddressValue:
+		return ManifestBuilderValueKindAddressValue, true
+	case ManifestBuilderValueBucketValue:
+		return ManifestBuilderValueKindBucketValue, true
+	case ManifestBuilderValueProofValue:
+		return ManifestBuilderValueKindProofValue, true
+	case ManifestBuilderValueExpressionValue:
+		return ManifestBuilderValueKindExpressionValue, true
+	case ManifestBuilderValueBlobValue:
+		return ManifestBuilderValueKindBlobValue, true
+	case ManifestBuilderValueDecimalValue:
+		return ManifestBuilderValueKindDecimalValue, true
+	case ManifestBuilderValuePreciseDecimalValue:
+		return ManifestBuilderValueKindPreciseDecimalValue, true
+	case ManifestBuilderValueNonFungibleLocalIdValue:
+		return ManifestBuilderValueKindNonFungibleLocalIdValue, true
+	case ManifestBuilderValueAddressReservationValue:
+		return ManifestBuilderValueKindAddressReservationValue, true
+	}
+	return 0, false
+}
@@ -0,0 +1,394 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// UnmarshalManifestValue decodes value into the Go value target points to,
+// the reverse of MarshalManifestBuilderValue.
+func UnmarshalManifestValue(value ManifestValue, target any) error {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Pointer || pointer.IsNil() {
+		return fmt.Errorf("UnmarshalManifestValue: target must be a non-nil pointer, not %T", target)
+	}
+	return decodeManifestValue(value, pointer.Elem(), manifestFieldOptions{})
+}
+
+// UnmarshalManifestArgs decodes the args of a CallMethod, CallFunction or
+// similar instruction, a Tuple, into targets, one per field:
+//
+//	var resourceAddress *Address
+//	var amount DecimalValue
+//	err := UnmarshalManifestArgs(instruction.Args, &resourceAddress, &amount)
+func UnmarshalManifestArgs(args ManifestValue, targets ...any) error {
+	tuple, ok := args.(ManifestValueTupleValue)
+	if !ok {
+		return fmt.Errorf("args are a %s, not a tuple", manifestValueKindName(args))
+	}
+	if len(tuple.Fields) != len(targets) {
+		return fmt.Errorf("%d args decoded into %d targets", len(tuple.Fields), len(targets))
+	}
+	for index, target := range targets {
+		if err := UnmarshalManifestValue(tuple.Fields[index], target); err != nil {
+			return fmt.Errorf("args[%d]: %w", index, err)
+		}
+	}
+	return nil
+}
+
+var (
+	manifestValueType              = reflect.TypeFor[ManifestValue]()
+	manifestAddressType            = reflect.TypeFor[ManifestAddress]()
+	manifestNonFungibleLocalIdType = reflect.TypeFor[NonFungibleLocalId]()
+)
+
+func decodeManifestValue(value ManifestValue, target reflect.Value, options manifestFieldOptions) error {
+	if options.option {
+		enum, ok := value.(ManifestValueEnumValue)
+		if !ok {
+			return fmt.Errorf("expected an option, found a %s", manifestValueKindName(value))
+		}
+		switch {
+		case enum.Discriminator == 0 && len(enum.Fields) == 0:
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		case enum.Discriminator == 1 && len(enum.Fields) == 1:
+			options.option = false
+			return decodeManifestValue(enum.Fields[0], target, options)
+		}
+		return fmt.Errorf("invalid option variant %d with %d fields", enum.Discriminator, len(enum.Fields))
+	}
+
+	t := target.Type()
+	switch t {
+	case manifestValueType:
+		target.Set(reflect.ValueOf(&value).Elem())
+		return nil
+	case manifestAddressType:
+		leaf, ok := value.(ManifestValueAddressValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		target.Set(reflect.ValueOf(&leaf.Value).Elem())
+		return nil
+	case manifestNonFungibleLocalIdType:
+		leaf, ok := value.(ManifestValueNonFungibleLocalIdValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		target.Set(reflect.ValueOf(&leaf.Value).Elem())
+		return nil
+	}
+
+	var decoded any
+	switch target.Interface().(type) {
+	case *Decimal, DecimalValue:
+		leaf, ok := value.(ManifestValueDecimalValue)
+		if !ok || leaf.Value == nil {
+			return manifestValueMismatch(value, t)
+		}
+		decoded = leaf.Value
+		if t == reflect.TypeFor[DecimalValue]() {
+			decoded = leaf.Value.Value()
+		}
+	case *PreciseDecimal, PreciseDecimalValue:
+		leaf, ok := value.(ManifestValuePreciseDecimalValue)
+		if !ok || leaf.Value == nil {
+			return manifestValueMismatch(value, t)
+		}
+		decoded = leaf.Value
+		if t == reflect.TypeFor[PreciseDecimalValue]() {
+			decoded = leaf.Value.Value()
+		}
+	case *Address, address.Address:
+		leaf, ok := value.(ManifestValueAddressValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		static, ok := leaf.Value.(ManifestAddressStatic)
+		if !ok {
+			if named, ok := leaf.Value.(ManifestAddressNamed); ok {
+				return fmt.Errorf("named address %d decoded into %v", named.NamedAddressId, t)
+			}
+			return manifestValueMismatch(value, t)
+		}
+		decoded = static.StaticAddress
+		if t == reflect.TypeFor[address.Address]() {
+			converted, err := static.StaticAddress.Value()
+			if err != nil {
+				return err
+			}
+			decoded = converted
+		}
+	case ManifestBucket:
+		leaf, ok := value.(ManifestValueBucketValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		decoded = leaf.Value
+	case ManifestProof:
+		leaf, ok := value.(ManifestValueProofValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		decoded = leaf.Value
+	case ManifestAddressReservation:
+		leaf, ok := value.(ManifestValueAddressReservationValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		decoded = leaf.Value
+	case ManifestExpression:
+		leaf, ok := value.(ManifestValueExpressionValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		decoded = leaf.Value
+	case ManifestBlobRef:
+		leaf, ok := value.(ManifestValueBlobValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		decoded = leaf.Value
+	case *big.Int, big.Int:
+		integer, err := manifestValueBigInt(value)
+		if err != nil {
+			return err
+		}
+		if t.Kind() == reflect.Pointer {
+			decoded = integer
+		} else {
+			decoded = *integer
+		}
+	case []byte:
+		array, ok := value.(ManifestValueArrayValue)
+		if !ok || array.ElementValueKind != ManifestValueKindU8Value {
+			return manifestValueMismatch(value, t)
+		}
+		bytes := make([]byte, len(array.Elements))
+		for index, element := range array.Elements {
+			u8, ok := element.(ManifestValueU8Value)
+			if !ok {
+				return fmt.Errorf("[%d]: %w", index, manifestValueMismatch(element, reflect.TypeFor[byte]()))
+			}
+			bytes[index] = u8.Value
+		}
+		decoded = bytes
+	}
+	if decoded != nil {
+		target.Set(reflect.ValueOf(decoded).Convert(t))
+		return nil
+	}
+
+	if t.Kind() != reflect.Interface && isManifestEnumType(t) {
+		return decodeManifestEnumVariant(value, target)
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if target.IsNil() {
+			target.Set(reflect.New(t.Elem()))
+		}
+		return decodeManifestValue(value, target.Elem(), options)
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			target.Set(reflect.ValueOf(&value).Elem())
+			return nil
+		}
+		return decodeManifestEnum(value, target)
+	case reflect.Bool:
+		leaf, ok := value.(ManifestValueBoolValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		target.SetBool(leaf.Value)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		integer, err := manifestValueBigInt(value)
+		if err != nil {
+			return err
+		}
+		if !integer.IsInt64() || target.OverflowInt(integer.Int64()) {
+			return fmt.Errorf("%v overflows %v", integer, t)
+		}
+		target.SetInt(integer.Int64())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		integer, err := manifestValueBigInt(value)
+		if err != nil {
+			return err
+		}
+		if !integer.IsUint64() || target.OverflowUint(integer.Uint64()) {
+			return fmt.Errorf("%v overflows %v", integer, t)
+		}
+		target.SetUint(integer.Uint64())
+		return nil
+	case reflect.String:
+		leaf, ok := value.(ManifestValueStringValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		target.SetString(leaf.Value)
+		return nil
+	case reflect.Slice, reflect.Array:
+		array, ok := value.(ManifestValueArrayValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		if t.Kind() == reflect.Array {
+			if len(array.Elements) != t.Len() {
+				return fmt.Errorf("array of %d elements decoded into %v", len(array.Elements), t)
+			}
+		} else {
+			target.Set(reflect.MakeSlice(t, len(array.Elements), len(array.Elements)))
+		}
+		for index, element := range array.Elements {
+			if err := decodeManifestValue(element, target.Index(index), manifestFieldOptions{}); err != nil {
+				return fmt.Errorf("[%d]: %w", index, err)
+			}
+		}
+		return nil
+	case reflect.Map:
+		mapValue, ok := value.(ManifestValueMapValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		decodedMap := reflect.MakeMapWithSize(t, len(mapValue.Entries))
+		for index, entry := range mapValue.Entries {
+			key := reflect.New(t.Key()).Elem()
+			if err := decodeManifestValue(entry.Key, key, manifestFieldOptions{}); err != nil {
+				return fmt.Errorf("entries[%d].key: %w", index, err)
+			}
+			element := reflect.New(t.Elem()).Elem()
+			if err := decodeManifestValue(entry.Value, element, manifestFieldOptions{}); err != nil {
+				return fmt.Errorf("entries[%d].value: %w", index, err)
+			}
+			decodedMap.SetMapIndex(key, element)
+		}
+		target.Set(decodedMap)
+		return nil
+	case reflect.Struct:
+		tuple, ok := value.(ManifestValueTupleValue)
+		if !ok {
+			return manifestValueMismatch(value, t)
+		}
+		return decodeManifestFields(tuple.Fields, target)
+	}
+	return fmt.Errorf("%v has no manifest encoding", t)
+}
+
+func decodeManifestFields(fields []ManifestValue, target reflect.Value) error {
+	t := target.Type()
+	indices, options, err := manifestFields(t)
+	if err != nil {
+		return err
+	}
+	if len(fields) != len(indices) {
+		return fmt.Errorf("%d fields decoded into %v, which has %d", len(fields), t, len(indices))
+	}
+	for position, index := range indices {
+		if err := decodeManifestValue(fields[position], target.Field(index), options[position]); err != nil {
+			return fmt.Errorf("%s.%s: %w", t.Name(), t.Field(index).Name, err)
+		}
+	}
+	return nil
+}
+
+// decodeManifestEnumVariant decodes an Enum into the variant type of target,
+// which must have the discriminator of the Enum.
+func decodeManifestEnumVariant(value ManifestValue, target reflect.Value) error {
+	t := target.Type()
+	enum, ok := value.(ManifestValueEnumValue)
+	if !ok {
+		return manifestValueMismatch(value, t)
+	}
+	if t.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			target.Set(reflect.New(t.Elem()))
+		}
+		target = target.Elem()
+	}
+	if target.Kind() != reflect.Struct {
+		return fmt.Errorf("enum variant %v is not a struct", t)
+	}
+	if discriminator := target.Addr().Interface().(ManifestEnum).ManifestEnumDiscriminator(); discriminator != enum.Discriminator {
+		return fmt.Errorf("enum variant %d decoded into %v, the variant %d", enum.Discriminator, t, discriminator)
+	}
+	return decodeManifestFields(enum.Fields, target)
+}
+
+// decodeManifestEnum decodes an Enum into the registered variant of the enum
+// interface type of target.
+func decodeManifestEnum(value ManifestValue, target reflect.Value) error {
+	t := target.Type()
+	enum, ok := value.(ManifestValueEnumValue)
+	if !ok {
+		return manifestValueMismatch(value, t)
+	}
+	variantType, registered, ok := manifestEnumVariant(t, enum.Discriminator)
+	if !registered {
+		return fmt.Errorf("%v is not a registered enum", t)
+	}
+	if !ok {
+		return fmt.Errorf("%v has no variant %d", t, enum.Discriminator)
+	}
+	variant := reflect.New(variantType).Elem()
+	if err := decodeManifestEnumVariant(value, variant); err != nil {
+		return err
+	}
+	target.Set(variant)
+	return nil
+}
+
+// manifestValueBigInt returns the integer held by any of the integer values.
+func manifestValueBigInt(value ManifestValue) (*big.Int, error) {
+	switch leaf := value.(type) {
+	case ManifestValueI8Value:
+		return big.NewInt(int64(leaf.Value)), nil
+	case ManifestValueI16Value:
+		return big.NewInt(int64(leaf.Value)), nil
+	case ManifestValueI32Value:
+		return big.NewInt(int64(leaf.Value)), nil
+	case ManifestValueI64Value:
+		return big.NewInt(leaf.Value), nil
+	case ManifestValueU8Value:
+		return new(big.Int).SetUint64(uint64(leaf.Value)), nil
+	case ManifestValueU16Value:
+		return new(big.Int).SetUint64(uint64(leaf.Value)), nil
+	case ManifestValueU32Value:
+		return new(big.Int).SetUint64(uint64(leaf.Value)), nil
+	case ManifestValueU64Value:
+		return new(big.Int).SetUint64(leaf.Value), nil
+	case ManifestValueI128Value:
+		integer, ok := new(big.Int).SetString(leaf.Value, 10)
+		if !ok {
+			return nil, NewRadixEngineToolkitErrorParseError("I128", leaf.Value)
+		}
+		return integer, nil
+	case ManifestValueU128Value:
+		integer, ok := new(big.Int).SetString(leaf.Value, 10)
+		if !ok {
+			return nil, NewRadixEngineToolkitErrorParseError("U128", leaf.Value)
+		}
+		return integer, nil
+	}
+	return nil, fmt.Errorf("expected an integer, found a %s", manifestValueKindName(value))
+}
+
+func manifestValueMismatch(value ManifestValue, t reflect.Type) error {
+	return fmt.Errorf("%s decoded into %v", manifestValueKindName(value), t)
+}
+
+// manifestValueKindName returns the name of the variant of value, e.g.
+// "TupleValue".
+func manifestValueKindName(value ManifestValue) string {
+	if value == nil {
+		return "nil value"
+	}
+	return strings.TrimPrefix(reflect.TypeOf(value).Name(), "ManifestValue")
+}
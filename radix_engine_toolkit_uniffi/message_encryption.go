@@ -0,0 +1,577 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/internal/blake2b"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// Message encryption
+//
+// An encrypted message holds the Manifest SBOR encoding of its
+// MessageContents encrypted with AES-GCM under a random message key (128
+// bit for V1, 256 bit for V2), as nonce || ciphertext || tag. For every
+// curve of the recipients a fresh ephemeral key pair is generated, whose
+// public key is the DhEphemeralPublicKey of the curve. The message key is
+// wrapped for each recipient with RFC 3394 AES-KeyWrap under a key
+// encryption key derived with HKDF-SHA256 from the static Diffie-Hellman
+// secret of the ephemeral key and the recipient's key, salted with
+// messageKdfSalt and bound to both public keys through the HKDF info.
+// Ed25519 keys take part in the key agreement through their X25519 form;
+// for secp256k1 the secret is the x coordinate of the shared point,
+// computed in constant time (see secp256k1.go). Recipients are found by the
+// fingerprint of their public key, the last 8 bytes of its Blake2b-256
+// hash.
+//
+// The Diffie-Hellman keys are ephemeral whoever the sender is: the sender
+// passed to EncryptMessageV1 and EncryptMessageV2 is only added to the
+// recipients, so that it can read the message again.
+
+var (
+	ErrMessageNotEncrypted      = errors.New("message is not encrypted")
+	ErrNoDecryptorForPrivateKey = errors.New("message has no decryptor for the private key")
+	ErrMessageDecryptionFailed  = errors.New("message decryption failed")
+)
+
+const (
+	messageContentsStr   uint8 = 0
+	messageContentsBytes uint8 = 1
+
+	aesGcmNonceLength   = 12
+	fingerprintLength   = 8
+	messageKeyLengthV1  = 16
+	messageKeyLengthV2  = 32
+	keyEncryptionLength = 32
+	x25519KeyLength     = 32
+	secp256k1KeyLength  = 32
+	aesKeyWrapBlockSize = 8
+)
+
+// messageKdfSalt is the HKDF salt of the key encryption keys.
+var messageKdfSalt = []byte("RadixEncryptedMessageKeyWrap")
+
+// EncryptMessageV1 encrypts plaintext for recipients and sender, if not nil,
+// returning a MessageV1Encrypted to be passed to
+// TransactionV1BuilderMessageStep.Message.
+func EncryptMessageV1(plaintext MessageContentV1, sender *PrivateKey, recipients []PublicKey) (MessageV1, error) {
+	var contents []byte
+	var err error
+	switch plaintext := plaintext.(type) {
+	case MessageContentV1Str:
+		contents, err = encodeMessageContents(messageContentsStr, []byte(plaintext.Value))
+	case MessageContentV1Bytes:
+		contents, err = encodeMessageContents(messageContentsBytes, plaintext.Value)
+	default:
+		err = fmt.Errorf("invalid message contents %v", plaintext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	message, err := encryptMessage(contents, messageKeyLengthV1, withSender(sender, recipients))
+	if err != nil {
+		return nil, err
+	}
+	encrypted := EncryptedMessageV1{
+		Encrypted:         message.encrypted,
+		DecryptorsByCurve: map[CurveTypeV1]DecryptorsByCurveV1{},
+	}
+	for curve, group := range message.groups {
+		decryptors := map[PublicKeyFingerprintV1][]byte{}
+		for fingerprint, wrappedKey := range group.decryptors {
+			decryptors[PublicKeyFingerprintV1{Bytes: HashableBytes(fingerprint)}] = wrappedKey
+		}
+		switch curve {
+		case CurveSecp256k1:
+			encrypted.DecryptorsByCurve[CurveTypeV1Secp256k1] = DecryptorsByCurveV1Secp256k1{
+				DhEphemeralPublicKey: Secp256k1PublicKey{Value: group.dhPublicKey},
+				Decryptors:           decryptors,
+			}
+		case CurveEd25519:
+			encrypted.DecryptorsByCurve[CurveTypeV1Ed25519] = DecryptorsByCurveV1Ed25519{
+				DhEphemeralPublicKey: Ed25519PublicKey{Value: group.dhPublicKey},
+				Decryptors:           decryptors,
+			}
+		}
+	}
+	return MessageV1Encrypted{Value: encrypted}, nil
+}
+
+// DecryptMessageV1 decrypts a MessageV1Encrypted with the private key of one
+// of its recipients.
+func DecryptMessageV1(message MessageV1, recipient *PrivateKey) (MessageContentV1, error) {
+	encrypted, ok := message.(MessageV1Encrypted)
+	if !ok {
+		return nil, ErrMessageNotEncrypted
+	}
+	key := PublicKeyFingerprintV1{Bytes: HashableBytes(publicKeyFingerprint(recipient.PublicKeyBytes()))}
+
+	var dhPublicKey, wrappedKey []byte
+	switch recipient.Curve() {
+	case CurveSecp256k1:
+		decryptors, ok := encrypted.Value.DecryptorsByCurve[CurveTypeV1Secp256k1].(DecryptorsByCurveV1Secp256k1)
+		if !ok {
+			return nil, ErrNoDecryptorForPrivateKey
+		}
+		dhPublicKey, wrappedKey = decryptors.DhEphemeralPublicKey.Value, decryptors.Decryptors[key]
+	case CurveEd25519:
+		decryptors, ok := encrypted.Value.DecryptorsByCurve[CurveTypeV1Ed25519].(DecryptorsByCurveV1Ed25519)
+		if !ok {
+			return nil, ErrNoDecryptorForPrivateKey
+		}
+		dhPublicKey, wrappedKey = decryptors.DhEphemeralPublicKey.Value, decryptors.Decryptors[key]
+	}
+	if wrappedKey == nil {
+		return nil, ErrNoDecryptorForPrivateKey
+	}
+
+	contents, err := decryptMessage(encrypted.Value.Encrypted, dhPublicKey, wrappedKey, messageKeyLengthV1, recipient.Curve(), recipient.Raw(), recipient.PublicKeyBytes())
+	if err != nil {
+		return nil, err
+	}
+	discriminator, value, err := decodeMessageContents(contents)
+	if err != nil {
+		return nil, err
+	}
+	if discriminator == messageContentsStr {
+		return MessageContentV1Str{Value: string(value)}, nil
+	}
+	return MessageContentV1Bytes{Value: value}, nil
+}
+
+// EncryptMessageV2 encrypts plaintext for recipients and sender, if not nil,
+// returning a MessageV2Encrypted to be passed to the V2 builders' Message
+// methods.
+func EncryptMessageV2(plaintext MessageContentsV2, sender *PrivateKey, recipients []PublicKey) (MessageV2, error) {
+	var contents []byte
+	var err error
+	switch plaintext := plaintext.(type) {
+	case MessageContentsV2Str:
+		contents, err = encodeMessageContents(messageContentsStr, []byte(plaintext.Value))
+	case MessageContentsV2Bytes:
+		contents, err = encodeMessageContents(messageContentsBytes, plaintext.Value)
+	default:
+		err = fmt.Errorf("invalid message contents %v", plaintext)
+	}
+	if err != nil {
+		return nil, err
+	}
+	message, err := encryptMessage(contents, messageKeyLengthV2, withSender(sender, recipients))
+	if err != nil {
+		return nil, err
+	}
+	encrypted := EncryptedMessageV2{
+		Encrypted:         message.encrypted,
+		DecryptorsByCurve: map[CurveTypeV2]DecryptorsByCurveV2{},
+	}
+	for curve, group := range message.groups {
+		decryptors := map[PublicKeyFingerprint][]byte{}
+		for fingerprint, wrappedKey := range group.decryptors {
+			decryptors[PublicKeyFingerprint{Bytes: HashableBytes(fingerprint)}] = wrappedKey
+		}
+		switch curve {
+		case CurveSecp256k1:
+			encrypted.DecryptorsByCurve[CurveTypeV2Secp256k1] = DecryptorsByCurveV2Secp256k1{
+				DhEphemeralPublicKey: Secp256k1PublicKey{Value: group.dhPublicKey},
+				Decryptors:           decryptors,
+			}
+		case CurveEd25519:
+			encrypted.DecryptorsByCurve[CurveTypeV2Ed25519] = DecryptorsByCurveV2Ed25519{
+				DhEphemeralPublicKey: Ed25519PublicKey{Value: group.dhPublicKey},
+				Decryptors:           decryptors,
+			}
+		}
+	}
+	return MessageV2Encrypted{Value: encrypted}, nil
+}
+
+// DecryptMessageV2 decrypts a MessageV2Encrypted with the private key of one
+// of its recipients.
+func DecryptMessageV2(message MessageV2, recipient *PrivateKey) (MessageContentsV2, error) {
+	encrypted, ok := message.(MessageV2Encrypted)
+	if !ok {
+		return nil, ErrMessageNotEncrypted
+	}
+	key := PublicKeyFingerprint{Bytes: HashableBytes(publicKeyFingerprint(recipient.PublicKeyBytes()))}
+
+	var dhPublicKey, wrappedKey []byte
+	switch recipient.Curve() {
+	case CurveSecp256k1:
+		decryptors, ok := encrypted.Value.DecryptorsByCurve[CurveTypeV2Secp256k1].(DecryptorsByCurveV2Secp256k1)
+		if !ok {
+			return nil, ErrNoDecryptorForPrivateKey
+		}
+		dhPublicKey, wrappedKey = decryptors.DhEphemeralPublicKey.Value, decryptors.Decryptors[key]
+	case CurveEd25519:
+		decryptors, ok := encrypted.Value.DecryptorsByCurve[CurveTypeV2Ed25519].(DecryptorsByCurveV2Ed25519)
+		if !ok {
+			return nil, ErrNoDecryptorForPrivateKey
+		}
+		dhPublicKey, wrappedKey = decryptors.DhEphemeralPublicKey.Value, decryptors.Decryptors[key]
+	}
+	if wrappedKey == nil {
+		return nil, ErrNoDecryptorForPrivateKey
+	}
+
+	contents, err := decryptMessage(encrypted.Value.Encrypted, dhPublicKey, wrappedKey, messageKeyLengthV2, recipient.Curve(), recipient.Raw(), recipient.PublicKeyBytes())
+	if err != nil {
+		return nil, err
+	}
+	discriminator, value, err := decodeMessageContents(contents)
+	if err != nil {
+		return nil, err
+	}
+	if discriminator == messageContentsStr {
+		return MessageContentsV2Str{Value: string(value)}, nil
+	}
+	return MessageContentsV2Bytes{Value: value}, nil
+}
+
+// withSender returns the recipients of a message, the public key of sender
+// included.
+func withSender(sender *PrivateKey, recipients []PublicKey) []PublicKey {
+	if sender == nil {
+		return recipients
+	}
+	return append(slices.Clip(recipients), sender.PublicKey())
+}
+
+// encodeMessageContents returns the Manifest SBOR payload of the
+// MessageContents enum, String(String) or Bytes(Vec<u8>).
+func encodeMessageContents(discriminator uint8, value []byte) ([]byte, error) {
+	field := sbor.Value{Kind: sbor.KindString, String: string(value)}
+	if discriminator == messageContentsBytes {
+		field = sbor.Value{Kind: sbor.KindArray, ElementKind: sbor.KindU8, Elements: make([]sbor.Value, len(value))}
+		for index, b := range value {
+			field.Elements[index] = sbor.Value{Kind: sbor.KindU8, Int: big.NewInt(int64(b))}
+		}
+	}
+	return sbor.Encode(sbor.Value{Kind: sbor.KindEnum, Discriminator: discriminator, Elements: []sbor.Value{field}}, sbor.Manifest)
+}
+
+// decodeMessageContents is the reverse of encodeMessageContents.
+func decodeMessageContents(payload []byte) (uint8, []byte, error) {
+	value, err := sbor.Decode(payload, sbor.Manifest)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrMessageDecryptionFailed, err)
+	}
+	if value.Kind == sbor.KindEnum && len(value.Elements) == 1 {
+		switch field := value.Elements[0]; {
+		case value.Discriminator == messageContentsStr && field.Kind == sbor.KindString:
+			return messageContentsStr, []byte(field.String), nil
+		case value.Discriminator == messageContentsBytes:
+			if bytes, ok := field.Bytes(); ok {
+				return messageContentsBytes, bytes, nil
+			}
+		}
+	}
+	return 0, nil, fmt.Errorf("%w: invalid message contents", ErrMessageDecryptionFailed)
+}
+
+// encryptedMessage is the version independent form of an encrypted message.
+type encryptedMessage struct {
+	encrypted []byte
+	groups    map[Curve]*decryptorGroup
+}
+
+type decryptorGroup struct {
+	dhPrivateKey []byte
+	dhPublicKey  []byte
+	// wrapped message keys by public key fingerprint
+	decryptors map[string][]byte
+}
+
+func encryptMessage(plaintext []byte, keyLength int, recipients []PublicKey) (encryptedMessage, error) {
+	if len(recipients) == 0 {
+		return encryptedMessage{}, errors.New("an encrypted message needs at least one recipient")
+	}
+	messageKey := make([]byte, keyLength)
+	if _, err := rand.Read(messageKey); err != nil {
+		return encryptedMessage{}, err
+	}
+	encrypted, err := aesGcmSeal(messageKey, plaintext)
+	if err != nil {
+		return encryptedMessage{}, err
+	}
+
+	message := encryptedMessage{encrypted: encrypted, groups: map[Curve]*decryptorGroup{}}
+	for _, recipient := range recipients {
+		curve, recipientKey, err := publicKeyParts(recipient)
+		if err != nil {
+			return encryptedMessage{}, err
+		}
+		group, ok := message.groups[curve]
+		if !ok {
+			if group, err = newDecryptorGroup(curve); err != nil {
+				return encryptedMessage{}, err
+			}
+			message.groups[curve] = group
+		}
+		kek, err := keyEncryptionKey(curve, group.dhPrivateKey, recipientKey, group.dhPublicKey, recipientKey)
+		if err != nil {
+			return encryptedMessage{}, err
+		}
+		wrappedKey, err := aesKeyWrap(kek, messageKey)
+		if err != nil {
+			return encryptedMessage{}, err
+		}
+		group.decryptors[string(publicKeyFingerprint(recipientKey))] = wrappedKey
+	}
+	return message, nil
+}
+
+func decryptMessage(encrypted, dhPublicKey, wrappedKey []byte, keyLength int, curve Curve, privateKey, publicKey []byte) ([]byte, error) {
+	kek, err := keyEncryptionKey(curve, privateKey, dhPublicKey, dhPublicKey, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	messageKey, err := aesKeyUnwrap(kek, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(messageKey) != keyLength {
+		return nil, fmt.Errorf("%w: unexpected message key length %d", ErrMessageDecryptionFailed, len(messageKey))
+	}
+	return aesGcmOpen(messageKey, encrypted)
+}
+
+// newDecryptorGroup generates the ephemeral key pair of the recipients on
+// curve.
+func newDecryptorGroup(curve Curve) (*decryptorGroup, error) {
+	group := &decryptorGroup{decryptors: map[string][]byte{}}
+	switch curve {
+	case CurveEd25519:
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		group.dhPrivateKey, group.dhPublicKey = privateKey.Seed(), publicKey
+	case CurveSecp256k1:
+		privateKey, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		group.dhPrivateKey = privateKey.Serialize()
+		privateKey.Zero()
+		if group.dhPublicKey, err = secp256k1PublicKey(group.dhPrivateKey); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported curve %d", curve)
+	}
+	return group, nil
+}
+
+func publicKeyParts(publicKey PublicKey) (Curve, []byte, error) {
+	if err := validatePublicKey(publicKey); err != nil {
+		return 0, nil, err
+	}
+	switch publicKey := publicKey.(type) {
+	case PublicKeySecp256k1:
+		return CurveSecp256k1, publicKey.Value, nil
+	case PublicKeyEd25519:
+		return CurveEd25519, publicKey.Value, nil
+	}
+	return 0, nil, fmt.Errorf("invalid public key %v", publicKey)
+}
+
+func publicKeyFingerprint(publicKey []byte) []byte {
+	digest := blake2b.Sum256(publicKey)
+	return digest[len(digest)-fingerprintLength:]
+}
+
+// keyEncryptionKey derives the AES-KeyWrap key of a decryptor from the
+// Diffie-Hellman secret of privateKey and publicKey, the HKDF info being the
+// DH ephemeral public key followed by the recipient's public key.
+func keyEncryptionKey(curve Curve, privateKey, publicKey, dhPublicKey, recipientKey []byte) ([]byte, error) {
+	secret, err := diffieHellman(curve, privateKey, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	info := append(append([]byte{}, dhPublicKey...), recipientKey...)
+	return hkdfSha256(secret, messageKdfSalt, info, keyEncryptionLength), nil
+}
+
+// hkdfSha256 is the HKDF of RFC 5869 with SHA-256.
+func hkdfSha256(secret, salt, info []byte, length int) []byte {
+	extract := hmac.New(sha256.New, salt)
+	extract.Write(secret)
+	expand := hmac.New(sha256.New, extract.Sum(nil))
+	var output, block []byte
+	for counter := byte(1); len(output) < length; counter++ {
+		expand.Reset()
+		expand.Write(block)
+		expand.Write(info)
+		expand.Write([]byte{counter})
+		block = expand.Sum(nil)
+		output = append(output, block...)
+	}
+	return output[:length]
+}
+
+// diffieHellman returns the shared secret of a raw private key and the public
+// key of the other party, both on curve.
+func diffieHellman(curve Curve, privateKey, publicKey []byte) ([]byte, error) {
+	switch curve {
+	case CurveSecp256k1:
+		return secp256k1SharedSecret(privateKey, publicKey)
+	case CurveEd25519:
+		if len(privateKey) != ed25519.SeedSize {
+			return nil, fmt.Errorf("invalid ed25519 private key length %d", len(privateKey))
+		}
+		digest := sha512.Sum512(privateKey)
+		x25519PrivateKey, err := ecdh.X25519().NewPrivateKey(digest[:x25519KeyLength])
+		if err != nil {
+			return nil, err
+		}
+		montgomery, err := ed25519PublicKeyToX25519(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		x25519PublicKey, err := ecdh.X25519().NewPublicKey(montgomery)
+		if err != nil {
+			return nil, err
+		}
+		return x25519PrivateKey.ECDH(x25519PublicKey)
+	}
+	return nil, fmt.Errorf("unsupported curve %d", curve)
+}
+
+var curve25519P = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+
+// ed25519PublicKeyToX25519 maps an Ed25519 public key onto the birationally
+// equivalent Montgomery curve, u = (1 + y) / (1 - y).
+func ed25519PublicKeyToX25519(publicKey []byte) ([]byte, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key length %d", len(publicKey))
+	}
+	littleEndian := make([]byte, len(publicKey))
+	copy(littleEndian, publicKey)
+	littleEndian[31] &= 0x7f
+	y := new(big.Int).SetBytes(reverseBytes(littleEndian))
+	if y.Cmp(curve25519P) >= 0 {
+		return nil, errors.New("invalid ed25519 public key")
+	}
+	denominator := new(big.Int).Sub(big.NewInt(1), y)
+	denominator.Mod(denominator, curve25519P)
+	if denominator.Sign() == 0 {
+		return nil, errors.New("invalid ed25519 public key")
+	}
+	u := new(big.Int).Add(big.NewInt(1), y)
+	u.Mul(u, denominator.ModInverse(denominator, curve25519P)).Mod(u, curve25519P)
+	return reverseBytes(u.FillBytes(make([]byte, x25519KeyLength))), nil
+}
+
+func reverseBytes(value []byte) []byte {
+	for i, j := 0, len(value)-1; i < j; i, j = i+1, j-1 {
+		value[i], value[j] = value[j], value[i]
+	}
+	return value
+}
+
+func aesGcmSeal(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aesGcmNonceLength)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func aesGcmOpen(key, payload []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) < aesGcmNonceLength+gcm.Overhead() {
+		return nil, fmt.Errorf("%w: encrypted payload too short", ErrMessageDecryptionFailed)
+	}
+	plaintext, err := gcm.Open(nil, payload[:aesGcmNonceLength], payload[aesGcmNonceLength:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMessageDecryptionFailed, err)
+	}
+	return plaintext, nil
+}
+
+var aesKeyWrapIV = []byte{0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6, 0xa6}
+
+// aesKeyWrap wraps key with kek following RFC 3394.
+func aesKeyWrap(kek, key []byte) ([]byte, error) {
+	if len(key)%aesKeyWrapBlockSize != 0 || len(key) < 2*aesKeyWrapBlockSize {
+		return nil, fmt.Errorf("invalid key length %d for key wrapping", len(key))
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	n := len(key) / aesKeyWrapBlockSize
+	a := append([]byte{}, aesKeyWrapIV...)
+	r := append([]byte{}, key...)
+	buffer := make([]byte, aes.BlockSize)
+	for j := 0; j < 6; j++ {
+		for i := 0; i < n; i++ {
+			copy(buffer, a)
+			copy(buffer[aesKeyWrapBlockSize:], r[i*aesKeyWrapBlockSize:])
+			block.Encrypt(buffer, buffer)
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(a, binary.BigEndian.Uint64(buffer[:aesKeyWrapBlockSize])^t)
+			copy(r[i*aesKeyWrapBlockSize:], buffer[aesKeyWrapBlockSize:])
+		}
+	}
+	return append(a, r...), nil
+}
+
+// aesKeyUnwrap unwraps a key wrapped with aesKeyWrap, checking its integrity.
+func aesKeyUnwrap(kek, wrapped []byte) ([]byte, error) {
+	if len(wrapped)%aesKeyWrapBlockSize != 0 || len(wrapped) < 3*aesKeyWrapBlockSize {
+		return nil, fmt.Errorf("%w: invalid wrapped key length %d", ErrMessageDecryptionFailed, len(wrapped))
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	n := len(wrapped)/aesKeyWrapBlockSize - 1
+	a := append([]byte{}, wrapped[:aesKeyWrapBlockSize]...)
+	r := append([]byte{}, wrapped[aesKeyWrapBlockSize:]...)
+	buffer := make([]byte, aes.BlockSize)
+	for j := 5; j >= 0; j-- {
+		for i := n - 1; i >= 0; i-- {
+			t := uint64(n*j + i + 1)
+			binary.BigEndian.PutUint64(buffer, binary.BigEndian.Uint64(a)^t)
+			copy(buffer[aesKeyWrapBlockSize:], r[i*aesKeyWrapBlockSize:(i+1)*aesKeyWrapBlockSize])
+			block.Decrypt(buffer, buffer)
+			copy(a, buffer[:aesKeyWrapBlockSize])
+			copy(r[i*aesKeyWrapBlockSize:], buffer[aesKeyWrapBlockSize:])
+		}
+	}
+	if subtle.ConstantTimeCompare(a, aesKeyWrapIV) != 1 {
+		return nil, fmt.Errorf("%w: wrapped key integrity check failed", ErrMessageDecryptionFailed)
+	}
+	return r, nil
+}
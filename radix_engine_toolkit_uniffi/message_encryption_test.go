@@ -0,0 +1,257 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+func mustHex(t *testing.T, value string) []byte {
+	t.Helper()
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestHkdfSha256(t *testing.T) {
+	// RFC 5869, appendix A.1 and A.3
+	tests := []struct {
+		secret, salt, info, output string
+	}{
+		{
+			"0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b",
+			"000102030405060708090a0b0c",
+			"f0f1f2f3f4f5f6f7f8f9",
+			"3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865",
+		},
+		{
+			"0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b0b",
+			"",
+			"",
+			"8da4e775a563c18f715f802a063c5a31b8a11f5c5ee1879ec3454e5f3c738d2d9d201395faa4b61a96c8",
+		},
+	}
+	for _, test := range tests {
+		output := hkdfSha256(mustHex(t, test.secret), mustHex(t, test.salt), mustHex(t, test.info), len(test.output)/2)
+		if hex.EncodeToString(output) != test.output {
+			t.Errorf("hkdfSha256(%s) = %x, want %s", test.secret, output, test.output)
+		}
+	}
+}
+
+func TestAesKeyWrap(t *testing.T) {
+	// RFC 3394, sections 4.1 and 4.6
+	tests := []struct {
+		kek, key, wrapped string
+	}{
+		{
+			"000102030405060708090a0b0c0d0e0f",
+			"00112233445566778899aabbccddeeff",
+			"1fa68b0a8112b447aef34bd8fb5a7b829d3e862371d2cfe5",
+		},
+		{
+			"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			"00112233445566778899aabbccddeeff000102030405060708090a0b0c0d0e0f",
+			"28c9f404c4b810f4cbccb35cfb87f8263f5786e2d80ed326cbc7f0e71a99f43bfb988b9b7a02dd21",
+		},
+	}
+	for _, test := range tests {
+		wrapped, err := aesKeyWrap(mustHex(t, test.kek), mustHex(t, test.key))
+		if err != nil || hex.EncodeToString(wrapped) != test.wrapped {
+			t.Errorf("aesKeyWrap(%s) = %x, %v, want %s", test.key, wrapped, err, test.wrapped)
+			continue
+		}
+		key, err := aesKeyUnwrap(mustHex(t, test.kek), wrapped)
+		if err != nil || hex.EncodeToString(key) != test.key {
+			t.Errorf("aesKeyUnwrap(%s) = %x, %v, want %s", test.wrapped, key, err, test.key)
+		}
+		wrapped[len(wrapped)-1] ^= 1
+		if _, err := aesKeyUnwrap(mustHex(t, test.kek), wrapped); !errors.Is(err, ErrMessageDecryptionFailed) {
+			t.Errorf("aesKeyUnwrap of a tampered key = %v, want ErrMessageDecryptionFailed", err)
+		}
+	}
+}
+
+func TestMessageContents(t *testing.T) {
+	tests := []struct {
+		discriminator uint8
+		value         []byte
+		encoded       string
+	}{
+		{messageContentsStr, []byte("hello"), "4d2200010c0568656c6c6f"},
+		{messageContentsStr, nil, "4d2200010c00"},
+		{messageContentsBytes, []byte{0xde, 0xad}, "4d220101200702dead"},
+	}
+	for _, test := range tests {
+		encoded, err := encodeMessageContents(test.discriminator, test.value)
+		if err != nil || hex.EncodeToString(encoded) != test.encoded {
+			t.Errorf("encodeMessageContents(%d, %x) = %x, %v, want %s", test.discriminator, test.value, encoded, err, test.encoded)
+			continue
+		}
+		discriminator, value, err := decodeMessageContents(encoded)
+		if err != nil || discriminator != test.discriminator || !bytes.Equal(value, test.value) {
+			t.Errorf("decodeMessageContents(%s) = %d, %x, %v", test.encoded, discriminator, value, err)
+		}
+	}
+
+	for _, invalid := range []string{
+		"",
+		"4d2202010c00",     // unknown variant
+		"4d2200010700",     // String variant holding a u8
+		"4d22010120080100", // Bytes variant holding a Vec<u16>
+		"5c2200010c00",     // Scrypto payload
+	} {
+		if _, _, err := decodeMessageContents(mustHex(t, invalid)); !errors.Is(err, ErrMessageDecryptionFailed) {
+			t.Errorf("decodeMessageContents(%s) = %v, want ErrMessageDecryptionFailed", invalid, err)
+		}
+	}
+}
+
+func TestSecp256k1SharedSecret(t *testing.T) {
+	for i := 0; i < 32; i++ {
+		a, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKey, err := secp256k1PublicKey(a.Serialize())
+		if err != nil || !bytes.Equal(publicKey, a.PubKey().SerializeCompressed()) {
+			t.Fatalf("secp256k1PublicKey(%x) = %x, %v", a.Serialize(), publicKey, err)
+		}
+		secret, err := secp256k1SharedSecret(a.Serialize(), b.PubKey().SerializeCompressed())
+		if err != nil || !bytes.Equal(secret, secp256k1.GenerateSharedSecret(a, b.PubKey())) {
+			t.Fatalf("secp256k1SharedSecret(%x) = %x, %v", a.Serialize(), secret, err)
+		}
+	}
+
+	// n - 1 is -1, whose public key is -G
+	publicKey, err := secp256k1PublicKey(mustHex(t, "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140"))
+	if err != nil || hex.EncodeToString(publicKey) != "0379be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" {
+		t.Errorf("secp256k1PublicKey(n - 1) = %x, %v", publicKey, err)
+	}
+	for _, invalid := range []string{
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141",
+		"01",
+	} {
+		if _, err := secp256k1PublicKey(mustHex(t, invalid)); err == nil {
+			t.Errorf("secp256k1PublicKey(%s) succeeded", invalid)
+		}
+	}
+}
+
+type messageRecipient struct {
+	curve      Curve
+	privateKey []byte
+	publicKey  PublicKey
+}
+
+func newMessageRecipient(t *testing.T, curve Curve) messageRecipient {
+	t.Helper()
+	if curve == CurveEd25519 {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return messageRecipient{curve, privateKey.Seed(), PublicKeyEd25519{Value: publicKey}}
+	}
+	privateKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return messageRecipient{curve, privateKey.Serialize(), PublicKeySecp256k1{Value: privateKey.PubKey().SerializeCompressed()}}
+}
+
+func TestEncryptMessage(t *testing.T) {
+	recipients := []messageRecipient{
+		newMessageRecipient(t, CurveEd25519),
+		newMessageRecipient(t, CurveSecp256k1),
+		newMessageRecipient(t, CurveEd25519),
+		newMessageRecipient(t, CurveSecp256k1),
+	}
+	publicKeys := make([]PublicKey, len(recipients))
+	for index, recipient := range recipients {
+		publicKeys[index] = recipient.publicKey
+	}
+	outsider := newMessageRecipient(t, CurveSecp256k1)
+
+	for _, keyLength := range []int{messageKeyLengthV1, messageKeyLengthV2} {
+		plaintext, err := encodeMessageContents(messageContentsStr, []byte("hello"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		message, err := encryptMessage(plaintext, keyLength, publicKeys)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(message.groups) != 2 {
+			t.Fatalf("%d decryptor groups, want 2", len(message.groups))
+		}
+		for _, recipient := range recipients {
+			_, publicKey, _ := publicKeyParts(recipient.publicKey)
+			group := message.groups[recipient.curve]
+			if bytes.Equal(group.dhPublicKey, publicKey) {
+				t.Errorf("the DH public key of curve %d is a recipient's key", recipient.curve)
+			}
+			wrappedKey := group.decryptors[string(publicKeyFingerprint(publicKey))]
+			if len(wrappedKey) != keyLength+aesKeyWrapBlockSize {
+				t.Fatalf("wrapped key of length %d, want %d", len(wrappedKey), keyLength+aesKeyWrapBlockSize)
+			}
+			decrypted, err := decryptMessage(message.encrypted, group.dhPublicKey, wrappedKey, keyLength, recipient.curve, recipient.privateKey, publicKey)
+			if err != nil || !bytes.Equal(decrypted, plaintext) {
+				t.Errorf("decryptMessage = %x, %v, want %x", decrypted, err, plaintext)
+			}
+		}
+
+		group := message.groups[CurveSecp256k1]
+		_, publicKey, _ := publicKeyParts(recipients[1].publicKey)
+		_, outsiderKey, _ := publicKeyParts(outsider.publicKey)
+		wrappedKey := group.decryptors[string(publicKeyFingerprint(publicKey))]
+		if _, err := decryptMessage(message.encrypted, group.dhPublicKey, wrappedKey, keyLength, outsider.curve, outsider.privateKey, outsiderKey); !errors.Is(err, ErrMessageDecryptionFailed) {
+			t.Errorf("decryptMessage with another key = %v, want ErrMessageDecryptionFailed", err)
+		}
+	}
+
+	first, err := encryptMessage([]byte{0}, messageKeyLengthV2, publicKeys[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := encryptMessage([]byte{0}, messageKeyLengthV2, publicKeys[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(first.groups[CurveEd25519].dhPublicKey, second.groups[CurveEd25519].dhPublicKey) {
+		t.Error("two messages share their DH ephemeral public key")
+	}
+	if _, err := encryptMessage([]byte{0}, messageKeyLengthV2, nil); err == nil {
+		t.Error("encryptMessage without recipients succeeded")
+	}
+}
+
+func TestEncryptMessageWithoutSender(t *testing.T) {
+	recipient := newMessageRecipient(t, CurveEd25519)
+	if recipients := withSender(nil, []PublicKey{recipient.publicKey}); len(recipients) != 1 {
+		t.Errorf("withSender(nil) = %v, want the recipients only", recipients)
+	}
+	message, err := EncryptMessageV2(MessageContentsV2Str{Value: "hello"}, nil, []PublicKey{recipient.publicKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted := message.(MessageV2Encrypted).Value
+	if len(encrypted.DecryptorsByCurve) != 1 {
+		t.Errorf("%d decryptor groups, want 1", len(encrypted.DecryptorsByCurve))
+	}
+	if _, err := EncryptMessageV1(MessageContentV1Str{Value: "hello"}, nil, nil); err == nil {
+		t.Error("EncryptMessageV1 without sender and recipients succeeded")
+	}
+}
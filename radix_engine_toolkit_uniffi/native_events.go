@@ -0,0 +1,102 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// DecodedEvent is the outcome of decoding one event of a receipt with
+// ScryptoSborDecodeToNativeEvents.
+//
+// Events of native blueprints and modules are decoded into Event. Other
+// events, e.g. those emitted by Scrypto components, are marked NonNative and
+// their payload is decoded into the programmatic JSON representation instead.
+// Err is set when neither decoding succeeds, in which case Event is nil; a
+// panic of the native library decoding the event is reported there as a
+// RadixEngineToolkitErrorInternalPanic error.
+type DecodedEvent struct {
+	TypeIdentifier EventTypeIdentifier
+	Event          TypedNativeEvent
+	NonNative      bool
+	// the programmatic JSON of a non-native event
+	Programmatic string
+	Err          error
+}
+
+// ScryptoSborDecodeToNativeEvents is ScryptoSborDecodeToNativeEvent for all
+// the events of a receipt. It returns one DecodedEvent for each event, in the
+// order of events; an event which cannot be decoded does not prevent the
+// others from being decoded.
+func ScryptoSborDecodeToNativeEvents(events []ToolkitEvent, networkId uint8) []DecodedEvent {
+	decoded := make([]DecodedEvent, len(events))
+	for index, event := range events {
+		decoded[index] = decodeNativeEvent(event, networkId)
+	}
+	return decoded
+}
+
+// NativeEvents decodes the events of the receipt with
+// ScryptoSborDecodeToNativeEvents.
+func (receipt ToolkitReceipt) NativeEvents(networkId uint8) []DecodedEvent {
+	return ScryptoSborDecodeToNativeEvents(receipt.Events, networkId)
+}
+
+// DecodedEventsErr returns the errors of the events which could not be
+// decoded, joined into one error, or nil if all the events were decoded.
+func DecodedEventsErr(events []DecodedEvent) error {
+	var errs []error
+	for _, event := range events {
+		if event.Err != nil {
+			errs = append(errs, event.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func decodeNativeEvent(event ToolkitEvent, networkId uint8) DecodedEvent {
+	decoded := DecodedEvent{TypeIdentifier: event.TypeIdentifier}
+	native, err := ScryptoSborDecodeToNativeEventSafe(event.TypeIdentifier, event.Data, networkId)
+	if err == nil {
+		decoded.Event = native
+		return decoded
+	}
+	// The toolkit reports events of blueprints it does not know, as well as
+	// payloads which do not match the event type, as TypedNativeEventError;
+	// only the former fall back to the programmatic representation.
+	if !errors.Is(err, ErrRadixEngineToolkitErrorTypedNativeEventError) || isNativeEmitter(event.TypeIdentifier.Emitter) {
+		decoded.Err = err
+		return decoded
+	}
+	programmatic, fallbackErr := SborDecodeToStringRepresentationSafe(event.Data, SerializationModeProgrammatic, networkId, nil)
+	if errors.Is(fallbackErr, ErrRadixEngineToolkitErrorInternalPanic) {
+		decoded.Err = fallbackErr
+		return decoded
+	}
+	if fallbackErr != nil {
+		decoded.Err = err
+		return decoded
+	}
+	decoded.NonNative = true
+	decoded.Programmatic = programmatic
+	return decoded
+}
+
+// isNativeEmitter reports whether the events of emitter are known to be
+// native: those of the modules of any component and those of the main module
+// of native components. Function emitters are not classified.
+func isNativeEmitter(emitter Emitter) bool {
+	method, ok := emitter.(EmitterMethod)
+	if !ok || method.Address == nil {
+		return false
+	}
+	if method.ObjectModuleId != ModuleIdMain {
+		return true
+	}
+	value, err := method.Address.Value()
+	if err != nil {
+		return false
+	}
+	entityType := value.EntityType()
+	return entityType != address.EntityTypeGlobalGenericComponent && entityType != address.EntityTypeInternalGenericComponent
+}
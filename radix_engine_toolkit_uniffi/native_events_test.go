@@ -0,0 +1,40 @@
+package radix_engine_toolkit_uniffi
+
+import "testing"
+
+func TestScryptoSborDecodeToNativeEventsMalformed(t *testing.T) {
+	account, err := NewAddress("account_rdx128dtethfy8ujrsfdztemyjk0kvhnah6dafr57frz85dcw2c8z0td87")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := func(name string, data []byte) ToolkitEvent {
+		return ToolkitEvent{
+			TypeIdentifier: EventTypeIdentifier{
+				Emitter:   EmitterMethod{Address: account, ObjectModuleId: ModuleIdMain},
+				EventName: name,
+			},
+			Data: data,
+		}
+	}
+	events := []ToolkitEvent{
+		event("WithdrawEvent", []byte{0x5c, 0x22, 0x07}),
+		event("DepositEvent", nil),
+		event("LockFeeEvent", []byte{0x5c, 0x21, 0x01, 0xa0}),
+	}
+
+	decoded := ScryptoSborDecodeToNativeEvents(events, 1)
+	if len(decoded) != len(events) {
+		t.Fatalf("ScryptoSborDecodeToNativeEvents returned %d events, want %d", len(decoded), len(events))
+	}
+	for index, event := range decoded {
+		if event.Err == nil || event.Event != nil || event.NonNative {
+			t.Errorf("event %d = %+v, want an error", index, event)
+		}
+		if event.TypeIdentifier.EventName != events[index].TypeIdentifier.EventName {
+			t.Errorf("event %d: name %q, want %q", index, event.TypeIdentifier.EventName, events[index].TypeIdentifier.EventName)
+		}
+	}
+	if DecodedEventsErr(decoded) == nil {
+		t.Error("DecodedEventsErr = nil, want the errors of the events")
+	}
+}
@@ -0,0 +1,243 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// Native panics
+//
+// A panic of the native library is caught at the FFI boundary and re-raised
+// in Go by the generated bindings as a plain panic, which by default takes
+// the process down. Code decoding untrusted input can instead use
+// CatchPanic, or one of the ...Safe entry points below, to receive the panic
+// as a RadixEngineToolkitErrorInternalPanic error value and keep running.
+//
+// The panics are told apart by the function of the bindings raising them,
+// not by their value, so the generated bindings are used as they are.
+//
+// RadixEngineToolkitErrorInternalPanic is a variant of
+// RadixEngineToolkitError which only exists on the Go side: the native
+// library never returns it and no callback interface returns a
+// RadixEngineToolkitError to the native library, so it is never lifted or
+// lowered by FfiConverterTypeRadixEngineToolkitError.
+
+var ErrRadixEngineToolkitErrorInternalPanic = fmt.Errorf("RadixEngineToolkitErrorInternalPanic")
+
+// RadixEngineToolkitErrorInternalPanic reports a panic of the native library,
+// Message holding the panic message.
+type RadixEngineToolkitErrorInternalPanic struct {
+	Message string
+}
+
+func NewRadixEngineToolkitErrorInternalPanic(
+	message string,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorInternalPanic{
+			Message: message,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorInternalPanic) Error() string {
+	return fmt.Sprint("InternalPanic",
+		": ",
+
+		"Message=",
+		err.Message,
+	)
+}
+
+func (self RadixEngineToolkitErrorInternalPanic) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorInternalPanic
+}
+
+// nativePanicFunctions are the functions of the generated bindings which
+// panic on the status of a native call: a panic of the native library, or an
+// error returned by a call which cannot fail.
+var nativePanicFunctions = map[string]bool{
+	"checkCallStatus":        true,
+	"checkCallStatusUnknown": true,
+	"rustCall":               true,
+}
+
+var packagePath = reflect.TypeFor[RadixEngineToolkitError]().PkgPath()
+
+// panickingFunction returns the name, relative to the package, of the
+// function of the package raising the panic the goroutine is running. It
+// must be called by a deferred function, while the panicking frames are
+// still on the stack.
+func panickingFunction() (string, bool) {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	panicking := false
+	for {
+		frame, more := frames.Next()
+		switch {
+		case frame.Function == "runtime.gopanic":
+			panicking = true
+		case panicking && !strings.HasPrefix(frame.Function, "runtime."):
+			name, ok := strings.CutPrefix(frame.Function, packagePath+".")
+			return strings.TrimSuffix(name, "[...]"), ok
+		}
+		if !more {
+			return "", false
+		}
+	}
+}
+
+// isNativePanic reports whether the goroutine is panicking with a panic
+// raised by the generated bindings for a native call.
+func isNativePanic() bool {
+	name, ok := panickingFunction()
+	return ok && nativePanicFunctions[name]
+}
+
+// CatchPanic calls function and returns a panic of the native library raised
+// during the call as a RadixEngineToolkitErrorInternalPanic error. Panics
+// which did not originate in the native library are propagated unchanged.
+func CatchPanic[T any](function func() (T, error)) (result T, err error) {
+	defer func() {
+		if !isNativePanic() {
+			return
+		}
+		var zero T
+		result, err = zero, NewRadixEngineToolkitErrorInternalPanic(fmt.Sprint(recover()))
+	}()
+	return function()
+}
+
+func IntentV1FromPayloadBytesSafe(compiledIntent []byte) (*IntentV1, error) {
+	return CatchPanic(func() (*IntentV1, error) {
+		return IntentV1FromPayloadBytes(compiledIntent)
+	})
+}
+
+func SignedTransactionIntentV1FromPayloadBytesSafe(compiledSignedIntent []byte) (*SignedTransactionIntentV1, error) {
+	return CatchPanic(func() (*SignedTransactionIntentV1, error) {
+		return SignedTransactionIntentV1FromPayloadBytes(compiledSignedIntent)
+	})
+}
+
+func NotarizedTransactionV1FromPayloadBytesSafe(compiledNotarizedTransaction []byte) (*NotarizedTransactionV1, error) {
+	return CatchPanic(func() (*NotarizedTransactionV1, error) {
+		return NotarizedTransactionV1FromPayloadBytes(compiledNotarizedTransaction)
+	})
+}
+
+func TransactionIntentV2FromPayloadBytesSafe(compiledIntent []byte) (*TransactionIntentV2, error) {
+	return CatchPanic(func() (*TransactionIntentV2, error) {
+		return TransactionIntentV2FromPayloadBytes(compiledIntent)
+	})
+}
+
+func SignedTransactionIntentV2FromPayloadBytesSafe(compiledSignedIntent []byte) (*SignedTransactionIntentV2, error) {
+	return CatchPanic(func() (*SignedTransactionIntentV2, error) {
+		return SignedTransactionIntentV2FromPayloadBytes(compiledSignedIntent)
+	})
+}
+
+func NotarizedTransactionV2FromPayloadBytesSafe(compiledNotarizedTransaction []byte) (*NotarizedTransactionV2, error) {
+	return CatchPanic(func() (*NotarizedTransactionV2, error) {
+		return NotarizedTransactionV2FromPayloadBytes(compiledNotarizedTransaction)
+	})
+}
+
+func SubintentV2FromPayloadBytesSafe(compiledIntent []byte) (*SubintentV2, error) {
+	return CatchPanic(func() (*SubintentV2, error) {
+		return SubintentV2FromPayloadBytes(compiledIntent)
+	})
+}
+
+func PartialTransactionV2FromPayloadBytesSafe(compiledIntent []byte) (*PartialTransactionV2, error) {
+	return CatchPanic(func() (*PartialTransactionV2, error) {
+		return PartialTransactionV2FromPayloadBytes(compiledIntent)
+	})
+}
+
+func SignedPartialTransactionV2FromPayloadBytesSafe(compiledIntent []byte) (*SignedPartialTransactionV2, error) {
+	return CatchPanic(func() (*SignedPartialTransactionV2, error) {
+		return SignedPartialTransactionV2FromPayloadBytes(compiledIntent)
+	})
+}
+
+func TransactionManifestV1FromPayloadBytesSafe(compiled []byte, networkId uint8) (*TransactionManifestV1, error) {
+	return CatchPanic(func() (*TransactionManifestV1, error) {
+		return TransactionManifestV1FromPayloadBytes(compiled, networkId)
+	})
+}
+
+func TransactionManifestV2FromPayloadBytesSafe(compiled []byte, networkId uint8) (*TransactionManifestV2, error) {
+	return CatchPanic(func() (*TransactionManifestV2, error) {
+		return TransactionManifestV2FromPayloadBytes(compiled, networkId)
+	})
+}
+
+func SubintentManifestV2FromPayloadBytesSafe(compiled []byte, networkId uint8) (*SubintentManifestV2, error) {
+	return CatchPanic(func() (*SubintentManifestV2, error) {
+		return SubintentManifestV2FromPayloadBytes(compiled, networkId)
+	})
+}
+
+func InstructionsV1FromStringSafe(string string, networkId uint8) (*InstructionsV1, error) {
+	return CatchPanic(func() (*InstructionsV1, error) {
+		return InstructionsV1FromString(string, networkId)
+	})
+}
+
+func InstructionsV2FromStringSafe(string string, networkId uint8) (*InstructionsV2, error) {
+	return CatchPanic(func() (*InstructionsV2, error) {
+		return InstructionsV2FromString(string, networkId)
+	})
+}
+
+func AccessRuleFromScryptoSborPayloadSafe(payload []byte) (*AccessRule, error) {
+	return CatchPanic(func() (*AccessRule, error) {
+		return AccessRuleFromScryptoSborPayload(payload)
+	})
+}
+
+func HashSborDecodeSafe(bytes []byte) (*Hash, error) {
+	return CatchPanic(func() (*Hash, error) {
+		return HashSborDecode(bytes)
+	})
+}
+
+func ScryptoSborDecodeToNativeEventSafe(eventTypeIdentifier EventTypeIdentifier, eventData []byte, networkId uint8) (TypedNativeEvent, error) {
+	return CatchPanic(func() (TypedNativeEvent, error) {
+		return ScryptoSborDecodeToNativeEvent(eventTypeIdentifier, eventData, networkId)
+	})
+}
+
+func SborDecodeToStringRepresentationSafe(bytes []byte, representation SerializationMode, networkId uint8, schema *Schema) (string, error) {
+	return CatchPanic(func() (string, error) {
+		return SborDecodeToStringRepresentation(bytes, representation, networkId, schema)
+	})
+}
+
+func ScryptoSborDecodeToStringRepresentationSafe(bytes []byte, representation SerializationMode, networkId uint8, schema *Schema) (string, error) {
+	return CatchPanic(func() (string, error) {
+		return ScryptoSborDecodeToStringRepresentation(bytes, representation, networkId, schema)
+	})
+}
+
+func ManifestSborDecodeToStringRepresentationSafe(bytes []byte, representation ManifestSborStringRepresentation, networkId uint8, schema *Schema) (string, error) {
+	return CatchPanic(func() (string, error) {
+		return ManifestSborDecodeToStringRepresentation(bytes, representation, networkId, schema)
+	})
+}
+
+func MetadataSborDecodeSafe(bytes []byte, networkId uint8) (MetadataValue, error) {
+	return CatchPanic(func() (MetadataValue, error) {
+		return MetadataSborDecode(bytes, networkId)
+	})
+}
+
+func NonFungibleLocalIdSborDecodeSafe(bytes []byte) (NonFungibleLocalId, error) {
+	return CatchPanic(func() (NonFungibleLocalId, error) {
+		return NonFungibleLocalIdSborDecode(bytes)
+	})
+}
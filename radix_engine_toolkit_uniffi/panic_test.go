@@ -0,0 +1,64 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+// checkStatus calls checkCallStatusUnknown with a status of the code, the
+// tests not being able to name the C type of the status.
+func checkStatus(code int8) error {
+	checker := reflect.ValueOf(checkCallStatusUnknown)
+	status := reflect.New(checker.Type().In(0)).Elem()
+	*(*int8)(unsafe.Pointer(status.UnsafeAddr())) = code
+	err, _ := checker.Call([]reflect.Value{status})[0].Interface().(error)
+	return err
+}
+
+func TestCatchPanic(t *testing.T) {
+	tests := []struct {
+		code    int8
+		message string
+	}{
+		{1, "function not returning an error returned an error"},
+		{2, "Rust panicked while handling Rust panic"},
+	}
+	for _, test := range tests {
+		result, err := CatchPanic(func() (int, error) {
+			return 1, checkStatus(test.code)
+		})
+		var internalPanic *RadixEngineToolkitErrorInternalPanic
+		if result != 0 || !errors.Is(err, ErrRadixEngineToolkitErrorInternalPanic) || !errors.As(err, &internalPanic) {
+			t.Errorf("CatchPanic of status %d = %d, %v", test.code, result, err)
+			continue
+		}
+		if internalPanic.Message != test.message {
+			t.Errorf("CatchPanic of status %d: message %q, want %q", test.code, internalPanic.Message, test.message)
+		}
+	}
+
+	result, err := CatchPanic(func() (int, error) {
+		return 1, checkStatus(0)
+	})
+	if result != 1 || err != nil {
+		t.Errorf("CatchPanic of status 0 = %d, %v, want 1, nil", result, err)
+	}
+}
+
+func TestCatchPanicPropagates(t *testing.T) {
+	for _, value := range []any{errors.New("Rust panicked while handling Rust panic"), "other"} {
+		func() {
+			defer func() {
+				if recovered := recover(); recovered != value {
+					t.Errorf("recovered %v, want %v", recovered, value)
+				}
+			}()
+			CatchPanic(func() (int, error) {
+				panic(value)
+			})
+			t.Errorf("CatchPanic recovered %v", value)
+		}()
+	}
+}
@@ -0,0 +1,732 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Manifest policies
+//
+// A Policy decides whether a signing service signs a manifest. It is checked
+// against the static analysis of the manifest and against each of its
+// instructions:
+//
+//   - the accounts and identities requiring auth must be among Accounts and
+//     Identities;
+//   - the manifest must have one of Classifications, if any;
+//   - the reserved instructions, such as a securify or a call to an access
+//     controller, must be of a kind in AllowReserved;
+//   - every instruction is matched against Rules in order, and the Action of
+//     the first rule matching it decides. An invocation, a yield or a burn
+//     matching no rule is decided by Default, any other instruction is
+//     allowed.
+//
+// Policies are read from JSON with ParsePolicy. Their fields also have yaml
+// tags and their enums implement encoding.TextUnmarshaler, so a YAML decoder
+// such as gopkg.in/yaml.v3 reads them too, Validate being called after it:
+//
+//	accounts: [account_rdx1...]
+//	classifications: [Transfer, General]
+//	default: deny
+//	rules:
+//	  - name: no-deposit-rule-changes
+//	    action: deny
+//	    entity_types: [GlobalAccount]
+//	    methods: [set_default_deposit_rule]
+//	  - name: large-withdrawals
+//	    action: deny
+//	    methods: ["withdraw*"]
+//	    resources: [resource_rdx1...]
+//	    amount_above: "10000"
+//	  - name: accounts
+//	    action: allow
+//	    entity_types: [GlobalAccount]
+//	  - name: dex
+//	    action: allow
+//	    entities: [component_rdx1...]
+//	  - name: yields
+//	    action: allow
+//	    instructions: [yield_to_parent]
+//
+// The decision on an intent guards a SignerV2, which then signs nothing but
+// the hash of that intent, and only if the intent is allowed:
+//
+//	decision, err := intent.EvaluatePolicy(policy)
+//	step, err := builder.SignWithSignerV2(ctx, decision.GuardSigner(signer))
+
+// PolicyAction is the decision of a policy rule.
+type PolicyAction int
+
+const (
+	PolicyDeny PolicyAction = iota
+	PolicyAllow
+)
+
+func (action PolicyAction) String() string {
+	switch action {
+	case PolicyDeny:
+		return "deny"
+	case PolicyAllow:
+		return "allow"
+	default:
+		return fmt.Sprintf("PolicyAction(%d)", int(action))
+	}
+}
+
+func (action PolicyAction) MarshalText() ([]byte, error) {
+	switch action {
+	case PolicyDeny, PolicyAllow:
+		return []byte(action.String()), nil
+	}
+	return nil, fmt.Errorf("invalid PolicyAction value %d", action)
+}
+
+func (action *PolicyAction) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "deny":
+		*action = PolicyDeny
+	case "allow":
+		*action = PolicyAllow
+	default:
+		return fmt.Errorf("invalid PolicyAction variant %q", text)
+	}
+	return nil
+}
+
+// Policy is a set of rules over manifests.
+type Policy struct {
+	// Accounts are the accounts the manifest may require the auth of.
+	Accounts []string `json:"accounts,omitempty" yaml:"accounts,omitempty"`
+	// Identities are the identities the manifest may require the auth of.
+	Identities []string `json:"identities,omitempty" yaml:"identities,omitempty"`
+	// Classifications, if not empty, are the classifications the manifest
+	// must have one of.
+	Classifications []ManifestClassification `json:"classifications,omitempty" yaml:"classifications,omitempty"`
+	// AllowReserved are the kinds of reserved instructions the manifest may
+	// contain: AccountLockFee, AccountSecurify,
+	// AccountLockOwnerKeysMetadataField, AccountUpdateOwnerKeysMetadataField,
+	// IdentitySecurify, IdentityLockOwnerKeysMetadataField,
+	// IdentityUpdateOwnerKeysMetadataField and AccessController.
+	AllowReserved []string `json:"allow_reserved,omitempty" yaml:"allow_reserved,omitempty"`
+	// Rules are matched in order against each instruction.
+	Rules []PolicyRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+	// Default decides the invocations, yields and burns matching no rule.
+	Default PolicyAction `json:"default" yaml:"default"`
+}
+
+// PolicyRule matches the instructions meeting all of its conditions, a rule
+// without conditions matching every instruction.
+type PolicyRule struct {
+	Name   string       `json:"name,omitempty" yaml:"name,omitempty"`
+	Action PolicyAction `json:"action" yaml:"action"`
+	// Instructions are the kinds of the instruction in snake case, such as
+	// call_method, yield_to_child or burn_resource.
+	Instructions []string `json:"instructions,omitempty" yaml:"instructions,omitempty"`
+	// Entities are the addresses of the entities the instruction calls.
+	Entities []string `json:"entities,omitempty" yaml:"entities,omitempty"`
+	// EntityTypes are the types of the entities the instruction calls. An
+	// entity the manifest allocates has no type.
+	EntityTypes []EntityType `json:"entity_types,omitempty" yaml:"entity_types,omitempty"`
+	// Methods are path.Match patterns of the methods or functions the
+	// instruction calls.
+	Methods []string `json:"methods,omitempty" yaml:"methods,omitempty"`
+	// Resources are the addresses of the resources the instruction names,
+	// in its resource_address or in its arguments.
+	Resources []string `json:"resources,omitempty" yaml:"resources,omitempty"`
+	// AmountAbove, if not nil, matches the instructions with an amount of
+	// one of Resources, or of any resource without Resources, above it. The
+	// amount of a resource is the amount or the number of ids of the
+	// instruction naming it in its resource_address, and in arguments the
+	// Decimal, the PreciseDecimal or the array of non fungible local ids
+	// following its address. A resource whose amount cannot be read, such as
+	// that of a take_all_from_worktop or of an address followed by anything
+	// else, is taken to be above AmountAbove.
+	AmountAbove *DecimalValue `json:"amount_above,omitempty" yaml:"amount_above,omitempty"`
+}
+
+// policyReservedKind is a kind of reserved instructions, with the methods
+// invoking them.
+type policyReservedKind struct {
+	name      string
+	method    string
+	addresses func(ReservedInstructionsOutput) []*Address
+}
+
+var policyReservedKinds = []policyReservedKind{
+	{"AccountLockFee", "lock_fee*", func(output ReservedInstructionsOutput) []*Address { return output.AccountLockFeeInvocations }},
+	{"AccountSecurify", "securify", func(output ReservedInstructionsOutput) []*Address { return output.AccountSecurifyInvocations }},
+	{"AccountLockOwnerKeysMetadataField", "lock", func(output ReservedInstructionsOutput) []*Address {
+		return output.AccountLockOwnerKeysMetadataFieldInvocations
+	}},
+	{"AccountUpdateOwnerKeysMetadataField", "set", func(output ReservedInstructionsOutput) []*Address {
+		return output.AccountUpdateOwnerKeysMetadataFieldInvocations
+	}},
+	{"IdentitySecurify", "securify", func(output ReservedInstructionsOutput) []*Address { return output.IdentitySecurifyInvocations }},
+	{"IdentityLockOwnerKeysMetadataField", "lock", func(output ReservedInstructionsOutput) []*Address {
+		return output.IdentityLockOwnerKeysMetadataFieldInvocations
+	}},
+	{"IdentityUpdateOwnerKeysMetadataField", "set", func(output ReservedInstructionsOutput) []*Address {
+		return output.IdentityUpdateOwnerKeysMetadataFieldInvocations
+	}},
+	{"AccessController", "*", func(output ReservedInstructionsOutput) []*Address { return output.AccessControllerInvocations }},
+}
+
+// policyInstructionKinds are the kinds of the instructions of V1 and V2
+// manifests, in snake case.
+var policyInstructionKinds = []string{
+	"take_all_from_worktop", "take_from_worktop", "take_non_fungibles_from_worktop", "return_to_worktop",
+	"assert_worktop_contains", "assert_worktop_contains_any", "assert_worktop_contains_non_fungibles",
+	"pop_from_auth_zone", "push_to_auth_zone", "create_proof_from_auth_zone_of_amount",
+	"create_proof_from_auth_zone_of_non_fungibles", "create_proof_from_auth_zone_of_all", "drop_all_proofs",
+	"drop_named_proofs", "drop_auth_zone_proofs", "drop_auth_zone_regular_proofs", "drop_auth_zone_signature_proofs",
+	"create_proof_from_bucket_of_amount", "create_proof_from_bucket_of_non_fungibles", "create_proof_from_bucket_of_all",
+	"burn_resource", "clone_proof", "drop_proof", "call_function", "call_method", "call_royalty_method",
+	"call_metadata_method", "call_role_assignment_method", "call_direct_vault_method", "allocate_global_address",
+	"yield_to_parent", "yield_to_child", "verify_parent", "assert_worktop_resources_only",
+	"assert_worktop_resources_include", "assert_next_call_returns_only", "assert_next_call_returns_include",
+	"assert_bucket_contents",
+}
+
+// ParsePolicy reads a policy from JSON, rejecting unknown fields.
+func ParsePolicy(data []byte) (*Policy, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var policy Policy
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("invalid policy: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Validate checks the reserved instruction kinds, the instruction kinds and
+// the method patterns of the policy.
+func (policy *Policy) Validate() error {
+	for _, kind := range policy.AllowReserved {
+		if !slices.ContainsFunc(policyReservedKinds, func(reserved policyReservedKind) bool {
+			return reserved.name == kind
+		}) {
+			return fmt.Errorf("invalid policy: unknown reserved instruction kind %q", kind)
+		}
+	}
+	for index, rule := range policy.Rules {
+		if rule.Action != PolicyDeny && rule.Action != PolicyAllow {
+			return fmt.Errorf("invalid policy: rule %d: invalid action %d", index, rule.Action)
+		}
+		for _, kind := range rule.Instructions {
+			if !slices.Contains(policyInstructionKinds, kind) {
+				return fmt.Errorf("invalid policy: rule %d: unknown instruction kind %q", index, kind)
+			}
+		}
+		for _, method := range rule.Methods {
+			if _, err := path.Match(method, ""); err != nil {
+				return fmt.Errorf("invalid policy: rule %d: invalid method pattern %q", index, method)
+			}
+		}
+	}
+	return nil
+}
+
+// PolicyViolation is a reason a policy denies a manifest.
+type PolicyViolation struct {
+	// Rule is the name of the rule denying the instructions, "default" for
+	// Default, or one of "accounts", "identities", "classifications" and
+	// "reserved".
+	Rule   string
+	Reason string
+	// Instructions are the indices of the instructions in violation, empty
+	// for a violation by the manifest as a whole.
+	Instructions []int
+}
+
+func (violation PolicyViolation) String() string {
+	if len(violation.Instructions) == 0 {
+		return fmt.Sprintf("%s: %s", violation.Rule, violation.Reason)
+	}
+	return fmt.Sprintf("%s: %s (instructions %s)", violation.Rule, violation.Reason, strings.Trim(fmt.Sprint(violation.Instructions), "[]"))
+}
+
+// PolicyDecision is the result of the evaluation of a manifest against a
+// policy.
+type PolicyDecision struct {
+	Allowed    bool
+	Violations []PolicyViolation
+	// intentHash is the hash of the evaluated intent, nil for a decision on
+	// a manifest alone.
+	intentHash []byte
+}
+
+func (decision PolicyDecision) String() string {
+	if decision.Allowed {
+		return "allowed"
+	}
+	reasons := make([]string, len(decision.Violations))
+	for index, violation := range decision.Violations {
+		reasons[index] = violation.String()
+	}
+	return "denied: " + strings.Join(reasons, "; ")
+}
+
+// ViolatingInstructions returns the indices of the instructions in violation
+// of the policy, in order.
+func (decision PolicyDecision) ViolatingInstructions() []int {
+	var indices []int
+	for _, violation := range decision.Violations {
+		indices = append(indices, violation.Instructions...)
+	}
+	sort.Ints(indices)
+	return slices.Compact(indices)
+}
+
+// EvaluatePolicy evaluates the manifest against the policy.
+func (_self *TransactionManifestV1) EvaluatePolicy(networkId uint8, policy *Policy) (PolicyDecision, error) {
+	analysis, err := _self.StaticallyAnalyze(networkId)
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	instructions := _self.Instructions()
+	defer instructions.Destroy()
+	return policy.evaluate(policyInstructions(instructions.InstructionsList()), analysis), nil
+}
+
+// EvaluatePolicy evaluates the manifest against the policy.
+func (_self *TransactionManifestV2) EvaluatePolicy(networkId uint8, policy *Policy) (PolicyDecision, error) {
+	analysis, err := _self.StaticallyAnalyze(networkId)
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	instructions := _self.Instructions()
+	defer instructions.Destroy()
+	return policy.evaluate(policyInstructions(instructions.InstructionsList()), analysis), nil
+}
+
+// EvaluatePolicy evaluates the manifest against the policy. The static
+// analysis is the one of the manifest enclosed in a transaction manifest,
+// and fails for a manifest which cannot be enclosed.
+func (_self *SubintentManifestV2) EvaluatePolicy(networkId uint8, policy *Policy) (PolicyDecision, error) {
+	enclosed := _self.AsEnclosed(networkId)
+	if enclosed == nil || *enclosed == nil {
+		return PolicyDecision{}, fmt.Errorf("EvaluatePolicy: the subintent manifest cannot be enclosed in a transaction manifest")
+	}
+	defer (*enclosed).Destroy()
+	analysis, err := (*enclosed).StaticallyAnalyze(networkId)
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	instructions := _self.Instructions()
+	defer instructions.Destroy()
+	return policy.evaluate(policyInstructions(instructions.InstructionsList()), analysis), nil
+}
+
+// EvaluatePolicy evaluates the manifest of the intent against the policy,
+// binding the decision to the intent hash.
+func (_self *IntentV1) EvaluatePolicy(policy *Policy) (PolicyDecision, error) {
+	hash, err := _self.IntentHash()
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	defer hash.Destroy()
+	manifest := _self.Manifest()
+	defer manifest.Destroy()
+	decision, err := manifest.EvaluatePolicy(_self.Header().NetworkId, policy)
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	return decision.bind(hash), nil
+}
+
+// EvaluatePolicy evaluates the manifest of the root intent against the
+// policy, binding the decision to the transaction intent hash.
+func (_self *TransactionIntentV2) EvaluatePolicy(policy *Policy) (PolicyDecision, error) {
+	hash, err := _self.TransactionIntentHash()
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	defer hash.Destroy()
+	core := _self.RootIntentCore()
+	defer core.Destroy()
+	manifest := intentCoreManifest(core, NewTransactionManifestV2)
+	defer manifest.Destroy()
+	decision, err := manifest.EvaluatePolicy(core.Header().NetworkId, policy)
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	return decision.bind(hash), nil
+}
+
+// EvaluateSubintentPolicy evaluates the manifest of the intent core against
+// the policy, binding the decision to the hash of the subintent of the core.
+func (_self *IntentCoreV2) EvaluateSubintentPolicy(policy *Policy) (PolicyDecision, error) {
+	subintent := _self.IntoSubintent()
+	defer subintent.Destroy()
+	hash, err := subintent.SubintentHash()
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	defer hash.Destroy()
+	manifest := intentCoreManifest(_self, NewSubintentManifestV2)
+	defer manifest.Destroy()
+	decision, err := manifest.EvaluatePolicy(_self.Header().NetworkId, policy)
+	if err != nil {
+		return PolicyDecision{}, err
+	}
+	return decision.bind(hash), nil
+}
+
+func intentCoreManifest[M any](core *IntentCoreV2, newManifest func(*InstructionsV2, [][]byte, []*Hash) M) M {
+	instructions := core.Instructions()
+	defer instructions.Destroy()
+	children := core.Children()
+	for _, child := range children {
+		defer child.Destroy()
+	}
+	return newManifest(instructions, core.Blobs(), children)
+}
+
+func (decision PolicyDecision) bind(hash *TransactionHash) PolicyDecision {
+	intentHash := hash.AsHash()
+	defer intentHash.Destroy()
+	decision.intentHash = intentHash.Bytes()
+	return decision
+}
+
+// GuardSigner returns a SignerV2 signing with signer the hash of the intent
+// the decision was made on, if the intent is allowed. It fails for any other
+// hash, including the signed intent hash a notary signs, for a denied intent
+// and for a decision on a manifest alone, which is bound to no intent.
+func (decision PolicyDecision) GuardSigner(signer SignerV2) SignerV2 {
+	return policySigner{signer: signer, decision: decision}
+}
+
+type policySigner struct {
+	signer   SignerV2
+	decision PolicyDecision
+}
+
+func (signer policySigner) check(hash *Hash) error {
+	if signer.decision.intentHash == nil {
+		return fmt.Errorf("the policy decision is bound to no intent")
+	}
+	if hash == nil || !bytes.Equal(hash.Bytes(), signer.decision.intentHash) {
+		return fmt.Errorf("the hash is not the hash of the intent the policy was evaluated on")
+	}
+	if !signer.decision.Allowed {
+		return fmt.Errorf("manifest %s", signer.decision)
+	}
+	return nil
+}
+
+func (signer policySigner) Sign(ctx context.Context, hash *Hash) ([]byte, error) {
+	if err := signer.check(hash); err != nil {
+		return nil, err
+	}
+	return signer.signer.Sign(ctx, hash)
+}
+
+func (signer policySigner) SignToSignature(ctx context.Context, hash *Hash) (SignatureV1, error) {
+	if err := signer.check(hash); err != nil {
+		return nil, err
+	}
+	return signer.signer.SignToSignature(ctx, hash)
+}
+
+func (signer policySigner) SignToSignatureWithPublicKey(ctx context.Context, hash *Hash) (SignatureWithPublicKeyV1, error) {
+	if err := signer.check(hash); err != nil {
+		return nil, err
+	}
+	return signer.signer.SignToSignatureWithPublicKey(ctx, hash)
+}
+
+func (signer policySigner) PublicKey(ctx context.Context) (PublicKey, error) {
+	return signer.signer.PublicKey(ctx)
+}
+
+// policyInstruction is what the rules of a policy see of an instruction.
+type policyInstruction struct {
+	kind string
+	// callee is the address of the entity the instruction calls, empty for
+	// an instruction which calls none or an entity the manifest allocates.
+	callee     string
+	calleeType *EntityType
+	method     string
+	resources  []policyResource
+}
+
+type policyResource struct {
+	address string
+	// amount is nil if it cannot be read.
+	amount *big.Rat
+}
+
+// isDecidedByDefault tells whether Default decides the instruction if no
+// rule matches it: the invocations, the yields, which pass resources to other
+// intents, and the burns.
+func (instruction policyInstruction) isDecidedByDefault() bool {
+	switch instruction.kind {
+	case "YieldToParent", "YieldToChild", "BurnResource":
+		return true
+	}
+	return strings.HasPrefix(instruction.kind, "Call")
+}
+
+func (instruction policyInstruction) describe() string {
+	description := snakeCase(instruction.kind)
+	if instruction.method != "" {
+		description += " " + instruction.method
+	}
+	if instruction.callee != "" {
+		description += " on " + instruction.callee
+	}
+	return description
+}
+
+func policyInstructions[I any](instructions []I) []policyInstruction {
+	result := make([]policyInstruction, len(instructions))
+	for index, instruction := range instructions {
+		result[index] = policyInstructionOf(reflect.ValueOf(instruction))
+	}
+	return result
+}
+
+// policyInstructionOf reads the fields of an instruction variant which the
+// V1 and V2 instruction sets name alike.
+func policyInstructionOf(value reflect.Value) policyInstruction {
+	instruction := policyInstruction{kind: diffTypeName(value.Type())}
+	for _, name := range []string{"Address", "PackageAddress"} {
+		field := value.FieldByName(name)
+		if !field.IsValid() {
+			continue
+		}
+		var callee *Address
+		switch address := field.Interface().(type) {
+		case ManifestAddressStatic:
+			callee = address.StaticAddress
+		case *Address:
+			callee = address
+		}
+		if callee != nil {
+			instruction.callee, instruction.calleeType = callee.AsStr(), callee.EntityType()
+		}
+	}
+	for _, name := range []string{"MethodName", "FunctionName"} {
+		if field := value.FieldByName(name); field.IsValid() {
+			instruction.method = field.String()
+		}
+	}
+	if field := value.FieldByName("ResourceAddress"); field.IsValid() {
+		if resource, ok := field.Interface().(*Address); ok && resource != nil {
+			var amount *big.Rat
+			if field := value.FieldByName("Amount"); field.IsValid() {
+				if decimal, ok := field.Interface().(*Decimal); ok && decimal != nil {
+					amount = policyDecimalAmount(decimal.Value())
+				}
+			}
+			if field := value.FieldByName("Ids"); field.IsValid() {
+				if ids, ok := field.Interface().([]NonFungibleLocalId); ok {
+					amount = new(big.Rat).SetInt64(int64(len(ids)))
+				}
+			}
+			instruction.resources = append(instruction.resources, policyResource{resource.AsStr(), amount})
+		}
+	}
+	if field := value.FieldByName("Args"); field.IsValid() {
+		if args, ok := field.Interface().(ManifestValue); ok {
+			instruction.resources = policyArgResources(args, instruction.resources)
+		}
+	}
+	return instruction
+}
+
+// policyArgResources appends the resources which the value names to
+// resources, with the amount following each, if any.
+func policyArgResources(value ManifestValue, resources []policyResource) []policyResource {
+	var values []ManifestValue
+	switch value := value.(type) {
+	case ManifestValueTupleValue:
+		values = value.Fields
+	case ManifestValueEnumValue:
+		values = value.Fields
+	case ManifestValueArrayValue:
+		values = value.Elements
+	case ManifestValueMapValue:
+		for _, entry := range value.Entries {
+			values = append(values, entry.Key, entry.Value)
+		}
+	default:
+		return resources
+	}
+	for index, element := range values {
+		address, ok := element.(ManifestValueAddressValue)
+		if !ok {
+			resources = policyArgResources(element, resources)
+			continue
+		}
+		static, ok := address.Value.(ManifestAddressStatic)
+		if !ok || static.StaticAddress == nil || !isResourceAddress(static.StaticAddress) {
+			continue
+		}
+		resource := policyResource{address: static.StaticAddress.AsStr()}
+		if index+1 < len(values) {
+			resource.amount = policyArgAmount(values[index+1])
+		}
+		resources = append(resources, resource)
+	}
+	return resources
+}
+
+// policyArgAmount returns the amount of a resource which value, following the
+// address of the resource in arguments, gives, or nil if it gives none.
+func policyArgAmount(value ManifestValue) *big.Rat {
+	switch value := value.(type) {
+	case ManifestValueDecimalValue:
+		if value.Value != nil {
+			return policyDecimalAmount(value.Value.Value())
+		}
+	case ManifestValuePreciseDecimalValue:
+		if value.Value != nil {
+			return new(big.Rat).SetFrac(value.Value.Value().big(), preciseDecimalValueSpec.one)
+		}
+	case ManifestValueArrayValue:
+		if value.ElementValueKind == ManifestValueKindNonFungibleLocalIdValue {
+			return new(big.Rat).SetInt64(int64(len(value.Elements)))
+		}
+	}
+	return nil
+}
+
+func policyDecimalAmount(value DecimalValue) *big.Rat {
+	return new(big.Rat).SetFrac(value.big(), decimalValueSpec.one)
+}
+
+func isResourceAddress(address *Address) bool {
+	entityType := address.EntityType()
+	return entityType != nil && (*entityType == EntityTypeGlobalFungibleResourceManager || *entityType == EntityTypeGlobalNonFungibleResourceManager)
+}
+
+func (policy *Policy) evaluate(instructions []policyInstruction, analysis StaticAnalysis) PolicyDecision {
+	var violations []PolicyViolation
+	if len(policy.Classifications) > 0 && !slices.ContainsFunc(analysis.ManifestClassification, func(classification ManifestClassification) bool {
+		return slices.Contains(policy.Classifications, classification)
+	}) {
+		names := make([]string, len(analysis.ManifestClassification))
+		for index, classification := range analysis.ManifestClassification {
+			text, _ := classification.MarshalText()
+			names[index] = string(text)
+		}
+		violations = append(violations, PolicyViolation{
+			Rule:   "classifications",
+			Reason: fmt.Sprintf("the manifest is classified as [%s]", strings.Join(names, ", ")),
+		})
+	}
+	for _, entities := range []struct {
+		rule    string
+		allowed []string
+		auth    []*Address
+	}{
+		{"accounts", policy.Accounts, analysis.EntitiesRequiringAuthSummary.Accounts},
+		{"identities", policy.Identities, analysis.EntitiesRequiringAuthSummary.Identities},
+	} {
+		for _, entity := range entities.auth {
+			if !slices.Contains(entities.allowed, entity.AsStr()) {
+				violations = append(violations, PolicyViolation{
+					Rule:         entities.rule,
+					Reason:       fmt.Sprintf("the manifest requires the auth of %s", entity.AsStr()),
+					Instructions: policyCalls(instructions, entity.AsStr(), "*"),
+				})
+			}
+		}
+	}
+	for _, reserved := range policyReservedKinds {
+		if slices.Contains(policy.AllowReserved, reserved.name) {
+			continue
+		}
+		for _, entity := range reserved.addresses(analysis.ReservedInstructionsSummary) {
+			violations = append(violations, PolicyViolation{
+				Rule:         "reserved",
+				Reason:       fmt.Sprintf("the manifest invokes a reserved %s on %s", reserved.name, entity.AsStr()),
+				Instructions: policyCalls(instructions, entity.AsStr(), reserved.method),
+			})
+		}
+	}
+	for index, instruction := range instructions {
+		rule, action := policy.match(instruction)
+		if action == PolicyAllow {
+			continue
+		}
+		violations = append(violations, PolicyViolation{
+			Rule:         rule,
+			Reason:       fmt.Sprintf("%s is denied", instruction.describe()),
+			Instructions: []int{index},
+		})
+	}
+	return PolicyDecision{Allowed: len(violations) == 0, Violations: violations}
+}
+
+// match returns the name and the action of the rule deciding the
+// instruction.
+func (policy *Policy) match(instruction policyInstruction) (string, PolicyAction) {
+	for index, rule := range policy.Rules {
+		if rule.matches(instruction) {
+			if rule.Name == "" {
+				return fmt.Sprintf("rule %d", index), rule.Action
+			}
+			return rule.Name, rule.Action
+		}
+	}
+	if instruction.isDecidedByDefault() {
+		return "default", policy.Default
+	}
+	return "", PolicyAllow
+}
+
+func (rule PolicyRule) matches(instruction policyInstruction) bool {
+	if len(rule.Instructions) > 0 && !slices.Contains(rule.Instructions, snakeCase(instruction.kind)) {
+		return false
+	}
+	if len(rule.Entities) > 0 && (instruction.callee == "" || !slices.Contains(rule.Entities, instruction.callee)) {
+		return false
+	}
+	if len(rule.EntityTypes) > 0 && (instruction.calleeType == nil || !slices.Contains(rule.EntityTypes, *instruction.calleeType)) {
+		return false
+	}
+	if len(rule.Methods) > 0 && (instruction.method == "" || !slices.ContainsFunc(rule.Methods, func(pattern string) bool {
+		matched, _ := path.Match(pattern, instruction.method)
+		return matched
+	})) {
+		return false
+	}
+	if len(rule.Resources) == 0 && rule.AmountAbove == nil {
+		return true
+	}
+	return slices.ContainsFunc(instruction.resources, func(resource policyResource) bool {
+		if len(rule.Resources) > 0 && !slices.Contains(rule.Resources, resource.address) {
+			return false
+		}
+		return rule.AmountAbove == nil || resource.amount == nil || resource.amount.Cmp(policyDecimalAmount(*rule.AmountAbove)) > 0
+	})
+}
+
+// policyCalls returns the indices of the instructions calling a method
+// matching method on entity.
+func policyCalls(instructions []policyInstruction, entity string, method string) []int {
+	var indices []int
+	for index, instruction := range instructions {
+		if instruction.callee != entity {
+			continue
+		}
+		if matched, _ := path.Match(method, instruction.method); matched {
+			indices = append(indices, index)
+		}
+	}
+	return indices
+}
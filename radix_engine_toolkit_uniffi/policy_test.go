@@ -0,0 +1,288 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+const (
+	policyAccount   = "account_rdx12xsvygvltz4uhsht6tdrfxktzpmnl77r0d40j8agmujgdj022sudkk"
+	policyComponent = "component_rdx1cptxxxxxxxxxfaucetxxxxxxxxx000527798379xxxxxxxxxhkrefh"
+	policyXrd       = "resource_rdx1tknxxxxxxxxxradxrdxxxxxxxxx009923554798xxxxxxxxxradxrd"
+	policyToken     = "resource_rdx1t4upr78guuapv5ept7d7ptekk9mqhy605zgms33mcszen8l9fac8vf"
+)
+
+func policyAmount(value int64) *big.Rat {
+	return big.NewRat(value, 1)
+}
+
+func policyCall(callee string, entityType EntityType, method string, resources ...policyResource) policyInstruction {
+	return policyInstruction{kind: "CallMethod", callee: callee, calleeType: &entityType, method: method, resources: resources}
+}
+
+func TestPolicyMatch(t *testing.T) {
+	thousand := mustDecimalValue(t, "1000")
+	withdraw := func(resources ...policyResource) policyInstruction {
+		return policyCall(policyAccount, EntityTypeGlobalAccount, "withdraw", resources...)
+	}
+	policy := &Policy{
+		Rules: []PolicyRule{
+			{Name: "no-deposit-rule-changes", Action: PolicyDeny, EntityTypes: []EntityType{EntityTypeGlobalAccount}, Methods: []string{"set_default_deposit_rule"}},
+			{Name: "large-withdrawals", Action: PolicyDeny, Methods: []string{"withdraw*"}, Resources: []string{policyXrd}, AmountAbove: &thousand},
+			{Name: "accounts", Action: PolicyAllow, EntityTypes: []EntityType{EntityTypeGlobalAccount}},
+			{Name: "faucet", Action: PolicyAllow, Entities: []string{policyComponent}, Methods: []string{"free"}},
+			{Action: PolicyAllow, Instructions: []string{"yield_to_parent"}},
+		},
+		Default: PolicyDeny,
+	}
+	tests := []struct {
+		name        string
+		instruction policyInstruction
+		rule        string
+		action      PolicyAction
+	}{
+		{"first matching rule", policyCall(policyAccount, EntityTypeGlobalAccount, "set_default_deposit_rule"), "no-deposit-rule-changes", PolicyDeny},
+		{"later rule", policyCall(policyAccount, EntityTypeGlobalAccount, "deposit"), "accounts", PolicyAllow},
+		{"amount above", withdraw(policyResource{policyXrd, policyAmount(1001)}), "large-withdrawals", PolicyDeny},
+		{"amount not above", withdraw(policyResource{policyXrd, policyAmount(1000)}), "accounts", PolicyAllow},
+		{"fractional amount above", withdraw(policyResource{policyXrd, big.NewRat(1000_000_001, 1000_000)}), "large-withdrawals", PolicyDeny},
+		{"unreadable amount", withdraw(policyResource{policyXrd, nil}), "large-withdrawals", PolicyDeny},
+		{"other resource", withdraw(policyResource{policyToken, policyAmount(5000)}), "accounts", PolicyAllow},
+		{"one of the resources", withdraw(policyResource{policyToken, nil}, policyResource{policyXrd, policyAmount(5000)}), "large-withdrawals", PolicyDeny},
+		{"entity and method", policyCall(policyComponent, EntityTypeGlobalGenericComponent, "free"), "faucet", PolicyAllow},
+		{"entity and other method", policyCall(policyComponent, EntityTypeGlobalGenericComponent, "lock_fee"), "default", PolicyDeny},
+		{"allocated entity", policyInstruction{kind: "CallMethod", method: "free"}, "default", PolicyDeny},
+		{"unnamed rule", policyInstruction{kind: "YieldToParent"}, "rule 4", PolicyAllow},
+		{"yield decided by default", policyInstruction{kind: "YieldToChild"}, "default", PolicyDeny},
+		{"burn decided by default", policyInstruction{kind: "BurnResource"}, "default", PolicyDeny},
+		{"function decided by default", policyInstruction{kind: "CallFunction", method: "instantiate"}, "default", PolicyDeny},
+		{"other instruction allowed", policyInstruction{kind: "TakeAllFromWorktop", resources: []policyResource{{policyXrd, nil}}}, "", PolicyAllow},
+	}
+	for _, test := range tests {
+		rule, action := policy.match(test.instruction)
+		if rule != test.rule || action != test.action {
+			t.Errorf("%s: match = %q, %s, want %q, %s", test.name, rule, action, test.rule, test.action)
+		}
+	}
+
+	policy.Default = PolicyAllow
+	if rule, action := policy.match(policyInstruction{kind: "CallMethod", method: "free"}); rule != "default" || action != PolicyAllow {
+		t.Errorf("match with an allowing default = %q, %s, want default, allow", rule, action)
+	}
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	instructions := []policyInstruction{
+		policyCall(policyAccount, EntityTypeGlobalAccount, "withdraw"),
+		{kind: "TakeAllFromWorktop"},
+		policyCall(policyComponent, EntityTypeGlobalGenericComponent, "swap"),
+		{kind: "BurnResource"},
+	}
+	transfer := StaticAnalysis{ManifestClassification: []ManifestClassification{ManifestClassificationTransfer}}
+	tests := []struct {
+		name       string
+		policy     Policy
+		analysis   StaticAnalysis
+		violations []PolicyViolation
+	}{
+		{"allowing default", Policy{Default: PolicyAllow}, transfer, nil},
+		{
+			"denying default",
+			Policy{Default: PolicyDeny},
+			transfer,
+			[]PolicyViolation{{Rule: "default", Instructions: []int{0}}, {Rule: "default", Instructions: []int{2}}, {Rule: "default", Instructions: []int{3}}},
+		},
+		{
+			"rules before the default",
+			Policy{Rules: []PolicyRule{
+				{Name: "accounts", Action: PolicyAllow, EntityTypes: []EntityType{EntityTypeGlobalAccount}},
+				{Name: "no-swaps", Action: PolicyDeny, Methods: []string{"swap"}},
+				{Name: "calls", Action: PolicyAllow, Instructions: []string{"call_method"}},
+			}, Default: PolicyAllow},
+			transfer,
+			[]PolicyViolation{{Rule: "no-swaps", Instructions: []int{2}}},
+		},
+		{"classification", Policy{Classifications: []ManifestClassification{ManifestClassificationGeneral, ManifestClassificationTransfer}, Default: PolicyAllow}, transfer, nil},
+		{
+			"other classification",
+			Policy{Classifications: []ManifestClassification{ManifestClassificationGeneral}, Default: PolicyAllow},
+			transfer,
+			[]PolicyViolation{{Rule: "classifications"}},
+		},
+		{
+			"no classification",
+			Policy{Classifications: []ManifestClassification{ManifestClassificationTransfer}, Default: PolicyAllow},
+			StaticAnalysis{},
+			[]PolicyViolation{{Rule: "classifications"}},
+		},
+	}
+	for _, test := range tests {
+		decision := test.policy.evaluate(instructions, test.analysis)
+		checkDecision(t, test.name, decision, test.violations)
+	}
+}
+
+// checkDecision checks the rules and the instructions of the violations of
+// decision.
+func checkDecision(t *testing.T, name string, decision PolicyDecision, violations []PolicyViolation) {
+	t.Helper()
+	if decision.Allowed != (len(violations) == 0) {
+		t.Errorf("%s: decision %s, want %d violations", name, decision, len(violations))
+		return
+	}
+	if len(decision.Violations) != len(violations) {
+		t.Errorf("%s: decision %s, want %d violations", name, decision, len(violations))
+		return
+	}
+	for index, violation := range decision.Violations {
+		want := violations[index]
+		if violation.Rule != want.Rule || !slices.Equal(violation.Instructions, want.Instructions) || violation.Reason == "" {
+			t.Errorf("%s: violation %d = %s, want %s of instructions %v", name, index, violation, want.Rule, want.Instructions)
+		}
+	}
+}
+
+func TestPolicyEvaluateEntities(t *testing.T) {
+	account := testAddress(t, address.EntityTypeGlobalAccount, 1)
+	other := testAddress(t, address.EntityTypeGlobalAccount, 2)
+	identity := testAddress(t, address.EntityTypeGlobalIdentity, 3)
+	controller := testAddress(t, address.EntityTypeGlobalAccessController, 4)
+	instructions := []policyInstruction{
+		policyCall(account.AsStr(), EntityTypeGlobalAccount, "lock_fee"),
+		policyCall(other.AsStr(), EntityTypeGlobalAccount, "withdraw"),
+		policyCall(identity.AsStr(), EntityTypeGlobalIdentity, "securify"),
+		policyCall(controller.AsStr(), EntityTypeGlobalAccessController, "create_proof"),
+	}
+	analysis := StaticAnalysis{
+		EntitiesRequiringAuthSummary: EntitiesRequiringAuthOutput{Accounts: []*Address{account, other}, Identities: []*Address{identity}},
+		ReservedInstructionsSummary: ReservedInstructionsOutput{
+			AccountLockFeeInvocations:   []*Address{account},
+			IdentitySecurifyInvocations: []*Address{identity},
+			AccessControllerInvocations: []*Address{controller},
+		},
+	}
+	allowAll := Policy{
+		Accounts:      []string{account.AsStr(), other.AsStr()},
+		Identities:    []string{identity.AsStr()},
+		AllowReserved: []string{"AccountLockFee", "IdentitySecurify", "AccessController"},
+		Default:       PolicyAllow,
+	}
+	checkDecision(t, "everything allowed", allowAll.evaluate(instructions, analysis), nil)
+
+	tests := []struct {
+		name       string
+		restrict   func(policy *Policy)
+		violations []PolicyViolation
+	}{
+		{"account", func(policy *Policy) { policy.Accounts = policy.Accounts[:1] }, []PolicyViolation{{Rule: "accounts", Instructions: []int{1}}}},
+		{"identity", func(policy *Policy) { policy.Identities = nil }, []PolicyViolation{{Rule: "identities", Instructions: []int{2}}}},
+		{"lock fee", func(policy *Policy) { policy.AllowReserved = policy.AllowReserved[1:] }, []PolicyViolation{{Rule: "reserved", Instructions: []int{0}}}},
+		{
+			"access controller and securify",
+			func(policy *Policy) { policy.AllowReserved = policy.AllowReserved[:1] },
+			[]PolicyViolation{{Rule: "reserved", Instructions: []int{2}}, {Rule: "reserved", Instructions: []int{3}}},
+		},
+	}
+	for _, test := range tests {
+		policy := allowAll
+		policy.Accounts, policy.AllowReserved = slices.Clone(allowAll.Accounts), slices.Clone(allowAll.AllowReserved)
+		test.restrict(&policy)
+		checkDecision(t, test.name, policy.evaluate(instructions, analysis), test.violations)
+	}
+}
+
+func TestPolicyArgResources(t *testing.T) {
+	xrd := testAddress(t, address.EntityTypeGlobalFungibleResourceManager, 1)
+	badge := testAddress(t, address.EntityTypeGlobalNonFungibleResourceManager, 2)
+	account := testAddress(t, address.EntityTypeGlobalAccount, 3)
+	precise, err := NewPreciseDecimal("2.000000000000000000000000000000000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource := func(address *Address) ManifestValue {
+		return ManifestValueAddressValue{Value: ManifestAddressStatic{StaticAddress: address}}
+	}
+	args := ManifestValueTupleValue{Fields: []ManifestValue{
+		resource(xrd), ManifestValueDecimalValue{Value: testDecimal(t, "1.5")},
+		resource(xrd), ManifestValuePreciseDecimalValue{Value: precise},
+		resource(badge), ManifestValueArrayValue{ElementValueKind: ManifestValueKindNonFungibleLocalIdValue, Elements: []ManifestValue{
+			ManifestValueNonFungibleLocalIdValue{Value: NonFungibleLocalIdInteger{Value: 1}},
+			ManifestValueNonFungibleLocalIdValue{Value: NonFungibleLocalIdInteger{Value: 2}},
+		}},
+		// An amount before the address, or no amount at all, is unreadable.
+		ManifestValueTupleValue{Fields: []ManifestValue{ManifestValueU64Value{Value: 7}, resource(xrd)}},
+		resource(xrd), ManifestValueU64Value{Value: 7},
+		// Only resource addresses are resources.
+		resource(account), ManifestValueDecimalValue{Value: testDecimal(t, "9")},
+	}}
+	preciseAmount, _ := new(big.Rat).SetString(precise.AsStr())
+	want := []struct {
+		address *Address
+		amount  *big.Rat
+	}{
+		{xrd, big.NewRat(3, 2)},
+		{xrd, preciseAmount},
+		{badge, policyAmount(2)},
+		{xrd, nil},
+		{xrd, nil},
+	}
+
+	resources := policyArgResources(args, nil)
+	if len(resources) != len(want) {
+		t.Fatalf("policyArgResources returned %d resources, want %d", len(resources), len(want))
+	}
+	for index, resource := range resources {
+		amountsEqual := resource.amount == nil && want[index].amount == nil ||
+			resource.amount != nil && want[index].amount != nil && resource.amount.Cmp(want[index].amount) == 0
+		if resource.address != want[index].address.AsStr() || !amountsEqual {
+			t.Errorf("resource %d = %s of %v, want %s of %v", index, resource.address, resource.amount, want[index].address.AsStr(), want[index].amount)
+		}
+	}
+
+	// A PreciseDecimal just above the limit is above it.
+	two := mustDecimalValue(t, "2")
+	rule := PolicyRule{Resources: []string{xrd.AsStr()}, AmountAbove: &two}
+	if !rule.matches(policyInstruction{kind: "CallMethod", resources: resources[1:2]}) {
+		t.Errorf("rule with an amount above 2 does not match %v", resources[1].amount)
+	}
+}
+
+func TestGuardSigner(t *testing.T) {
+	ctx := context.Background()
+	intent := HashFromUnhashedBytes([]byte("intent"))
+	other := HashFromUnhashedBytes([]byte("other intent"))
+	allowed := PolicyDecision{Allowed: true, intentHash: intent.Bytes()}
+	denied := PolicyDecision{Violations: []PolicyViolation{{Rule: "default", Reason: "call_method swap is denied", Instructions: []int{2}}}, intentHash: intent.Bytes()}
+
+	if _, err := allowed.GuardSigner(failingSigner{}).Sign(ctx, intent); err != nil {
+		t.Errorf("signing the hash of an allowed intent = %v", err)
+	}
+	tests := []struct {
+		name     string
+		decision PolicyDecision
+		hash     *Hash
+		reason   string
+	}{
+		{"hash mismatch", allowed, other, "not the hash of the intent"},
+		{"no hash", allowed, nil, "not the hash of the intent"},
+		{"denied decision", denied, intent, "denied"},
+		{"decision bound to no intent", PolicyDecision{Allowed: true}, intent, "bound to no intent"},
+	}
+	for _, test := range tests {
+		signer := test.decision.GuardSigner(failingSigner{})
+		if _, err := signer.Sign(ctx, test.hash); err == nil || !strings.Contains(err.Error(), test.reason) {
+			t.Errorf("%s: Sign = %v, want an error about %q", test.name, err, test.reason)
+		}
+		if _, err := signer.SignToSignature(ctx, test.hash); err == nil {
+			t.Errorf("%s: SignToSignature succeeded", test.name)
+		}
+		if _, err := signer.SignToSignatureWithPublicKey(ctx, test.hash); err == nil {
+			t.Errorf("%s: SignToSignatureWithPublicKey succeeded", test.name)
+		}
+	}
+}
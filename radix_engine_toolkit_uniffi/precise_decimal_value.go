@@ -0,0 +1,238 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const preciseDecimalValueLength = 32
+
+// PreciseDecimalValue is a pure Go PreciseDecimal: 36 decimal places over a 256
+// bit two's complement integer, bit-exact with the engine. Unlike
+// *PreciseDecimal it may be compared with == and needs no Destroy.
+type PreciseDecimalValue struct {
+	subunits [4]uint64
+}
+
+// ParsePreciseDecimalValue parses a decimal in the format accepted by
+// NewPreciseDecimal.
+func ParsePreciseDecimalValue(value string) (PreciseDecimalValue, error) {
+	subunits, err := preciseDecimalValueSpec.parse(value)
+	if err != nil {
+		return PreciseDecimalValue{}, err
+	}
+	return preciseDecimalValueFromBig(subunits), nil
+}
+
+// PreciseDecimalValueFromInt64 returns the decimal with the integral value
+// value.
+func PreciseDecimalValueFromInt64(value int64) PreciseDecimalValue {
+	return preciseDecimalValueFromBig(new(big.Int).Mul(big.NewInt(value), preciseDecimalValueSpec.one))
+}
+
+// PreciseDecimalValueFromLeBytes is the counterpart of
+// PreciseDecimalFromLeBytes, reading the 32 byte little endian two's complement subunits.
+func PreciseDecimalValueFromLeBytes(value []byte) (PreciseDecimalValue, error) {
+	if len(value) != preciseDecimalValueLength {
+		return PreciseDecimalValue{}, NewRadixEngineToolkitErrorInvalidLength(preciseDecimalValueLength, uint64(len(value)), value)
+	}
+	var decimal PreciseDecimalValue
+	limbsFromLeBytes(decimal.subunits[:], value)
+	return decimal, nil
+}
+
+func PreciseDecimalValueZero() PreciseDecimalValue {
+	return PreciseDecimalValue{}
+}
+
+func PreciseDecimalValueOne() PreciseDecimalValue {
+	return preciseDecimalValueFromBig(preciseDecimalValueSpec.one)
+}
+
+func PreciseDecimalValueMax() PreciseDecimalValue {
+	return preciseDecimalValueFromBig(preciseDecimalValueSpec.max)
+}
+
+func PreciseDecimalValueMin() PreciseDecimalValue {
+	return preciseDecimalValueFromBig(preciseDecimalValueSpec.min)
+}
+
+func preciseDecimalValueFromBig(subunits *big.Int) PreciseDecimalValue {
+	var decimal PreciseDecimalValue
+	preciseDecimalValueSpec.fromBig(decimal.subunits[:], subunits)
+	return decimal
+}
+
+func (d PreciseDecimalValue) big() *big.Int {
+	return preciseDecimalValueSpec.toBig(d.subunits[:])
+}
+
+func preciseDecimalValueResult(subunits *big.Int, err error) (PreciseDecimalValue, error) {
+	if err != nil {
+		return PreciseDecimalValue{}, err
+	}
+	return preciseDecimalValueFromBig(subunits), nil
+}
+
+// Value converts the native precise decimal into a PreciseDecimalValue.
+func (_self *PreciseDecimal) Value() PreciseDecimalValue {
+	decimal, err := PreciseDecimalValueFromLeBytes(_self.ToLeBytes())
+	if err != nil {
+		panic(fmt.Sprintf("invalid native precise decimal: %v", err))
+	}
+	return decimal
+}
+
+// ToPreciseDecimal converts the value into a native *PreciseDecimal.
+func (d PreciseDecimalValue) ToPreciseDecimal() *PreciseDecimal {
+	return PreciseDecimalFromLeBytes(d.ToLeBytes())
+}
+
+func (d PreciseDecimalValue) ToLeBytes() []byte {
+	return limbsToLeBytes(d.subunits[:])
+}
+
+func (d PreciseDecimalValue) Add(other PreciseDecimalValue) (PreciseDecimalValue, error) {
+	var sum PreciseDecimalValue
+	if limbsAdd(sum.subunits[:], d.subunits[:], other.subunits[:]) {
+		return PreciseDecimalValue{}, NewRadixEngineToolkitErrorDecimalError()
+	}
+	return sum, nil
+}
+
+func (d PreciseDecimalValue) Sub(other PreciseDecimalValue) (PreciseDecimalValue, error) {
+	var difference PreciseDecimalValue
+	if limbsSub(difference.subunits[:], d.subunits[:], other.subunits[:]) {
+		return PreciseDecimalValue{}, NewRadixEngineToolkitErrorDecimalError()
+	}
+	return difference, nil
+}
+
+func (d PreciseDecimalValue) Mul(other PreciseDecimalValue) (PreciseDecimalValue, error) {
+	return preciseDecimalValueResult(preciseDecimalValueSpec.mul(d.big(), other.big()))
+}
+
+func (d PreciseDecimalValue) Div(other PreciseDecimalValue) (PreciseDecimalValue, error) {
+	return preciseDecimalValueResult(preciseDecimalValueSpec.div(d.big(), other.big()))
+}
+
+func (d PreciseDecimalValue) Neg() (PreciseDecimalValue, error) {
+	return PreciseDecimalValue{}.Sub(d)
+}
+
+func (d PreciseDecimalValue) Abs() (PreciseDecimalValue, error) {
+	if d.IsNegative() {
+		return d.Neg()
+	}
+	return d, nil
+}
+
+func (d PreciseDecimalValue) Powi(exp int64) (PreciseDecimalValue, error) {
+	return preciseDecimalValueResult(preciseDecimalValueSpec.powi(d.big(), exp))
+}
+
+// Sqrt returns the square root, ok being false for negative values.
+func (d PreciseDecimalValue) Sqrt() (root PreciseDecimalValue, ok bool) {
+	subunits, ok := preciseDecimalValueSpec.sqrt(d.big())
+	if !ok {
+		return PreciseDecimalValue{}, false
+	}
+	return preciseDecimalValueFromBig(subunits), true
+}
+
+// Cbrt returns the cube root, which exists for every value and never
+// overflows.
+func (d PreciseDecimalValue) Cbrt() PreciseDecimalValue {
+	subunits, _ := preciseDecimalValueSpec.nthRoot(d.big(), 3)
+	return preciseDecimalValueFromBig(subunits)
+}
+
+// NthRoot returns the nth root, ok being false for n = 0 and for even roots
+// of negative values.
+func (d PreciseDecimalValue) NthRoot(n uint32) (root PreciseDecimalValue, ok bool) {
+	subunits, ok := preciseDecimalValueSpec.nthRoot(d.big(), n)
+	if !ok {
+		return PreciseDecimalValue{}, false
+	}
+	return preciseDecimalValueFromBig(subunits), true
+}
+
+func (d PreciseDecimalValue) Round(decimalPlaces int32, roundingMode RoundingMode) (PreciseDecimalValue, error) {
+	return preciseDecimalValueResult(preciseDecimalValueSpec.round(d.big(), decimalPlaces, roundingMode))
+}
+
+func (d PreciseDecimalValue) Floor() (PreciseDecimalValue, error) {
+	return d.Round(0, RoundingModeToNegativeInfinity)
+}
+
+func (d PreciseDecimalValue) Ceiling() (PreciseDecimalValue, error) {
+	return d.Round(0, RoundingModeToPositiveInfinity)
+}
+
+// Cmp returns -1, 0 or +1 depending on whether d is less than, equal to or
+// greater than other.
+func (d PreciseDecimalValue) Cmp(other PreciseDecimalValue) int {
+	return limbsCmp(d.subunits[:], other.subunits[:])
+}
+
+func (d PreciseDecimalValue) Equal(other PreciseDecimalValue) bool {
+	return d == other
+}
+
+func (d PreciseDecimalValue) NotEqual(other PreciseDecimalValue) bool {
+	return d != other
+}
+
+func (d PreciseDecimalValue) GreaterThan(other PreciseDecimalValue) bool {
+	return d.Cmp(other) > 0
+}
+
+func (d PreciseDecimalValue) GreaterThanOrEqual(other PreciseDecimalValue) bool {
+	return d.Cmp(other) >= 0
+}
+
+func (d PreciseDecimalValue) LessThan(other PreciseDecimalValue) bool {
+	return d.Cmp(other) < 0
+}
+
+func (d PreciseDecimalValue) LessThanOrEqual(other PreciseDecimalValue) bool {
+	return d.Cmp(other) <= 0
+}
+
+func (d PreciseDecimalValue) IsZero() bool {
+	return limbsIsZero(d.subunits[:])
+}
+
+func (d PreciseDecimalValue) IsNegative() bool {
+	return limbsNegative(d.subunits[:])
+}
+
+func (d PreciseDecimalValue) IsPositive() bool {
+	return !d.IsNegative() && !d.IsZero()
+}
+
+// Mantissa returns the subunits, the value multiplied by 10^36.
+func (d PreciseDecimalValue) Mantissa() string {
+	return d.big().String()
+}
+
+func (d PreciseDecimalValue) AsStr() string {
+	return preciseDecimalValueSpec.format(d.big())
+}
+
+func (d PreciseDecimalValue) String() string {
+	return d.AsStr()
+}
+
+func (d PreciseDecimalValue) MarshalText() ([]byte, error) {
+	return []byte(d.AsStr()), nil
+}
+
+func (d *PreciseDecimalValue) UnmarshalText(text []byte) error {
+	decimal, err := ParsePreciseDecimalValue(string(text))
+	if err != nil {
+		return err
+	}
+	*d = decimal
+	return nil
+}
@@ -0,0 +1,45 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"context"
+)
+
+// PreviewBackend previews transaction manifests, producing the receipts
+// DynamicallyAnalyze takes. Implementations may call out to a Gateway or Core
+// API node, or execute the manifest locally like LocalPreviewEngine.
+//
+// A manifest which can be previewed but fails is reported through the kind
+// of the receipt; the error is reserved for manifests the backend could not
+// preview at all.
+type PreviewBackend interface {
+	PreviewManifestV1(ctx context.Context, manifest *TransactionManifestV1, options PreviewOptions) (ToolkitReceipt, error)
+
+	PreviewManifestV2(ctx context.Context, manifest *TransactionManifestV2, options PreviewOptions) (ToolkitReceipt, error)
+}
+
+// PreviewOptions holds the parts of a transaction, beyond its manifest, which
+// a preview may depend on.
+type PreviewOptions struct {
+	// the public keys the transaction is assumed to be signed with
+	SignerPublicKeys []PublicKey
+}
+
+// PreviewAndAnalyzeV1 previews manifest with backend and dynamically
+// analyzes it with the resulting receipt.
+func PreviewAndAnalyzeV1(ctx context.Context, backend PreviewBackend, manifest *TransactionManifestV1, networkId uint8, options PreviewOptions) (DynamicAnalysis, error) {
+	receipt, err := backend.PreviewManifestV1(ctx, manifest, options)
+	if err != nil {
+		return DynamicAnalysis{}, err
+	}
+	return manifest.DynamicallyAnalyzeReceipt(networkId, receipt)
+}
+
+// PreviewAndAnalyzeV2 is the TransactionManifestV2 counterpart of
+// PreviewAndAnalyzeV1.
+func PreviewAndAnalyzeV2(ctx context.Context, backend PreviewBackend, manifest *TransactionManifestV2, networkId uint8, options PreviewOptions) (DynamicAnalysis, error) {
+	receipt, err := backend.PreviewManifestV2(ctx, manifest, options)
+	if err != nil {
+		return DynamicAnalysis{}, err
+	}
+	return manifest.DynamicallyAnalyzeReceipt(networkId, receipt)
+}
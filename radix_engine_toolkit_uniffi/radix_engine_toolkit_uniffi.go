@@ -6470,7 +6470,7 @@ func (ffiObject *FfiObject)incrementPointer(debugName string) unsafe.Pointer {
 	for {
 		counter := ffiObject.callCounter.Load()
 		if counter <= -1 {
-			panic(fmt.Errorf("%v object has already been destroyed", debugName))
+			panic(NewRadixEngineToolkitErrorObjectDestroyed(debugName))
 		}
 		if counter == math.MaxInt64 {
 			panic(fmt.Errorf("%v object call counter would overflow", debugName))
@@ -6520,7 +6520,8 @@ func AccessRuleDenyAll() *AccessRule {
 	}))
 }
 
-func AccessRuleFromScryptoSborPayload(payload []byte) (*AccessRule, error) {
+func AccessRuleFromScryptoSborPayload(payload []byte) (_ *AccessRule, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_accessrule_from_scrypto_sbor_payload(FfiConverterBytesINSTANCE.Lower(payload), _uniffiStatus)
 	})
@@ -6532,7 +6533,8 @@ func AccessRuleFromScryptoSborPayload(payload []byte) (*AccessRule, error) {
 		}
 }
 
-func AccessRuleRequire(resourceOrNonFungible ResourceOrNonFungible) (*AccessRule, error) {
+func AccessRuleRequire(resourceOrNonFungible ResourceOrNonFungible) (_ *AccessRule, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_accessrule_require(FfiConverterTypeResourceOrNonFungibleINSTANCE.Lower(resourceOrNonFungible), _uniffiStatus)
 	})
@@ -6544,7 +6546,8 @@ func AccessRuleRequire(resourceOrNonFungible ResourceOrNonFungible) (*AccessRule
 		}
 }
 
-func AccessRuleRequireAllOf(resources []ResourceOrNonFungible) (*AccessRule, error) {
+func AccessRuleRequireAllOf(resources []ResourceOrNonFungible) (_ *AccessRule, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_accessrule_require_all_of(FfiConverterSequenceTypeResourceOrNonFungibleINSTANCE.Lower(resources), _uniffiStatus)
 	})
@@ -6556,7 +6559,8 @@ func AccessRuleRequireAllOf(resources []ResourceOrNonFungible) (*AccessRule, err
 		}
 }
 
-func AccessRuleRequireAmount(amount *Decimal, resource *Address) (*AccessRule, error) {
+func AccessRuleRequireAmount(amount *Decimal, resource *Address) (_ *AccessRule, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_accessrule_require_amount(FfiConverterDecimalINSTANCE.Lower(amount), FfiConverterAddressINSTANCE.Lower(resource), _uniffiStatus)
 	})
@@ -6568,7 +6572,8 @@ func AccessRuleRequireAmount(amount *Decimal, resource *Address) (*AccessRule, e
 		}
 }
 
-func AccessRuleRequireAnyOf(resources []ResourceOrNonFungible) (*AccessRule, error) {
+func AccessRuleRequireAnyOf(resources []ResourceOrNonFungible) (_ *AccessRule, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_accessrule_require_any_of(FfiConverterSequenceTypeResourceOrNonFungibleINSTANCE.Lower(resources), _uniffiStatus)
 	})
@@ -6580,7 +6585,8 @@ func AccessRuleRequireAnyOf(resources []ResourceOrNonFungible) (*AccessRule, err
 		}
 }
 
-func AccessRuleRequireCountOf(count uint8, resources []ResourceOrNonFungible) (*AccessRule, error) {
+func AccessRuleRequireCountOf(count uint8, resources []ResourceOrNonFungible) (_ *AccessRule, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_accessrule_require_count_of(FfiConverterUint8INSTANCE.Lower(count), FfiConverterSequenceTypeResourceOrNonFungibleINSTANCE.Lower(resources), _uniffiStatus)
 	})
@@ -6592,7 +6598,8 @@ func AccessRuleRequireCountOf(count uint8, resources []ResourceOrNonFungible) (*
 		}
 }
 
-func AccessRuleRequireSignature(publicKey PublicKey) (*AccessRule, error) {
+func AccessRuleRequireSignature(publicKey PublicKey) (_ *AccessRule, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_accessrule_require_signature(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), _uniffiStatus)
 	})
@@ -6655,6 +6662,7 @@ func (c FfiConverterAccessRule) Lift(pointer unsafe.Pointer) *AccessRule {
 		}),
 	}
 	runtime.SetFinalizer(result, (*AccessRule).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -6685,7 +6693,8 @@ func (_ FfiDestroyerAccessRule) Destroy(value *AccessRule) {
 type Address struct {
 	ffiObject FfiObject
 }
-func NewAddress(address string) (*Address, error) {
+func NewAddress(address string) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_address_new(FfiConverterStringINSTANCE.Lower(address), _uniffiStatus)
 	})
@@ -6698,7 +6707,8 @@ func NewAddress(address string) (*Address, error) {
 }
 
 
-func AddressFromRaw(nodeIdBytes []byte, networkId uint8) (*Address, error) {
+func AddressFromRaw(nodeIdBytes []byte, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_address_from_raw(FfiConverterBytesINSTANCE.Lower(nodeIdBytes), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -6710,7 +6720,8 @@ func AddressFromRaw(nodeIdBytes []byte, networkId uint8) (*Address, error) {
 		}
 }
 
-func AddressPreallocatedAccountAddressFromOlympiaAddress(olympiaAccountAddress *OlympiaAddress, networkId uint8) (*Address, error) {
+func AddressPreallocatedAccountAddressFromOlympiaAddress(olympiaAccountAddress *OlympiaAddress, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_address_preallocated_account_address_from_olympia_address(FfiConverterOlympiaAddressINSTANCE.Lower(olympiaAccountAddress), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -6722,7 +6733,8 @@ func AddressPreallocatedAccountAddressFromOlympiaAddress(olympiaAccountAddress *
 		}
 }
 
-func AddressPreallocatedAccountAddressFromPublicKey(publicKey PublicKey, networkId uint8) (*Address, error) {
+func AddressPreallocatedAccountAddressFromPublicKey(publicKey PublicKey, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_address_preallocated_account_address_from_public_key(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -6734,7 +6746,8 @@ func AddressPreallocatedAccountAddressFromPublicKey(publicKey PublicKey, network
 		}
 }
 
-func AddressPreallocatedIdentityAddressFromPublicKey(publicKey PublicKey, networkId uint8) (*Address, error) {
+func AddressPreallocatedIdentityAddressFromPublicKey(publicKey PublicKey, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_address_preallocated_identity_address_from_public_key(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -6746,7 +6759,8 @@ func AddressPreallocatedIdentityAddressFromPublicKey(publicKey PublicKey, networ
 		}
 }
 
-func AddressResourceAddressFromOlympiaResourceAddress(olympiaResourceAddress *OlympiaAddress, networkId uint8) (*Address, error) {
+func AddressResourceAddressFromOlympiaResourceAddress(olympiaResourceAddress *OlympiaAddress, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_address_resource_address_from_olympia_resource_address(FfiConverterOlympiaAddressINSTANCE.Lower(olympiaResourceAddress), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -6959,6 +6973,7 @@ func (c FfiConverterAddress) Lift(pointer unsafe.Pointer) *Address {
 		}),
 	}
 	runtime.SetFinalizer(result, (*Address).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -6989,7 +7004,8 @@ func (_ FfiDestroyerAddress) Destroy(value *Address) {
 type Decimal struct {
 	ffiObject FfiObject
 }
-func NewDecimal(value string) (*Decimal, error) {
+func NewDecimal(value string) (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_decimal_new(FfiConverterStringINSTANCE.Lower(value), _uniffiStatus)
 	})
@@ -7034,7 +7050,8 @@ func DecimalZero() *Decimal {
 
 
 
-func (_self *Decimal)Abs() (*Decimal, error) {
+func (_self *Decimal)Abs() (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7050,7 +7067,8 @@ func (_self *Decimal)Abs() (*Decimal, error) {
 }
 
 
-func (_self *Decimal)Add(other *Decimal) (*Decimal, error) {
+func (_self *Decimal)Add(other *Decimal) (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7076,7 +7094,8 @@ func (_self *Decimal)AsStr() string {
 }
 
 
-func (_self *Decimal)Cbrt() (*Decimal, error) {
+func (_self *Decimal)Cbrt() (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7092,7 +7111,8 @@ func (_self *Decimal)Cbrt() (*Decimal, error) {
 }
 
 
-func (_self *Decimal)Ceiling() (*Decimal, error) {
+func (_self *Decimal)Ceiling() (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7108,7 +7128,8 @@ func (_self *Decimal)Ceiling() (*Decimal, error) {
 }
 
 
-func (_self *Decimal)Div(other *Decimal) (*Decimal, error) {
+func (_self *Decimal)Div(other *Decimal) (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7134,7 +7155,8 @@ func (_self *Decimal)Equal(other *Decimal) bool {
 }
 
 
-func (_self *Decimal)Floor() (*Decimal, error) {
+func (_self *Decimal)Floor() (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7230,7 +7252,8 @@ func (_self *Decimal)Mantissa() string {
 }
 
 
-func (_self *Decimal)Mul(other *Decimal) (*Decimal, error) {
+func (_self *Decimal)Mul(other *Decimal) (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7266,7 +7289,8 @@ func (_self *Decimal)NthRoot(n uint32) **Decimal {
 }
 
 
-func (_self *Decimal)Powi(exp int64) (*Decimal, error) {
+func (_self *Decimal)Powi(exp int64) (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7282,7 +7306,8 @@ func (_self *Decimal)Powi(exp int64) (*Decimal, error) {
 }
 
 
-func (_self *Decimal)Round(decimalPlaces int32, roundingMode RoundingMode) (*Decimal, error) {
+func (_self *Decimal)Round(decimalPlaces int32, roundingMode RoundingMode) (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7308,7 +7333,8 @@ func (_self *Decimal)Sqrt() **Decimal {
 }
 
 
-func (_self *Decimal)Sub(other *Decimal) (*Decimal, error) {
+func (_self *Decimal)Sub(other *Decimal) (_ *Decimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*Decimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7353,6 +7379,7 @@ func (c FfiConverterDecimal) Lift(pointer unsafe.Pointer) *Decimal {
 		}),
 	}
 	runtime.SetFinalizer(result, (*Decimal).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -7383,7 +7410,8 @@ func (_ FfiDestroyerDecimal) Destroy(value *Decimal) {
 type Hash struct {
 	ffiObject FfiObject
 }
-func NewHash(hash []byte) (*Hash, error) {
+func NewHash(hash []byte) (_ *Hash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_hash_new(FfiConverterBytesINSTANCE.Lower(hash), _uniffiStatus)
 	})
@@ -7396,7 +7424,8 @@ func NewHash(hash []byte) (*Hash, error) {
 }
 
 
-func HashFromHexString(hash string) (*Hash, error) {
+func HashFromHexString(hash string) (_ *Hash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_hash_from_hex_string(FfiConverterStringINSTANCE.Lower(hash), _uniffiStatus)
 	})
@@ -7414,7 +7443,8 @@ func HashFromUnhashedBytes(bytes []byte) *Hash {
 	}))
 }
 
-func HashSborDecode(bytes []byte) (*Hash, error) {
+func HashSborDecode(bytes []byte) (_ *Hash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_hash_sbor_decode(FfiConverterBytesINSTANCE.Lower(bytes), _uniffiStatus)
 	})
@@ -7467,6 +7497,7 @@ func (c FfiConverterHash) Lift(pointer unsafe.Pointer) *Hash {
 		}),
 	}
 	runtime.SetFinalizer(result, (*Hash).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -7499,7 +7530,8 @@ type InstructionsV1 struct {
 }
 
 
-func InstructionsV1FromInstructions(instructions []InstructionV1, networkId uint8) (*InstructionsV1, error) {
+func InstructionsV1FromInstructions(instructions []InstructionV1, networkId uint8) (_ *InstructionsV1, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_instructionsv1_from_instructions(FfiConverterSequenceTypeInstructionV1INSTANCE.Lower(instructions), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -7511,7 +7543,8 @@ func InstructionsV1FromInstructions(instructions []InstructionV1, networkId uint
 		}
 }
 
-func InstructionsV1FromString(string string, networkId uint8) (*InstructionsV1, error) {
+func InstructionsV1FromString(string string, networkId uint8) (_ *InstructionsV1, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_instructionsv1_from_string(FfiConverterStringINSTANCE.Lower(string), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -7525,7 +7558,8 @@ func InstructionsV1FromString(string string, networkId uint8) (*InstructionsV1,
 
 
 
-func (_self *InstructionsV1)AsStr() (string, error) {
+func (_self *InstructionsV1)AsStr() (_ string, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*InstructionsV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -7580,6 +7614,7 @@ func (c FfiConverterInstructionsV1) Lift(pointer unsafe.Pointer) *InstructionsV1
 		}),
 	}
 	runtime.SetFinalizer(result, (*InstructionsV1).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -7612,7 +7647,8 @@ type InstructionsV2 struct {
 }
 
 
-func InstructionsV2FromInstructions(instructions []InstructionV2, networkId uint8) (*InstructionsV2, error) {
+func InstructionsV2FromInstructions(instructions []InstructionV2, networkId uint8) (_ *InstructionsV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_instructionsv2_from_instructions(FfiConverterSequenceTypeInstructionV2INSTANCE.Lower(instructions), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -7624,7 +7660,8 @@ func InstructionsV2FromInstructions(instructions []InstructionV2, networkId uint
 		}
 }
 
-func InstructionsV2FromString(string string, networkId uint8) (*InstructionsV2, error) {
+func InstructionsV2FromString(string string, networkId uint8) (_ *InstructionsV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_instructionsv2_from_string(FfiConverterStringINSTANCE.Lower(string), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -7638,7 +7675,8 @@ func InstructionsV2FromString(string string, networkId uint8) (*InstructionsV2,
 
 
 
-func (_self *InstructionsV2)AsStr() (string, error) {
+func (_self *InstructionsV2)AsStr() (_ string, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*InstructionsV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -7693,6 +7731,7 @@ func (c FfiConverterInstructionsV2) Lift(pointer unsafe.Pointer) *InstructionsV2
 		}),
 	}
 	runtime.SetFinalizer(result, (*InstructionsV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -7811,6 +7850,7 @@ func (c FfiConverterIntentCoreV2) Lift(pointer unsafe.Pointer) *IntentCoreV2 {
 		}),
 	}
 	runtime.SetFinalizer(result, (*IntentCoreV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -7848,7 +7888,8 @@ func NewIntentV1(header TransactionHeaderV1, manifest *TransactionManifestV1, me
 }
 
 
-func IntentV1FromPayloadBytes(compiledIntent []byte) (*IntentV1, error) {
+func IntentV1FromPayloadBytes(compiledIntent []byte) (_ *IntentV1, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_intentv1_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledIntent), _uniffiStatus)
 	})
@@ -7862,7 +7903,8 @@ func IntentV1FromPayloadBytes(compiledIntent []byte) (*IntentV1, error) {
 
 
 
-func (_self *IntentV1)Hash() (*TransactionHash, error) {
+func (_self *IntentV1)Hash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*IntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7888,7 +7930,8 @@ func (_self *IntentV1)Header() TransactionHeaderV1 {
 }
 
 
-func (_self *IntentV1)IntentHash() (*TransactionHash, error) {
+func (_self *IntentV1)IntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*IntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -7924,7 +7967,8 @@ func (_self *IntentV1)Message() MessageV1 {
 }
 
 
-func (_self *IntentV1)StaticallyValidate(networkId uint8) error {
+func (_self *IntentV1)StaticallyValidate(networkId uint8) (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*IntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
@@ -7936,7 +7980,8 @@ func (_self *IntentV1)StaticallyValidate(networkId uint8) error {
 }
 
 
-func (_self *IntentV1)ToPayloadBytes() ([]byte, error) {
+func (_self *IntentV1)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*IntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -7971,6 +8016,7 @@ func (c FfiConverterIntentV1) Lift(pointer unsafe.Pointer) *IntentV1 {
 		}),
 	}
 	runtime.SetFinalizer(result, (*IntentV1).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -8010,7 +8056,8 @@ func NewManifestV1Builder() *ManifestV1Builder {
 
 
 
-func (_self *ManifestV1Builder)AccessControllerCancelPrimaryRoleBadgeWithdrawAttempt(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerCancelPrimaryRoleBadgeWithdrawAttempt(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8026,7 +8073,8 @@ func (_self *ManifestV1Builder)AccessControllerCancelPrimaryRoleBadgeWithdrawAtt
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerCancelPrimaryRoleRecoveryProposal(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerCancelPrimaryRoleRecoveryProposal(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8042,7 +8090,8 @@ func (_self *ManifestV1Builder)AccessControllerCancelPrimaryRoleRecoveryProposal
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerCancelRecoveryRoleBadgeWithdrawAttempt(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerCancelRecoveryRoleBadgeWithdrawAttempt(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8058,7 +8107,8 @@ func (_self *ManifestV1Builder)AccessControllerCancelRecoveryRoleBadgeWithdrawAt
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerCancelRecoveryRoleRecoveryProposal(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerCancelRecoveryRoleRecoveryProposal(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8074,7 +8124,8 @@ func (_self *ManifestV1Builder)AccessControllerCancelRecoveryRoleRecoveryProposa
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerCreate(controlledAsset ManifestBuilderBucket, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerCreate(controlledAsset ManifestBuilderBucket, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8090,7 +8141,8 @@ func (_self *ManifestV1Builder)AccessControllerCreate(controlledAsset ManifestBu
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerCreateProof(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerCreateProof(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8106,7 +8158,8 @@ func (_self *ManifestV1Builder)AccessControllerCreateProof(address *Address) (*M
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerCreateWithSecurityStructure(controlledAsset ManifestBuilderBucket, primaryRole SecurityStructureRole, recoveryRole SecurityStructureRole, confirmationRole SecurityStructureRole, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerCreateWithSecurityStructure(controlledAsset ManifestBuilderBucket, primaryRole SecurityStructureRole, recoveryRole SecurityStructureRole, confirmationRole SecurityStructureRole, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8122,7 +8175,8 @@ func (_self *ManifestV1Builder)AccessControllerCreateWithSecurityStructure(contr
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerInitiateBadgeWithdrawAsPrimary(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerInitiateBadgeWithdrawAsPrimary(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8138,7 +8192,8 @@ func (_self *ManifestV1Builder)AccessControllerInitiateBadgeWithdrawAsPrimary(ad
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerInitiateBadgeWithdrawAsRecovery(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerInitiateBadgeWithdrawAsRecovery(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8154,7 +8209,8 @@ func (_self *ManifestV1Builder)AccessControllerInitiateBadgeWithdrawAsRecovery(a
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerInitiateRecoveryAsPrimary(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerInitiateRecoveryAsPrimary(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8170,7 +8226,8 @@ func (_self *ManifestV1Builder)AccessControllerInitiateRecoveryAsPrimary(address
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerInitiateRecoveryAsRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerInitiateRecoveryAsRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8186,7 +8243,8 @@ func (_self *ManifestV1Builder)AccessControllerInitiateRecoveryAsRecovery(addres
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerLockPrimaryRole(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerLockPrimaryRole(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8202,7 +8260,8 @@ func (_self *ManifestV1Builder)AccessControllerLockPrimaryRole(address *Address)
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerMintRecoveryBadges(address *Address, nonFungibleLocalIds []NonFungibleLocalId) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerMintRecoveryBadges(address *Address, nonFungibleLocalIds []NonFungibleLocalId) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8218,7 +8277,8 @@ func (_self *ManifestV1Builder)AccessControllerMintRecoveryBadges(address *Addre
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerNewFromPublicKeys(controlledAsset ManifestBuilderBucket, primaryRole PublicKey, recoveryRole PublicKey, confirmationRole PublicKey, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerNewFromPublicKeys(controlledAsset ManifestBuilderBucket, primaryRole PublicKey, recoveryRole PublicKey, confirmationRole PublicKey, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8234,7 +8294,8 @@ func (_self *ManifestV1Builder)AccessControllerNewFromPublicKeys(controlledAsset
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerQuickConfirmPrimaryRoleBadgeWithdrawAttempt(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerQuickConfirmPrimaryRoleBadgeWithdrawAttempt(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8250,7 +8311,8 @@ func (_self *ManifestV1Builder)AccessControllerQuickConfirmPrimaryRoleBadgeWithd
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerQuickConfirmPrimaryRoleRecoveryProposal(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerQuickConfirmPrimaryRoleRecoveryProposal(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8266,7 +8328,8 @@ func (_self *ManifestV1Builder)AccessControllerQuickConfirmPrimaryRoleRecoveryPr
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerQuickConfirmRecoveryRoleBadgeWithdrawAttempt(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerQuickConfirmRecoveryRoleBadgeWithdrawAttempt(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8282,7 +8345,8 @@ func (_self *ManifestV1Builder)AccessControllerQuickConfirmRecoveryRoleBadgeWith
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerQuickConfirmRecoveryRoleRecoveryProposal(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerQuickConfirmRecoveryRoleRecoveryProposal(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8298,7 +8362,8 @@ func (_self *ManifestV1Builder)AccessControllerQuickConfirmRecoveryRoleRecoveryP
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerStopTimedRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerStopTimedRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8314,7 +8379,8 @@ func (_self *ManifestV1Builder)AccessControllerStopTimedRecovery(address *Addres
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerTimedConfirmRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerTimedConfirmRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8330,7 +8396,8 @@ func (_self *ManifestV1Builder)AccessControllerTimedConfirmRecovery(address *Add
 }
 
 
-func (_self *ManifestV1Builder)AccessControllerUnlockPrimaryRole(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccessControllerUnlockPrimaryRole(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8346,7 +8413,8 @@ func (_self *ManifestV1Builder)AccessControllerUnlockPrimaryRole(address *Addres
 }
 
 
-func (_self *ManifestV1Builder)AccountAddAuthorizedDepositor(address *Address, badge ResourceOrNonFungible) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountAddAuthorizedDepositor(address *Address, badge ResourceOrNonFungible) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8362,7 +8430,8 @@ func (_self *ManifestV1Builder)AccountAddAuthorizedDepositor(address *Address, b
 }
 
 
-func (_self *ManifestV1Builder)AccountBurn(address *Address, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountBurn(address *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8378,7 +8447,8 @@ func (_self *ManifestV1Builder)AccountBurn(address *Address, resourceAddress *Ad
 }
 
 
-func (_self *ManifestV1Builder)AccountBurnNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountBurnNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8394,7 +8464,8 @@ func (_self *ManifestV1Builder)AccountBurnNonFungibles(address *Address, resourc
 }
 
 
-func (_self *ManifestV1Builder)AccountCreate() (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountCreate() (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8410,7 +8481,8 @@ func (_self *ManifestV1Builder)AccountCreate() (*ManifestV1Builder, error) {
 }
 
 
-func (_self *ManifestV1Builder)AccountCreateAdvanced(ownerRole OwnerRole, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountCreateAdvanced(ownerRole OwnerRole, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8426,7 +8498,8 @@ func (_self *ManifestV1Builder)AccountCreateAdvanced(ownerRole OwnerRole, addres
 }
 
 
-func (_self *ManifestV1Builder)AccountCreateProofOfAmount(address *Address, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountCreateProofOfAmount(address *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8442,7 +8515,8 @@ func (_self *ManifestV1Builder)AccountCreateProofOfAmount(address *Address, reso
 }
 
 
-func (_self *ManifestV1Builder)AccountCreateProofOfNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountCreateProofOfNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8458,7 +8532,8 @@ func (_self *ManifestV1Builder)AccountCreateProofOfNonFungibles(address *Address
 }
 
 
-func (_self *ManifestV1Builder)AccountDeposit(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountDeposit(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8474,7 +8549,8 @@ func (_self *ManifestV1Builder)AccountDeposit(address *Address, bucket ManifestB
 }
 
 
-func (_self *ManifestV1Builder)AccountDepositBatch(address *Address, buckets []ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountDepositBatch(address *Address, buckets []ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8490,7 +8566,8 @@ func (_self *ManifestV1Builder)AccountDepositBatch(address *Address, buckets []M
 }
 
 
-func (_self *ManifestV1Builder)AccountDepositEntireWorktop(accountAddress *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountDepositEntireWorktop(accountAddress *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8506,7 +8583,8 @@ func (_self *ManifestV1Builder)AccountDepositEntireWorktop(accountAddress *Addre
 }
 
 
-func (_self *ManifestV1Builder)AccountLockContingentFee(address *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockContingentFee(address *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8522,7 +8600,8 @@ func (_self *ManifestV1Builder)AccountLockContingentFee(address *Address, amount
 }
 
 
-func (_self *ManifestV1Builder)AccountLockFee(address *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockFee(address *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8538,7 +8617,8 @@ func (_self *ManifestV1Builder)AccountLockFee(address *Address, amount *Decimal)
 }
 
 
-func (_self *ManifestV1Builder)AccountLockFeeAndWithdraw(address *Address, amountToLock *Decimal, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockFeeAndWithdraw(address *Address, amountToLock *Decimal, resourceAddress *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8554,7 +8634,8 @@ func (_self *ManifestV1Builder)AccountLockFeeAndWithdraw(address *Address, amoun
 }
 
 
-func (_self *ManifestV1Builder)AccountLockFeeAndWithdrawNonFungibles(address *Address, amountToLock *Decimal, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockFeeAndWithdrawNonFungibles(address *Address, amountToLock *Decimal, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8570,7 +8651,8 @@ func (_self *ManifestV1Builder)AccountLockFeeAndWithdrawNonFungibles(address *Ad
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerAirdrop(address *Address, claimants map[string]ResourceSpecifier, bucket ManifestBuilderBucket, tryDirectSend bool) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerAirdrop(address *Address, claimants map[string]ResourceSpecifier, bucket ManifestBuilderBucket, tryDirectSend bool) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8586,7 +8668,8 @@ func (_self *ManifestV1Builder)AccountLockerAirdrop(address *Address, claimants
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerClaim(address *Address, claimant *Address, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerClaim(address *Address, claimant *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8602,7 +8685,8 @@ func (_self *ManifestV1Builder)AccountLockerClaim(address *Address, claimant *Ad
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerClaimNonFungibles(address *Address, claimant *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerClaimNonFungibles(address *Address, claimant *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8618,7 +8702,8 @@ func (_self *ManifestV1Builder)AccountLockerClaimNonFungibles(address *Address,
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerGetAmount(address *Address, claimant *Address, resourceAddress *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerGetAmount(address *Address, claimant *Address, resourceAddress *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8634,7 +8719,8 @@ func (_self *ManifestV1Builder)AccountLockerGetAmount(address *Address, claimant
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerGetNonFungibleLocalIds(address *Address, claimant *Address, resourceAddress *Address, limit uint32) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerGetNonFungibleLocalIds(address *Address, claimant *Address, resourceAddress *Address, limit uint32) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8650,7 +8736,8 @@ func (_self *ManifestV1Builder)AccountLockerGetNonFungibleLocalIds(address *Addr
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerInstantiate(ownerRole OwnerRole, storerRole *AccessRule, storerUpdaterRole *AccessRule, recovererRole *AccessRule, recovererUpdaterRole *AccessRule, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerInstantiate(ownerRole OwnerRole, storerRole *AccessRule, storerUpdaterRole *AccessRule, recovererRole *AccessRule, recovererUpdaterRole *AccessRule, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8666,7 +8753,8 @@ func (_self *ManifestV1Builder)AccountLockerInstantiate(ownerRole OwnerRole, sto
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerInstantiateSimple(allowRecover bool) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerInstantiateSimple(allowRecover bool) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8682,7 +8770,8 @@ func (_self *ManifestV1Builder)AccountLockerInstantiateSimple(allowRecover bool)
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerRecover(address *Address, claimant *Address, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerRecover(address *Address, claimant *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8698,7 +8787,8 @@ func (_self *ManifestV1Builder)AccountLockerRecover(address *Address, claimant *
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerRecoverNonFungibles(address *Address, claimant *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerRecoverNonFungibles(address *Address, claimant *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8714,7 +8804,8 @@ func (_self *ManifestV1Builder)AccountLockerRecoverNonFungibles(address *Address
 }
 
 
-func (_self *ManifestV1Builder)AccountLockerStore(address *Address, claimant *Address, bucket ManifestBuilderBucket, tryDirectSend bool) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountLockerStore(address *Address, claimant *Address, bucket ManifestBuilderBucket, tryDirectSend bool) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8730,7 +8821,8 @@ func (_self *ManifestV1Builder)AccountLockerStore(address *Address, claimant *Ad
 }
 
 
-func (_self *ManifestV1Builder)AccountRemoveAuthorizedDepositor(address *Address, badge ResourceOrNonFungible) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountRemoveAuthorizedDepositor(address *Address, badge ResourceOrNonFungible) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8746,7 +8838,8 @@ func (_self *ManifestV1Builder)AccountRemoveAuthorizedDepositor(address *Address
 }
 
 
-func (_self *ManifestV1Builder)AccountRemoveResourcePreference(address *Address, resourceAddress *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountRemoveResourcePreference(address *Address, resourceAddress *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8762,7 +8855,8 @@ func (_self *ManifestV1Builder)AccountRemoveResourcePreference(address *Address,
 }
 
 
-func (_self *ManifestV1Builder)AccountSecurify(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountSecurify(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8778,7 +8872,8 @@ func (_self *ManifestV1Builder)AccountSecurify(address *Address) (*ManifestV1Bui
 }
 
 
-func (_self *ManifestV1Builder)AccountSetDefaultDepositRule(address *Address, defaultDepositRule AccountDefaultDepositRule) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountSetDefaultDepositRule(address *Address, defaultDepositRule AccountDefaultDepositRule) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8794,7 +8889,8 @@ func (_self *ManifestV1Builder)AccountSetDefaultDepositRule(address *Address, de
 }
 
 
-func (_self *ManifestV1Builder)AccountSetResourcePreference(address *Address, resourceAddress *Address, resourcePreference ResourcePreference) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountSetResourcePreference(address *Address, resourceAddress *Address, resourcePreference ResourcePreference) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8810,7 +8906,8 @@ func (_self *ManifestV1Builder)AccountSetResourcePreference(address *Address, re
 }
 
 
-func (_self *ManifestV1Builder)AccountTryDepositBatchOrAbort(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountTryDepositBatchOrAbort(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8826,7 +8923,8 @@ func (_self *ManifestV1Builder)AccountTryDepositBatchOrAbort(address *Address, b
 }
 
 
-func (_self *ManifestV1Builder)AccountTryDepositBatchOrRefund(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountTryDepositBatchOrRefund(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8842,7 +8940,8 @@ func (_self *ManifestV1Builder)AccountTryDepositBatchOrRefund(address *Address,
 }
 
 
-func (_self *ManifestV1Builder)AccountTryDepositEntireWorktopOrAbort(accountAddress *Address, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountTryDepositEntireWorktopOrAbort(accountAddress *Address, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8858,7 +8957,8 @@ func (_self *ManifestV1Builder)AccountTryDepositEntireWorktopOrAbort(accountAddr
 }
 
 
-func (_self *ManifestV1Builder)AccountTryDepositEntireWorktopOrRefund(accountAddress *Address, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountTryDepositEntireWorktopOrRefund(accountAddress *Address, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8874,7 +8974,8 @@ func (_self *ManifestV1Builder)AccountTryDepositEntireWorktopOrRefund(accountAdd
 }
 
 
-func (_self *ManifestV1Builder)AccountTryDepositOrAbort(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountTryDepositOrAbort(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8890,7 +8991,8 @@ func (_self *ManifestV1Builder)AccountTryDepositOrAbort(address *Address, bucket
 }
 
 
-func (_self *ManifestV1Builder)AccountTryDepositOrRefund(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountTryDepositOrRefund(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8906,7 +9008,8 @@ func (_self *ManifestV1Builder)AccountTryDepositOrRefund(address *Address, bucke
 }
 
 
-func (_self *ManifestV1Builder)AccountWithdraw(address *Address, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountWithdraw(address *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8922,7 +9025,8 @@ func (_self *ManifestV1Builder)AccountWithdraw(address *Address, resourceAddress
 }
 
 
-func (_self *ManifestV1Builder)AccountWithdrawNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AccountWithdrawNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8938,7 +9042,8 @@ func (_self *ManifestV1Builder)AccountWithdrawNonFungibles(address *Address, res
 }
 
 
-func (_self *ManifestV1Builder)AllocateGlobalAddress(packageAddress *Address, blueprintName string, intoAddressReservation ManifestBuilderAddressReservation, intoNamedAddress ManifestBuilderNamedAddress) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AllocateGlobalAddress(packageAddress *Address, blueprintName string, intoAddressReservation ManifestBuilderAddressReservation, intoNamedAddress ManifestBuilderNamedAddress) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8954,7 +9059,8 @@ func (_self *ManifestV1Builder)AllocateGlobalAddress(packageAddress *Address, bl
 }
 
 
-func (_self *ManifestV1Builder)AssertWorktopContains(resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AssertWorktopContains(resourceAddress *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8970,7 +9076,8 @@ func (_self *ManifestV1Builder)AssertWorktopContains(resourceAddress *Address, a
 }
 
 
-func (_self *ManifestV1Builder)AssertWorktopContainsAny(resourceAddress *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AssertWorktopContainsAny(resourceAddress *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -8986,7 +9093,8 @@ func (_self *ManifestV1Builder)AssertWorktopContainsAny(resourceAddress *Address
 }
 
 
-func (_self *ManifestV1Builder)AssertWorktopContainsNonFungibles(resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)AssertWorktopContainsNonFungibles(resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9012,7 +9120,8 @@ func (_self *ManifestV1Builder)Build(networkId uint8) *TransactionManifestV1 {
 }
 
 
-func (_self *ManifestV1Builder)BurnResource(bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)BurnResource(bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9028,7 +9137,8 @@ func (_self *ManifestV1Builder)BurnResource(bucket ManifestBuilderBucket) (*Mani
 }
 
 
-func (_self *ManifestV1Builder)CallAccessRulesMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CallAccessRulesMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9044,7 +9154,8 @@ func (_self *ManifestV1Builder)CallAccessRulesMethod(address ManifestBuilderAddr
 }
 
 
-func (_self *ManifestV1Builder)CallDirectVaultMethod(address *Address, methodName string, args []ManifestBuilderValue) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CallDirectVaultMethod(address *Address, methodName string, args []ManifestBuilderValue) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9060,7 +9171,8 @@ func (_self *ManifestV1Builder)CallDirectVaultMethod(address *Address, methodNam
 }
 
 
-func (_self *ManifestV1Builder)CallFunction(address ManifestBuilderAddress, blueprintName string, functionName string, args []ManifestBuilderValue) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CallFunction(address ManifestBuilderAddress, blueprintName string, functionName string, args []ManifestBuilderValue) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9076,7 +9188,8 @@ func (_self *ManifestV1Builder)CallFunction(address ManifestBuilderAddress, blue
 }
 
 
-func (_self *ManifestV1Builder)CallMetadataMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CallMetadataMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9092,7 +9205,8 @@ func (_self *ManifestV1Builder)CallMetadataMethod(address ManifestBuilderAddress
 }
 
 
-func (_self *ManifestV1Builder)CallMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CallMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9108,7 +9222,8 @@ func (_self *ManifestV1Builder)CallMethod(address ManifestBuilderAddress, method
 }
 
 
-func (_self *ManifestV1Builder)CallRoyaltyMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CallRoyaltyMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9124,7 +9239,8 @@ func (_self *ManifestV1Builder)CallRoyaltyMethod(address ManifestBuilderAddress,
 }
 
 
-func (_self *ManifestV1Builder)CloneProof(proof ManifestBuilderProof, intoProof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CloneProof(proof ManifestBuilderProof, intoProof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9140,7 +9256,8 @@ func (_self *ManifestV1Builder)CloneProof(proof ManifestBuilderProof, intoProof
 }
 
 
-func (_self *ManifestV1Builder)CreateFungibleResourceManager(ownerRole OwnerRole, trackTotalSupply bool, divisibility uint8, initialSupply **Decimal, resourceRoles FungibleResourceRoles, metadata MetadataModuleConfig, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CreateFungibleResourceManager(ownerRole OwnerRole, trackTotalSupply bool, divisibility uint8, initialSupply **Decimal, resourceRoles FungibleResourceRoles, metadata MetadataModuleConfig, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9156,7 +9273,8 @@ func (_self *ManifestV1Builder)CreateFungibleResourceManager(ownerRole OwnerRole
 }
 
 
-func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfAll(resourceAddress *Address, intoProof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfAll(resourceAddress *Address, intoProof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9172,7 +9290,8 @@ func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfAll(resourceAddress *Add
 }
 
 
-func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfAmount(resourceAddress *Address, amount *Decimal, intoProof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfAmount(resourceAddress *Address, amount *Decimal, intoProof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9188,7 +9307,8 @@ func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfAmount(resourceAddress *
 }
 
 
-func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfNonFungibles(resourceAddress *Address, ids []NonFungibleLocalId, intoProof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfNonFungibles(resourceAddress *Address, ids []NonFungibleLocalId, intoProof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9204,7 +9324,8 @@ func (_self *ManifestV1Builder)CreateProofFromAuthZoneOfNonFungibles(resourceAdd
 }
 
 
-func (_self *ManifestV1Builder)CreateProofFromBucketOfAll(bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CreateProofFromBucketOfAll(bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9220,7 +9341,8 @@ func (_self *ManifestV1Builder)CreateProofFromBucketOfAll(bucket ManifestBuilder
 }
 
 
-func (_self *ManifestV1Builder)CreateProofFromBucketOfAmount(amount *Decimal, bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CreateProofFromBucketOfAmount(amount *Decimal, bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9236,7 +9358,8 @@ func (_self *ManifestV1Builder)CreateProofFromBucketOfAmount(amount *Decimal, bu
 }
 
 
-func (_self *ManifestV1Builder)CreateProofFromBucketOfNonFungibles(ids []NonFungibleLocalId, bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)CreateProofFromBucketOfNonFungibles(ids []NonFungibleLocalId, bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9252,7 +9375,8 @@ func (_self *ManifestV1Builder)CreateProofFromBucketOfNonFungibles(ids []NonFung
 }
 
 
-func (_self *ManifestV1Builder)DropAllProofs() (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)DropAllProofs() (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9268,7 +9392,8 @@ func (_self *ManifestV1Builder)DropAllProofs() (*ManifestV1Builder, error) {
 }
 
 
-func (_self *ManifestV1Builder)DropAuthZoneProofs() (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)DropAuthZoneProofs() (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9284,7 +9409,8 @@ func (_self *ManifestV1Builder)DropAuthZoneProofs() (*ManifestV1Builder, error)
 }
 
 
-func (_self *ManifestV1Builder)DropAuthZoneSignatureProofs() (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)DropAuthZoneSignatureProofs() (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9300,7 +9426,8 @@ func (_self *ManifestV1Builder)DropAuthZoneSignatureProofs() (*ManifestV1Builder
 }
 
 
-func (_self *ManifestV1Builder)DropProof(proof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)DropProof(proof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9316,7 +9443,8 @@ func (_self *ManifestV1Builder)DropProof(proof ManifestBuilderProof) (*ManifestV
 }
 
 
-func (_self *ManifestV1Builder)FaucetFreeXrd() (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)FaucetFreeXrd() (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9332,7 +9460,8 @@ func (_self *ManifestV1Builder)FaucetFreeXrd() (*ManifestV1Builder, error) {
 }
 
 
-func (_self *ManifestV1Builder)FaucetLockFee() (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)FaucetLockFee() (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9348,7 +9477,8 @@ func (_self *ManifestV1Builder)FaucetLockFee() (*ManifestV1Builder, error) {
 }
 
 
-func (_self *ManifestV1Builder)IdentityCreate() (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)IdentityCreate() (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9364,7 +9494,8 @@ func (_self *ManifestV1Builder)IdentityCreate() (*ManifestV1Builder, error) {
 }
 
 
-func (_self *ManifestV1Builder)IdentityCreateAdvanced(ownerRole OwnerRole) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)IdentityCreateAdvanced(ownerRole OwnerRole) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9380,7 +9511,8 @@ func (_self *ManifestV1Builder)IdentityCreateAdvanced(ownerRole OwnerRole) (*Man
 }
 
 
-func (_self *ManifestV1Builder)IdentitySecurify(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)IdentitySecurify(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9396,7 +9528,8 @@ func (_self *ManifestV1Builder)IdentitySecurify(address *Address) (*ManifestV1Bu
 }
 
 
-func (_self *ManifestV1Builder)MetadataGet(address *Address, key string) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MetadataGet(address *Address, key string) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9412,7 +9545,8 @@ func (_self *ManifestV1Builder)MetadataGet(address *Address, key string) (*Manif
 }
 
 
-func (_self *ManifestV1Builder)MetadataLock(address *Address, key string) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MetadataLock(address *Address, key string) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9428,7 +9562,8 @@ func (_self *ManifestV1Builder)MetadataLock(address *Address, key string) (*Mani
 }
 
 
-func (_self *ManifestV1Builder)MetadataRemove(address *Address, key string) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MetadataRemove(address *Address, key string) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9444,7 +9579,8 @@ func (_self *ManifestV1Builder)MetadataRemove(address *Address, key string) (*Ma
 }
 
 
-func (_self *ManifestV1Builder)MetadataSet(address *Address, key string, value MetadataValue) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MetadataSet(address *Address, key string, value MetadataValue) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9460,7 +9596,8 @@ func (_self *ManifestV1Builder)MetadataSet(address *Address, key string, value M
 }
 
 
-func (_self *ManifestV1Builder)MintFungible(resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MintFungible(resourceAddress *Address, amount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9476,7 +9613,8 @@ func (_self *ManifestV1Builder)MintFungible(resourceAddress *Address, amount *De
 }
 
 
-func (_self *ManifestV1Builder)MultiResourcePoolContribute(address *Address, buckets []ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MultiResourcePoolContribute(address *Address, buckets []ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9492,7 +9630,8 @@ func (_self *ManifestV1Builder)MultiResourcePoolContribute(address *Address, buc
 }
 
 
-func (_self *ManifestV1Builder)MultiResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MultiResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9508,7 +9647,8 @@ func (_self *ManifestV1Builder)MultiResourcePoolGetRedemptionValue(address *Addr
 }
 
 
-func (_self *ManifestV1Builder)MultiResourcePoolGetVaultAmount(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MultiResourcePoolGetVaultAmount(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9524,7 +9664,8 @@ func (_self *ManifestV1Builder)MultiResourcePoolGetVaultAmount(address *Address)
 }
 
 
-func (_self *ManifestV1Builder)MultiResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddresses []*Address, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MultiResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddresses []*Address, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9540,7 +9681,8 @@ func (_self *ManifestV1Builder)MultiResourcePoolInstantiate(ownerRole OwnerRole,
 }
 
 
-func (_self *ManifestV1Builder)MultiResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MultiResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9556,7 +9698,8 @@ func (_self *ManifestV1Builder)MultiResourcePoolProtectedDeposit(address *Addres
 }
 
 
-func (_self *ManifestV1Builder)MultiResourcePoolProtectedWithdraw(address *Address, resourceAddress *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MultiResourcePoolProtectedWithdraw(address *Address, resourceAddress *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9572,7 +9715,8 @@ func (_self *ManifestV1Builder)MultiResourcePoolProtectedWithdraw(address *Addre
 }
 
 
-func (_self *ManifestV1Builder)MultiResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)MultiResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9588,7 +9732,8 @@ func (_self *ManifestV1Builder)MultiResourcePoolRedeem(address *Address, bucket
 }
 
 
-func (_self *ManifestV1Builder)OneResourcePoolContribute(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)OneResourcePoolContribute(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9604,7 +9749,8 @@ func (_self *ManifestV1Builder)OneResourcePoolContribute(address *Address, bucke
 }
 
 
-func (_self *ManifestV1Builder)OneResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)OneResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9620,7 +9766,8 @@ func (_self *ManifestV1Builder)OneResourcePoolGetRedemptionValue(address *Addres
 }
 
 
-func (_self *ManifestV1Builder)OneResourcePoolGetVaultAmount(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)OneResourcePoolGetVaultAmount(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9636,7 +9783,8 @@ func (_self *ManifestV1Builder)OneResourcePoolGetVaultAmount(address *Address) (
 }
 
 
-func (_self *ManifestV1Builder)OneResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddress *Address, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)OneResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddress *Address, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9652,7 +9800,8 @@ func (_self *ManifestV1Builder)OneResourcePoolInstantiate(ownerRole OwnerRole, p
 }
 
 
-func (_self *ManifestV1Builder)OneResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)OneResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9668,7 +9817,8 @@ func (_self *ManifestV1Builder)OneResourcePoolProtectedDeposit(address *Address,
 }
 
 
-func (_self *ManifestV1Builder)OneResourcePoolProtectedWithdraw(address *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)OneResourcePoolProtectedWithdraw(address *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9684,7 +9834,8 @@ func (_self *ManifestV1Builder)OneResourcePoolProtectedWithdraw(address *Address
 }
 
 
-func (_self *ManifestV1Builder)OneResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)OneResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9700,7 +9851,8 @@ func (_self *ManifestV1Builder)OneResourcePoolRedeem(address *Address, bucket Ma
 }
 
 
-func (_self *ManifestV1Builder)PackageClaimRoyalty(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)PackageClaimRoyalty(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9716,7 +9868,8 @@ func (_self *ManifestV1Builder)PackageClaimRoyalty(address *Address) (*ManifestV
 }
 
 
-func (_self *ManifestV1Builder)PackagePublish(code []byte, definition []byte, metadata map[string]MetadataInitEntry) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)PackagePublish(code []byte, definition []byte, metadata map[string]MetadataInitEntry) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9732,7 +9885,8 @@ func (_self *ManifestV1Builder)PackagePublish(code []byte, definition []byte, me
 }
 
 
-func (_self *ManifestV1Builder)PackagePublishAdvanced(ownerRole OwnerRole, code []byte, definition []byte, metadata map[string]MetadataInitEntry, packageAddress *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)PackagePublishAdvanced(ownerRole OwnerRole, code []byte, definition []byte, metadata map[string]MetadataInitEntry, packageAddress *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9748,7 +9902,8 @@ func (_self *ManifestV1Builder)PackagePublishAdvanced(ownerRole OwnerRole, code
 }
 
 
-func (_self *ManifestV1Builder)PopFromAuthZone(intoProof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)PopFromAuthZone(intoProof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9764,7 +9919,8 @@ func (_self *ManifestV1Builder)PopFromAuthZone(intoProof ManifestBuilderProof) (
 }
 
 
-func (_self *ManifestV1Builder)PushToAuthZone(proof ManifestBuilderProof) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)PushToAuthZone(proof ManifestBuilderProof) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9780,7 +9936,8 @@ func (_self *ManifestV1Builder)PushToAuthZone(proof ManifestBuilderProof) (*Mani
 }
 
 
-func (_self *ManifestV1Builder)ReturnToWorktop(bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ReturnToWorktop(bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9796,7 +9953,8 @@ func (_self *ManifestV1Builder)ReturnToWorktop(bucket ManifestBuilderBucket) (*M
 }
 
 
-func (_self *ManifestV1Builder)RoleAssignmentGet(address *Address, module ModuleId, roleKey string) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)RoleAssignmentGet(address *Address, module ModuleId, roleKey string) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9812,7 +9970,8 @@ func (_self *ManifestV1Builder)RoleAssignmentGet(address *Address, module Module
 }
 
 
-func (_self *ManifestV1Builder)RoleAssignmentLockOwner(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)RoleAssignmentLockOwner(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9828,7 +9987,8 @@ func (_self *ManifestV1Builder)RoleAssignmentLockOwner(address *Address) (*Manif
 }
 
 
-func (_self *ManifestV1Builder)RoleAssignmentSet(address *Address, module ModuleId, roleKey string, rule *AccessRule) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)RoleAssignmentSet(address *Address, module ModuleId, roleKey string, rule *AccessRule) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9844,7 +10004,8 @@ func (_self *ManifestV1Builder)RoleAssignmentSet(address *Address, module Module
 }
 
 
-func (_self *ManifestV1Builder)RoleAssignmentSetOwner(address *Address, rule *AccessRule) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)RoleAssignmentSetOwner(address *Address, rule *AccessRule) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9860,7 +10021,8 @@ func (_self *ManifestV1Builder)RoleAssignmentSetOwner(address *Address, rule *Ac
 }
 
 
-func (_self *ManifestV1Builder)RoyaltyClaim(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)RoyaltyClaim(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9876,7 +10038,8 @@ func (_self *ManifestV1Builder)RoyaltyClaim(address *Address) (*ManifestV1Builde
 }
 
 
-func (_self *ManifestV1Builder)RoyaltyLock(address *Address, method string) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)RoyaltyLock(address *Address, method string) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9892,7 +10055,8 @@ func (_self *ManifestV1Builder)RoyaltyLock(address *Address, method string) (*Ma
 }
 
 
-func (_self *ManifestV1Builder)RoyaltySet(address *Address, method string, amount RoyaltyAmount) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)RoyaltySet(address *Address, method string, amount RoyaltyAmount) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9908,7 +10072,8 @@ func (_self *ManifestV1Builder)RoyaltySet(address *Address, method string, amoun
 }
 
 
-func (_self *ManifestV1Builder)TakeAllFromWorktop(resourceAddress *Address, intoBucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TakeAllFromWorktop(resourceAddress *Address, intoBucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9924,7 +10089,8 @@ func (_self *ManifestV1Builder)TakeAllFromWorktop(resourceAddress *Address, into
 }
 
 
-func (_self *ManifestV1Builder)TakeFromWorktop(resourceAddress *Address, amount *Decimal, intoBucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TakeFromWorktop(resourceAddress *Address, amount *Decimal, intoBucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9940,7 +10106,8 @@ func (_self *ManifestV1Builder)TakeFromWorktop(resourceAddress *Address, amount
 }
 
 
-func (_self *ManifestV1Builder)TakeNonFungiblesFromWorktop(resourceAddress *Address, ids []NonFungibleLocalId, intoBucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TakeNonFungiblesFromWorktop(resourceAddress *Address, ids []NonFungibleLocalId, intoBucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9956,7 +10123,8 @@ func (_self *ManifestV1Builder)TakeNonFungiblesFromWorktop(resourceAddress *Addr
 }
 
 
-func (_self *ManifestV1Builder)TwoResourcePoolContribute(address *Address, buckets []ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TwoResourcePoolContribute(address *Address, buckets []ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9972,7 +10140,8 @@ func (_self *ManifestV1Builder)TwoResourcePoolContribute(address *Address, bucke
 }
 
 
-func (_self *ManifestV1Builder)TwoResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TwoResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -9988,7 +10157,8 @@ func (_self *ManifestV1Builder)TwoResourcePoolGetRedemptionValue(address *Addres
 }
 
 
-func (_self *ManifestV1Builder)TwoResourcePoolGetVaultAmount(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TwoResourcePoolGetVaultAmount(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10004,7 +10174,8 @@ func (_self *ManifestV1Builder)TwoResourcePoolGetVaultAmount(address *Address) (
 }
 
 
-func (_self *ManifestV1Builder)TwoResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddresses []*Address, addressReservation *ManifestBuilderAddressReservation) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TwoResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddresses []*Address, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10020,7 +10191,8 @@ func (_self *ManifestV1Builder)TwoResourcePoolInstantiate(ownerRole OwnerRole, p
 }
 
 
-func (_self *ManifestV1Builder)TwoResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TwoResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10036,7 +10208,8 @@ func (_self *ManifestV1Builder)TwoResourcePoolProtectedDeposit(address *Address,
 }
 
 
-func (_self *ManifestV1Builder)TwoResourcePoolProtectedWithdraw(address *Address, resourceAddress *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TwoResourcePoolProtectedWithdraw(address *Address, resourceAddress *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10052,7 +10225,8 @@ func (_self *ManifestV1Builder)TwoResourcePoolProtectedWithdraw(address *Address
 }
 
 
-func (_self *ManifestV1Builder)TwoResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)TwoResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10068,7 +10242,8 @@ func (_self *ManifestV1Builder)TwoResourcePoolRedeem(address *Address, bucket Ma
 }
 
 
-func (_self *ManifestV1Builder)ValidatorAcceptsDelegatedStake(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorAcceptsDelegatedStake(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10084,7 +10259,8 @@ func (_self *ManifestV1Builder)ValidatorAcceptsDelegatedStake(address *Address)
 }
 
 
-func (_self *ManifestV1Builder)ValidatorClaimXrd(address *Address, bucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorClaimXrd(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10100,7 +10276,8 @@ func (_self *ManifestV1Builder)ValidatorClaimXrd(address *Address, bucket Manife
 }
 
 
-func (_self *ManifestV1Builder)ValidatorFinishUnlockOwnerStakeUnits(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorFinishUnlockOwnerStakeUnits(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10116,7 +10293,8 @@ func (_self *ManifestV1Builder)ValidatorFinishUnlockOwnerStakeUnits(address *Add
 }
 
 
-func (_self *ManifestV1Builder)ValidatorGetProtocolUpdateReadiness(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorGetProtocolUpdateReadiness(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10132,7 +10310,8 @@ func (_self *ManifestV1Builder)ValidatorGetProtocolUpdateReadiness(address *Addr
 }
 
 
-func (_self *ManifestV1Builder)ValidatorGetRedemptionValue(address *Address, amountOfStakeUnits *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorGetRedemptionValue(address *Address, amountOfStakeUnits *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10148,7 +10327,8 @@ func (_self *ManifestV1Builder)ValidatorGetRedemptionValue(address *Address, amo
 }
 
 
-func (_self *ManifestV1Builder)ValidatorLockOwnerStakeUnits(address *Address, stakeUnitBucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorLockOwnerStakeUnits(address *Address, stakeUnitBucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10164,7 +10344,8 @@ func (_self *ManifestV1Builder)ValidatorLockOwnerStakeUnits(address *Address, st
 }
 
 
-func (_self *ManifestV1Builder)ValidatorRegister(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorRegister(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10180,7 +10361,8 @@ func (_self *ManifestV1Builder)ValidatorRegister(address *Address) (*ManifestV1B
 }
 
 
-func (_self *ManifestV1Builder)ValidatorSignalProtocolUpdateReadiness(address *Address, vote string) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorSignalProtocolUpdateReadiness(address *Address, vote string) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10196,7 +10378,8 @@ func (_self *ManifestV1Builder)ValidatorSignalProtocolUpdateReadiness(address *A
 }
 
 
-func (_self *ManifestV1Builder)ValidatorStake(address *Address, stake ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorStake(address *Address, stake ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10212,7 +10395,8 @@ func (_self *ManifestV1Builder)ValidatorStake(address *Address, stake ManifestBu
 }
 
 
-func (_self *ManifestV1Builder)ValidatorStakeAsOwner(address *Address, stake ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorStakeAsOwner(address *Address, stake ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10228,7 +10412,8 @@ func (_self *ManifestV1Builder)ValidatorStakeAsOwner(address *Address, stake Man
 }
 
 
-func (_self *ManifestV1Builder)ValidatorStartUnlockOwnerStakeUnits(address *Address, requestedStakeUnitAmount *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorStartUnlockOwnerStakeUnits(address *Address, requestedStakeUnitAmount *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10244,7 +10429,8 @@ func (_self *ManifestV1Builder)ValidatorStartUnlockOwnerStakeUnits(address *Addr
 }
 
 
-func (_self *ManifestV1Builder)ValidatorTotalStakeUnitSupply(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorTotalStakeUnitSupply(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10260,7 +10446,8 @@ func (_self *ManifestV1Builder)ValidatorTotalStakeUnitSupply(address *Address) (
 }
 
 
-func (_self *ManifestV1Builder)ValidatorTotalStakeXrdAmount(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorTotalStakeXrdAmount(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10276,7 +10463,8 @@ func (_self *ManifestV1Builder)ValidatorTotalStakeXrdAmount(address *Address) (*
 }
 
 
-func (_self *ManifestV1Builder)ValidatorUnregister(address *Address) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorUnregister(address *Address) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10292,7 +10480,8 @@ func (_self *ManifestV1Builder)ValidatorUnregister(address *Address) (*ManifestV
 }
 
 
-func (_self *ManifestV1Builder)ValidatorUnstake(address *Address, stakeUnitBucket ManifestBuilderBucket) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorUnstake(address *Address, stakeUnitBucket ManifestBuilderBucket) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10308,7 +10497,8 @@ func (_self *ManifestV1Builder)ValidatorUnstake(address *Address, stakeUnitBucke
 }
 
 
-func (_self *ManifestV1Builder)ValidatorUpdateAcceptDelegatedStake(address *Address, acceptDelegatedStake bool) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorUpdateAcceptDelegatedStake(address *Address, acceptDelegatedStake bool) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10324,7 +10514,8 @@ func (_self *ManifestV1Builder)ValidatorUpdateAcceptDelegatedStake(address *Addr
 }
 
 
-func (_self *ManifestV1Builder)ValidatorUpdateFee(address *Address, newFeeFactor *Decimal) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorUpdateFee(address *Address, newFeeFactor *Decimal) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10340,7 +10531,8 @@ func (_self *ManifestV1Builder)ValidatorUpdateFee(address *Address, newFeeFactor
 }
 
 
-func (_self *ManifestV1Builder)ValidatorUpdateKey(address *Address, key PublicKey) (*ManifestV1Builder, error) {
+func (_self *ManifestV1Builder)ValidatorUpdateKey(address *Address, key PublicKey) (_ *ManifestV1Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV1Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10375,6 +10567,7 @@ func (c FfiConverterManifestV1Builder) Lift(pointer unsafe.Pointer) *ManifestV1B
 		}),
 	}
 	runtime.SetFinalizer(result, (*ManifestV1Builder).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -10414,7 +10607,8 @@ func NewManifestV2Builder(networkId uint8) *ManifestV2Builder {
 
 
 
-func (_self *ManifestV2Builder)AccessControllerCancelPrimaryRoleBadgeWithdrawAttempt(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerCancelPrimaryRoleBadgeWithdrawAttempt(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10430,7 +10624,8 @@ func (_self *ManifestV2Builder)AccessControllerCancelPrimaryRoleBadgeWithdrawAtt
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerCancelPrimaryRoleRecoveryProposal(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerCancelPrimaryRoleRecoveryProposal(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10446,7 +10641,8 @@ func (_self *ManifestV2Builder)AccessControllerCancelPrimaryRoleRecoveryProposal
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerCancelRecoveryRoleBadgeWithdrawAttempt(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerCancelRecoveryRoleBadgeWithdrawAttempt(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10462,7 +10658,8 @@ func (_self *ManifestV2Builder)AccessControllerCancelRecoveryRoleBadgeWithdrawAt
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerCancelRecoveryRoleRecoveryProposal(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerCancelRecoveryRoleRecoveryProposal(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10478,7 +10675,8 @@ func (_self *ManifestV2Builder)AccessControllerCancelRecoveryRoleRecoveryProposa
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerCreate(controlledAsset ManifestBuilderBucket, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerCreate(controlledAsset ManifestBuilderBucket, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10494,7 +10692,8 @@ func (_self *ManifestV2Builder)AccessControllerCreate(controlledAsset ManifestBu
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerCreateProof(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerCreateProof(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10510,7 +10709,8 @@ func (_self *ManifestV2Builder)AccessControllerCreateProof(address *Address) (*M
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerCreateWithSecurityStructure(controlledAsset ManifestBuilderBucket, primaryRole SecurityStructureRole, recoveryRole SecurityStructureRole, confirmationRole SecurityStructureRole, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerCreateWithSecurityStructure(controlledAsset ManifestBuilderBucket, primaryRole SecurityStructureRole, recoveryRole SecurityStructureRole, confirmationRole SecurityStructureRole, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10526,7 +10726,8 @@ func (_self *ManifestV2Builder)AccessControllerCreateWithSecurityStructure(contr
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerInitiateBadgeWithdrawAsPrimary(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerInitiateBadgeWithdrawAsPrimary(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10542,7 +10743,8 @@ func (_self *ManifestV2Builder)AccessControllerInitiateBadgeWithdrawAsPrimary(ad
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerInitiateBadgeWithdrawAsRecovery(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerInitiateBadgeWithdrawAsRecovery(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10558,7 +10760,8 @@ func (_self *ManifestV2Builder)AccessControllerInitiateBadgeWithdrawAsRecovery(a
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerInitiateRecoveryAsPrimary(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerInitiateRecoveryAsPrimary(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10574,7 +10777,8 @@ func (_self *ManifestV2Builder)AccessControllerInitiateRecoveryAsPrimary(address
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerInitiateRecoveryAsRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerInitiateRecoveryAsRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10590,7 +10794,8 @@ func (_self *ManifestV2Builder)AccessControllerInitiateRecoveryAsRecovery(addres
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerLockPrimaryRole(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerLockPrimaryRole(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10606,7 +10811,8 @@ func (_self *ManifestV2Builder)AccessControllerLockPrimaryRole(address *Address)
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerMintRecoveryBadges(address *Address, nonFungibleLocalIds []NonFungibleLocalId) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerMintRecoveryBadges(address *Address, nonFungibleLocalIds []NonFungibleLocalId) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10622,7 +10828,8 @@ func (_self *ManifestV2Builder)AccessControllerMintRecoveryBadges(address *Addre
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerNewFromPublicKeys(controlledAsset ManifestBuilderBucket, primaryRole PublicKey, recoveryRole PublicKey, confirmationRole PublicKey, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerNewFromPublicKeys(controlledAsset ManifestBuilderBucket, primaryRole PublicKey, recoveryRole PublicKey, confirmationRole PublicKey, timedRecoveryDelayInMinutes *uint32, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10638,7 +10845,8 @@ func (_self *ManifestV2Builder)AccessControllerNewFromPublicKeys(controlledAsset
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerQuickConfirmPrimaryRoleBadgeWithdrawAttempt(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerQuickConfirmPrimaryRoleBadgeWithdrawAttempt(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10654,7 +10862,8 @@ func (_self *ManifestV2Builder)AccessControllerQuickConfirmPrimaryRoleBadgeWithd
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerQuickConfirmPrimaryRoleRecoveryProposal(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerQuickConfirmPrimaryRoleRecoveryProposal(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10670,7 +10879,8 @@ func (_self *ManifestV2Builder)AccessControllerQuickConfirmPrimaryRoleRecoveryPr
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerQuickConfirmRecoveryRoleBadgeWithdrawAttempt(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerQuickConfirmRecoveryRoleBadgeWithdrawAttempt(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10686,7 +10896,8 @@ func (_self *ManifestV2Builder)AccessControllerQuickConfirmRecoveryRoleBadgeWith
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerQuickConfirmRecoveryRoleRecoveryProposal(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerQuickConfirmRecoveryRoleRecoveryProposal(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10702,7 +10913,8 @@ func (_self *ManifestV2Builder)AccessControllerQuickConfirmRecoveryRoleRecoveryP
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerStopTimedRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerStopTimedRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10718,7 +10930,8 @@ func (_self *ManifestV2Builder)AccessControllerStopTimedRecovery(address *Addres
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerTimedConfirmRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerTimedConfirmRecovery(address *Address, ruleSet RuleSet, timedRecoveryDelayInMinutes *uint32) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10734,7 +10947,8 @@ func (_self *ManifestV2Builder)AccessControllerTimedConfirmRecovery(address *Add
 }
 
 
-func (_self *ManifestV2Builder)AccessControllerUnlockPrimaryRole(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccessControllerUnlockPrimaryRole(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10750,7 +10964,8 @@ func (_self *ManifestV2Builder)AccessControllerUnlockPrimaryRole(address *Addres
 }
 
 
-func (_self *ManifestV2Builder)AccountAddAuthorizedDepositor(address *Address, badge ResourceOrNonFungible) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountAddAuthorizedDepositor(address *Address, badge ResourceOrNonFungible) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10766,7 +10981,8 @@ func (_self *ManifestV2Builder)AccountAddAuthorizedDepositor(address *Address, b
 }
 
 
-func (_self *ManifestV2Builder)AccountBurn(address *Address, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountBurn(address *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10782,7 +10998,8 @@ func (_self *ManifestV2Builder)AccountBurn(address *Address, resourceAddress *Ad
 }
 
 
-func (_self *ManifestV2Builder)AccountBurnNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountBurnNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10798,7 +11015,8 @@ func (_self *ManifestV2Builder)AccountBurnNonFungibles(address *Address, resourc
 }
 
 
-func (_self *ManifestV2Builder)AccountCreate() (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountCreate() (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10814,7 +11032,8 @@ func (_self *ManifestV2Builder)AccountCreate() (*ManifestV2Builder, error) {
 }
 
 
-func (_self *ManifestV2Builder)AccountCreateAdvanced(ownerRole OwnerRole, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountCreateAdvanced(ownerRole OwnerRole, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10830,7 +11049,8 @@ func (_self *ManifestV2Builder)AccountCreateAdvanced(ownerRole OwnerRole, addres
 }
 
 
-func (_self *ManifestV2Builder)AccountCreateProofOfAmount(address *Address, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountCreateProofOfAmount(address *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10846,7 +11066,8 @@ func (_self *ManifestV2Builder)AccountCreateProofOfAmount(address *Address, reso
 }
 
 
-func (_self *ManifestV2Builder)AccountCreateProofOfNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountCreateProofOfNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10862,7 +11083,8 @@ func (_self *ManifestV2Builder)AccountCreateProofOfNonFungibles(address *Address
 }
 
 
-func (_self *ManifestV2Builder)AccountDeposit(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountDeposit(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10878,7 +11100,8 @@ func (_self *ManifestV2Builder)AccountDeposit(address *Address, bucket ManifestB
 }
 
 
-func (_self *ManifestV2Builder)AccountDepositBatch(address *Address, buckets []ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountDepositBatch(address *Address, buckets []ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10894,7 +11117,8 @@ func (_self *ManifestV2Builder)AccountDepositBatch(address *Address, buckets []M
 }
 
 
-func (_self *ManifestV2Builder)AccountDepositEntireWorktop(accountAddress *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountDepositEntireWorktop(accountAddress *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10910,7 +11134,8 @@ func (_self *ManifestV2Builder)AccountDepositEntireWorktop(accountAddress *Addre
 }
 
 
-func (_self *ManifestV2Builder)AccountLockContingentFee(address *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockContingentFee(address *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10926,7 +11151,8 @@ func (_self *ManifestV2Builder)AccountLockContingentFee(address *Address, amount
 }
 
 
-func (_self *ManifestV2Builder)AccountLockFee(address *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockFee(address *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10942,7 +11168,8 @@ func (_self *ManifestV2Builder)AccountLockFee(address *Address, amount *Decimal)
 }
 
 
-func (_self *ManifestV2Builder)AccountLockFeeAndWithdraw(address *Address, amountToLock *Decimal, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockFeeAndWithdraw(address *Address, amountToLock *Decimal, resourceAddress *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10958,7 +11185,8 @@ func (_self *ManifestV2Builder)AccountLockFeeAndWithdraw(address *Address, amoun
 }
 
 
-func (_self *ManifestV2Builder)AccountLockFeeAndWithdrawNonFungibles(address *Address, amountToLock *Decimal, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockFeeAndWithdrawNonFungibles(address *Address, amountToLock *Decimal, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10974,7 +11202,8 @@ func (_self *ManifestV2Builder)AccountLockFeeAndWithdrawNonFungibles(address *Ad
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerAirdrop(address *Address, claimants map[string]ResourceSpecifier, bucket ManifestBuilderBucket, tryDirectSend bool) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerAirdrop(address *Address, claimants map[string]ResourceSpecifier, bucket ManifestBuilderBucket, tryDirectSend bool) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -10990,7 +11219,8 @@ func (_self *ManifestV2Builder)AccountLockerAirdrop(address *Address, claimants
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerClaim(address *Address, claimant *Address, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerClaim(address *Address, claimant *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11006,7 +11236,8 @@ func (_self *ManifestV2Builder)AccountLockerClaim(address *Address, claimant *Ad
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerClaimNonFungibles(address *Address, claimant *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerClaimNonFungibles(address *Address, claimant *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11022,7 +11253,8 @@ func (_self *ManifestV2Builder)AccountLockerClaimNonFungibles(address *Address,
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerGetAmount(address *Address, claimant *Address, resourceAddress *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerGetAmount(address *Address, claimant *Address, resourceAddress *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11038,7 +11270,8 @@ func (_self *ManifestV2Builder)AccountLockerGetAmount(address *Address, claimant
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerGetNonFungibleLocalIds(address *Address, claimant *Address, resourceAddress *Address, limit uint32) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerGetNonFungibleLocalIds(address *Address, claimant *Address, resourceAddress *Address, limit uint32) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11054,7 +11287,8 @@ func (_self *ManifestV2Builder)AccountLockerGetNonFungibleLocalIds(address *Addr
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerInstantiate(ownerRole OwnerRole, storerRole *AccessRule, storerUpdaterRole *AccessRule, recovererRole *AccessRule, recovererUpdaterRole *AccessRule, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerInstantiate(ownerRole OwnerRole, storerRole *AccessRule, storerUpdaterRole *AccessRule, recovererRole *AccessRule, recovererUpdaterRole *AccessRule, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11070,7 +11304,8 @@ func (_self *ManifestV2Builder)AccountLockerInstantiate(ownerRole OwnerRole, sto
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerInstantiateSimple(allowRecover bool) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerInstantiateSimple(allowRecover bool) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11086,7 +11321,8 @@ func (_self *ManifestV2Builder)AccountLockerInstantiateSimple(allowRecover bool)
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerRecover(address *Address, claimant *Address, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerRecover(address *Address, claimant *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11102,7 +11338,8 @@ func (_self *ManifestV2Builder)AccountLockerRecover(address *Address, claimant *
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerRecoverNonFungibles(address *Address, claimant *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerRecoverNonFungibles(address *Address, claimant *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11118,7 +11355,8 @@ func (_self *ManifestV2Builder)AccountLockerRecoverNonFungibles(address *Address
 }
 
 
-func (_self *ManifestV2Builder)AccountLockerStore(address *Address, claimant *Address, bucket ManifestBuilderBucket, tryDirectSend bool) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountLockerStore(address *Address, claimant *Address, bucket ManifestBuilderBucket, tryDirectSend bool) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11134,7 +11372,8 @@ func (_self *ManifestV2Builder)AccountLockerStore(address *Address, claimant *Ad
 }
 
 
-func (_self *ManifestV2Builder)AccountRemoveAuthorizedDepositor(address *Address, badge ResourceOrNonFungible) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountRemoveAuthorizedDepositor(address *Address, badge ResourceOrNonFungible) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11150,7 +11389,8 @@ func (_self *ManifestV2Builder)AccountRemoveAuthorizedDepositor(address *Address
 }
 
 
-func (_self *ManifestV2Builder)AccountRemoveResourcePreference(address *Address, resourceAddress *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountRemoveResourcePreference(address *Address, resourceAddress *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11166,7 +11406,8 @@ func (_self *ManifestV2Builder)AccountRemoveResourcePreference(address *Address,
 }
 
 
-func (_self *ManifestV2Builder)AccountSecurify(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountSecurify(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11182,7 +11423,8 @@ func (_self *ManifestV2Builder)AccountSecurify(address *Address) (*ManifestV2Bui
 }
 
 
-func (_self *ManifestV2Builder)AccountSetDefaultDepositRule(address *Address, defaultDepositRule AccountDefaultDepositRule) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountSetDefaultDepositRule(address *Address, defaultDepositRule AccountDefaultDepositRule) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11198,7 +11440,8 @@ func (_self *ManifestV2Builder)AccountSetDefaultDepositRule(address *Address, de
 }
 
 
-func (_self *ManifestV2Builder)AccountSetResourcePreference(address *Address, resourceAddress *Address, resourcePreference ResourcePreference) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountSetResourcePreference(address *Address, resourceAddress *Address, resourcePreference ResourcePreference) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11214,7 +11457,8 @@ func (_self *ManifestV2Builder)AccountSetResourcePreference(address *Address, re
 }
 
 
-func (_self *ManifestV2Builder)AccountTryDepositBatchOrAbort(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountTryDepositBatchOrAbort(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11230,7 +11474,8 @@ func (_self *ManifestV2Builder)AccountTryDepositBatchOrAbort(address *Address, b
 }
 
 
-func (_self *ManifestV2Builder)AccountTryDepositBatchOrRefund(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountTryDepositBatchOrRefund(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11246,7 +11491,8 @@ func (_self *ManifestV2Builder)AccountTryDepositBatchOrRefund(address *Address,
 }
 
 
-func (_self *ManifestV2Builder)AccountTryDepositEntireWorktopOrAbort(accountAddress *Address, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountTryDepositEntireWorktopOrAbort(accountAddress *Address, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11262,7 +11508,8 @@ func (_self *ManifestV2Builder)AccountTryDepositEntireWorktopOrAbort(accountAddr
 }
 
 
-func (_self *ManifestV2Builder)AccountTryDepositEntireWorktopOrRefund(accountAddress *Address, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountTryDepositEntireWorktopOrRefund(accountAddress *Address, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11278,7 +11525,8 @@ func (_self *ManifestV2Builder)AccountTryDepositEntireWorktopOrRefund(accountAdd
 }
 
 
-func (_self *ManifestV2Builder)AccountTryDepositOrAbort(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountTryDepositOrAbort(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11294,7 +11542,8 @@ func (_self *ManifestV2Builder)AccountTryDepositOrAbort(address *Address, bucket
 }
 
 
-func (_self *ManifestV2Builder)AccountTryDepositOrRefund(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountTryDepositOrRefund(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11310,7 +11559,8 @@ func (_self *ManifestV2Builder)AccountTryDepositOrRefund(address *Address, bucke
 }
 
 
-func (_self *ManifestV2Builder)AccountWithdraw(address *Address, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountWithdraw(address *Address, resourceAddress *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11326,7 +11576,8 @@ func (_self *ManifestV2Builder)AccountWithdraw(address *Address, resourceAddress
 }
 
 
-func (_self *ManifestV2Builder)AccountWithdrawNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AccountWithdrawNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11342,7 +11593,8 @@ func (_self *ManifestV2Builder)AccountWithdrawNonFungibles(address *Address, res
 }
 
 
-func (_self *ManifestV2Builder)AddInstruction(instruction InstructionV2) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AddInstruction(instruction InstructionV2) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11358,7 +11610,8 @@ func (_self *ManifestV2Builder)AddInstruction(instruction InstructionV2) (*Manif
 }
 
 
-func (_self *ManifestV2Builder)AllocateGlobalAddress(packageAddress *Address, blueprintName string, intoAddressReservation ManifestBuilderAddressReservation, intoNamedAddress ManifestBuilderNamedAddress) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AllocateGlobalAddress(packageAddress *Address, blueprintName string, intoAddressReservation ManifestBuilderAddressReservation, intoNamedAddress ManifestBuilderNamedAddress) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11374,7 +11627,8 @@ func (_self *ManifestV2Builder)AllocateGlobalAddress(packageAddress *Address, bl
 }
 
 
-func (_self *ManifestV2Builder)AssertWorktopContains(resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AssertWorktopContains(resourceAddress *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11390,7 +11644,8 @@ func (_self *ManifestV2Builder)AssertWorktopContains(resourceAddress *Address, a
 }
 
 
-func (_self *ManifestV2Builder)AssertWorktopContainsAny(resourceAddress *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AssertWorktopContainsAny(resourceAddress *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11406,7 +11661,8 @@ func (_self *ManifestV2Builder)AssertWorktopContainsAny(resourceAddress *Address
 }
 
 
-func (_self *ManifestV2Builder)AssertWorktopContainsNonFungibles(resourceAddress *Address, ids []NonFungibleLocalId) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)AssertWorktopContainsNonFungibles(resourceAddress *Address, ids []NonFungibleLocalId) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11432,7 +11688,8 @@ func (_self *ManifestV2Builder)Build() *TransactionManifestV2 {
 }
 
 
-func (_self *ManifestV2Builder)BurnResource(bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)BurnResource(bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11448,7 +11705,8 @@ func (_self *ManifestV2Builder)BurnResource(bucket ManifestBuilderBucket) (*Mani
 }
 
 
-func (_self *ManifestV2Builder)CallAccessRulesMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CallAccessRulesMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11464,7 +11722,8 @@ func (_self *ManifestV2Builder)CallAccessRulesMethod(address ManifestBuilderAddr
 }
 
 
-func (_self *ManifestV2Builder)CallDirectVaultMethod(address *Address, methodName string, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CallDirectVaultMethod(address *Address, methodName string, args []ManifestBuilderValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11480,7 +11739,8 @@ func (_self *ManifestV2Builder)CallDirectVaultMethod(address *Address, methodNam
 }
 
 
-func (_self *ManifestV2Builder)CallFunction(address ManifestBuilderAddress, blueprintName string, functionName string, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CallFunction(address ManifestBuilderAddress, blueprintName string, functionName string, args []ManifestBuilderValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11496,7 +11756,8 @@ func (_self *ManifestV2Builder)CallFunction(address ManifestBuilderAddress, blue
 }
 
 
-func (_self *ManifestV2Builder)CallMetadataMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CallMetadataMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11512,7 +11773,8 @@ func (_self *ManifestV2Builder)CallMetadataMethod(address ManifestBuilderAddress
 }
 
 
-func (_self *ManifestV2Builder)CallMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CallMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11528,7 +11790,8 @@ func (_self *ManifestV2Builder)CallMethod(address ManifestBuilderAddress, method
 }
 
 
-func (_self *ManifestV2Builder)CallRoyaltyMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CallRoyaltyMethod(address ManifestBuilderAddress, methodName string, args []ManifestBuilderValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11544,7 +11807,8 @@ func (_self *ManifestV2Builder)CallRoyaltyMethod(address ManifestBuilderAddress,
 }
 
 
-func (_self *ManifestV2Builder)CloneProof(proof ManifestBuilderProof, intoProof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CloneProof(proof ManifestBuilderProof, intoProof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11560,7 +11824,8 @@ func (_self *ManifestV2Builder)CloneProof(proof ManifestBuilderProof, intoProof
 }
 
 
-func (_self *ManifestV2Builder)CreateFungibleResourceManager(ownerRole OwnerRole, trackTotalSupply bool, divisibility uint8, initialSupply **Decimal, resourceRoles FungibleResourceRoles, metadata MetadataModuleConfig, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CreateFungibleResourceManager(ownerRole OwnerRole, trackTotalSupply bool, divisibility uint8, initialSupply **Decimal, resourceRoles FungibleResourceRoles, metadata MetadataModuleConfig, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11576,7 +11841,8 @@ func (_self *ManifestV2Builder)CreateFungibleResourceManager(ownerRole OwnerRole
 }
 
 
-func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfAll(resourceAddress *Address, intoProof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfAll(resourceAddress *Address, intoProof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11592,7 +11858,8 @@ func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfAll(resourceAddress *Add
 }
 
 
-func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfAmount(resourceAddress *Address, amount *Decimal, intoProof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfAmount(resourceAddress *Address, amount *Decimal, intoProof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11608,7 +11875,8 @@ func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfAmount(resourceAddress *
 }
 
 
-func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfNonFungibles(resourceAddress *Address, ids []NonFungibleLocalId, intoProof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfNonFungibles(resourceAddress *Address, ids []NonFungibleLocalId, intoProof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11624,7 +11892,8 @@ func (_self *ManifestV2Builder)CreateProofFromAuthZoneOfNonFungibles(resourceAdd
 }
 
 
-func (_self *ManifestV2Builder)CreateProofFromBucketOfAll(bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CreateProofFromBucketOfAll(bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11640,7 +11909,8 @@ func (_self *ManifestV2Builder)CreateProofFromBucketOfAll(bucket ManifestBuilder
 }
 
 
-func (_self *ManifestV2Builder)CreateProofFromBucketOfAmount(amount *Decimal, bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CreateProofFromBucketOfAmount(amount *Decimal, bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11656,7 +11926,8 @@ func (_self *ManifestV2Builder)CreateProofFromBucketOfAmount(amount *Decimal, bu
 }
 
 
-func (_self *ManifestV2Builder)CreateProofFromBucketOfNonFungibles(ids []NonFungibleLocalId, bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)CreateProofFromBucketOfNonFungibles(ids []NonFungibleLocalId, bucket ManifestBuilderBucket, intoProof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11672,7 +11943,8 @@ func (_self *ManifestV2Builder)CreateProofFromBucketOfNonFungibles(ids []NonFung
 }
 
 
-func (_self *ManifestV2Builder)DropAllProofs() (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)DropAllProofs() (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11688,7 +11960,8 @@ func (_self *ManifestV2Builder)DropAllProofs() (*ManifestV2Builder, error) {
 }
 
 
-func (_self *ManifestV2Builder)DropAuthZoneProofs() (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)DropAuthZoneProofs() (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11704,7 +11977,8 @@ func (_self *ManifestV2Builder)DropAuthZoneProofs() (*ManifestV2Builder, error)
 }
 
 
-func (_self *ManifestV2Builder)DropAuthZoneSignatureProofs() (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)DropAuthZoneSignatureProofs() (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11720,7 +11994,8 @@ func (_self *ManifestV2Builder)DropAuthZoneSignatureProofs() (*ManifestV2Builder
 }
 
 
-func (_self *ManifestV2Builder)DropProof(proof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)DropProof(proof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11736,7 +12011,8 @@ func (_self *ManifestV2Builder)DropProof(proof ManifestBuilderProof) (*ManifestV
 }
 
 
-func (_self *ManifestV2Builder)FaucetFreeXrd() (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)FaucetFreeXrd() (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11752,7 +12028,8 @@ func (_self *ManifestV2Builder)FaucetFreeXrd() (*ManifestV2Builder, error) {
 }
 
 
-func (_self *ManifestV2Builder)FaucetLockFee() (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)FaucetLockFee() (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11768,7 +12045,8 @@ func (_self *ManifestV2Builder)FaucetLockFee() (*ManifestV2Builder, error) {
 }
 
 
-func (_self *ManifestV2Builder)IdentityCreate() (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)IdentityCreate() (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11784,7 +12062,8 @@ func (_self *ManifestV2Builder)IdentityCreate() (*ManifestV2Builder, error) {
 }
 
 
-func (_self *ManifestV2Builder)IdentityCreateAdvanced(ownerRole OwnerRole) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)IdentityCreateAdvanced(ownerRole OwnerRole) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11800,7 +12079,8 @@ func (_self *ManifestV2Builder)IdentityCreateAdvanced(ownerRole OwnerRole) (*Man
 }
 
 
-func (_self *ManifestV2Builder)IdentitySecurify(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)IdentitySecurify(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11816,7 +12096,8 @@ func (_self *ManifestV2Builder)IdentitySecurify(address *Address) (*ManifestV2Bu
 }
 
 
-func (_self *ManifestV2Builder)MetadataGet(address *Address, key string) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MetadataGet(address *Address, key string) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11832,7 +12113,8 @@ func (_self *ManifestV2Builder)MetadataGet(address *Address, key string) (*Manif
 }
 
 
-func (_self *ManifestV2Builder)MetadataLock(address *Address, key string) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MetadataLock(address *Address, key string) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11848,7 +12130,8 @@ func (_self *ManifestV2Builder)MetadataLock(address *Address, key string) (*Mani
 }
 
 
-func (_self *ManifestV2Builder)MetadataRemove(address *Address, key string) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MetadataRemove(address *Address, key string) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11864,7 +12147,8 @@ func (_self *ManifestV2Builder)MetadataRemove(address *Address, key string) (*Ma
 }
 
 
-func (_self *ManifestV2Builder)MetadataSet(address *Address, key string, value MetadataValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MetadataSet(address *Address, key string, value MetadataValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11880,7 +12164,8 @@ func (_self *ManifestV2Builder)MetadataSet(address *Address, key string, value M
 }
 
 
-func (_self *ManifestV2Builder)MintFungible(resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MintFungible(resourceAddress *Address, amount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11896,7 +12181,8 @@ func (_self *ManifestV2Builder)MintFungible(resourceAddress *Address, amount *De
 }
 
 
-func (_self *ManifestV2Builder)MultiResourcePoolContribute(address *Address, buckets []ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MultiResourcePoolContribute(address *Address, buckets []ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11912,7 +12198,8 @@ func (_self *ManifestV2Builder)MultiResourcePoolContribute(address *Address, buc
 }
 
 
-func (_self *ManifestV2Builder)MultiResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MultiResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11928,7 +12215,8 @@ func (_self *ManifestV2Builder)MultiResourcePoolGetRedemptionValue(address *Addr
 }
 
 
-func (_self *ManifestV2Builder)MultiResourcePoolGetVaultAmount(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MultiResourcePoolGetVaultAmount(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11944,7 +12232,8 @@ func (_self *ManifestV2Builder)MultiResourcePoolGetVaultAmount(address *Address)
 }
 
 
-func (_self *ManifestV2Builder)MultiResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddresses []*Address, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MultiResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddresses []*Address, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11960,7 +12249,8 @@ func (_self *ManifestV2Builder)MultiResourcePoolInstantiate(ownerRole OwnerRole,
 }
 
 
-func (_self *ManifestV2Builder)MultiResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MultiResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11976,7 +12266,8 @@ func (_self *ManifestV2Builder)MultiResourcePoolProtectedDeposit(address *Addres
 }
 
 
-func (_self *ManifestV2Builder)MultiResourcePoolProtectedWithdraw(address *Address, resourceAddress *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MultiResourcePoolProtectedWithdraw(address *Address, resourceAddress *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -11992,7 +12283,8 @@ func (_self *ManifestV2Builder)MultiResourcePoolProtectedWithdraw(address *Addre
 }
 
 
-func (_self *ManifestV2Builder)MultiResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)MultiResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12008,7 +12300,8 @@ func (_self *ManifestV2Builder)MultiResourcePoolRedeem(address *Address, bucket
 }
 
 
-func (_self *ManifestV2Builder)OneResourcePoolContribute(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)OneResourcePoolContribute(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12024,7 +12317,8 @@ func (_self *ManifestV2Builder)OneResourcePoolContribute(address *Address, bucke
 }
 
 
-func (_self *ManifestV2Builder)OneResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)OneResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12040,7 +12334,8 @@ func (_self *ManifestV2Builder)OneResourcePoolGetRedemptionValue(address *Addres
 }
 
 
-func (_self *ManifestV2Builder)OneResourcePoolGetVaultAmount(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)OneResourcePoolGetVaultAmount(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12056,7 +12351,8 @@ func (_self *ManifestV2Builder)OneResourcePoolGetVaultAmount(address *Address) (
 }
 
 
-func (_self *ManifestV2Builder)OneResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddress *Address, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)OneResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddress *Address, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12072,7 +12368,8 @@ func (_self *ManifestV2Builder)OneResourcePoolInstantiate(ownerRole OwnerRole, p
 }
 
 
-func (_self *ManifestV2Builder)OneResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)OneResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12088,7 +12385,8 @@ func (_self *ManifestV2Builder)OneResourcePoolProtectedDeposit(address *Address,
 }
 
 
-func (_self *ManifestV2Builder)OneResourcePoolProtectedWithdraw(address *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)OneResourcePoolProtectedWithdraw(address *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12104,7 +12402,8 @@ func (_self *ManifestV2Builder)OneResourcePoolProtectedWithdraw(address *Address
 }
 
 
-func (_self *ManifestV2Builder)OneResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)OneResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12120,7 +12419,8 @@ func (_self *ManifestV2Builder)OneResourcePoolRedeem(address *Address, bucket Ma
 }
 
 
-func (_self *ManifestV2Builder)PackageClaimRoyalty(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)PackageClaimRoyalty(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12136,7 +12436,8 @@ func (_self *ManifestV2Builder)PackageClaimRoyalty(address *Address) (*ManifestV
 }
 
 
-func (_self *ManifestV2Builder)PackagePublish(code []byte, definition []byte, metadata map[string]MetadataInitEntry) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)PackagePublish(code []byte, definition []byte, metadata map[string]MetadataInitEntry) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12152,7 +12453,8 @@ func (_self *ManifestV2Builder)PackagePublish(code []byte, definition []byte, me
 }
 
 
-func (_self *ManifestV2Builder)PackagePublishAdvanced(ownerRole OwnerRole, code []byte, definition []byte, metadata map[string]MetadataInitEntry, packageAddress *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)PackagePublishAdvanced(ownerRole OwnerRole, code []byte, definition []byte, metadata map[string]MetadataInitEntry, packageAddress *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12168,7 +12470,8 @@ func (_self *ManifestV2Builder)PackagePublishAdvanced(ownerRole OwnerRole, code
 }
 
 
-func (_self *ManifestV2Builder)PopFromAuthZone(intoProof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)PopFromAuthZone(intoProof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12184,7 +12487,8 @@ func (_self *ManifestV2Builder)PopFromAuthZone(intoProof ManifestBuilderProof) (
 }
 
 
-func (_self *ManifestV2Builder)PushToAuthZone(proof ManifestBuilderProof) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)PushToAuthZone(proof ManifestBuilderProof) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12200,7 +12504,8 @@ func (_self *ManifestV2Builder)PushToAuthZone(proof ManifestBuilderProof) (*Mani
 }
 
 
-func (_self *ManifestV2Builder)ReturnToWorktop(bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ReturnToWorktop(bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12216,7 +12521,8 @@ func (_self *ManifestV2Builder)ReturnToWorktop(bucket ManifestBuilderBucket) (*M
 }
 
 
-func (_self *ManifestV2Builder)RoleAssignmentGet(address *Address, module ModuleId, roleKey string) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)RoleAssignmentGet(address *Address, module ModuleId, roleKey string) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12232,7 +12538,8 @@ func (_self *ManifestV2Builder)RoleAssignmentGet(address *Address, module Module
 }
 
 
-func (_self *ManifestV2Builder)RoleAssignmentLockOwner(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)RoleAssignmentLockOwner(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12248,7 +12555,8 @@ func (_self *ManifestV2Builder)RoleAssignmentLockOwner(address *Address) (*Manif
 }
 
 
-func (_self *ManifestV2Builder)RoleAssignmentSet(address *Address, module ModuleId, roleKey string, rule *AccessRule) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)RoleAssignmentSet(address *Address, module ModuleId, roleKey string, rule *AccessRule) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12264,7 +12572,8 @@ func (_self *ManifestV2Builder)RoleAssignmentSet(address *Address, module Module
 }
 
 
-func (_self *ManifestV2Builder)RoleAssignmentSetOwner(address *Address, rule *AccessRule) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)RoleAssignmentSetOwner(address *Address, rule *AccessRule) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12280,7 +12589,8 @@ func (_self *ManifestV2Builder)RoleAssignmentSetOwner(address *Address, rule *Ac
 }
 
 
-func (_self *ManifestV2Builder)RoyaltyClaim(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)RoyaltyClaim(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12296,7 +12606,8 @@ func (_self *ManifestV2Builder)RoyaltyClaim(address *Address) (*ManifestV2Builde
 }
 
 
-func (_self *ManifestV2Builder)RoyaltyLock(address *Address, method string) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)RoyaltyLock(address *Address, method string) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12312,7 +12623,8 @@ func (_self *ManifestV2Builder)RoyaltyLock(address *Address, method string) (*Ma
 }
 
 
-func (_self *ManifestV2Builder)RoyaltySet(address *Address, method string, amount RoyaltyAmount) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)RoyaltySet(address *Address, method string, amount RoyaltyAmount) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12328,7 +12640,8 @@ func (_self *ManifestV2Builder)RoyaltySet(address *Address, method string, amoun
 }
 
 
-func (_self *ManifestV2Builder)TakeAllFromWorktop(resourceAddress *Address, intoBucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TakeAllFromWorktop(resourceAddress *Address, intoBucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12344,7 +12657,8 @@ func (_self *ManifestV2Builder)TakeAllFromWorktop(resourceAddress *Address, into
 }
 
 
-func (_self *ManifestV2Builder)TakeFromWorktop(resourceAddress *Address, amount *Decimal, intoBucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TakeFromWorktop(resourceAddress *Address, amount *Decimal, intoBucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12360,7 +12674,8 @@ func (_self *ManifestV2Builder)TakeFromWorktop(resourceAddress *Address, amount
 }
 
 
-func (_self *ManifestV2Builder)TakeNonFungiblesFromWorktop(resourceAddress *Address, ids []NonFungibleLocalId, intoBucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TakeNonFungiblesFromWorktop(resourceAddress *Address, ids []NonFungibleLocalId, intoBucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12376,7 +12691,8 @@ func (_self *ManifestV2Builder)TakeNonFungiblesFromWorktop(resourceAddress *Addr
 }
 
 
-func (_self *ManifestV2Builder)TwoResourcePoolContribute(address *Address, buckets []ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TwoResourcePoolContribute(address *Address, buckets []ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12392,7 +12708,8 @@ func (_self *ManifestV2Builder)TwoResourcePoolContribute(address *Address, bucke
 }
 
 
-func (_self *ManifestV2Builder)TwoResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TwoResourcePoolGetRedemptionValue(address *Address, amountOfPoolUnits *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12408,7 +12725,8 @@ func (_self *ManifestV2Builder)TwoResourcePoolGetRedemptionValue(address *Addres
 }
 
 
-func (_self *ManifestV2Builder)TwoResourcePoolGetVaultAmount(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TwoResourcePoolGetVaultAmount(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12424,7 +12742,8 @@ func (_self *ManifestV2Builder)TwoResourcePoolGetVaultAmount(address *Address) (
 }
 
 
-func (_self *ManifestV2Builder)TwoResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddresses []*Address, addressReservation *ManifestBuilderAddressReservation) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TwoResourcePoolInstantiate(ownerRole OwnerRole, poolManagerRule *AccessRule, resourceAddresses []*Address, addressReservation *ManifestBuilderAddressReservation) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12440,7 +12759,8 @@ func (_self *ManifestV2Builder)TwoResourcePoolInstantiate(ownerRole OwnerRole, p
 }
 
 
-func (_self *ManifestV2Builder)TwoResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TwoResourcePoolProtectedDeposit(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12456,7 +12776,8 @@ func (_self *ManifestV2Builder)TwoResourcePoolProtectedDeposit(address *Address,
 }
 
 
-func (_self *ManifestV2Builder)TwoResourcePoolProtectedWithdraw(address *Address, resourceAddress *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TwoResourcePoolProtectedWithdraw(address *Address, resourceAddress *Address, amount *Decimal, withdrawStrategy WithdrawStrategy) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12472,7 +12793,8 @@ func (_self *ManifestV2Builder)TwoResourcePoolProtectedWithdraw(address *Address
 }
 
 
-func (_self *ManifestV2Builder)TwoResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)TwoResourcePoolRedeem(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12488,7 +12810,8 @@ func (_self *ManifestV2Builder)TwoResourcePoolRedeem(address *Address, bucket Ma
 }
 
 
-func (_self *ManifestV2Builder)UseChild(subintentHash *TransactionHash, name ManifestBuilderIntent) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)UseChild(subintentHash *TransactionHash, name ManifestBuilderIntent) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12504,7 +12827,8 @@ func (_self *ManifestV2Builder)UseChild(subintentHash *TransactionHash, name Man
 }
 
 
-func (_self *ManifestV2Builder)ValidatorAcceptsDelegatedStake(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorAcceptsDelegatedStake(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12520,7 +12844,8 @@ func (_self *ManifestV2Builder)ValidatorAcceptsDelegatedStake(address *Address)
 }
 
 
-func (_self *ManifestV2Builder)ValidatorClaimXrd(address *Address, bucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorClaimXrd(address *Address, bucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12536,7 +12861,8 @@ func (_self *ManifestV2Builder)ValidatorClaimXrd(address *Address, bucket Manife
 }
 
 
-func (_self *ManifestV2Builder)ValidatorFinishUnlockOwnerStakeUnits(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorFinishUnlockOwnerStakeUnits(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12552,7 +12878,8 @@ func (_self *ManifestV2Builder)ValidatorFinishUnlockOwnerStakeUnits(address *Add
 }
 
 
-func (_self *ManifestV2Builder)ValidatorGetProtocolUpdateReadiness(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorGetProtocolUpdateReadiness(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12568,7 +12895,8 @@ func (_self *ManifestV2Builder)ValidatorGetProtocolUpdateReadiness(address *Addr
 }
 
 
-func (_self *ManifestV2Builder)ValidatorGetRedemptionValue(address *Address, amountOfStakeUnits *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorGetRedemptionValue(address *Address, amountOfStakeUnits *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12584,7 +12912,8 @@ func (_self *ManifestV2Builder)ValidatorGetRedemptionValue(address *Address, amo
 }
 
 
-func (_self *ManifestV2Builder)ValidatorLockOwnerStakeUnits(address *Address, stakeUnitBucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorLockOwnerStakeUnits(address *Address, stakeUnitBucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12600,7 +12929,8 @@ func (_self *ManifestV2Builder)ValidatorLockOwnerStakeUnits(address *Address, st
 }
 
 
-func (_self *ManifestV2Builder)ValidatorRegister(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorRegister(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12616,7 +12946,8 @@ func (_self *ManifestV2Builder)ValidatorRegister(address *Address) (*ManifestV2B
 }
 
 
-func (_self *ManifestV2Builder)ValidatorSignalProtocolUpdateReadiness(address *Address, vote string) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorSignalProtocolUpdateReadiness(address *Address, vote string) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12632,7 +12963,8 @@ func (_self *ManifestV2Builder)ValidatorSignalProtocolUpdateReadiness(address *A
 }
 
 
-func (_self *ManifestV2Builder)ValidatorStake(address *Address, stake ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorStake(address *Address, stake ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12648,7 +12980,8 @@ func (_self *ManifestV2Builder)ValidatorStake(address *Address, stake ManifestBu
 }
 
 
-func (_self *ManifestV2Builder)ValidatorStakeAsOwner(address *Address, stake ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorStakeAsOwner(address *Address, stake ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12664,7 +12997,8 @@ func (_self *ManifestV2Builder)ValidatorStakeAsOwner(address *Address, stake Man
 }
 
 
-func (_self *ManifestV2Builder)ValidatorStartUnlockOwnerStakeUnits(address *Address, requestedStakeUnitAmount *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorStartUnlockOwnerStakeUnits(address *Address, requestedStakeUnitAmount *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12680,7 +13014,8 @@ func (_self *ManifestV2Builder)ValidatorStartUnlockOwnerStakeUnits(address *Addr
 }
 
 
-func (_self *ManifestV2Builder)ValidatorTotalStakeUnitSupply(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorTotalStakeUnitSupply(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12696,7 +13031,8 @@ func (_self *ManifestV2Builder)ValidatorTotalStakeUnitSupply(address *Address) (
 }
 
 
-func (_self *ManifestV2Builder)ValidatorTotalStakeXrdAmount(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorTotalStakeXrdAmount(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12712,7 +13048,8 @@ func (_self *ManifestV2Builder)ValidatorTotalStakeXrdAmount(address *Address) (*
 }
 
 
-func (_self *ManifestV2Builder)ValidatorUnregister(address *Address) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorUnregister(address *Address) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12728,7 +13065,8 @@ func (_self *ManifestV2Builder)ValidatorUnregister(address *Address) (*ManifestV
 }
 
 
-func (_self *ManifestV2Builder)ValidatorUnstake(address *Address, stakeUnitBucket ManifestBuilderBucket) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorUnstake(address *Address, stakeUnitBucket ManifestBuilderBucket) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12744,7 +13082,8 @@ func (_self *ManifestV2Builder)ValidatorUnstake(address *Address, stakeUnitBucke
 }
 
 
-func (_self *ManifestV2Builder)ValidatorUpdateAcceptDelegatedStake(address *Address, acceptDelegatedStake bool) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorUpdateAcceptDelegatedStake(address *Address, acceptDelegatedStake bool) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12760,7 +13099,8 @@ func (_self *ManifestV2Builder)ValidatorUpdateAcceptDelegatedStake(address *Addr
 }
 
 
-func (_self *ManifestV2Builder)ValidatorUpdateFee(address *Address, newFeeFactor *Decimal) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorUpdateFee(address *Address, newFeeFactor *Decimal) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12776,7 +13116,8 @@ func (_self *ManifestV2Builder)ValidatorUpdateFee(address *Address, newFeeFactor
 }
 
 
-func (_self *ManifestV2Builder)ValidatorUpdateKey(address *Address, key PublicKey) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)ValidatorUpdateKey(address *Address, key PublicKey) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12792,7 +13133,8 @@ func (_self *ManifestV2Builder)ValidatorUpdateKey(address *Address, key PublicKe
 }
 
 
-func (_self *ManifestV2Builder)VerifyParent(accessRule *AccessRule) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)VerifyParent(accessRule *AccessRule) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12808,7 +13150,8 @@ func (_self *ManifestV2Builder)VerifyParent(accessRule *AccessRule) (*ManifestV2
 }
 
 
-func (_self *ManifestV2Builder)YieldToChild(name ManifestBuilderIntent, args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)YieldToChild(name ManifestBuilderIntent, args []ManifestBuilderValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12824,7 +13167,8 @@ func (_self *ManifestV2Builder)YieldToChild(name ManifestBuilderIntent, args []M
 }
 
 
-func (_self *ManifestV2Builder)YieldToParent(args []ManifestBuilderValue) (*ManifestV2Builder, error) {
+func (_self *ManifestV2Builder)YieldToParent(args []ManifestBuilderValue) (_ *ManifestV2Builder, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*ManifestV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -12859,6 +13203,7 @@ func (c FfiConverterManifestV2Builder) Lift(pointer unsafe.Pointer) *ManifestV2B
 		}),
 	}
 	runtime.SetFinalizer(result, (*ManifestV2Builder).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -12889,7 +13234,8 @@ func (_ FfiDestroyerManifestV2Builder) Destroy(value *ManifestV2Builder) {
 type NonFungibleGlobalId struct {
 	ffiObject FfiObject
 }
-func NewNonFungibleGlobalId(nonFungibleGlobalId string) (*NonFungibleGlobalId, error) {
+func NewNonFungibleGlobalId(nonFungibleGlobalId string) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_nonfungibleglobalid_new(FfiConverterStringINSTANCE.Lower(nonFungibleGlobalId), _uniffiStatus)
 	})
@@ -12902,7 +13248,8 @@ func NewNonFungibleGlobalId(nonFungibleGlobalId string) (*NonFungibleGlobalId, e
 }
 
 
-func NonFungibleGlobalIdFromParts(resourceAddress *Address, nonFungibleLocalId NonFungibleLocalId) (*NonFungibleGlobalId, error) {
+func NonFungibleGlobalIdFromParts(resourceAddress *Address, nonFungibleLocalId NonFungibleLocalId) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_nonfungibleglobalid_from_parts(FfiConverterAddressINSTANCE.Lower(resourceAddress), FfiConverterTypeNonFungibleLocalIdINSTANCE.Lower(nonFungibleLocalId), _uniffiStatus)
 	})
@@ -12914,7 +13261,8 @@ func NonFungibleGlobalIdFromParts(resourceAddress *Address, nonFungibleLocalId N
 		}
 }
 
-func NonFungibleGlobalIdGlobalCallerBadgeFromBlueprintId(packageAddress *Address, blueprintName string, networkId uint8) (*NonFungibleGlobalId, error) {
+func NonFungibleGlobalIdGlobalCallerBadgeFromBlueprintId(packageAddress *Address, blueprintName string, networkId uint8) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_nonfungibleglobalid_global_caller_badge_from_blueprint_id(FfiConverterAddressINSTANCE.Lower(packageAddress), FfiConverterStringINSTANCE.Lower(blueprintName), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -12926,7 +13274,8 @@ func NonFungibleGlobalIdGlobalCallerBadgeFromBlueprintId(packageAddress *Address
 		}
 }
 
-func NonFungibleGlobalIdGlobalCallerBadgeFromGlobalAddress(componentAddress *Address, networkId uint8) (*NonFungibleGlobalId, error) {
+func NonFungibleGlobalIdGlobalCallerBadgeFromGlobalAddress(componentAddress *Address, networkId uint8) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_nonfungibleglobalid_global_caller_badge_from_global_address(FfiConverterAddressINSTANCE.Lower(componentAddress), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -12938,7 +13287,8 @@ func NonFungibleGlobalIdGlobalCallerBadgeFromGlobalAddress(componentAddress *Add
 		}
 }
 
-func NonFungibleGlobalIdPackageOfDirectCallerBadge(packageAddress *Address, networkId uint8) (*NonFungibleGlobalId, error) {
+func NonFungibleGlobalIdPackageOfDirectCallerBadge(packageAddress *Address, networkId uint8) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_nonfungibleglobalid_package_of_direct_caller_badge(FfiConverterAddressINSTANCE.Lower(packageAddress), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -12950,7 +13300,8 @@ func NonFungibleGlobalIdPackageOfDirectCallerBadge(packageAddress *Address, netw
 		}
 }
 
-func NonFungibleGlobalIdSignatureBadge(publicKey PublicKey, networkId uint8) (*NonFungibleGlobalId, error) {
+func NonFungibleGlobalIdSignatureBadge(publicKey PublicKey, networkId uint8) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_nonfungibleglobalid_signature_badge(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -13033,6 +13384,7 @@ func (c FfiConverterNonFungibleGlobalId) Lift(pointer unsafe.Pointer) *NonFungib
 		}),
 	}
 	runtime.SetFinalizer(result, (*NonFungibleGlobalId).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -13070,7 +13422,8 @@ func NewNotarizedTransactionV1(signedIntent *SignedTransactionIntentV1, notarySi
 }
 
 
-func NotarizedTransactionV1FromPayloadBytes(compiledNotarizedTransaction []byte) (*NotarizedTransactionV1, error) {
+func NotarizedTransactionV1FromPayloadBytes(compiledNotarizedTransaction []byte) (_ *NotarizedTransactionV1, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_notarizedtransactionv1_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledNotarizedTransaction), _uniffiStatus)
 	})
@@ -13084,7 +13437,8 @@ func NotarizedTransactionV1FromPayloadBytes(compiledNotarizedTransaction []byte)
 
 
 
-func (_self *NotarizedTransactionV1)Hash() (*TransactionHash, error) {
+func (_self *NotarizedTransactionV1)Hash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13100,7 +13454,8 @@ func (_self *NotarizedTransactionV1)Hash() (*TransactionHash, error) {
 }
 
 
-func (_self *NotarizedTransactionV1)IntentHash() (*TransactionHash, error) {
+func (_self *NotarizedTransactionV1)IntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13116,7 +13471,8 @@ func (_self *NotarizedTransactionV1)IntentHash() (*TransactionHash, error) {
 }
 
 
-func (_self *NotarizedTransactionV1)NotarizedTransactionHash() (*TransactionHash, error) {
+func (_self *NotarizedTransactionV1)NotarizedTransactionHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13152,7 +13508,8 @@ func (_self *NotarizedTransactionV1)SignedIntent() *SignedTransactionIntentV1 {
 }
 
 
-func (_self *NotarizedTransactionV1)SignedIntentHash() (*TransactionHash, error) {
+func (_self *NotarizedTransactionV1)SignedIntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13168,7 +13525,8 @@ func (_self *NotarizedTransactionV1)SignedIntentHash() (*TransactionHash, error)
 }
 
 
-func (_self *NotarizedTransactionV1)SignerPublicKeys() ([]PublicKey, error) {
+func (_self *NotarizedTransactionV1)SignerPublicKeys() (_ []PublicKey, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -13184,7 +13542,8 @@ func (_self *NotarizedTransactionV1)SignerPublicKeys() ([]PublicKey, error) {
 }
 
 
-func (_self *NotarizedTransactionV1)StaticallyValidate(networkId uint8) error {
+func (_self *NotarizedTransactionV1)StaticallyValidate(networkId uint8) (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV1")
 	defer _self.ffiObject.decrementPointer()
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
@@ -13196,7 +13555,8 @@ func (_self *NotarizedTransactionV1)StaticallyValidate(networkId uint8) error {
 }
 
 
-func (_self *NotarizedTransactionV1)ToPayloadBytes() ([]byte, error) {
+func (_self *NotarizedTransactionV1)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -13231,6 +13591,7 @@ func (c FfiConverterNotarizedTransactionV1) Lift(pointer unsafe.Pointer) *Notari
 		}),
 	}
 	runtime.SetFinalizer(result, (*NotarizedTransactionV1).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -13268,7 +13629,8 @@ func NewNotarizedTransactionV2(signedTransactionIntent *SignedTransactionIntentV
 }
 
 
-func NotarizedTransactionV2FromPayloadBytes(compiledNotarizedTransaction []byte) (*NotarizedTransactionV2, error) {
+func NotarizedTransactionV2FromPayloadBytes(compiledNotarizedTransaction []byte) (_ *NotarizedTransactionV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_notarizedtransactionv2_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledNotarizedTransaction), _uniffiStatus)
 	})
@@ -13282,7 +13644,8 @@ func NotarizedTransactionV2FromPayloadBytes(compiledNotarizedTransaction []byte)
 
 
 
-func (_self *NotarizedTransactionV2)Hash() (*TransactionHash, error) {
+func (_self *NotarizedTransactionV2)Hash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13298,7 +13661,8 @@ func (_self *NotarizedTransactionV2)Hash() (*TransactionHash, error) {
 }
 
 
-func (_self *NotarizedTransactionV2)IntentHash() (*TransactionHash, error) {
+func (_self *NotarizedTransactionV2)IntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13314,7 +13678,8 @@ func (_self *NotarizedTransactionV2)IntentHash() (*TransactionHash, error) {
 }
 
 
-func (_self *NotarizedTransactionV2)NotarizedTransactionHash() (*TransactionHash, error) {
+func (_self *NotarizedTransactionV2)NotarizedTransactionHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13350,7 +13715,8 @@ func (_self *NotarizedTransactionV2)SignedTransactionIntent() *SignedTransaction
 }
 
 
-func (_self *NotarizedTransactionV2)SignedTransactionIntentHash() (*TransactionHash, error) {
+func (_self *NotarizedTransactionV2)SignedTransactionIntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13366,7 +13732,8 @@ func (_self *NotarizedTransactionV2)SignedTransactionIntentHash() (*TransactionH
 }
 
 
-func (_self *NotarizedTransactionV2)SignerPublicKeys() ([]PublicKey, error) {
+func (_self *NotarizedTransactionV2)SignerPublicKeys() (_ []PublicKey, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -13382,7 +13749,8 @@ func (_self *NotarizedTransactionV2)SignerPublicKeys() ([]PublicKey, error) {
 }
 
 
-func (_self *NotarizedTransactionV2)StaticallyValidate(networkId uint8) error {
+func (_self *NotarizedTransactionV2)StaticallyValidate(networkId uint8) (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
@@ -13394,7 +13762,8 @@ func (_self *NotarizedTransactionV2)StaticallyValidate(networkId uint8) error {
 }
 
 
-func (_self *NotarizedTransactionV2)ToPayloadBytes() ([]byte, error) {
+func (_self *NotarizedTransactionV2)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*NotarizedTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -13429,6 +13798,7 @@ func (c FfiConverterNotarizedTransactionV2) Lift(pointer unsafe.Pointer) *Notari
 		}),
 	}
 	runtime.SetFinalizer(result, (*NotarizedTransactionV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -13478,7 +13848,8 @@ func (_self *OlympiaAddress)AsStr() string {
 }
 
 
-func (_self *OlympiaAddress)PublicKey() (PublicKey, error) {
+func (_self *OlympiaAddress)PublicKey() (_ PublicKey, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*OlympiaAddress")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -13513,6 +13884,7 @@ func (c FfiConverterOlympiaAddress) Lift(pointer unsafe.Pointer) *OlympiaAddress
 		}),
 	}
 	runtime.SetFinalizer(result, (*OlympiaAddress).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -13550,7 +13922,8 @@ func NewPartialTransactionV2(rootSubintent *SubintentV2, nonRootSubintents []*Su
 }
 
 
-func PartialTransactionV2FromPayloadBytes(compiledIntent []byte) (*PartialTransactionV2, error) {
+func PartialTransactionV2FromPayloadBytes(compiledIntent []byte) (_ *PartialTransactionV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_partialtransactionv2_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledIntent), _uniffiStatus)
 	})
@@ -13584,7 +13957,8 @@ func (_self *PartialTransactionV2)RootSubintent() *SubintentV2 {
 }
 
 
-func (_self *PartialTransactionV2)RootSubintentHash() (*TransactionHash, error) {
+func (_self *PartialTransactionV2)RootSubintentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PartialTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13600,7 +13974,8 @@ func (_self *PartialTransactionV2)RootSubintentHash() (*TransactionHash, error)
 }
 
 
-func (_self *PartialTransactionV2)ToPayloadBytes() ([]byte, error) {
+func (_self *PartialTransactionV2)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PartialTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -13635,6 +14010,7 @@ func (c FfiConverterPartialTransactionV2) Lift(pointer unsafe.Pointer) *PartialT
 		}),
 	}
 	runtime.SetFinalizer(result, (*PartialTransactionV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -13684,7 +14060,8 @@ func (_self *PartialTransactionV2Builder)AddChild(child *PartialTransactionV2) *
 }
 
 
-func (_self *PartialTransactionV2Builder)Build() (*PartialTransactionV2, error) {
+func (_self *PartialTransactionV2Builder)Build() (_ *PartialTransactionV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PartialTransactionV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13749,6 +14126,7 @@ func (c FfiConverterPartialTransactionV2Builder) Lift(pointer unsafe.Pointer) *P
 		}),
 	}
 	runtime.SetFinalizer(result, (*PartialTransactionV2Builder).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -13779,7 +14157,8 @@ func (_ FfiDestroyerPartialTransactionV2Builder) Destroy(value *PartialTransacti
 type PreciseDecimal struct {
 	ffiObject FfiObject
 }
-func NewPreciseDecimal(value string) (*PreciseDecimal, error) {
+func NewPreciseDecimal(value string) (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_precisedecimal_new(FfiConverterStringINSTANCE.Lower(value), _uniffiStatus)
 	})
@@ -13824,7 +14203,8 @@ func PreciseDecimalZero() *PreciseDecimal {
 
 
 
-func (_self *PreciseDecimal)Abs() (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Abs() (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13840,7 +14220,8 @@ func (_self *PreciseDecimal)Abs() (*PreciseDecimal, error) {
 }
 
 
-func (_self *PreciseDecimal)Add(other *PreciseDecimal) (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Add(other *PreciseDecimal) (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13866,7 +14247,8 @@ func (_self *PreciseDecimal)AsStr() string {
 }
 
 
-func (_self *PreciseDecimal)Cbrt() (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Cbrt() (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13882,7 +14264,8 @@ func (_self *PreciseDecimal)Cbrt() (*PreciseDecimal, error) {
 }
 
 
-func (_self *PreciseDecimal)Ceiling() (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Ceiling() (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13898,7 +14281,8 @@ func (_self *PreciseDecimal)Ceiling() (*PreciseDecimal, error) {
 }
 
 
-func (_self *PreciseDecimal)Div(other *PreciseDecimal) (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Div(other *PreciseDecimal) (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -13924,7 +14308,8 @@ func (_self *PreciseDecimal)Equal(other *PreciseDecimal) bool {
 }
 
 
-func (_self *PreciseDecimal)Floor() (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Floor() (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14020,7 +14405,8 @@ func (_self *PreciseDecimal)Mantissa() string {
 }
 
 
-func (_self *PreciseDecimal)Mul(other *PreciseDecimal) (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Mul(other *PreciseDecimal) (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14056,7 +14442,8 @@ func (_self *PreciseDecimal)NthRoot(n uint32) **PreciseDecimal {
 }
 
 
-func (_self *PreciseDecimal)Powi(exp int64) (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Powi(exp int64) (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14072,7 +14459,8 @@ func (_self *PreciseDecimal)Powi(exp int64) (*PreciseDecimal, error) {
 }
 
 
-func (_self *PreciseDecimal)Round(decimalPlaces int32, roundingMode RoundingMode) (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Round(decimalPlaces int32, roundingMode RoundingMode) (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14098,7 +14486,8 @@ func (_self *PreciseDecimal)Sqrt() **PreciseDecimal {
 }
 
 
-func (_self *PreciseDecimal)Sub(other *PreciseDecimal) (*PreciseDecimal, error) {
+func (_self *PreciseDecimal)Sub(other *PreciseDecimal) (_ *PreciseDecimal, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreciseDecimal")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14143,6 +14532,7 @@ func (c FfiConverterPreciseDecimal) Lift(pointer unsafe.Pointer) *PreciseDecimal
 		}),
 	}
 	runtime.SetFinalizer(result, (*PreciseDecimal).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -14202,7 +14592,8 @@ func (_self *PreviewPartialTransactionV2)PartialTransaction() *PartialTransactio
 }
 
 
-func (_self *PreviewPartialTransactionV2)RootSubintentHash() (*TransactionHash, error) {
+func (_self *PreviewPartialTransactionV2)RootSubintentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreviewPartialTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14247,6 +14638,7 @@ func (c FfiConverterPreviewPartialTransactionV2) Lift(pointer unsafe.Pointer) *P
 		}),
 	}
 	runtime.SetFinalizer(result, (*PreviewPartialTransactionV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -14306,7 +14698,8 @@ func (_self *PreviewPartialTransactionV2Builder)AddRootSubintentSigner(signer Pu
 }
 
 
-func (_self *PreviewPartialTransactionV2Builder)Build() (*PreviewPartialTransactionV2, error) {
+func (_self *PreviewPartialTransactionV2Builder)Build() (_ *PreviewPartialTransactionV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreviewPartialTransactionV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14371,6 +14764,7 @@ func (c FfiConverterPreviewPartialTransactionV2Builder) Lift(pointer unsafe.Poin
 		}),
 	}
 	runtime.SetFinalizer(result, (*PreviewPartialTransactionV2Builder).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -14430,7 +14824,8 @@ func (_self *PreviewTransactionV2Builder)AddRootIntentSigner(signer PublicKey) *
 }
 
 
-func (_self *PreviewTransactionV2Builder)Build() ([]byte, error) {
+func (_self *PreviewTransactionV2Builder)Build() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*PreviewTransactionV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -14505,6 +14900,7 @@ func (c FfiConverterPreviewTransactionV2Builder) Lift(pointer unsafe.Pointer) *P
 		}),
 	}
 	runtime.SetFinalizer(result, (*PreviewTransactionV2Builder).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -14535,7 +14931,8 @@ func (_ FfiDestroyerPreviewTransactionV2Builder) Destroy(value *PreviewTransacti
 type PrivateKey struct {
 	ffiObject FfiObject
 }
-func NewPrivateKey(bytes []byte, curve Curve) (*PrivateKey, error) {
+func NewPrivateKey(bytes []byte, curve Curve) (_ *PrivateKey, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_privatekey_new(FfiConverterBytesINSTANCE.Lower(bytes), FfiConverterTypeCurveINSTANCE.Lower(curve), _uniffiStatus)
 	})
@@ -14548,7 +14945,8 @@ func NewPrivateKey(bytes []byte, curve Curve) (*PrivateKey, error) {
 }
 
 
-func PrivateKeyNewEd25519(bytes []byte) (*PrivateKey, error) {
+func PrivateKeyNewEd25519(bytes []byte) (_ *PrivateKey, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_privatekey_new_ed25519(FfiConverterBytesINSTANCE.Lower(bytes), _uniffiStatus)
 	})
@@ -14560,7 +14958,8 @@ func PrivateKeyNewEd25519(bytes []byte) (*PrivateKey, error) {
 		}
 }
 
-func PrivateKeyNewSecp256k1(bytes []byte) (*PrivateKey, error) {
+func PrivateKeyNewSecp256k1(bytes []byte) (_ *PrivateKey, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_privatekey_new_secp256k1(FfiConverterBytesINSTANCE.Lower(bytes), _uniffiStatus)
 	})
@@ -14673,6 +15072,7 @@ func (c FfiConverterPrivateKey) Lift(pointer unsafe.Pointer) *PrivateKey {
 		}),
 	}
 	runtime.SetFinalizer(result, (*PrivateKey).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -14710,7 +15110,8 @@ func NewSignedPartialTransactionV2(partialTransaction *PartialTransactionV2, roo
 }
 
 
-func SignedPartialTransactionV2FromPayloadBytes(compiledIntent []byte) (*SignedPartialTransactionV2, error) {
+func SignedPartialTransactionV2FromPayloadBytes(compiledIntent []byte) (_ *SignedPartialTransactionV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_signedpartialtransactionv2_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledIntent), _uniffiStatus)
 	})
@@ -14744,7 +15145,8 @@ func (_self *SignedPartialTransactionV2)PartialTransaction() *PartialTransaction
 }
 
 
-func (_self *SignedPartialTransactionV2)RootSubintentHash() (*TransactionHash, error) {
+func (_self *SignedPartialTransactionV2)RootSubintentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedPartialTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14770,7 +15172,8 @@ func (_self *SignedPartialTransactionV2)RootSubintentSignatures() []SignatureWit
 }
 
 
-func (_self *SignedPartialTransactionV2)StaticallyValidate(networkId uint8) error {
+func (_self *SignedPartialTransactionV2)StaticallyValidate(networkId uint8) (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedPartialTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
@@ -14782,7 +15185,8 @@ func (_self *SignedPartialTransactionV2)StaticallyValidate(networkId uint8) erro
 }
 
 
-func (_self *SignedPartialTransactionV2)ToPayloadBytes() ([]byte, error) {
+func (_self *SignedPartialTransactionV2)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedPartialTransactionV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -14817,6 +15221,7 @@ func (c FfiConverterSignedPartialTransactionV2) Lift(pointer unsafe.Pointer) *Si
 		}),
 	}
 	runtime.SetFinalizer(result, (*SignedPartialTransactionV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -14896,7 +15301,8 @@ func (_self *SignedPartialTransactionV2Builder)Message(message MessageV2) *Signe
 }
 
 
-func (_self *SignedPartialTransactionV2Builder)PrepareForSigning() (*SignedPartialTransactionV2BuilderSignatureStep, error) {
+func (_self *SignedPartialTransactionV2Builder)PrepareForSigning() (_ *SignedPartialTransactionV2BuilderSignatureStep, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedPartialTransactionV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -14931,6 +15337,7 @@ func (c FfiConverterSignedPartialTransactionV2Builder) Lift(pointer unsafe.Point
 		}),
 	}
 	runtime.SetFinalizer(result, (*SignedPartialTransactionV2Builder).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -15014,6 +15421,7 @@ func (c FfiConverterSignedPartialTransactionV2BuilderSignatureStep) Lift(pointer
 		}),
 	}
 	runtime.SetFinalizer(result, (*SignedPartialTransactionV2BuilderSignatureStep).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -15051,7 +15459,8 @@ func NewSignedTransactionIntentV1(intent *IntentV1, intentSignatures []Signature
 }
 
 
-func SignedTransactionIntentV1FromPayloadBytes(compiledSignedIntent []byte) (*SignedTransactionIntentV1, error) {
+func SignedTransactionIntentV1FromPayloadBytes(compiledSignedIntent []byte) (_ *SignedTransactionIntentV1, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_signedtransactionintentv1_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledSignedIntent), _uniffiStatus)
 	})
@@ -15065,7 +15474,8 @@ func SignedTransactionIntentV1FromPayloadBytes(compiledSignedIntent []byte) (*Si
 
 
 
-func (_self *SignedTransactionIntentV1)Hash() (*TransactionHash, error) {
+func (_self *SignedTransactionIntentV1)Hash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15091,7 +15501,8 @@ func (_self *SignedTransactionIntentV1)Intent() *IntentV1 {
 }
 
 
-func (_self *SignedTransactionIntentV1)IntentHash() (*TransactionHash, error) {
+func (_self *SignedTransactionIntentV1)IntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15117,7 +15528,8 @@ func (_self *SignedTransactionIntentV1)IntentSignatures() []SignatureWithPublicK
 }
 
 
-func (_self *SignedTransactionIntentV1)SignedIntentHash() (*TransactionHash, error) {
+func (_self *SignedTransactionIntentV1)SignedIntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15133,7 +15545,8 @@ func (_self *SignedTransactionIntentV1)SignedIntentHash() (*TransactionHash, err
 }
 
 
-func (_self *SignedTransactionIntentV1)StaticallyValidate(networkId uint8) error {
+func (_self *SignedTransactionIntentV1)StaticallyValidate(networkId uint8) (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
@@ -15145,7 +15558,8 @@ func (_self *SignedTransactionIntentV1)StaticallyValidate(networkId uint8) error
 }
 
 
-func (_self *SignedTransactionIntentV1)ToPayloadBytes() ([]byte, error) {
+func (_self *SignedTransactionIntentV1)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -15180,6 +15594,7 @@ func (c FfiConverterSignedTransactionIntentV1) Lift(pointer unsafe.Pointer) *Sig
 		}),
 	}
 	runtime.SetFinalizer(result, (*SignedTransactionIntentV1).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -15217,7 +15632,8 @@ func NewSignedTransactionIntentV2(transactionIntent *TransactionIntentV2, transa
 }
 
 
-func SignedTransactionIntentV2FromPayloadBytes(compiledSignedIntent []byte) (*SignedTransactionIntentV2, error) {
+func SignedTransactionIntentV2FromPayloadBytes(compiledSignedIntent []byte) (_ *SignedTransactionIntentV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_signedtransactionintentv2_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledSignedIntent), _uniffiStatus)
 	})
@@ -15231,7 +15647,8 @@ func SignedTransactionIntentV2FromPayloadBytes(compiledSignedIntent []byte) (*Si
 
 
 
-func (_self *SignedTransactionIntentV2)Hash() (*TransactionHash, error) {
+func (_self *SignedTransactionIntentV2)Hash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15247,7 +15664,8 @@ func (_self *SignedTransactionIntentV2)Hash() (*TransactionHash, error) {
 }
 
 
-func (_self *SignedTransactionIntentV2)IntentHash() (*TransactionHash, error) {
+func (_self *SignedTransactionIntentV2)IntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15263,7 +15681,8 @@ func (_self *SignedTransactionIntentV2)IntentHash() (*TransactionHash, error) {
 }
 
 
-func (_self *SignedTransactionIntentV2)SignedIntentHash() (*TransactionHash, error) {
+func (_self *SignedTransactionIntentV2)SignedIntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15279,7 +15698,8 @@ func (_self *SignedTransactionIntentV2)SignedIntentHash() (*TransactionHash, err
 }
 
 
-func (_self *SignedTransactionIntentV2)ToPayloadBytes() ([]byte, error) {
+func (_self *SignedTransactionIntentV2)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SignedTransactionIntentV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -15334,6 +15754,7 @@ func (c FfiConverterSignedTransactionIntentV2) Lift(pointer unsafe.Pointer) *Sig
 		}),
 	}
 	runtime.SetFinalizer(result, (*SignedTransactionIntentV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -15371,7 +15792,8 @@ func NewSubintentManifestV2(instructions *InstructionsV2, blobs [][]byte, childr
 }
 
 
-func SubintentManifestV2FromPayloadBytes(compiled []byte, networkId uint8) (*SubintentManifestV2, error) {
+func SubintentManifestV2FromPayloadBytes(compiled []byte, networkId uint8) (_ *SubintentManifestV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_subintentmanifestv2_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiled), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -15425,7 +15847,8 @@ func (_self *SubintentManifestV2)Instructions() *InstructionsV2 {
 }
 
 
-func (_self *SubintentManifestV2)StaticallyValidate() error {
+func (_self *SubintentManifestV2)StaticallyValidate() (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SubintentManifestV2")
 	defer _self.ffiObject.decrementPointer()
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
@@ -15437,7 +15860,8 @@ func (_self *SubintentManifestV2)StaticallyValidate() error {
 }
 
 
-func (_self *SubintentManifestV2)ToPayloadBytes() ([]byte, error) {
+func (_self *SubintentManifestV2)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SubintentManifestV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -15472,6 +15896,7 @@ func (c FfiConverterSubintentManifestV2) Lift(pointer unsafe.Pointer) *Subintent
 		}),
 	}
 	runtime.SetFinalizer(result, (*SubintentManifestV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -15509,7 +15934,8 @@ func NewSubintentV2(intentCore *IntentCoreV2) *SubintentV2 {
 }
 
 
-func SubintentV2FromPayloadBytes(compiledIntent []byte) (*SubintentV2, error) {
+func SubintentV2FromPayloadBytes(compiledIntent []byte) (_ *SubintentV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_subintentv2_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledIntent), _uniffiStatus)
 	})
@@ -15523,7 +15949,8 @@ func SubintentV2FromPayloadBytes(compiledIntent []byte) (*SubintentV2, error) {
 
 
 
-func (_self *SubintentV2)SubintentHash() (*TransactionHash, error) {
+func (_self *SubintentV2)SubintentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*SubintentV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15558,6 +15985,7 @@ func (c FfiConverterSubintentV2) Lift(pointer unsafe.Pointer) *SubintentV2 {
 		}),
 	}
 	runtime.SetFinalizer(result, (*SubintentV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -15590,7 +16018,8 @@ type TransactionHash struct {
 }
 
 
-func TransactionHashFromStr(string string, networkId uint8) (*TransactionHash, error) {
+func TransactionHashFromStr(string string, networkId uint8) (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_transactionhash_from_str(FfiConverterStringINSTANCE.Lower(string), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -15663,6 +16092,7 @@ func (c FfiConverterTransactionHash) Lift(pointer unsafe.Pointer) *TransactionHa
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionHash).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -15700,7 +16130,8 @@ func NewTransactionIntentV2(transactionHeader TransactionHeaderV2, rootIntentCor
 }
 
 
-func TransactionIntentV2FromPayloadBytes(compiledIntent []byte) (*TransactionIntentV2, error) {
+func TransactionIntentV2FromPayloadBytes(compiledIntent []byte) (_ *TransactionIntentV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_transactionintentv2_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiledIntent), _uniffiStatus)
 	})
@@ -15714,7 +16145,8 @@ func TransactionIntentV2FromPayloadBytes(compiledIntent []byte) (*TransactionInt
 
 
 
-func (_self *TransactionIntentV2)Hash() (*TransactionHash, error) {
+func (_self *TransactionIntentV2)Hash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionIntentV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15750,7 +16182,8 @@ func (_self *TransactionIntentV2)RootIntentCore() *IntentCoreV2 {
 }
 
 
-func (_self *TransactionIntentV2)ToPayloadBytes() ([]byte, error) {
+func (_self *TransactionIntentV2)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionIntentV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -15776,7 +16209,8 @@ func (_self *TransactionIntentV2)TransactionHeader() TransactionHeaderV2 {
 }
 
 
-func (_self *TransactionIntentV2)TransactionIntentHash() (*TransactionHash, error) {
+func (_self *TransactionIntentV2)TransactionIntentHash() (_ *TransactionHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionIntentV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -15811,6 +16245,7 @@ func (c FfiConverterTransactionIntentV2) Lift(pointer unsafe.Pointer) *Transacti
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionIntentV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -15848,7 +16283,8 @@ func NewTransactionManifestV1(instructions *InstructionsV1, blobs [][]byte) *Tra
 }
 
 
-func TransactionManifestV1FromPayloadBytes(compiled []byte, networkId uint8) (*TransactionManifestV1, error) {
+func TransactionManifestV1FromPayloadBytes(compiled []byte, networkId uint8) (_ *TransactionManifestV1, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_transactionmanifestv1_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiled), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -15872,7 +16308,8 @@ func (_self *TransactionManifestV1)Blobs() [][]byte {
 }
 
 
-func (_self *TransactionManifestV1)DynamicallyAnalyze(networkId uint8, toolkitReceipt string) (DynamicAnalysis, error) {
+func (_self *TransactionManifestV1)DynamicallyAnalyze(networkId uint8, toolkitReceipt string) (_ DynamicAnalysis, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionManifestV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -15908,7 +16345,8 @@ func (_self *TransactionManifestV1)Instructions() *InstructionsV1 {
 }
 
 
-func (_self *TransactionManifestV1)StaticallyAnalyze(networkId uint8) (StaticAnalysis, error) {
+func (_self *TransactionManifestV1)StaticallyAnalyze(networkId uint8) (_ StaticAnalysis, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionManifestV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -15924,7 +16362,8 @@ func (_self *TransactionManifestV1)StaticallyAnalyze(networkId uint8) (StaticAna
 }
 
 
-func (_self *TransactionManifestV1)StaticallyValidate(networkId uint8) error {
+func (_self *TransactionManifestV1)StaticallyValidate(networkId uint8) (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionManifestV1")
 	defer _self.ffiObject.decrementPointer()
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
@@ -15936,7 +16375,8 @@ func (_self *TransactionManifestV1)StaticallyValidate(networkId uint8) error {
 }
 
 
-func (_self *TransactionManifestV1)ToPayloadBytes() ([]byte, error) {
+func (_self *TransactionManifestV1)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionManifestV1")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -15971,6 +16411,7 @@ func (c FfiConverterTransactionManifestV1) Lift(pointer unsafe.Pointer) *Transac
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionManifestV1).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -16008,7 +16449,8 @@ func NewTransactionManifestV2(instructions *InstructionsV2, blobs [][]byte, chil
 }
 
 
-func TransactionManifestV2FromPayloadBytes(compiled []byte, networkId uint8) (*TransactionManifestV2, error) {
+func TransactionManifestV2FromPayloadBytes(compiled []byte, networkId uint8) (_ *TransactionManifestV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_constructor_transactionmanifestv2_from_payload_bytes(FfiConverterBytesINSTANCE.Lower(compiled), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -16032,7 +16474,8 @@ func (_self *TransactionManifestV2)Blobs() [][]byte {
 }
 
 
-func (_self *TransactionManifestV2)DynamicallyAnalyze(networkId uint8, toolkitReceipt string) (DynamicAnalysis, error) {
+func (_self *TransactionManifestV2)DynamicallyAnalyze(networkId uint8, toolkitReceipt string) (_ DynamicAnalysis, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionManifestV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -16068,7 +16511,8 @@ func (_self *TransactionManifestV2)Instructions() *InstructionsV2 {
 }
 
 
-func (_self *TransactionManifestV2)StaticallyAnalyze(networkId uint8) (StaticAnalysis, error) {
+func (_self *TransactionManifestV2)StaticallyAnalyze(networkId uint8) (_ StaticAnalysis, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionManifestV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -16084,7 +16528,8 @@ func (_self *TransactionManifestV2)StaticallyAnalyze(networkId uint8) (StaticAna
 }
 
 
-func (_self *TransactionManifestV2)StaticallyValidate() error {
+func (_self *TransactionManifestV2)StaticallyValidate() (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionManifestV2")
 	defer _self.ffiObject.decrementPointer()
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
@@ -16096,7 +16541,8 @@ func (_self *TransactionManifestV2)StaticallyValidate() error {
 }
 
 
-func (_self *TransactionManifestV2)ToPayloadBytes() ([]byte, error) {
+func (_self *TransactionManifestV2)ToPayloadBytes() (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionManifestV2")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
@@ -16131,6 +16577,7 @@ func (c FfiConverterTransactionManifestV2) Lift(pointer unsafe.Pointer) *Transac
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionManifestV2).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -16199,6 +16646,7 @@ func (c FfiConverterTransactionV1Builder) Lift(pointer unsafe.Pointer) *Transact
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionV1Builder).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -16262,6 +16710,7 @@ func (c FfiConverterTransactionV1BuilderHeaderStep) Lift(pointer unsafe.Pointer)
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionV1BuilderHeaderStep).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -16301,7 +16750,8 @@ func NewTransactionV1BuilderIntentSignaturesStep(messageStep *TransactionV1Build
 
 
 
-func (_self *TransactionV1BuilderIntentSignaturesStep)NotarizeWithPrivateKey(privateKey *PrivateKey) (*NotarizedTransactionV1, error) {
+func (_self *TransactionV1BuilderIntentSignaturesStep)NotarizeWithPrivateKey(privateKey *PrivateKey) (_ *NotarizedTransactionV1, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionV1BuilderIntentSignaturesStep")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -16317,7 +16767,8 @@ func (_self *TransactionV1BuilderIntentSignaturesStep)NotarizeWithPrivateKey(pri
 }
 
 
-func (_self *TransactionV1BuilderIntentSignaturesStep)NotarizeWithSigner(signer Signer) (*NotarizedTransactionV1, error) {
+func (_self *TransactionV1BuilderIntentSignaturesStep)NotarizeWithSigner(signer Signer) (_ *NotarizedTransactionV1, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionV1BuilderIntentSignaturesStep")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -16372,6 +16823,7 @@ func (c FfiConverterTransactionV1BuilderIntentSignaturesStep) Lift(pointer unsaf
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionV1BuilderIntentSignaturesStep).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -16455,6 +16907,7 @@ func (c FfiConverterTransactionV1BuilderMessageStep) Lift(pointer unsafe.Pointer
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionV1BuilderMessageStep).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -16534,7 +16987,8 @@ func (_self *TransactionV2Builder)Message(message MessageV2) *TransactionV2Build
 }
 
 
-func (_self *TransactionV2Builder)PrepareForSigning() (*TransactionV2BuilderSignatureStep, error) {
+func (_self *TransactionV2Builder)PrepareForSigning() (_ *TransactionV2BuilderSignatureStep, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionV2Builder")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -16579,6 +17033,7 @@ func (c FfiConverterTransactionV2Builder) Lift(pointer unsafe.Pointer) *Transact
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionV2Builder).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -16613,7 +17068,8 @@ type TransactionV2BuilderSignatureStep struct {
 
 
 
-func (_self *TransactionV2BuilderSignatureStep)NotarizeWithPrivateKey(privateKey *PrivateKey) (*NotarizedTransactionV2, error) {
+func (_self *TransactionV2BuilderSignatureStep)NotarizeWithPrivateKey(privateKey *PrivateKey) (_ *NotarizedTransactionV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionV2BuilderSignatureStep")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -16629,7 +17085,8 @@ func (_self *TransactionV2BuilderSignatureStep)NotarizeWithPrivateKey(privateKey
 }
 
 
-func (_self *TransactionV2BuilderSignatureStep)NotarizeWithSigner(privateKey Signer) (*NotarizedTransactionV2, error) {
+func (_self *TransactionV2BuilderSignatureStep)NotarizeWithSigner(privateKey Signer) (_ *NotarizedTransactionV2, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_pointer := _self.ffiObject.incrementPointer("*TransactionV2BuilderSignatureStep")
 	defer _self.ffiObject.decrementPointer()
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
@@ -16684,6 +17141,7 @@ func (c FfiConverterTransactionV2BuilderSignatureStep) Lift(pointer unsafe.Point
 		}),
 	}
 	runtime.SetFinalizer(result, (*TransactionV2BuilderSignatureStep).Destroy)
+	trackObject(result)
 	return result
 }
 
@@ -34968,7 +35426,8 @@ func (FfiDestroyerTypeHashableBytes) Destroy(value HashableBytes) {
 	FfiDestroyerBytes{}.Destroy(builtinValue)
 }
 
-func DeriveGlobalCallerNonFungibleGlobalIdFromBlueprintId(packageAddress *Address, blueprintName string, networkId uint8) (*NonFungibleGlobalId, error) {
+func DeriveGlobalCallerNonFungibleGlobalIdFromBlueprintId(packageAddress *Address, blueprintName string, networkId uint8) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_global_caller_non_fungible_global_id_from_blueprint_id(FfiConverterAddressINSTANCE.Lower(packageAddress), FfiConverterStringINSTANCE.Lower(blueprintName), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -34980,7 +35439,8 @@ func DeriveGlobalCallerNonFungibleGlobalIdFromBlueprintId(packageAddress *Addres
 		}
 }
 
-func DeriveGlobalCallerNonFungibleGlobalIdFromGlobalAddress(globalAddress *Address, networkId uint8) (*NonFungibleGlobalId, error) {
+func DeriveGlobalCallerNonFungibleGlobalIdFromGlobalAddress(globalAddress *Address, networkId uint8) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_global_caller_non_fungible_global_id_from_global_address(FfiConverterAddressINSTANCE.Lower(globalAddress), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -34992,7 +35452,8 @@ func DeriveGlobalCallerNonFungibleGlobalIdFromGlobalAddress(globalAddress *Addre
 		}
 }
 
-func DeriveOlympiaAccountAddressFromPublicKey(publicKey PublicKey, olympiaNetwork OlympiaNetwork) (*OlympiaAddress, error) {
+func DeriveOlympiaAccountAddressFromPublicKey(publicKey PublicKey, olympiaNetwork OlympiaNetwork) (_ *OlympiaAddress, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_olympia_account_address_from_public_key(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), FfiConverterTypeOlympiaNetworkINSTANCE.Lower(olympiaNetwork), _uniffiStatus)
 	})
@@ -35004,7 +35465,8 @@ func DeriveOlympiaAccountAddressFromPublicKey(publicKey PublicKey, olympiaNetwor
 		}
 }
 
-func DerivePackageOfDirectCallerNonFungibleGlobalIdFromPackageAddress(packageAddress *Address, networkId uint8) (*NonFungibleGlobalId, error) {
+func DerivePackageOfDirectCallerNonFungibleGlobalIdFromPackageAddress(packageAddress *Address, networkId uint8) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_package_of_direct_caller_non_fungible_global_id_from_package_address(FfiConverterAddressINSTANCE.Lower(packageAddress), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -35016,7 +35478,8 @@ func DerivePackageOfDirectCallerNonFungibleGlobalIdFromPackageAddress(packageAdd
 		}
 }
 
-func DerivePreallocatedAccountAddressFromOlympiaAccountAddress(olympiaAccountAddress *OlympiaAddress, networkId uint8) (*Address, error) {
+func DerivePreallocatedAccountAddressFromOlympiaAccountAddress(olympiaAccountAddress *OlympiaAddress, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_preallocated_account_address_from_olympia_account_address(FfiConverterOlympiaAddressINSTANCE.Lower(olympiaAccountAddress), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -35028,7 +35491,8 @@ func DerivePreallocatedAccountAddressFromOlympiaAccountAddress(olympiaAccountAdd
 		}
 }
 
-func DerivePreallocatedAccountAddressFromPublicKey(publicKey PublicKey, networkId uint8) (*Address, error) {
+func DerivePreallocatedAccountAddressFromPublicKey(publicKey PublicKey, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_preallocated_account_address_from_public_key(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -35040,7 +35504,8 @@ func DerivePreallocatedAccountAddressFromPublicKey(publicKey PublicKey, networkI
 		}
 }
 
-func DerivePreallocatedIdentityAddressFromPublicKey(publicKey PublicKey, networkId uint8) (*Address, error) {
+func DerivePreallocatedIdentityAddressFromPublicKey(publicKey PublicKey, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_preallocated_identity_address_from_public_key(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -35052,7 +35517,8 @@ func DerivePreallocatedIdentityAddressFromPublicKey(publicKey PublicKey, network
 		}
 }
 
-func DerivePublicKeyFromOlympiaAccountAddress(olympiaResourceAddress *OlympiaAddress) (PublicKey, error) {
+func DerivePublicKeyFromOlympiaAccountAddress(olympiaResourceAddress *OlympiaAddress) (_ PublicKey, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_public_key_from_olympia_account_address(FfiConverterOlympiaAddressINSTANCE.Lower(olympiaResourceAddress), _uniffiStatus)
 	})
@@ -35064,7 +35530,8 @@ func DerivePublicKeyFromOlympiaAccountAddress(olympiaResourceAddress *OlympiaAdd
 		}
 }
 
-func DeriveResourceAddressFromOlympiaResourceAddress(olympiaResourceAddress *OlympiaAddress, networkId uint8) (*Address, error) {
+func DeriveResourceAddressFromOlympiaResourceAddress(olympiaResourceAddress *OlympiaAddress, networkId uint8) (_ *Address, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_resource_address_from_olympia_resource_address(FfiConverterOlympiaAddressINSTANCE.Lower(olympiaResourceAddress), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -35076,7 +35543,8 @@ func DeriveResourceAddressFromOlympiaResourceAddress(olympiaResourceAddress *Oly
 		}
 }
 
-func DeriveSignatureBadgeNonFungibleGlobalIdFromPublicKey(publicKey PublicKey, networkId uint8) (*NonFungibleGlobalId, error) {
+func DeriveSignatureBadgeNonFungibleGlobalIdFromPublicKey(publicKey PublicKey, networkId uint8) (_ *NonFungibleGlobalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_derive_signature_badge_non_fungible_global_id_from_public_key(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -35106,7 +35574,8 @@ func GetKnownAddresses(networkId uint8) KnownAddresses {
 	}))
 }
 
-func ManifestSborDecodeToStringRepresentation(bytes []byte, representation ManifestSborStringRepresentation, networkId uint8, schema *Schema) (string, error) {
+func ManifestSborDecodeToStringRepresentation(bytes []byte, representation ManifestSborStringRepresentation, networkId uint8, schema *Schema) (_ string, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_manifest_sbor_decode_to_string_representation(FfiConverterBytesINSTANCE.Lower(bytes), FfiConverterTypeManifestSborStringRepresentationINSTANCE.Lower(representation), FfiConverterUint8INSTANCE.Lower(networkId), FfiConverterOptionalTypeSchemaINSTANCE.Lower(schema), _uniffiStatus)
 	})
@@ -35118,7 +35587,8 @@ func ManifestSborDecodeToStringRepresentation(bytes []byte, representation Manif
 		}
 }
 
-func MetadataSborDecode(bytes []byte, networkId uint8) (MetadataValue, error) {
+func MetadataSborDecode(bytes []byte, networkId uint8) (_ MetadataValue, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_metadata_sbor_decode(FfiConverterBytesINSTANCE.Lower(bytes), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -35130,7 +35600,8 @@ func MetadataSborDecode(bytes []byte, networkId uint8) (MetadataValue, error) {
 		}
 }
 
-func MetadataSborEncode(value MetadataValue) ([]byte, error) {
+func MetadataSborEncode(value MetadataValue) (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_metadata_sbor_encode(FfiConverterTypeMetadataValueINSTANCE.Lower(value), _uniffiStatus)
 	})
@@ -35142,7 +35613,8 @@ func MetadataSborEncode(value MetadataValue) ([]byte, error) {
 		}
 }
 
-func NonFungibleLocalIdAsStr(value NonFungibleLocalId) (string, error) {
+func NonFungibleLocalIdAsStr(value NonFungibleLocalId) (_ string, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_non_fungible_local_id_as_str(FfiConverterTypeNonFungibleLocalIdINSTANCE.Lower(value), _uniffiStatus)
 	})
@@ -35154,7 +35626,8 @@ func NonFungibleLocalIdAsStr(value NonFungibleLocalId) (string, error) {
 		}
 }
 
-func NonFungibleLocalIdFromStr(string string) (NonFungibleLocalId, error) {
+func NonFungibleLocalIdFromStr(string string) (_ NonFungibleLocalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_non_fungible_local_id_from_str(FfiConverterStringINSTANCE.Lower(string), _uniffiStatus)
 	})
@@ -35166,7 +35639,8 @@ func NonFungibleLocalIdFromStr(string string) (NonFungibleLocalId, error) {
 		}
 }
 
-func NonFungibleLocalIdSborDecode(bytes []byte) (NonFungibleLocalId, error) {
+func NonFungibleLocalIdSborDecode(bytes []byte) (_ NonFungibleLocalId, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_non_fungible_local_id_sbor_decode(FfiConverterBytesINSTANCE.Lower(bytes), _uniffiStatus)
 	})
@@ -35178,7 +35652,8 @@ func NonFungibleLocalIdSborDecode(bytes []byte) (NonFungibleLocalId, error) {
 		}
 }
 
-func NonFungibleLocalIdSborEncode(value NonFungibleLocalId) ([]byte, error) {
+func NonFungibleLocalIdSborEncode(value NonFungibleLocalId) (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_non_fungible_local_id_sbor_encode(FfiConverterTypeNonFungibleLocalIdINSTANCE.Lower(value), _uniffiStatus)
 	})
@@ -35214,7 +35689,8 @@ func PublicKeyFingerprintV2ToVec(value PublicKeyFingerprint) []byte {
 	}))
 }
 
-func PublicKeyHashFromPublicKey(publicKey PublicKey) (PublicKeyHash, error) {
+func PublicKeyHashFromPublicKey(publicKey PublicKey) (_ PublicKeyHash, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_public_key_hash_from_public_key(FfiConverterTypePublicKeyINSTANCE.Lower(publicKey), _uniffiStatus)
 	})
@@ -35226,7 +35702,8 @@ func PublicKeyHashFromPublicKey(publicKey PublicKey) (PublicKeyHash, error) {
 		}
 }
 
-func SborDecodeToStringRepresentation(bytes []byte, representation SerializationMode, networkId uint8, schema *Schema) (string, error) {
+func SborDecodeToStringRepresentation(bytes []byte, representation SerializationMode, networkId uint8, schema *Schema) (_ string, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_sbor_decode_to_string_representation(FfiConverterBytesINSTANCE.Lower(bytes), FfiConverterTypeSerializationModeINSTANCE.Lower(representation), FfiConverterUint8INSTANCE.Lower(networkId), FfiConverterOptionalTypeSchemaINSTANCE.Lower(schema), _uniffiStatus)
 	})
@@ -35238,7 +35715,8 @@ func SborDecodeToStringRepresentation(bytes []byte, representation Serialization
 		}
 }
 
-func ScryptoSborDecodeToNativeEvent(eventTypeIdentifier EventTypeIdentifier, eventData []byte, networkId uint8) (TypedNativeEvent, error) {
+func ScryptoSborDecodeToNativeEvent(eventTypeIdentifier EventTypeIdentifier, eventData []byte, networkId uint8) (_ TypedNativeEvent, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_scrypto_sbor_decode_to_native_event(FfiConverterTypeEventTypeIdentifierINSTANCE.Lower(eventTypeIdentifier), FfiConverterBytesINSTANCE.Lower(eventData), FfiConverterUint8INSTANCE.Lower(networkId), _uniffiStatus)
 	})
@@ -35250,7 +35728,8 @@ func ScryptoSborDecodeToNativeEvent(eventTypeIdentifier EventTypeIdentifier, eve
 		}
 }
 
-func ScryptoSborDecodeToStringRepresentation(bytes []byte, representation SerializationMode, networkId uint8, schema *Schema) (string, error) {
+func ScryptoSborDecodeToStringRepresentation(bytes []byte, representation SerializationMode, networkId uint8, schema *Schema) (_ string, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_scrypto_sbor_decode_to_string_representation(FfiConverterBytesINSTANCE.Lower(bytes), FfiConverterTypeSerializationModeINSTANCE.Lower(representation), FfiConverterUint8INSTANCE.Lower(networkId), FfiConverterOptionalTypeSchemaINSTANCE.Lower(schema), _uniffiStatus)
 	})
@@ -35262,7 +35741,8 @@ func ScryptoSborDecodeToStringRepresentation(bytes []byte, representation Serial
 		}
 }
 
-func ScryptoSborEncodeStringRepresentation(representation ScryptoSborString) ([]byte, error) {
+func ScryptoSborEncodeStringRepresentation(representation ScryptoSborString) (_ []byte, _uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_uniffiRV, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return C.uniffi_radix_engine_toolkit_uniffi_fn_func_scrypto_sbor_encode_string_representation(FfiConverterTypeScryptoSborStringINSTANCE.Lower(representation), _uniffiStatus)
 	})
@@ -35274,7 +35754,8 @@ func ScryptoSborEncodeStringRepresentation(representation ScryptoSborString) ([]
 		}
 }
 
-func TestPanic(message string) error {
+func TestPanic(message string) (_uniffiObjectErr error) {
+	defer recoverObjectDestroyed(&_uniffiObjectErr)
 	_, _uniffiErr := rustCallWithError(FfiConverterTypeRadixEngineToolkitError{},func(_uniffiStatus *C.RustCallStatus) bool {
 		C.uniffi_radix_engine_toolkit_uniffi_fn_func_test_panic(FfiConverterStringINSTANCE.Lower(message), _uniffiStatus)
 		return false
@@ -0,0 +1,5456 @@
+// Code generated by internal/cmd/jsongen. DO NOT EDIT.
+
+package radix_engine_toolkit_uniffi
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func (e AccountDefaultDepositRule) MarshalText() ([]byte, error) {
+	switch e {
+	case AccountDefaultDepositRuleAccept:
+		return []byte("Accept"), nil
+	case AccountDefaultDepositRuleReject:
+		return []byte("Reject"), nil
+	case AccountDefaultDepositRuleAllowExisting:
+		return []byte("AllowExisting"), nil
+	}
+	return nil, fmt.Errorf("invalid AccountDefaultDepositRule value %d", e)
+}
+
+func (e *AccountDefaultDepositRule) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Accept":
+		*e = AccountDefaultDepositRuleAccept
+	case "Reject":
+		*e = AccountDefaultDepositRuleReject
+	case "AllowExisting":
+		*e = AccountDefaultDepositRuleAllowExisting
+	default:
+		return fmt.Errorf("invalid AccountDefaultDepositRule variant %q", text)
+	}
+	return nil
+}
+
+func (e Curve) MarshalText() ([]byte, error) {
+	switch e {
+	case CurveSecp256k1:
+		return []byte("Secp256k1"), nil
+	case CurveEd25519:
+		return []byte("Ed25519"), nil
+	}
+	return nil, fmt.Errorf("invalid Curve value %d", e)
+}
+
+func (e *Curve) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Secp256k1":
+		*e = CurveSecp256k1
+	case "Ed25519":
+		*e = CurveEd25519
+	default:
+		return fmt.Errorf("invalid Curve variant %q", text)
+	}
+	return nil
+}
+
+func (e CurveTypeV1) MarshalText() ([]byte, error) {
+	switch e {
+	case CurveTypeV1Ed25519:
+		return []byte("Ed25519"), nil
+	case CurveTypeV1Secp256k1:
+		return []byte("Secp256k1"), nil
+	}
+	return nil, fmt.Errorf("invalid CurveTypeV1 value %d", e)
+}
+
+func (e *CurveTypeV1) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Ed25519":
+		*e = CurveTypeV1Ed25519
+	case "Secp256k1":
+		*e = CurveTypeV1Secp256k1
+	default:
+		return fmt.Errorf("invalid CurveTypeV1 variant %q", text)
+	}
+	return nil
+}
+
+func (e CurveTypeV2) MarshalText() ([]byte, error) {
+	switch e {
+	case CurveTypeV2Ed25519:
+		return []byte("Ed25519"), nil
+	case CurveTypeV2Secp256k1:
+		return []byte("Secp256k1"), nil
+	}
+	return nil, fmt.Errorf("invalid CurveTypeV2 value %d", e)
+}
+
+func (e *CurveTypeV2) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Ed25519":
+		*e = CurveTypeV2Ed25519
+	case "Secp256k1":
+		*e = CurveTypeV2Secp256k1
+	default:
+		return fmt.Errorf("invalid CurveTypeV2 variant %q", text)
+	}
+	return nil
+}
+
+func (e EntityType) MarshalText() ([]byte, error) {
+	switch e {
+	case EntityTypeGlobalPackage:
+		return []byte("GlobalPackage"), nil
+	case EntityTypeGlobalFungibleResourceManager:
+		return []byte("GlobalFungibleResourceManager"), nil
+	case EntityTypeGlobalNonFungibleResourceManager:
+		return []byte("GlobalNonFungibleResourceManager"), nil
+	case EntityTypeGlobalConsensusManager:
+		return []byte("GlobalConsensusManager"), nil
+	case EntityTypeGlobalValidator:
+		return []byte("GlobalValidator"), nil
+	case EntityTypeGlobalAccessController:
+		return []byte("GlobalAccessController"), nil
+	case EntityTypeGlobalAccount:
+		return []byte("GlobalAccount"), nil
+	case EntityTypeGlobalIdentity:
+		return []byte("GlobalIdentity"), nil
+	case EntityTypeGlobalGenericComponent:
+		return []byte("GlobalGenericComponent"), nil
+	case EntityTypeGlobalPreallocatedSecp256k1Account:
+		return []byte("GlobalPreallocatedSecp256k1Account"), nil
+	case EntityTypeGlobalPreallocatedEd25519Account:
+		return []byte("GlobalPreallocatedEd25519Account"), nil
+	case EntityTypeGlobalPreallocatedSecp256k1Identity:
+		return []byte("GlobalPreallocatedSecp256k1Identity"), nil
+	case EntityTypeGlobalPreallocatedEd25519Identity:
+		return []byte("GlobalPreallocatedEd25519Identity"), nil
+	case EntityTypeGlobalOneResourcePool:
+		return []byte("GlobalOneResourcePool"), nil
+	case EntityTypeGlobalTwoResourcePool:
+		return []byte("GlobalTwoResourcePool"), nil
+	case EntityTypeGlobalMultiResourcePool:
+		return []byte("GlobalMultiResourcePool"), nil
+	case EntityTypeGlobalAccountLocker:
+		return []byte("GlobalAccountLocker"), nil
+	case EntityTypeGlobalTransactionTracker:
+		return []byte("GlobalTransactionTracker"), nil
+	case EntityTypeInternalFungibleVault:
+		return []byte("InternalFungibleVault"), nil
+	case EntityTypeInternalNonFungibleVault:
+		return []byte("InternalNonFungibleVault"), nil
+	case EntityTypeInternalGenericComponent:
+		return []byte("InternalGenericComponent"), nil
+	case EntityTypeInternalKeyValueStore:
+		return []byte("InternalKeyValueStore"), nil
+	}
+	return nil, fmt.Errorf("invalid EntityType value %d", e)
+}
+
+func (e *EntityType) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "GlobalPackage":
+		*e = EntityTypeGlobalPackage
+	case "GlobalFungibleResourceManager":
+		*e = EntityTypeGlobalFungibleResourceManager
+	case "GlobalNonFungibleResourceManager":
+		*e = EntityTypeGlobalNonFungibleResourceManager
+	case "GlobalConsensusManager":
+		*e = EntityTypeGlobalConsensusManager
+	case "GlobalValidator":
+		*e = EntityTypeGlobalValidator
+	case "GlobalAccessController":
+		*e = EntityTypeGlobalAccessController
+	case "GlobalAccount":
+		*e = EntityTypeGlobalAccount
+	case "GlobalIdentity":
+		*e = EntityTypeGlobalIdentity
+	case "GlobalGenericComponent":
+		*e = EntityTypeGlobalGenericComponent
+	case "GlobalPreallocatedSecp256k1Account":
+		*e = EntityTypeGlobalPreallocatedSecp256k1Account
+	case "GlobalPreallocatedEd25519Account":
+		*e = EntityTypeGlobalPreallocatedEd25519Account
+	case "GlobalPreallocatedSecp256k1Identity":
+		*e = EntityTypeGlobalPreallocatedSecp256k1Identity
+	case "GlobalPreallocatedEd25519Identity":
+		*e = EntityTypeGlobalPreallocatedEd25519Identity
+	case "GlobalOneResourcePool":
+		*e = EntityTypeGlobalOneResourcePool
+	case "GlobalTwoResourcePool":
+		*e = EntityTypeGlobalTwoResourcePool
+	case "GlobalMultiResourcePool":
+		*e = EntityTypeGlobalMultiResourcePool
+	case "GlobalAccountLocker":
+		*e = EntityTypeGlobalAccountLocker
+	case "GlobalTransactionTracker":
+		*e = EntityTypeGlobalTransactionTracker
+	case "InternalFungibleVault":
+		*e = EntityTypeInternalFungibleVault
+	case "InternalNonFungibleVault":
+		*e = EntityTypeInternalNonFungibleVault
+	case "InternalGenericComponent":
+		*e = EntityTypeInternalGenericComponent
+	case "InternalKeyValueStore":
+		*e = EntityTypeInternalKeyValueStore
+	default:
+		return fmt.Errorf("invalid EntityType variant %q", text)
+	}
+	return nil
+}
+
+func (e ManifestBuilderValueKind) MarshalText() ([]byte, error) {
+	switch e {
+	case ManifestBuilderValueKindBoolValue:
+		return []byte("BoolValue"), nil
+	case ManifestBuilderValueKindI8Value:
+		return []byte("I8Value"), nil
+	case ManifestBuilderValueKindI16Value:
+		return []byte("I16Value"), nil
+	case ManifestBuilderValueKindI32Value:
+		return []byte("I32Value"), nil
+	case ManifestBuilderValueKindI64Value:
+		return []byte("I64Value"), nil
+	case ManifestBuilderValueKindI128Value:
+		return []byte("I128Value"), nil
+	case ManifestBuilderValueKindU8Value:
+		return []byte("U8Value"), nil
+	case ManifestBuilderValueKindU16Value:
+		return []byte("U16Value"), nil
+	case ManifestBuilderValueKindU32Value:
+		return []byte("U32Value"), nil
+	case ManifestBuilderValueKindU64Value:
+		return []byte("U64Value"), nil
+	case ManifestBuilderValueKindU128Value:
+		return []byte("U128Value"), nil
+	case ManifestBuilderValueKindStringValue:
+		return []byte("StringValue"), nil
+	case ManifestBuilderValueKindEnumValue:
+		return []byte("EnumValue"), nil
+	case ManifestBuilderValueKindArrayValue:
+		return []byte("ArrayValue"), nil
+	case ManifestBuilderValueKindTupleValue:
+		return []byte("TupleValue"), nil
+	case ManifestBuilderValueKindMapValue:
+		return []byte("MapValue"), nil
+	case ManifestBuilderValueKindAddressValue:
+		return []byte("AddressValue"), nil
+	case ManifestBuilderValueKindBucketValue:
+		return []byte("BucketValue"), nil
+	case ManifestBuilderValueKindProofValue:
+		return []byte("ProofValue"), nil
+	case ManifestBuilderValueKindExpressionValue:
+		return []byte("ExpressionValue"), nil
+	case ManifestBuilderValueKindBlobValue:
+		return []byte("BlobValue"), nil
+	case ManifestBuilderValueKindDecimalValue:
+		return []byte("DecimalValue"), nil
+	case ManifestBuilderValueKindPreciseDecimalValue:
+		return []byte("PreciseDecimalValue"), nil
+	case ManifestBuilderValueKindNonFungibleLocalIdValue:
+		return []byte("NonFungibleLocalIdValue"), nil
+	case ManifestBuilderValueKindAddressReservationValue:
+		return []byte("AddressReservationValue"), nil
+	}
+	return nil, fmt.Errorf("invalid ManifestBuilderValueKind value %d", e)
+}
+
+func (e *ManifestBuilderValueKind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "BoolValue":
+		*e = ManifestBuilderValueKindBoolValue
+	case "I8Value":
+		*e = ManifestBuilderValueKindI8Value
+	case "I16Value":
+		*e = ManifestBuilderValueKindI16Value
+	case "I32Value":
+		*e = ManifestBuilderValueKindI32Value
+	case "I64Value":
+		*e = ManifestBuilderValueKindI64Value
+	case "I128Value":
+		*e = ManifestBuilderValueKindI128Value
+	case "U8Value":
+		*e = ManifestBuilderValueKindU8Value
+	case "U16Value":
+		*e = ManifestBuilderValueKindU16Value
+	case "U32Value":
+		*e = ManifestBuilderValueKindU32Value
+	case "U64Value":
+		*e = ManifestBuilderValueKindU64Value
+	case "U128Value":
+		*e = ManifestBuilderValueKindU128Value
+	case "StringValue":
+		*e = ManifestBuilderValueKindStringValue
+	case "EnumValue":
+		*e = ManifestBuilderValueKindEnumValue
+	case "ArrayValue":
+		*e = ManifestBuilderValueKindArrayValue
+	case "TupleValue":
+		*e = ManifestBuilderValueKindTupleValue
+	case "MapValue":
+		*e = ManifestBuilderValueKindMapValue
+	case "AddressValue":
+		*e = ManifestBuilderValueKindAddressValue
+	case "BucketValue":
+		*e = ManifestBuilderValueKindBucketValue
+	case "ProofValue":
+		*e = ManifestBuilderValueKindProofValue
+	case "ExpressionValue":
+		*e = ManifestBuilderValueKindExpressionValue
+	case "BlobValue":
+		*e = ManifestBuilderValueKindBlobValue
+	case "DecimalValue":
+		*e = ManifestBuilderValueKindDecimalValue
+	case "PreciseDecimalValue":
+		*e = ManifestBuilderValueKindPreciseDecimalValue
+	case "NonFungibleLocalIdValue":
+		*e = ManifestBuilderValueKindNonFungibleLocalIdValue
+	case "AddressReservationValue":
+		*e = ManifestBuilderValueKindAddressReservationValue
+	default:
+		return fmt.Errorf("invalid ManifestBuilderValueKind variant %q", text)
+	}
+	return nil
+}
+
+func (e ManifestClassification) MarshalText() ([]byte, error) {
+	switch e {
+	case ManifestClassificationGeneral:
+		return []byte("General"), nil
+	case ManifestClassificationGeneralSubintent:
+		return []byte("GeneralSubintent"), nil
+	case ManifestClassificationTransfer:
+		return []byte("Transfer"), nil
+	case ManifestClassificationValidatorStake:
+		return []byte("ValidatorStake"), nil
+	case ManifestClassificationValidatorUnstake:
+		return []byte("ValidatorUnstake"), nil
+	case ManifestClassificationValidatorClaimXrd:
+		return []byte("ValidatorClaimXrd"), nil
+	case ManifestClassificationPoolContribution:
+		return []byte("PoolContribution"), nil
+	case ManifestClassificationPoolRedemption:
+		return []byte("PoolRedemption"), nil
+	case ManifestClassificationAccountDepositSettingsUpdate:
+		return []byte("AccountDepositSettingsUpdate"), nil
+	}
+	return nil, fmt.Errorf("invalid ManifestClassification value %d", e)
+}
+
+func (e *ManifestClassification) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "General":
+		*e = ManifestClassificationGeneral
+	case "GeneralSubintent":
+		*e = ManifestClassificationGeneralSubintent
+	case "Transfer":
+		*e = ManifestClassificationTransfer
+	case "ValidatorStake":
+		*e = ManifestClassificationValidatorStake
+	case "ValidatorUnstake":
+		*e = ManifestClassificationValidatorUnstake
+	case "ValidatorClaimXrd":
+		*e = ManifestClassificationValidatorClaimXrd
+	case "PoolContribution":
+		*e = ManifestClassificationPoolContribution
+	case "PoolRedemption":
+		*e = ManifestClassificationPoolRedemption
+	case "AccountDepositSettingsUpdate":
+		*e = ManifestClassificationAccountDepositSettingsUpdate
+	default:
+		return fmt.Errorf("invalid ManifestClassification variant %q", text)
+	}
+	return nil
+}
+
+func (e ManifestExpression) MarshalText() ([]byte, error) {
+	switch e {
+	case ManifestExpressionEntireWorktop:
+		return []byte("EntireWorktop"), nil
+	case ManifestExpressionEntireAuthZone:
+		return []byte("EntireAuthZone"), nil
+	}
+	return nil, fmt.Errorf("invalid ManifestExpression value %d", e)
+}
+
+func (e *ManifestExpression) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "EntireWorktop":
+		*e = ManifestExpressionEntireWorktop
+	case "EntireAuthZone":
+		*e = ManifestExpressionEntireAuthZone
+	default:
+		return fmt.Errorf("invalid ManifestExpression variant %q", text)
+	}
+	return nil
+}
+
+func (e ManifestValueKind) MarshalText() ([]byte, error) {
+	switch e {
+	case ManifestValueKindBoolValue:
+		return []byte("BoolValue"), nil
+	case ManifestValueKindI8Value:
+		return []byte("I8Value"), nil
+	case ManifestValueKindI16Value:
+		return []byte("I16Value"), nil
+	case ManifestValueKindI32Value:
+		return []byte("I32Value"), nil
+	case ManifestValueKindI64Value:
+		return []byte("I64Value"), nil
+	case ManifestValueKindI128Value:
+		return []byte("I128Value"), nil
+	case ManifestValueKindU8Value:
+		return []byte("U8Value"), nil
+	case ManifestValueKindU16Value:
+		return []byte("U16Value"), nil
+	case ManifestValueKindU32Value:
+		return []byte("U32Value"), nil
+	case ManifestValueKindU64Value:
+		return []byte("U64Value"), nil
+	case ManifestValueKindU128Value:
+		return []byte("U128Value"), nil
+	case ManifestValueKindStringValue:
+		return []byte("StringValue"), nil
+	case ManifestValueKindEnumValue:
+		return []byte("EnumValue"), nil
+	case ManifestValueKindArrayValue:
+		return []byte("ArrayValue"), nil
+	case ManifestValueKindTupleValue:
+		return []byte("TupleValue"), nil
+	case ManifestValueKindMapValue:
+		return []byte("MapValue"), nil
+	case ManifestValueKindAddressValue:
+		return []byte("AddressValue"), nil
+	case ManifestValueKindBucketValue:
+		return []byte("BucketValue"), nil
+	case ManifestValueKindProofValue:
+		return []byte("ProofValue"), nil
+	case ManifestValueKindExpressionValue:
+		return []byte("ExpressionValue"), nil
+	case ManifestValueKindBlobValue:
+		return []byte("BlobValue"), nil
+	case ManifestValueKindDecimalValue:
+		return []byte("DecimalValue"), nil
+	case ManifestValueKindPreciseDecimalValue:
+		return []byte("PreciseDecimalValue"), nil
+	case ManifestValueKindNonFungibleLocalIdValue:
+		return []byte("NonFungibleLocalIdValue"), nil
+	case ManifestValueKindAddressReservationValue:
+		return []byte("AddressReservationValue"), nil
+	}
+	return nil, fmt.Errorf("invalid ManifestValueKind value %d", e)
+}
+
+func (e *ManifestValueKind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "BoolValue":
+		*e = ManifestValueKindBoolValue
+	case "I8Value":
+		*e = ManifestValueKindI8Value
+	case "I16Value":
+		*e = ManifestValueKindI16Value
+	case "I32Value":
+		*e = ManifestValueKindI32Value
+	case "I64Value":
+		*e = ManifestValueKindI64Value
+	case "I128Value":
+		*e = ManifestValueKindI128Value
+	case "U8Value":
+		*e = ManifestValueKindU8Value
+	case "U16Value":
+		*e = ManifestValueKindU16Value
+	case "U32Value":
+		*e = ManifestValueKindU32Value
+	case "U64Value":
+		*e = ManifestValueKindU64Value
+	case "U128Value":
+		*e = ManifestValueKindU128Value
+	case "StringValue":
+		*e = ManifestValueKindStringValue
+	case "EnumValue":
+		*e = ManifestValueKindEnumValue
+	case "ArrayValue":
+		*e = ManifestValueKindArrayValue
+	case "TupleValue":
+		*e = ManifestValueKindTupleValue
+	case "MapValue":
+		*e = ManifestValueKindMapValue
+	case "AddressValue":
+		*e = ManifestValueKindAddressValue
+	case "BucketValue":
+		*e = ManifestValueKindBucketValue
+	case "ProofValue":
+		*e = ManifestValueKindProofValue
+	case "ExpressionValue":
+		*e = ManifestValueKindExpressionValue
+	case "BlobValue":
+		*e = ManifestValueKindBlobValue
+	case "DecimalValue":
+		*e = ManifestValueKindDecimalValue
+	case "PreciseDecimalValue":
+		*e = ManifestValueKindPreciseDecimalValue
+	case "NonFungibleLocalIdValue":
+		*e = ManifestValueKindNonFungibleLocalIdValue
+	case "AddressReservationValue":
+		*e = ManifestValueKindAddressReservationValue
+	default:
+		return fmt.Errorf("invalid ManifestValueKind variant %q", text)
+	}
+	return nil
+}
+
+func (e ModuleId) MarshalText() ([]byte, error) {
+	switch e {
+	case ModuleIdMain:
+		return []byte("Main"), nil
+	case ModuleIdMetadata:
+		return []byte("Metadata"), nil
+	case ModuleIdRoyalty:
+		return []byte("Royalty"), nil
+	case ModuleIdRoleAssignment:
+		return []byte("RoleAssignment"), nil
+	}
+	return nil, fmt.Errorf("invalid ModuleId value %d", e)
+}
+
+func (e *ModuleId) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Main":
+		*e = ModuleIdMain
+	case "Metadata":
+		*e = ModuleIdMetadata
+	case "Royalty":
+		*e = ModuleIdRoyalty
+	case "RoleAssignment":
+		*e = ModuleIdRoleAssignment
+	default:
+		return fmt.Errorf("invalid ModuleId variant %q", text)
+	}
+	return nil
+}
+
+func (e OlympiaNetwork) MarshalText() ([]byte, error) {
+	switch e {
+	case OlympiaNetworkMainnet:
+		return []byte("Mainnet"), nil
+	case OlympiaNetworkStokenet:
+		return []byte("Stokenet"), nil
+	case OlympiaNetworkReleasenet:
+		return []byte("Releasenet"), nil
+	case OlympiaNetworkRcNet:
+		return []byte("RcNet"), nil
+	case OlympiaNetworkMilestonenet:
+		return []byte("Milestonenet"), nil
+	case OlympiaNetworkDevopsnet:
+		return []byte("Devopsnet"), nil
+	case OlympiaNetworkSandpitnet:
+		return []byte("Sandpitnet"), nil
+	case OlympiaNetworkLocalnet:
+		return []byte("Localnet"), nil
+	}
+	return nil, fmt.Errorf("invalid OlympiaNetwork value %d", e)
+}
+
+func (e *OlympiaNetwork) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Mainnet":
+		*e = OlympiaNetworkMainnet
+	case "Stokenet":
+		*e = OlympiaNetworkStokenet
+	case "Releasenet":
+		*e = OlympiaNetworkReleasenet
+	case "RcNet":
+		*e = OlympiaNetworkRcNet
+	case "Milestonenet":
+		*e = OlympiaNetworkMilestonenet
+	case "Devopsnet":
+		*e = OlympiaNetworkDevopsnet
+	case "Sandpitnet":
+		*e = OlympiaNetworkSandpitnet
+	case "Localnet":
+		*e = OlympiaNetworkLocalnet
+	default:
+		return fmt.Errorf("invalid OlympiaNetwork variant %q", text)
+	}
+	return nil
+}
+
+func (e Operation) MarshalText() ([]byte, error) {
+	switch e {
+	case OperationAdded:
+		return []byte("Added"), nil
+	case OperationRemoved:
+		return []byte("Removed"), nil
+	}
+	return nil, fmt.Errorf("invalid Operation value %d", e)
+}
+
+func (e *Operation) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Added":
+		*e = OperationAdded
+	case "Removed":
+		*e = OperationRemoved
+	default:
+		return fmt.Errorf("invalid Operation variant %q", text)
+	}
+	return nil
+}
+
+func (e Proposer) MarshalText() ([]byte, error) {
+	switch e {
+	case ProposerPrimary:
+		return []byte("Primary"), nil
+	case ProposerRecovery:
+		return []byte("Recovery"), nil
+	}
+	return nil, fmt.Errorf("invalid Proposer value %d", e)
+}
+
+func (e *Proposer) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Primary":
+		*e = ProposerPrimary
+	case "Recovery":
+		*e = ProposerRecovery
+	default:
+		return fmt.Errorf("invalid Proposer variant %q", text)
+	}
+	return nil
+}
+
+func (e ResourcePreference) MarshalText() ([]byte, error) {
+	switch e {
+	case ResourcePreferenceAllowed:
+		return []byte("Allowed"), nil
+	case ResourcePreferenceDisallowed:
+		return []byte("Disallowed"), nil
+	}
+	return nil, fmt.Errorf("invalid ResourcePreference value %d", e)
+}
+
+func (e *ResourcePreference) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Allowed":
+		*e = ResourcePreferenceAllowed
+	case "Disallowed":
+		*e = ResourcePreferenceDisallowed
+	default:
+		return fmt.Errorf("invalid ResourcePreference variant %q", text)
+	}
+	return nil
+}
+
+func (e Role) MarshalText() ([]byte, error) {
+	switch e {
+	case RolePrimary:
+		return []byte("Primary"), nil
+	case RoleRecovery:
+		return []byte("Recovery"), nil
+	case RoleConfirmation:
+		return []byte("Confirmation"), nil
+	}
+	return nil, fmt.Errorf("invalid Role value %d", e)
+}
+
+func (e *Role) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Primary":
+		*e = RolePrimary
+	case "Recovery":
+		*e = RoleRecovery
+	case "Confirmation":
+		*e = RoleConfirmation
+	default:
+		return fmt.Errorf("invalid Role variant %q", text)
+	}
+	return nil
+}
+
+func (e RoundingMode) MarshalText() ([]byte, error) {
+	switch e {
+	case RoundingModeToPositiveInfinity:
+		return []byte("ToPositiveInfinity"), nil
+	case RoundingModeToNegativeInfinity:
+		return []byte("ToNegativeInfinity"), nil
+	case RoundingModeToZero:
+		return []byte("ToZero"), nil
+	case RoundingModeAwayFromZero:
+		return []byte("AwayFromZero"), nil
+	case RoundingModeToNearestMidpointTowardZero:
+		return []byte("ToNearestMidpointTowardZero"), nil
+	case RoundingModeToNearestMidpointAwayFromZero:
+		return []byte("ToNearestMidpointAwayFromZero"), nil
+	case RoundingModeToNearestMidpointToEven:
+		return []byte("ToNearestMidpointToEven"), nil
+	}
+	return nil, fmt.Errorf("invalid RoundingMode value %d", e)
+}
+
+func (e *RoundingMode) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "ToPositiveInfinity":
+		*e = RoundingModeToPositiveInfinity
+	case "ToNegativeInfinity":
+		*e = RoundingModeToNegativeInfinity
+	case "ToZero":
+		*e = RoundingModeToZero
+	case "AwayFromZero":
+		*e = RoundingModeAwayFromZero
+	case "ToNearestMidpointTowardZero":
+		*e = RoundingModeToNearestMidpointTowardZero
+	case "ToNearestMidpointAwayFromZero":
+		*e = RoundingModeToNearestMidpointAwayFromZero
+	case "ToNearestMidpointToEven":
+		*e = RoundingModeToNearestMidpointToEven
+	default:
+		return fmt.Errorf("invalid RoundingMode variant %q", text)
+	}
+	return nil
+}
+
+func (e SerializationMode) MarshalText() ([]byte, error) {
+	switch e {
+	case SerializationModeProgrammatic:
+		return []byte("Programmatic"), nil
+	case SerializationModeNatural:
+		return []byte("Natural"), nil
+	}
+	return nil, fmt.Errorf("invalid SerializationMode value %d", e)
+}
+
+func (e *SerializationMode) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Programmatic":
+		*e = SerializationModeProgrammatic
+	case "Natural":
+		*e = SerializationModeNatural
+	default:
+		return fmt.Errorf("invalid SerializationMode variant %q", text)
+	}
+	return nil
+}
+
+func (r AccessRulesAddresses) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccessRulesAddresses) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountAddAuthorizedDepositorEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountAddAuthorizedDepositorEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountDynamicResourceMovementsOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountDynamicResourceMovementsOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountInteractionsOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountInteractionsOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountRemoveAuthorizedDepositorEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountRemoveAuthorizedDepositorEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountRemoveResourcePreferenceEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountRemoveResourcePreferenceEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountSetDefaultDepositRuleEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountSetDefaultDepositRuleEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountSetResourcePreferenceEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountSetResourcePreferenceEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountSettingsUpdateOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountSettingsUpdateOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r AccountStaticResourceMovementsOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *AccountStaticResourceMovementsOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r BadgeWithdrawEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *BadgeWithdrawEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r BuildInformation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *BuildInformation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r BurnFungibleResourceEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *BurnFungibleResourceEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r BurnNonFungibleResourceEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *BurnNonFungibleResourceEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r CancelBadgeWithdrawAttemptEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *CancelBadgeWithdrawAttemptEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r CancelRecoveryProposalEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *CancelRecoveryProposalEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ClaimEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ClaimEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ClaimXrdEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ClaimXrdEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ComponentAddresses) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ComponentAddresses) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r DepositRecoveryXrdEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *DepositRecoveryXrdEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r DynamicAnalysis) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *DynamicAnalysis) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r Ed25519PublicKey) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *Ed25519PublicKey) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r EncounteredEntitiesOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *EncounteredEntitiesOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r EncryptedMessageV1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *EncryptedMessageV1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r EncryptedMessageV2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *EncryptedMessageV2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r EntitiesRequiringAuthOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *EntitiesRequiringAuthOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r EpochChangeEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *EpochChangeEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r EventTypeIdentifier) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *EventTypeIdentifier) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r FeeLocks) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *FeeLocks) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r FeeSummary) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *FeeSummary) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r FungibleResourceRoles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *FungibleResourceRoles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r FungibleVaultDepositEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *FungibleVaultDepositEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r FungibleVaultLockFeeEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *FungibleVaultLockFeeEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r FungibleVaultPayFeeEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *FungibleVaultPayFeeEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r FungibleVaultRecallEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *FungibleVaultRecallEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r FungibleVaultWithdrawEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *FungibleVaultWithdrawEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r GeneralResourceConstraint) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *GeneralResourceConstraint) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r InitiateBadgeWithdrawAttemptEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *InitiateBadgeWithdrawAttemptEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r InitiateRecoveryEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *InitiateRecoveryEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r InstructionIndex) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *InstructionIndex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r IntentHeaderV2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *IntentHeaderV2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r KnownAddresses) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *KnownAddresses) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r LockOwnerRoleEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *LockOwnerRoleEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r LockPrimaryRoleEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *LockPrimaryRoleEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r LockRoleEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *LockRoleEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestAddressReservation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestAddressReservation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestBlobRef) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestBlobRef) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestBucket) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestBucket) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestBuilderAddressReservation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestBuilderAddressReservation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestBuilderBucket) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestBuilderBucket) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestBuilderIntent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestBuilderIntent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestBuilderMapEntry) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestBuilderMapEntry) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestBuilderNamedAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestBuilderNamedAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestBuilderProof) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestBuilderProof) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ManifestProof) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ManifestProof) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MapEntry) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MapEntry) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MetadataInitEntry) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MetadataInitEntry) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MetadataModuleConfig) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MetadataModuleConfig) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MintFungibleResourceEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MintFungibleResourceEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MintNonFungibleResourceEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MintNonFungibleResourceEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MultiResourcePoolContributionEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MultiResourcePoolContributionEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MultiResourcePoolDepositEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MultiResourcePoolDepositEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MultiResourcePoolRedemptionEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MultiResourcePoolRedemptionEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r MultiResourcePoolWithdrawEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *MultiResourcePoolWithdrawEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r NewEntitiesOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *NewEntitiesOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r NonFungibleVaultDepositEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *NonFungibleVaultDepositEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r NonFungibleVaultRecallEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *NonFungibleVaultRecallEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r NonFungibleVaultWithdrawEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *NonFungibleVaultWithdrawEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r OneResourcePoolContributionEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *OneResourcePoolContributionEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r OneResourcePoolDepositEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *OneResourcePoolDepositEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r OneResourcePoolRedemptionEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *OneResourcePoolRedemptionEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r OneResourcePoolWithdrawEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *OneResourcePoolWithdrawEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r PackageAddresses) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *PackageAddresses) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r PlainTextMessageV1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *PlainTextMessageV1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r PlainTextMessageV2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *PlainTextMessageV2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r PoolContributionOperation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *PoolContributionOperation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r PoolContributionOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *PoolContributionOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r PoolRedemptionOperation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *PoolRedemptionOperation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r PoolRedemptionOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *PoolRedemptionOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r PresentedProofsOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *PresentedProofsOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ProtocolUpdateReadinessSignalEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ProtocolUpdateReadinessSignalEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r RecoverEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *RecoverEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r RecoveryProposal) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *RecoveryProposal) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r RegisterValidatorEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *RegisterValidatorEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r RemoveMetadataEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *RemoveMetadataEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ReservedInstructionsOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ReservedInstructionsOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ResourceAddresses) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ResourceAddresses) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ResourceManagerRole) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ResourceManagerRole) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r RoundChangeEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *RoundChangeEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r RuleSet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *RuleSet) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r RuleSetUpdateEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *RuleSetUpdateEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r Schema) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *Schema) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r Secp256k1PublicKey) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *Secp256k1PublicKey) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r SecurityStructureRole) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *SecurityStructureRole) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r SetAndLockRoleEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *SetAndLockRoleEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r SetMetadataEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *SetMetadataEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r SetOwnerRoleEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *SetOwnerRoleEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r SetRoleEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *SetRoleEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r StakeEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *StakeEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r StaticAnalysis) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *StaticAnalysis) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r StopTimedRecoveryEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *StopTimedRecoveryEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r StoreEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *StoreEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r TransactionHeaderV1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *TransactionHeaderV1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r TransactionHeaderV2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *TransactionHeaderV2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r TwoResourcePoolContributionEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *TwoResourcePoolContributionEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r TwoResourcePoolDepositEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *TwoResourcePoolDepositEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r TwoResourcePoolRedemptionEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *TwoResourcePoolRedemptionEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r TwoResourcePoolWithdrawEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *TwoResourcePoolWithdrawEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r UnlockPrimaryRoleEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *UnlockPrimaryRoleEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r UnregisterValidatorEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *UnregisterValidatorEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r UnstakeEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *UnstakeEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r UpdateAcceptingStakeDelegationStateEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *UpdateAcceptingStakeDelegationStateEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorClaimOperation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorClaimOperation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorClaimingXrdOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorClaimingXrdOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorEmissionAppliedEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorEmissionAppliedEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorInfo) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorInfo) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorRewardAppliedEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorRewardAppliedEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorStakeOperation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorStakeOperation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorStakingOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorStakingOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorUnstakeOperation) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorUnstakeOperation) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r ValidatorUnstakingOutput) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *ValidatorUnstakingOutput) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r VaultCreationEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *VaultCreationEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+func (r WithdrawRecoveryXrdEvent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(r)
+}
+
+func (r *WithdrawRecoveryXrdEvent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, r)
+}
+
+// AccountDepositFromJSON decodes a AccountDeposit from its JSON encoding.
+func AccountDepositFromJSON(data []byte) (AccountDeposit, error) {
+	var value AccountDeposit
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e AccountDepositKnownFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountDepositKnownFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e AccountDepositKnownNonFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountDepositKnownNonFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e AccountDepositUnknown) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountDepositUnknown) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// AccountDepositEventFromJSON decodes a AccountDepositEvent from its JSON encoding.
+func AccountDepositEventFromJSON(data []byte) (AccountDepositEvent, error) {
+	var value AccountDepositEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e AccountDepositEventFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountDepositEventFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e AccountDepositEventNonFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountDepositEventNonFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// AccountRejectedDepositEventFromJSON decodes a AccountRejectedDepositEvent from its JSON encoding.
+func AccountRejectedDepositEventFromJSON(data []byte) (AccountRejectedDepositEvent, error) {
+	var value AccountRejectedDepositEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e AccountRejectedDepositEventFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountRejectedDepositEventFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e AccountRejectedDepositEventNonFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountRejectedDepositEventNonFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// AccountWithdrawFromJSON decodes a AccountWithdraw from its JSON encoding.
+func AccountWithdrawFromJSON(data []byte) (AccountWithdraw, error) {
+	var value AccountWithdraw
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e AccountWithdrawAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountWithdrawAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e AccountWithdrawIds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountWithdrawIds) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// AccountWithdrawEventFromJSON decodes a AccountWithdrawEvent from its JSON encoding.
+func AccountWithdrawEventFromJSON(data []byte) (AccountWithdrawEvent, error) {
+	var value AccountWithdrawEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e AccountWithdrawEventFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountWithdrawEventFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e AccountWithdrawEventNonFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AccountWithdrawEventNonFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// AllowedIdsFromJSON decodes a AllowedIds from its JSON encoding.
+func AllowedIdsFromJSON(data []byte) (AllowedIds, error) {
+	var value AllowedIds
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e AllowedIdsAllowlist) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AllowedIdsAllowlist) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e AllowedIdsAny) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *AllowedIdsAny) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// DecryptorsByCurveV1FromJSON decodes a DecryptorsByCurveV1 from its JSON encoding.
+func DecryptorsByCurveV1FromJSON(data []byte) (DecryptorsByCurveV1, error) {
+	var value DecryptorsByCurveV1
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e DecryptorsByCurveV1Ed25519) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DecryptorsByCurveV1Ed25519) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DecryptorsByCurveV1Secp256k1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DecryptorsByCurveV1Secp256k1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// DecryptorsByCurveV2FromJSON decodes a DecryptorsByCurveV2 from its JSON encoding.
+func DecryptorsByCurveV2FromJSON(data []byte) (DecryptorsByCurveV2, error) {
+	var value DecryptorsByCurveV2
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e DecryptorsByCurveV2Ed25519) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DecryptorsByCurveV2Ed25519) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DecryptorsByCurveV2Secp256k1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DecryptorsByCurveV2Secp256k1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// DependencyInformationFromJSON decodes a DependencyInformation from its JSON encoding.
+func DependencyInformationFromJSON(data []byte) (DependencyInformation, error) {
+	var value DependencyInformation
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e DependencyInformationVersion) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DependencyInformationVersion) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DependencyInformationTag) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DependencyInformationTag) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DependencyInformationBranch) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DependencyInformationBranch) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DependencyInformationRev) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DependencyInformationRev) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// DepositResourceEventFromJSON decodes a DepositResourceEvent from its JSON encoding.
+func DepositResourceEventFromJSON(data []byte) (DepositResourceEvent, error) {
+	var value DepositResourceEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e DepositResourceEventAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DepositResourceEventAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DepositResourceEventIds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DepositResourceEventIds) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// DetailedManifestClassificationFromJSON decodes a DetailedManifestClassification from its JSON encoding.
+func DetailedManifestClassificationFromJSON(data []byte) (DetailedManifestClassification, error) {
+	var value DetailedManifestClassification
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e DetailedManifestClassificationGeneral) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationGeneral) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DetailedManifestClassificationGeneralSubintent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationGeneralSubintent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DetailedManifestClassificationTransfer) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationTransfer) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DetailedManifestClassificationValidatorStake) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationValidatorStake) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DetailedManifestClassificationValidatorUnstake) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationValidatorUnstake) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DetailedManifestClassificationValidatorClaimXrd) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationValidatorClaimXrd) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DetailedManifestClassificationPoolContribution) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationPoolContribution) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DetailedManifestClassificationPoolRedemption) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationPoolRedemption) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e DetailedManifestClassificationAccountDepositSettingsUpdate) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *DetailedManifestClassificationAccountDepositSettingsUpdate) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// EitherGuaranteedOrPredictedDecimalFromJSON decodes a EitherGuaranteedOrPredictedDecimal from its JSON encoding.
+func EitherGuaranteedOrPredictedDecimalFromJSON(data []byte) (EitherGuaranteedOrPredictedDecimal, error) {
+	var value EitherGuaranteedOrPredictedDecimal
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e EitherGuaranteedOrPredictedDecimalGuaranteed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *EitherGuaranteedOrPredictedDecimalGuaranteed) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e EitherGuaranteedOrPredictedDecimalPredicted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *EitherGuaranteedOrPredictedDecimalPredicted) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// EitherGuaranteedOrPredictedNonFungibleIdsFromJSON decodes a EitherGuaranteedOrPredictedNonFungibleIds from its JSON encoding.
+func EitherGuaranteedOrPredictedNonFungibleIdsFromJSON(data []byte) (EitherGuaranteedOrPredictedNonFungibleIds, error) {
+	var value EitherGuaranteedOrPredictedNonFungibleIds
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e EitherGuaranteedOrPredictedNonFungibleIdsGuaranteed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *EitherGuaranteedOrPredictedNonFungibleIdsGuaranteed) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e EitherGuaranteedOrPredictedNonFungibleIdsPredicted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *EitherGuaranteedOrPredictedNonFungibleIdsPredicted) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// EmitterFromJSON decodes a Emitter from its JSON encoding.
+func EmitterFromJSON(data []byte) (Emitter, error) {
+	var value Emitter
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e EmitterFunction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *EmitterFunction) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e EmitterMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *EmitterMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// InstructionV1FromJSON decodes a InstructionV1 from its JSON encoding.
+func InstructionV1FromJSON(data []byte) (InstructionV1, error) {
+	var value InstructionV1
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e InstructionV1TakeAllFromWorktop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1TakeAllFromWorktop) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1TakeFromWorktop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1TakeFromWorktop) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1TakeNonFungiblesFromWorktop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1TakeNonFungiblesFromWorktop) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1ReturnToWorktop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1ReturnToWorktop) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1AssertWorktopContains) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1AssertWorktopContains) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1AssertWorktopContainsAny) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1AssertWorktopContainsAny) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1AssertWorktopContainsNonFungibles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1AssertWorktopContainsNonFungibles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1PopFromAuthZone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1PopFromAuthZone) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1PushToAuthZone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1PushToAuthZone) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CreateProofFromAuthZoneOfAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CreateProofFromAuthZoneOfAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CreateProofFromAuthZoneOfNonFungibles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CreateProofFromAuthZoneOfNonFungibles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CreateProofFromAuthZoneOfAll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CreateProofFromAuthZoneOfAll) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1DropAllProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1DropAllProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1DropNamedProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1DropNamedProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1DropAuthZoneProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1DropAuthZoneProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1DropAuthZoneRegularProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1DropAuthZoneRegularProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1DropAuthZoneSignatureProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1DropAuthZoneSignatureProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CreateProofFromBucketOfAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CreateProofFromBucketOfAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CreateProofFromBucketOfNonFungibles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CreateProofFromBucketOfNonFungibles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CreateProofFromBucketOfAll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CreateProofFromBucketOfAll) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1BurnResource) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1BurnResource) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CloneProof) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CloneProof) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1DropProof) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1DropProof) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CallFunction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CallFunction) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CallMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CallMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CallRoyaltyMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CallRoyaltyMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CallMetadataMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CallMetadataMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CallRoleAssignmentMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CallRoleAssignmentMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1CallDirectVaultMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1CallDirectVaultMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV1AllocateGlobalAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV1AllocateGlobalAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// InstructionV2FromJSON decodes a InstructionV2 from its JSON encoding.
+func InstructionV2FromJSON(data []byte) (InstructionV2, error) {
+	var value InstructionV2
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e InstructionV2TakeAllFromWorktop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2TakeAllFromWorktop) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2TakeFromWorktop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2TakeFromWorktop) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2TakeNonFungiblesFromWorktop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2TakeNonFungiblesFromWorktop) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2ReturnToWorktop) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2ReturnToWorktop) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AssertWorktopContains) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AssertWorktopContains) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AssertWorktopContainsAny) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AssertWorktopContainsAny) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AssertWorktopContainsNonFungibles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AssertWorktopContainsNonFungibles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2PopFromAuthZone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2PopFromAuthZone) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2PushToAuthZone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2PushToAuthZone) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CreateProofFromAuthZoneOfAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CreateProofFromAuthZoneOfAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CreateProofFromAuthZoneOfNonFungibles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CreateProofFromAuthZoneOfNonFungibles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CreateProofFromAuthZoneOfAll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CreateProofFromAuthZoneOfAll) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2DropAllProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2DropAllProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2DropNamedProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2DropNamedProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2DropAuthZoneProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2DropAuthZoneProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2DropAuthZoneRegularProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2DropAuthZoneRegularProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2DropAuthZoneSignatureProofs) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2DropAuthZoneSignatureProofs) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CreateProofFromBucketOfAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CreateProofFromBucketOfAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CreateProofFromBucketOfNonFungibles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CreateProofFromBucketOfNonFungibles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CreateProofFromBucketOfAll) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CreateProofFromBucketOfAll) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2BurnResource) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2BurnResource) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CloneProof) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CloneProof) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2DropProof) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2DropProof) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CallFunction) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CallFunction) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CallMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CallMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CallRoyaltyMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CallRoyaltyMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CallMetadataMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CallMetadataMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CallRoleAssignmentMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CallRoleAssignmentMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2CallDirectVaultMethod) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2CallDirectVaultMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AllocateGlobalAddress) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AllocateGlobalAddress) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2YieldToParent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2YieldToParent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2YieldToChild) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2YieldToChild) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2VerifyParent) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2VerifyParent) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AssertWorktopResourcesOnly) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AssertWorktopResourcesOnly) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AssertWorktopResourcesInclude) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AssertWorktopResourcesInclude) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AssertNextCallReturnsOnly) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AssertNextCallReturnsOnly) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AssertNextCallReturnsInclude) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AssertNextCallReturnsInclude) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InstructionV2AssertBucketContents) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InstructionV2AssertBucketContents) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// InvocationIoItemFromJSON decodes a InvocationIoItem from its JSON encoding.
+func InvocationIoItemFromJSON(data []byte) (InvocationIoItem, error) {
+	var value InvocationIoItem
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e InvocationIoItemFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InvocationIoItemFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e InvocationIoItemNonFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *InvocationIoItemNonFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// LocalTypeIdFromJSON decodes a LocalTypeId from its JSON encoding.
+func LocalTypeIdFromJSON(data []byte) (LocalTypeId, error) {
+	var value LocalTypeId
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e LocalTypeIdWellKnown) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *LocalTypeIdWellKnown) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e LocalTypeIdSchemaLocalIndex) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *LocalTypeIdSchemaLocalIndex) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// LowerBoundFromJSON decodes a LowerBound from its JSON encoding.
+func LowerBoundFromJSON(data []byte) (LowerBound, error) {
+	var value LowerBound
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e LowerBoundNonZero) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *LowerBoundNonZero) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e LowerBoundInclusive) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *LowerBoundInclusive) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ManifestAddressFromJSON decodes a ManifestAddress from its JSON encoding.
+func ManifestAddressFromJSON(data []byte) (ManifestAddress, error) {
+	var value ManifestAddress
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ManifestAddressNamed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestAddressNamed) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestAddressStatic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestAddressStatic) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ManifestBuilderAddressFromJSON decodes a ManifestBuilderAddress from its JSON encoding.
+func ManifestBuilderAddressFromJSON(data []byte) (ManifestBuilderAddress, error) {
+	var value ManifestBuilderAddress
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ManifestBuilderAddressNamed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderAddressNamed) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderAddressStatic) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderAddressStatic) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ManifestBuilderValueFromJSON decodes a ManifestBuilderValue from its JSON encoding.
+func ManifestBuilderValueFromJSON(data []byte) (ManifestBuilderValue, error) {
+	var value ManifestBuilderValue
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ManifestBuilderValueBoolValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueBoolValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueI8Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueI8Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueI16Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueI16Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueI32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueI32Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueI64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueI64Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueI128Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueI128Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueU8Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueU8Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueU16Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueU16Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueU32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueU32Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueU64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueU64Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueU128Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueU128Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueStringValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueStringValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueEnumValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueEnumValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueTupleValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueTupleValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueMapValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueMapValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueAddressValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueAddressValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueBucketValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueBucketValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueProofValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueProofValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueExpressionValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueExpressionValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueBlobValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueBlobValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueDecimalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueDecimalValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValuePreciseDecimalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValuePreciseDecimalValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueNonFungibleLocalIdValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueNonFungibleLocalIdValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestBuilderValueAddressReservationValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestBuilderValueAddressReservationValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ManifestResourceConstraintFromJSON decodes a ManifestResourceConstraint from its JSON encoding.
+func ManifestResourceConstraintFromJSON(data []byte) (ManifestResourceConstraint, error) {
+	var value ManifestResourceConstraint
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ManifestResourceConstraintNonZeroAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestResourceConstraintNonZeroAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestResourceConstraintExactAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestResourceConstraintExactAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestResourceConstraintAtLeastAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestResourceConstraintAtLeastAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestResourceConstraintExactNonFungibles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestResourceConstraintExactNonFungibles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestResourceConstraintAtLeastNonFungibles) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestResourceConstraintAtLeastNonFungibles) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestResourceConstraintGeneral) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestResourceConstraintGeneral) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ManifestSborStringRepresentationFromJSON decodes a ManifestSborStringRepresentation from its JSON encoding.
+func ManifestSborStringRepresentationFromJSON(data []byte) (ManifestSborStringRepresentation, error) {
+	var value ManifestSborStringRepresentation
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ManifestSborStringRepresentationManifestString) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestSborStringRepresentationManifestString) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestSborStringRepresentationJson) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestSborStringRepresentationJson) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ManifestValueFromJSON decodes a ManifestValue from its JSON encoding.
+func ManifestValueFromJSON(data []byte) (ManifestValue, error) {
+	var value ManifestValue
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ManifestValueBoolValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueBoolValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueI8Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueI8Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueI16Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueI16Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueI32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueI32Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueI64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueI64Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueI128Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueI128Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueU8Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueU8Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueU16Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueU16Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueU32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueU32Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueU64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueU64Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueU128Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueU128Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueStringValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueStringValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueEnumValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueEnumValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueTupleValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueTupleValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueMapValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueMapValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueAddressValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueAddressValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueBucketValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueBucketValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueProofValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueProofValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueExpressionValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueExpressionValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueBlobValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueBlobValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueDecimalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueDecimalValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValuePreciseDecimalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValuePreciseDecimalValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueNonFungibleLocalIdValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueNonFungibleLocalIdValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ManifestValueAddressReservationValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ManifestValueAddressReservationValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// MessageContentV1FromJSON decodes a MessageContentV1 from its JSON encoding.
+func MessageContentV1FromJSON(data []byte) (MessageContentV1, error) {
+	var value MessageContentV1
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e MessageContentV1Str) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageContentV1Str) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MessageContentV1Bytes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageContentV1Bytes) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// MessageContentsV2FromJSON decodes a MessageContentsV2 from its JSON encoding.
+func MessageContentsV2FromJSON(data []byte) (MessageContentsV2, error) {
+	var value MessageContentsV2
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e MessageContentsV2Str) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageContentsV2Str) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MessageContentsV2Bytes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageContentsV2Bytes) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// MessageV1FromJSON decodes a MessageV1 from its JSON encoding.
+func MessageV1FromJSON(data []byte) (MessageV1, error) {
+	var value MessageV1
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e MessageV1None) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageV1None) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MessageV1PlainText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageV1PlainText) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MessageV1Encrypted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageV1Encrypted) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// MessageV2FromJSON decodes a MessageV2 from its JSON encoding.
+func MessageV2FromJSON(data []byte) (MessageV2, error) {
+	var value MessageV2
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e MessageV2None) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageV2None) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MessageV2PlainText) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageV2PlainText) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MessageV2Encrypted) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MessageV2Encrypted) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// MetadataValueFromJSON decodes a MetadataValue from its JSON encoding.
+func MetadataValueFromJSON(data []byte) (MetadataValue, error) {
+	var value MetadataValue
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e MetadataValueStringValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueStringValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueBoolValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueBoolValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueU8Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueU8Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueU32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueU32Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueU64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueU64Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueI32Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueI32Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueI64Value) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueI64Value) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueDecimalValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueDecimalValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueGlobalAddressValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueGlobalAddressValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValuePublicKeyValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValuePublicKeyValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueNonFungibleGlobalIdValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueNonFungibleGlobalIdValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueNonFungibleLocalIdValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueNonFungibleLocalIdValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueInstantValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueInstantValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueUrlValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueUrlValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueOriginValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueOriginValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValuePublicKeyHashValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValuePublicKeyHashValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueStringArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueStringArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueBoolArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueBoolArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueU8ArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueU8ArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueU32ArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueU32ArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueU64ArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueU64ArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueI32ArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueI32ArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueI64ArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueI64ArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueDecimalArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueDecimalArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueGlobalAddressArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueGlobalAddressArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValuePublicKeyArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValuePublicKeyArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueNonFungibleGlobalIdArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueNonFungibleGlobalIdArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueNonFungibleLocalIdArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueNonFungibleLocalIdArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueInstantArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueInstantArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueUrlArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueUrlArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValueOriginArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValueOriginArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e MetadataValuePublicKeyHashArrayValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *MetadataValuePublicKeyHashArrayValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// NameRecordErrorFromJSON decodes a NameRecordError from its JSON encoding.
+func NameRecordErrorFromJSON(data []byte) (NameRecordError, error) {
+	var value NameRecordError
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e NameRecordErrorObjectNameIsAlreadyTaken) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *NameRecordErrorObjectNameIsAlreadyTaken) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e NameRecordErrorObjectDoesNotExist) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *NameRecordErrorObjectDoesNotExist) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// NonFungibleLocalIdFromJSON decodes a NonFungibleLocalId from its JSON encoding.
+func NonFungibleLocalIdFromJSON(data []byte) (NonFungibleLocalId, error) {
+	var value NonFungibleLocalId
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e NonFungibleLocalIdInteger) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *NonFungibleLocalIdInteger) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e NonFungibleLocalIdStr) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *NonFungibleLocalIdStr) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e NonFungibleLocalIdBytes) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *NonFungibleLocalIdBytes) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e NonFungibleLocalIdRuid) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *NonFungibleLocalIdRuid) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// OwnerRoleFromJSON decodes a OwnerRole from its JSON encoding.
+func OwnerRoleFromJSON(data []byte) (OwnerRole, error) {
+	var value OwnerRole
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e OwnerRoleNone) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *OwnerRoleNone) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e OwnerRoleFixed) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *OwnerRoleFixed) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e OwnerRoleUpdatable) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *OwnerRoleUpdatable) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// PublicKeyFromJSON decodes a PublicKey from its JSON encoding.
+func PublicKeyFromJSON(data []byte) (PublicKey, error) {
+	var value PublicKey
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e PublicKeySecp256k1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *PublicKeySecp256k1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e PublicKeyEd25519) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *PublicKeyEd25519) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// PublicKeyHashFromJSON decodes a PublicKeyHash from its JSON encoding.
+func PublicKeyHashFromJSON(data []byte) (PublicKeyHash, error) {
+	var value PublicKeyHash
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e PublicKeyHashSecp256k1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *PublicKeyHashSecp256k1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e PublicKeyHashEd25519) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *PublicKeyHashEd25519) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// RecallResourceEventFromJSON decodes a RecallResourceEvent from its JSON encoding.
+func RecallResourceEventFromJSON(data []byte) (RecallResourceEvent, error) {
+	var value RecallResourceEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e RecallResourceEventAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *RecallResourceEventAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e RecallResourceEventIds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *RecallResourceEventIds) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ResourceOrNonFungibleFromJSON decodes a ResourceOrNonFungible from its JSON encoding.
+func ResourceOrNonFungibleFromJSON(data []byte) (ResourceOrNonFungible, error) {
+	var value ResourceOrNonFungible
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ResourceOrNonFungibleNonFungible) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ResourceOrNonFungibleNonFungible) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ResourceOrNonFungibleResource) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ResourceOrNonFungibleResource) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ResourcePreferenceUpdateFromJSON decodes a ResourcePreferenceUpdate from its JSON encoding.
+func ResourcePreferenceUpdateFromJSON(data []byte) (ResourcePreferenceUpdate, error) {
+	var value ResourcePreferenceUpdate
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ResourcePreferenceUpdateSet) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ResourcePreferenceUpdateSet) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ResourcePreferenceUpdateRemove) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ResourcePreferenceUpdateRemove) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ResourceSpecifierFromJSON decodes a ResourceSpecifier from its JSON encoding.
+func ResourceSpecifierFromJSON(data []byte) (ResourceSpecifier, error) {
+	var value ResourceSpecifier
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ResourceSpecifierAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ResourceSpecifierAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e ResourceSpecifierIds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ResourceSpecifierIds) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// RoyaltyAmountFromJSON decodes a RoyaltyAmount from its JSON encoding.
+func RoyaltyAmountFromJSON(data []byte) (RoyaltyAmount, error) {
+	var value RoyaltyAmount
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e RoyaltyAmountFree) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *RoyaltyAmountFree) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e RoyaltyAmountXrd) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *RoyaltyAmountXrd) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e RoyaltyAmountUsd) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *RoyaltyAmountUsd) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// ScryptoSborStringFromJSON decodes a ScryptoSborString from its JSON encoding.
+func ScryptoSborStringFromJSON(data []byte) (ScryptoSborString, error) {
+	var value ScryptoSborString
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e ScryptoSborStringProgrammaticJson) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *ScryptoSborStringProgrammaticJson) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// SignatureV1FromJSON decodes a SignatureV1 from its JSON encoding.
+func SignatureV1FromJSON(data []byte) (SignatureV1, error) {
+	var value SignatureV1
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e SignatureV1Secp256k1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SignatureV1Secp256k1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e SignatureV1Ed25519) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SignatureV1Ed25519) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// SignatureWithPublicKeyV1FromJSON decodes a SignatureWithPublicKeyV1 from its JSON encoding.
+func SignatureWithPublicKeyV1FromJSON(data []byte) (SignatureWithPublicKeyV1, error) {
+	var value SignatureWithPublicKeyV1
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e SignatureWithPublicKeyV1Secp256k1) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SignatureWithPublicKeyV1Secp256k1) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e SignatureWithPublicKeyV1Ed25519) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SignatureWithPublicKeyV1Ed25519) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// SimpleFungibleResourceBoundsFromJSON decodes a SimpleFungibleResourceBounds from its JSON encoding.
+func SimpleFungibleResourceBoundsFromJSON(data []byte) (SimpleFungibleResourceBounds, error) {
+	var value SimpleFungibleResourceBounds
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e SimpleFungibleResourceBoundsExact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SimpleFungibleResourceBoundsExact) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e SimpleFungibleResourceBoundsAtMost) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SimpleFungibleResourceBoundsAtMost) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e SimpleFungibleResourceBoundsAtLeast) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SimpleFungibleResourceBoundsAtLeast) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e SimpleFungibleResourceBoundsBetween) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SimpleFungibleResourceBoundsBetween) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e SimpleFungibleResourceBoundsUnknownAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SimpleFungibleResourceBoundsUnknownAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// SimpleNonFungibleResourceBoundsFromJSON decodes a SimpleNonFungibleResourceBounds from its JSON encoding.
+func SimpleNonFungibleResourceBoundsFromJSON(data []byte) (SimpleNonFungibleResourceBounds, error) {
+	var value SimpleNonFungibleResourceBounds
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e SimpleNonFungibleResourceBoundsExact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SimpleNonFungibleResourceBoundsExact) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e SimpleNonFungibleResourceBoundsNotExact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *SimpleNonFungibleResourceBoundsNotExact) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedAccessControllerBlueprintEventFromJSON decodes a TypedAccessControllerBlueprintEvent from its JSON encoding.
+func TypedAccessControllerBlueprintEventFromJSON(data []byte) (TypedAccessControllerBlueprintEvent, error) {
+	var value TypedAccessControllerBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedAccessControllerBlueprintEventInitiateRecoveryEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventInitiateRecoveryEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventInitiateBadgeWithdrawAttemptEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventInitiateBadgeWithdrawAttemptEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventRuleSetUpdateEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventRuleSetUpdateEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventBadgeWithdrawEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventBadgeWithdrawEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventCancelRecoveryProposalEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventCancelRecoveryProposalEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventCancelBadgeWithdrawAttemptEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventCancelBadgeWithdrawAttemptEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventLockPrimaryRoleEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventLockPrimaryRoleEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventUnlockPrimaryRoleEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventUnlockPrimaryRoleEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventStopTimedRecoveryEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventStopTimedRecoveryEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventDepositRecoveryXrdEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventDepositRecoveryXrdEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccessControllerBlueprintEventWithdrawRecoveryXrdEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerBlueprintEventWithdrawRecoveryXrdEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedAccessControllerPackageEventFromJSON decodes a TypedAccessControllerPackageEvent from its JSON encoding.
+func TypedAccessControllerPackageEventFromJSON(data []byte) (TypedAccessControllerPackageEvent, error) {
+	var value TypedAccessControllerPackageEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedAccessControllerPackageEventAccessController) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccessControllerPackageEventAccessController) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedAccountBlueprintEventFromJSON decodes a TypedAccountBlueprintEvent from its JSON encoding.
+func TypedAccountBlueprintEventFromJSON(data []byte) (TypedAccountBlueprintEvent, error) {
+	var value TypedAccountBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedAccountBlueprintEventAccountWithdrawEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountBlueprintEventAccountWithdrawEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountBlueprintEventAccountDepositEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountBlueprintEventAccountDepositEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountBlueprintEventAccountRejectedDepositEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountBlueprintEventAccountRejectedDepositEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountBlueprintEventAccountSetResourcePreferenceEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountBlueprintEventAccountSetResourcePreferenceEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountBlueprintEventAccountRemoveResourcePreferenceEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountBlueprintEventAccountRemoveResourcePreferenceEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountBlueprintEventAccountSetDefaultDepositRuleEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountBlueprintEventAccountSetDefaultDepositRuleEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountBlueprintEventAccountAddAuthorizedDepositorEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountBlueprintEventAccountAddAuthorizedDepositorEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountBlueprintEventAccountRemoveAuthorizedDepositorEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountBlueprintEventAccountRemoveAuthorizedDepositorEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedAccountLockerBlueprintEventFromJSON decodes a TypedAccountLockerBlueprintEvent from its JSON encoding.
+func TypedAccountLockerBlueprintEventFromJSON(data []byte) (TypedAccountLockerBlueprintEvent, error) {
+	var value TypedAccountLockerBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedAccountLockerBlueprintEventStoreEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountLockerBlueprintEventStoreEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountLockerBlueprintEventRecoverEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountLockerBlueprintEventRecoverEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedAccountLockerBlueprintEventClaimEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountLockerBlueprintEventClaimEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedAccountPackageEventFromJSON decodes a TypedAccountPackageEvent from its JSON encoding.
+func TypedAccountPackageEventFromJSON(data []byte) (TypedAccountPackageEvent, error) {
+	var value TypedAccountPackageEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedAccountPackageEventAccount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedAccountPackageEventAccount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedConsensusManagerBlueprintEventFromJSON decodes a TypedConsensusManagerBlueprintEvent from its JSON encoding.
+func TypedConsensusManagerBlueprintEventFromJSON(data []byte) (TypedConsensusManagerBlueprintEvent, error) {
+	var value TypedConsensusManagerBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedConsensusManagerBlueprintEventRoundChangeEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedConsensusManagerBlueprintEventRoundChangeEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedConsensusManagerBlueprintEventEpochChangeEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedConsensusManagerBlueprintEventEpochChangeEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedConsensusManagerPackageEventFromJSON decodes a TypedConsensusManagerPackageEvent from its JSON encoding.
+func TypedConsensusManagerPackageEventFromJSON(data []byte) (TypedConsensusManagerPackageEvent, error) {
+	var value TypedConsensusManagerPackageEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedConsensusManagerPackageEventConsensusManager) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedConsensusManagerPackageEventConsensusManager) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedConsensusManagerPackageEventValidator) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedConsensusManagerPackageEventValidator) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedFungibleResourceManagerBlueprintEventFromJSON decodes a TypedFungibleResourceManagerBlueprintEvent from its JSON encoding.
+func TypedFungibleResourceManagerBlueprintEventFromJSON(data []byte) (TypedFungibleResourceManagerBlueprintEvent, error) {
+	var value TypedFungibleResourceManagerBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedFungibleResourceManagerBlueprintEventVaultCreationEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedFungibleResourceManagerBlueprintEventVaultCreationEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedFungibleResourceManagerBlueprintEventMintFungibleResourceEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedFungibleResourceManagerBlueprintEventMintFungibleResourceEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedFungibleResourceManagerBlueprintEventBurnFungibleResourceEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedFungibleResourceManagerBlueprintEventBurnFungibleResourceEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedFungibleVaultBlueprintEventFromJSON decodes a TypedFungibleVaultBlueprintEvent from its JSON encoding.
+func TypedFungibleVaultBlueprintEventFromJSON(data []byte) (TypedFungibleVaultBlueprintEvent, error) {
+	var value TypedFungibleVaultBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedFungibleVaultBlueprintEventFungibleVaultLockFeeEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedFungibleVaultBlueprintEventFungibleVaultLockFeeEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedFungibleVaultBlueprintEventFungibleVaultWithdrawEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedFungibleVaultBlueprintEventFungibleVaultWithdrawEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedFungibleVaultBlueprintEventFungibleVaultDepositEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedFungibleVaultBlueprintEventFungibleVaultDepositEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedFungibleVaultBlueprintEventFungibleVaultRecallEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedFungibleVaultBlueprintEventFungibleVaultRecallEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedFungibleVaultBlueprintEventFungibleVaultPayFeeEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedFungibleVaultBlueprintEventFungibleVaultPayFeeEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedLockerPackageEventFromJSON decodes a TypedLockerPackageEvent from its JSON encoding.
+func TypedLockerPackageEventFromJSON(data []byte) (TypedLockerPackageEvent, error) {
+	var value TypedLockerPackageEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedLockerPackageEventAccountLocker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedLockerPackageEventAccountLocker) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedMetadataBlueprintEventFromJSON decodes a TypedMetadataBlueprintEvent from its JSON encoding.
+func TypedMetadataBlueprintEventFromJSON(data []byte) (TypedMetadataBlueprintEvent, error) {
+	var value TypedMetadataBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedMetadataBlueprintEventSetMetadataEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedMetadataBlueprintEventSetMetadataEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedMetadataBlueprintEventRemoveMetadataEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedMetadataBlueprintEventRemoveMetadataEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedMetadataPackageEventFromJSON decodes a TypedMetadataPackageEvent from its JSON encoding.
+func TypedMetadataPackageEventFromJSON(data []byte) (TypedMetadataPackageEvent, error) {
+	var value TypedMetadataPackageEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedMetadataPackageEventMetadata) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedMetadataPackageEventMetadata) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedMultiResourcePoolBlueprintEventFromJSON decodes a TypedMultiResourcePoolBlueprintEvent from its JSON encoding.
+func TypedMultiResourcePoolBlueprintEventFromJSON(data []byte) (TypedMultiResourcePoolBlueprintEvent, error) {
+	var value TypedMultiResourcePoolBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedMultiResourcePoolBlueprintEventMultiResourcePoolContributionEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedMultiResourcePoolBlueprintEventMultiResourcePoolContributionEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedMultiResourcePoolBlueprintEventMultiResourcePoolRedemptionEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedMultiResourcePoolBlueprintEventMultiResourcePoolRedemptionEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedMultiResourcePoolBlueprintEventMultiResourcePoolWithdrawEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedMultiResourcePoolBlueprintEventMultiResourcePoolWithdrawEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedMultiResourcePoolBlueprintEventMultiResourcePoolDepositEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedMultiResourcePoolBlueprintEventMultiResourcePoolDepositEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedNativeEventFromJSON decodes a TypedNativeEvent from its JSON encoding.
+func TypedNativeEventFromJSON(data []byte) (TypedNativeEvent, error) {
+	var value TypedNativeEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedNativeEventAccessController) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNativeEventAccessController) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNativeEventAccount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNativeEventAccount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNativeEventConsensusManager) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNativeEventConsensusManager) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNativeEventPool) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNativeEventPool) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNativeEventResource) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNativeEventResource) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNativeEventRoleAssignment) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNativeEventRoleAssignment) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNativeEventMetadata) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNativeEventMetadata) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNativeEventLocker) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNativeEventLocker) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedNonFungibleResourceManagerBlueprintEventFromJSON decodes a TypedNonFungibleResourceManagerBlueprintEvent from its JSON encoding.
+func TypedNonFungibleResourceManagerBlueprintEventFromJSON(data []byte) (TypedNonFungibleResourceManagerBlueprintEvent, error) {
+	var value TypedNonFungibleResourceManagerBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedNonFungibleResourceManagerBlueprintEventVaultCreationEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNonFungibleResourceManagerBlueprintEventVaultCreationEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNonFungibleResourceManagerBlueprintEventMintNonFungibleResourceEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNonFungibleResourceManagerBlueprintEventMintNonFungibleResourceEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNonFungibleResourceManagerBlueprintEventBurnNonFungibleResourceEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNonFungibleResourceManagerBlueprintEventBurnNonFungibleResourceEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedNonFungibleVaultBlueprintEventFromJSON decodes a TypedNonFungibleVaultBlueprintEvent from its JSON encoding.
+func TypedNonFungibleVaultBlueprintEventFromJSON(data []byte) (TypedNonFungibleVaultBlueprintEvent, error) {
+	var value TypedNonFungibleVaultBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedNonFungibleVaultBlueprintEventNonFungibleVaultWithdrawEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNonFungibleVaultBlueprintEventNonFungibleVaultWithdrawEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNonFungibleVaultBlueprintEventNonFungibleVaultDepositEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNonFungibleVaultBlueprintEventNonFungibleVaultDepositEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedNonFungibleVaultBlueprintEventNonFungibleVaultRecallEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedNonFungibleVaultBlueprintEventNonFungibleVaultRecallEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedOneResourcePoolBlueprintEventFromJSON decodes a TypedOneResourcePoolBlueprintEvent from its JSON encoding.
+func TypedOneResourcePoolBlueprintEventFromJSON(data []byte) (TypedOneResourcePoolBlueprintEvent, error) {
+	var value TypedOneResourcePoolBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedOneResourcePoolBlueprintEventOneResourcePoolContributionEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedOneResourcePoolBlueprintEventOneResourcePoolContributionEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedOneResourcePoolBlueprintEventOneResourcePoolRedemptionEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedOneResourcePoolBlueprintEventOneResourcePoolRedemptionEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedOneResourcePoolBlueprintEventOneResourcePoolWithdrawEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedOneResourcePoolBlueprintEventOneResourcePoolWithdrawEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedOneResourcePoolBlueprintEventOneResourcePoolDepositEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedOneResourcePoolBlueprintEventOneResourcePoolDepositEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedPoolPackageEventFromJSON decodes a TypedPoolPackageEvent from its JSON encoding.
+func TypedPoolPackageEventFromJSON(data []byte) (TypedPoolPackageEvent, error) {
+	var value TypedPoolPackageEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedPoolPackageEventOneResourcePool) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedPoolPackageEventOneResourcePool) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedPoolPackageEventTwoResourcePool) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedPoolPackageEventTwoResourcePool) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedPoolPackageEventMultiResourcePool) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedPoolPackageEventMultiResourcePool) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedResourcePackageEventFromJSON decodes a TypedResourcePackageEvent from its JSON encoding.
+func TypedResourcePackageEventFromJSON(data []byte) (TypedResourcePackageEvent, error) {
+	var value TypedResourcePackageEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedResourcePackageEventFungibleVault) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedResourcePackageEventFungibleVault) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedResourcePackageEventNonFungibleVault) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedResourcePackageEventNonFungibleVault) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedResourcePackageEventFungibleResourceManager) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedResourcePackageEventFungibleResourceManager) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedResourcePackageEventNonFungibleResourceManager) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedResourcePackageEventNonFungibleResourceManager) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedRoleAssignmentBlueprintEventFromJSON decodes a TypedRoleAssignmentBlueprintEvent from its JSON encoding.
+func TypedRoleAssignmentBlueprintEventFromJSON(data []byte) (TypedRoleAssignmentBlueprintEvent, error) {
+	var value TypedRoleAssignmentBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedRoleAssignmentBlueprintEventSetRoleEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedRoleAssignmentBlueprintEventSetRoleEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedRoleAssignmentBlueprintEventSetOwnerRoleEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedRoleAssignmentBlueprintEventSetOwnerRoleEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedRoleAssignmentBlueprintEventLockOwnerRoleEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedRoleAssignmentBlueprintEventLockOwnerRoleEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedRoleAssignmentPackageEventFromJSON decodes a TypedRoleAssignmentPackageEvent from its JSON encoding.
+func TypedRoleAssignmentPackageEventFromJSON(data []byte) (TypedRoleAssignmentPackageEvent, error) {
+	var value TypedRoleAssignmentPackageEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedRoleAssignmentPackageEventRoleAssignment) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedRoleAssignmentPackageEventRoleAssignment) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedTwoResourcePoolBlueprintEventFromJSON decodes a TypedTwoResourcePoolBlueprintEvent from its JSON encoding.
+func TypedTwoResourcePoolBlueprintEventFromJSON(data []byte) (TypedTwoResourcePoolBlueprintEvent, error) {
+	var value TypedTwoResourcePoolBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedTwoResourcePoolBlueprintEventTwoResourcePoolContributionEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedTwoResourcePoolBlueprintEventTwoResourcePoolContributionEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedTwoResourcePoolBlueprintEventTwoResourcePoolRedemptionEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedTwoResourcePoolBlueprintEventTwoResourcePoolRedemptionEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedTwoResourcePoolBlueprintEventTwoResourcePoolWithdrawEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedTwoResourcePoolBlueprintEventTwoResourcePoolWithdrawEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedTwoResourcePoolBlueprintEventTwoResourcePoolDepositEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedTwoResourcePoolBlueprintEventTwoResourcePoolDepositEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// TypedValidatorBlueprintEventFromJSON decodes a TypedValidatorBlueprintEvent from its JSON encoding.
+func TypedValidatorBlueprintEventFromJSON(data []byte) (TypedValidatorBlueprintEvent, error) {
+	var value TypedValidatorBlueprintEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e TypedValidatorBlueprintEventRegisterValidatorEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventRegisterValidatorEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedValidatorBlueprintEventUnregisterValidatorEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventUnregisterValidatorEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedValidatorBlueprintEventStakeEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventStakeEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedValidatorBlueprintEventUnstakeEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventUnstakeEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedValidatorBlueprintEventClaimXrdEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventClaimXrdEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedValidatorBlueprintEventUpdateAcceptingStakeDelegationStateEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventUpdateAcceptingStakeDelegationStateEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedValidatorBlueprintEventProtocolUpdateReadinessSignalEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventProtocolUpdateReadinessSignalEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedValidatorBlueprintEventValidatorEmissionAppliedEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventValidatorEmissionAppliedEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e TypedValidatorBlueprintEventValidatorRewardAppliedEventValue) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *TypedValidatorBlueprintEventValidatorRewardAppliedEventValue) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// UpperBoundFromJSON decodes a UpperBound from its JSON encoding.
+func UpperBoundFromJSON(data []byte) (UpperBound, error) {
+	var value UpperBound
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e UpperBoundInclusive) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *UpperBoundInclusive) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e UpperBoundUnbounded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *UpperBoundUnbounded) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// WithdrawResourceEventFromJSON decodes a WithdrawResourceEvent from its JSON encoding.
+func WithdrawResourceEventFromJSON(data []byte) (WithdrawResourceEvent, error) {
+	var value WithdrawResourceEvent
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e WithdrawResourceEventAmount) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *WithdrawResourceEventAmount) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e WithdrawResourceEventIds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *WithdrawResourceEventIds) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+// WithdrawStrategyFromJSON decodes a WithdrawStrategy from its JSON encoding.
+func WithdrawStrategyFromJSON(data []byte) (WithdrawStrategy, error) {
+	var value WithdrawStrategy
+	err := unmarshalJSON(data, &value)
+	return value, err
+}
+
+func (e WithdrawStrategyExact) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *WithdrawStrategyExact) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+func (e WithdrawStrategyRounded) MarshalJSON() ([]byte, error) {
+	return marshalJSON(e)
+}
+
+func (e *WithdrawStrategyRounded) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, e)
+}
+
+var jsonUnions = map[reflect.Type][]jsonVariant{
+	reflect.TypeFor[AccountDeposit](): {
+		{"KnownFungible", reflect.TypeFor[AccountDepositKnownFungible]()},
+		{"KnownNonFungible", reflect.TypeFor[AccountDepositKnownNonFungible]()},
+		{"Unknown", reflect.TypeFor[AccountDepositUnknown]()},
+	},
+	reflect.TypeFor[AccountDepositEvent](): {
+		{"Fungible", reflect.TypeFor[AccountDepositEventFungible]()},
+		{"NonFungible", reflect.TypeFor[AccountDepositEventNonFungible]()},
+	},
+	reflect.TypeFor[AccountRejectedDepositEvent](): {
+		{"Fungible", reflect.TypeFor[AccountRejectedDepositEventFungible]()},
+		{"NonFungible", reflect.TypeFor[AccountRejectedDepositEventNonFungible]()},
+	},
+	reflect.TypeFor[AccountWithdraw](): {
+		{"Amount", reflect.TypeFor[AccountWithdrawAmount]()},
+		{"Ids", reflect.TypeFor[AccountWithdrawIds]()},
+	},
+	reflect.TypeFor[AccountWithdrawEvent](): {
+		{"Fungible", reflect.TypeFor[AccountWithdrawEventFungible]()},
+		{"NonFungible", reflect.TypeFor[AccountWithdrawEventNonFungible]()},
+	},
+	reflect.TypeFor[AllowedIds](): {
+		{"Allowlist", reflect.TypeFor[AllowedIdsAllowlist]()},
+		{"Any", reflect.TypeFor[AllowedIdsAny]()},
+	},
+	reflect.TypeFor[DecryptorsByCurveV1](): {
+		{"Ed25519", reflect.TypeFor[DecryptorsByCurveV1Ed25519]()},
+		{"Secp256k1", reflect.TypeFor[DecryptorsByCurveV1Secp256k1]()},
+	},
+	reflect.TypeFor[DecryptorsByCurveV2](): {
+		{"Ed25519", reflect.TypeFor[DecryptorsByCurveV2Ed25519]()},
+		{"Secp256k1", reflect.TypeFor[DecryptorsByCurveV2Secp256k1]()},
+	},
+	reflect.TypeFor[DependencyInformation](): {
+		{"Version", reflect.TypeFor[DependencyInformationVersion]()},
+		{"Tag", reflect.TypeFor[DependencyInformationTag]()},
+		{"Branch", reflect.TypeFor[DependencyInformationBranch]()},
+		{"Rev", reflect.TypeFor[DependencyInformationRev]()},
+	},
+	reflect.TypeFor[DepositResourceEvent](): {
+		{"Amount", reflect.TypeFor[DepositResourceEventAmount]()},
+		{"Ids", reflect.TypeFor[DepositResourceEventIds]()},
+	},
+	reflect.TypeFor[DetailedManifestClassification](): {
+		{"General", reflect.TypeFor[DetailedManifestClassificationGeneral]()},
+		{"GeneralSubintent", reflect.TypeFor[DetailedManifestClassificationGeneralSubintent]()},
+		{"Transfer", reflect.TypeFor[DetailedManifestClassificationTransfer]()},
+		{"ValidatorStake", reflect.TypeFor[DetailedManifestClassificationValidatorStake]()},
+		{"ValidatorUnstake", reflect.TypeFor[DetailedManifestClassificationValidatorUnstake]()},
+		{"ValidatorClaimXrd", reflect.TypeFor[DetailedManifestClassificationValidatorClaimXrd]()},
+		{"PoolContribution", reflect.TypeFor[DetailedManifestClassificationPoolContribution]()},
+		{"PoolRedemption", reflect.TypeFor[DetailedManifestClassificationPoolRedemption]()},
+		{"AccountDepositSettingsUpdate", reflect.TypeFor[DetailedManifestClassificationAccountDepositSettingsUpdate]()},
+	},
+	reflect.TypeFor[EitherGuaranteedOrPredictedDecimal](): {
+		{"Guaranteed", reflect.TypeFor[EitherGuaranteedOrPredictedDecimalGuaranteed]()},
+		{"Predicted", reflect.TypeFor[EitherGuaranteedOrPredictedDecimalPredicted]()},
+	},
+	reflect.TypeFor[EitherGuaranteedOrPredictedNonFungibleIds](): {
+		{"Guaranteed", reflect.TypeFor[EitherGuaranteedOrPredictedNonFungibleIdsGuaranteed]()},
+		{"Predicted", reflect.TypeFor[EitherGuaranteedOrPredictedNonFungibleIdsPredicted]()},
+	},
+	reflect.TypeFor[Emitter](): {
+		{"Function", reflect.TypeFor[EmitterFunction]()},
+		{"Method", reflect.TypeFor[EmitterMethod]()},
+	},
+	reflect.TypeFor[InstructionV1](): {
+		{"TakeAllFromWorktop", reflect.TypeFor[InstructionV1TakeAllFromWorktop]()},
+		{"TakeFromWorktop", reflect.TypeFor[InstructionV1TakeFromWorktop]()},
+		{"TakeNonFungiblesFromWorktop", reflect.TypeFor[InstructionV1TakeNonFungiblesFromWorktop]()},
+		{"ReturnToWorktop", reflect.TypeFor[InstructionV1ReturnToWorktop]()},
+		{"AssertWorktopContains", reflect.TypeFor[InstructionV1AssertWorktopContains]()},
+		{"AssertWorktopContainsAny", reflect.TypeFor[InstructionV1AssertWorktopContainsAny]()},
+		{"AssertWorktopContainsNonFungibles", reflect.TypeFor[InstructionV1AssertWorktopContainsNonFungibles]()},
+		{"PopFromAuthZone", reflect.TypeFor[InstructionV1PopFromAuthZone]()},
+		{"PushToAuthZone", reflect.TypeFor[InstructionV1PushToAuthZone]()},
+		{"CreateProofFromAuthZoneOfAmount", reflect.TypeFor[InstructionV1CreateProofFromAuthZoneOfAmount]()},
+		{"CreateProofFromAuthZoneOfNonFungibles", reflect.TypeFor[InstructionV1CreateProofFromAuthZoneOfNonFungibles]()},
+		{"CreateProofFromAuthZoneOfAll", reflect.TypeFor[InstructionV1CreateProofFromAuthZoneOfAll]()},
+		{"DropAllProofs", reflect.TypeFor[InstructionV1DropAllProofs]()},
+		{"DropNamedProofs", reflect.TypeFor[InstructionV1DropNamedProofs]()},
+		{"DropAuthZoneProofs", reflect.TypeFor[InstructionV1DropAuthZoneProofs]()},
+		{"DropAuthZoneRegularProofs", reflect.TypeFor[InstructionV1DropAuthZoneRegularProofs]()},
+		{"DropAuthZoneSignatureProofs", reflect.TypeFor[InstructionV1DropAuthZoneSignatureProofs]()},
+		{"CreateProofFromBucketOfAmount", reflect.TypeFor[InstructionV1CreateProofFromBucketOfAmount]()},
+		{"CreateProofFromBucketOfNonFungibles", reflect.TypeFor[InstructionV1CreateProofFromBucketOfNonFungibles]()},
+		{"CreateProofFromBucketOfAll", reflect.TypeFor[InstructionV1CreateProofFromBucketOfAll]()},
+		{"BurnResource", reflect.TypeFor[InstructionV1BurnResource]()},
+		{"CloneProof", reflect.TypeFor[InstructionV1CloneProof]()},
+		{"DropProof", reflect.TypeFor[InstructionV1DropProof]()},
+		{"CallFunction", reflect.TypeFor[InstructionV1CallFunction]()},
+		{"CallMethod", reflect.TypeFor[InstructionV1CallMethod]()},
+		{"CallRoyaltyMethod", reflect.TypeFor[InstructionV1CallRoyaltyMethod]()},
+		{"CallMetadataMethod", reflect.TypeFor[InstructionV1CallMetadataMethod]()},
+		{"CallRoleAssignmentMethod", reflect.TypeFor[InstructionV1CallRoleAssignmentMethod]()},
+		{"CallDirectVaultMethod", reflect.TypeFor[InstructionV1CallDirectVaultMethod]()},
+		{"AllocateGlobalAddress", reflect.TypeFor[InstructionV1AllocateGlobalAddress]()},
+	},
+	reflect.TypeFor[InstructionV2](): {
+		{"TakeAllFromWorktop", reflect.TypeFor[InstructionV2TakeAllFromWorktop]()},
+		{"TakeFromWorktop", reflect.TypeFor[InstructionV2TakeFromWorktop]()},
+		{"TakeNonFungiblesFromWorktop", reflect.TypeFor[InstructionV2TakeNonFungiblesFromWorktop]()},
+		{"ReturnToWorktop", reflect.TypeFor[InstructionV2ReturnToWorktop]()},
+		{"AssertWorktopContains", reflect.TypeFor[InstructionV2AssertWorktopContains]()},
+		{"AssertWorktopContainsAny", reflect.TypeFor[InstructionV2AssertWorktopContainsAny]()},
+		{"AssertWorktopContainsNonFungibles", reflect.TypeFor[InstructionV2AssertWorktopContainsNonFungibles]()},
+		{"PopFromAuthZone", reflect.TypeFor[InstructionV2PopFromAuthZone]()},
+		{"PushToAuthZone", reflect.TypeFor[InstructionV2PushToAuthZone]()},
+		{"CreateProofFromAuthZoneOfAmount", reflect.TypeFor[InstructionV2CreateProofFromAuthZoneOfAmount]()},
+		{"CreateProofFromAuthZoneOfNonFungibles", reflect.TypeFor[InstructionV2CreateProofFromAuthZoneOfNonFungibles]()},
+		{"CreateProofFromAuthZoneOfAll", reflect.TypeFor[InstructionV2CreateProofFromAuthZoneOfAll]()},
+		{"DropAllProofs", reflect.TypeFor[InstructionV2DropAllProofs]()},
+		{"DropNamedProofs", reflect.TypeFor[InstructionV2DropNamedProofs]()},
+		{"DropAuthZoneProofs", reflect.TypeFor[InstructionV2DropAuthZoneProofs]()},
+		{"DropAuthZoneRegularProofs", reflect.TypeFor[InstructionV2DropAuthZoneRegularProofs]()},
+		{"DropAuthZoneSignatureProofs", reflect.TypeFor[InstructionV2DropAuthZoneSignatureProofs]()},
+		{"CreateProofFromBucketOfAmount", reflect.TypeFor[InstructionV2CreateProofFromBucketOfAmount]()},
+		{"CreateProofFromBucketOfNonFungibles", reflect.TypeFor[InstructionV2CreateProofFromBucketOfNonFungibles]()},
+		{"CreateProofFromBucketOfAll", reflect.TypeFor[InstructionV2CreateProofFromBucketOfAll]()},
+		{"BurnResource", reflect.TypeFor[InstructionV2BurnResource]()},
+		{"CloneProof", reflect.TypeFor[InstructionV2CloneProof]()},
+		{"DropProof", reflect.TypeFor[InstructionV2DropProof]()},
+		{"CallFunction", reflect.TypeFor[InstructionV2CallFunction]()},
+		{"CallMethod", reflect.TypeFor[InstructionV2CallMethod]()},
+		{"CallRoyaltyMethod", reflect.TypeFor[InstructionV2CallRoyaltyMethod]()},
+		{"CallMetadataMethod", reflect.TypeFor[InstructionV2CallMetadataMethod]()},
+		{"CallRoleAssignmentMethod", reflect.TypeFor[InstructionV2CallRoleAssignmentMethod]()},
+		{"CallDirectVaultMethod", reflect.TypeFor[InstructionV2CallDirectVaultMethod]()},
+		{"AllocateGlobalAddress", reflect.TypeFor[InstructionV2AllocateGlobalAddress]()},
+		{"YieldToParent", reflect.TypeFor[InstructionV2YieldToParent]()},
+		{"YieldToChild", reflect.TypeFor[InstructionV2YieldToChild]()},
+		{"VerifyParent", reflect.TypeFor[InstructionV2VerifyParent]()},
+		{"AssertWorktopResourcesOnly", reflect.TypeFor[InstructionV2AssertWorktopResourcesOnly]()},
+		{"AssertWorktopResourcesInclude", reflect.TypeFor[InstructionV2AssertWorktopResourcesInclude]()},
+		{"AssertNextCallReturnsOnly", reflect.TypeFor[InstructionV2AssertNextCallReturnsOnly]()},
+		{"AssertNextCallReturnsInclude", reflect.TypeFor[InstructionV2AssertNextCallReturnsInclude]()},
+		{"AssertBucketContents", reflect.TypeFor[InstructionV2AssertBucketContents]()},
+	},
+	reflect.TypeFor[InvocationIoItem](): {
+		{"Fungible", reflect.TypeFor[InvocationIoItemFungible]()},
+		{"NonFungible", reflect.TypeFor[InvocationIoItemNonFungible]()},
+	},
+	reflect.TypeFor[LocalTypeId](): {
+		{"WellKnown", reflect.TypeFor[LocalTypeIdWellKnown]()},
+		{"SchemaLocalIndex", reflect.TypeFor[LocalTypeIdSchemaLocalIndex]()},
+	},
+	reflect.TypeFor[LowerBound](): {
+		{"NonZero", reflect.TypeFor[LowerBoundNonZero]()},
+		{"Inclusive", reflect.TypeFor[LowerBoundInclusive]()},
+	},
+	reflect.TypeFor[ManifestAddress](): {
+		{"Named", reflect.TypeFor[ManifestAddressNamed]()},
+		{"Static", reflect.TypeFor[ManifestAddressStatic]()},
+	},
+	reflect.TypeFor[ManifestBuilderAddress](): {
+		{"Named", reflect.TypeFor[ManifestBuilderAddressNamed]()},
+		{"Static", reflect.TypeFor[ManifestBuilderAddressStatic]()},
+	},
+	reflect.TypeFor[ManifestBuilderValue](): {
+		{"BoolValue", reflect.TypeFor[ManifestBuilderValueBoolValue]()},
+		{"I8Value", reflect.TypeFor[ManifestBuilderValueI8Value]()},
+		{"I16Value", reflect.TypeFor[ManifestBuilderValueI16Value]()},
+		{"I32Value", reflect.TypeFor[ManifestBuilderValueI32Value]()},
+		{"I64Value", reflect.TypeFor[ManifestBuilderValueI64Value]()},
+		{"I128Value", reflect.TypeFor[ManifestBuilderValueI128Value]()},
+		{"U8Value", reflect.TypeFor[ManifestBuilderValueU8Value]()},
+		{"U16Value", reflect.TypeFor[ManifestBuilderValueU16Value]()},
+		{"U32Value", reflect.TypeFor[ManifestBuilderValueU32Value]()},
+		{"U64Value", reflect.TypeFor[ManifestBuilderValueU64Value]()},
+		{"U128Value", reflect.TypeFor[ManifestBuilderValueU128Value]()},
+		{"StringValue", reflect.TypeFor[ManifestBuilderValueStringValue]()},
+		{"EnumValue", reflect.TypeFor[ManifestBuilderValueEnumValue]()},
+		{"ArrayValue", reflect.TypeFor[ManifestBuilderValueArrayValue]()},
+		{"TupleValue", reflect.TypeFor[ManifestBuilderValueTupleValue]()},
+		{"MapValue", reflect.TypeFor[ManifestBuilderValueMapValue]()},
+		{"AddressValue", reflect.TypeFor[ManifestBuilderValueAddressValue]()},
+		{"BucketValue", reflect.TypeFor[ManifestBuilderValueBucketValue]()},
+		{"ProofValue", reflect.TypeFor[ManifestBuilderValueProofValue]()},
+		{"ExpressionValue", reflect.TypeFor[ManifestBuilderValueExpressionValue]()},
+		{"BlobValue", reflect.TypeFor[ManifestBuilderValueBlobValue]()},
+		{"DecimalValue", reflect.TypeFor[ManifestBuilderValueDecimalValue]()},
+		{"PreciseDecimalValue", reflect.TypeFor[ManifestBuilderValuePreciseDecimalValue]()},
+		{"NonFungibleLocalIdValue", reflect.TypeFor[ManifestBuilderValueNonFungibleLocalIdValue]()},
+		{"AddressReservationValue", reflect.TypeFor[ManifestBuilderValueAddressReservationValue]()},
+	},
+	reflect.TypeFor[ManifestResourceConstraint](): {
+		{"NonZeroAmount", reflect.TypeFor[ManifestResourceConstraintNonZeroAmount]()},
+		{"ExactAmount", reflect.TypeFor[ManifestResourceConstraintExactAmount]()},
+		{"AtLeastAmount", reflect.TypeFor[ManifestResourceConstraintAtLeastAmount]()},
+		{"ExactNonFungibles", reflect.TypeFor[ManifestResourceConstraintExactNonFungibles]()},
+		{"AtLeastNonFungibles", reflect.TypeFor[ManifestResourceConstraintAtLeastNonFungibles]()},
+		{"General", reflect.TypeFor[ManifestResourceConstraintGeneral]()},
+	},
+	reflect.TypeFor[ManifestSborStringRepresentation](): {
+		{"ManifestString", reflect.TypeFor[ManifestSborStringRepresentationManifestString]()},
+		{"Json", reflect.TypeFor[ManifestSborStringRepresentationJson]()},
+	},
+	reflect.TypeFor[ManifestValue](): {
+		{"BoolValue", reflect.TypeFor[ManifestValueBoolValue]()},
+		{"I8Value", reflect.TypeFor[ManifestValueI8Value]()},
+		{"I16Value", reflect.TypeFor[ManifestValueI16Value]()},
+		{"I32Value", reflect.TypeFor[ManifestValueI32Value]()},
+		{"I64Value", reflect.TypeFor[ManifestValueI64Value]()},
+		{"I128Value", reflect.TypeFor[ManifestValueI128Value]()},
+		{"U8Value", reflect.TypeFor[ManifestValueU8Value]()},
+		{"U16Value", reflect.TypeFor[ManifestValueU16Value]()},
+		{"U32Value", reflect.TypeFor[ManifestValueU32Value]()},
+		{"U64Value", reflect.TypeFor[ManifestValueU64Value]()},
+		{"U128Value", reflect.TypeFor[ManifestValueU128Value]()},
+		{"StringValue", reflect.TypeFor[ManifestValueStringValue]()},
+		{"EnumValue", reflect.TypeFor[ManifestValueEnumValue]()},
+		{"ArrayValue", reflect.TypeFor[ManifestValueArrayValue]()},
+		{"TupleValue", reflect.TypeFor[ManifestValueTupleValue]()},
+		{"MapValue", reflect.TypeFor[ManifestValueMapValue]()},
+		{"AddressValue", reflect.TypeFor[ManifestValueAddressValue]()},
+		{"BucketValue", reflect.TypeFor[ManifestValueBucketValue]()},
+		{"ProofValue", reflect.TypeFor[ManifestValueProofValue]()},
+		{"ExpressionValue", reflect.TypeFor[ManifestValueExpressionValue]()},
+		{"BlobValue", reflect.TypeFor[ManifestValueBlobValue]()},
+		{"DecimalValue", reflect.TypeFor[ManifestValueDecimalValue]()},
+		{"PreciseDecimalValue", reflect.TypeFor[ManifestValuePreciseDecimalValue]()},
+		{"NonFungibleLocalIdValue", reflect.TypeFor[ManifestValueNonFungibleLocalIdValue]()},
+		{"AddressReservationValue", reflect.TypeFor[ManifestValueAddressReservationValue]()},
+	},
+	reflect.TypeFor[MessageContentV1](): {
+		{"Str", reflect.TypeFor[MessageContentV1Str]()},
+		{"Bytes", reflect.TypeFor[MessageContentV1Bytes]()},
+	},
+	reflect.TypeFor[MessageContentsV2](): {
+		{"Str", reflect.TypeFor[MessageContentsV2Str]()},
+		{"Bytes", reflect.TypeFor[MessageContentsV2Bytes]()},
+	},
+	reflect.TypeFor[MessageV1](): {
+		{"None", reflect.TypeFor[MessageV1None]()},
+		{"PlainText", reflect.TypeFor[MessageV1PlainText]()},
+		{"Encrypted", reflect.TypeFor[MessageV1Encrypted]()},
+	},
+	reflect.TypeFor[MessageV2](): {
+		{"None", reflect.TypeFor[MessageV2None]()},
+		{"PlainText", reflect.TypeFor[MessageV2PlainText]()},
+		{"Encrypted", reflect.TypeFor[MessageV2Encrypted]()},
+	},
+	reflect.TypeFor[MetadataValue](): {
+		{"StringValue", reflect.TypeFor[MetadataValueStringValue]()},
+		{"BoolValue", reflect.TypeFor[MetadataValueBoolValue]()},
+		{"U8Value", reflect.TypeFor[MetadataValueU8Value]()},
+		{"U32Value", reflect.TypeFor[MetadataValueU32Value]()},
+		{"U64Value", reflect.TypeFor[MetadataValueU64Value]()},
+		{"I32Value", reflect.TypeFor[MetadataValueI32Value]()},
+		{"I64Value", reflect.TypeFor[MetadataValueI64Value]()},
+		{"DecimalValue", reflect.TypeFor[MetadataValueDecimalValue]()},
+		{"GlobalAddressValue", reflect.TypeFor[MetadataValueGlobalAddressValue]()},
+		{"PublicKeyValue", reflect.TypeFor[MetadataValuePublicKeyValue]()},
+		{"NonFungibleGlobalIdValue", reflect.TypeFor[MetadataValueNonFungibleGlobalIdValue]()},
+		{"NonFungibleLocalIdValue", reflect.TypeFor[MetadataValueNonFungibleLocalIdValue]()},
+		{"InstantValue", reflect.TypeFor[MetadataValueInstantValue]()},
+		{"UrlValue", reflect.TypeFor[MetadataValueUrlValue]()},
+		{"OriginValue", reflect.TypeFor[MetadataValueOriginValue]()},
+		{"PublicKeyHashValue", reflect.TypeFor[MetadataValuePublicKeyHashValue]()},
+		{"StringArrayValue", reflect.TypeFor[MetadataValueStringArrayValue]()},
+		{"BoolArrayValue", reflect.TypeFor[MetadataValueBoolArrayValue]()},
+		{"U8ArrayValue", reflect.TypeFor[MetadataValueU8ArrayValue]()},
+		{"U32ArrayValue", reflect.TypeFor[MetadataValueU32ArrayValue]()},
+		{"U64ArrayValue", reflect.TypeFor[MetadataValueU64ArrayValue]()},
+		{"I32ArrayValue", reflect.TypeFor[MetadataValueI32ArrayValue]()},
+		{"I64ArrayValue", reflect.TypeFor[MetadataValueI64ArrayValue]()},
+		{"DecimalArrayValue", reflect.TypeFor[MetadataValueDecimalArrayValue]()},
+		{"GlobalAddressArrayValue", reflect.TypeFor[MetadataValueGlobalAddressArrayValue]()},
+		{"PublicKeyArrayValue", reflect.TypeFor[MetadataValuePublicKeyArrayValue]()},
+		{"NonFungibleGlobalIdArrayValue", reflect.TypeFor[MetadataValueNonFungibleGlobalIdArrayValue]()},
+		{"NonFungibleLocalIdArrayValue", reflect.TypeFor[MetadataValueNonFungibleLocalIdArrayValue]()},
+		{"InstantArrayValue", reflect.TypeFor[MetadataValueInstantArrayValue]()},
+		{"UrlArrayValue", reflect.TypeFor[MetadataValueUrlArrayValue]()},
+		{"OriginArrayValue", reflect.TypeFor[MetadataValueOriginArrayValue]()},
+		{"PublicKeyHashArrayValue", reflect.TypeFor[MetadataValuePublicKeyHashArrayValue]()},
+	},
+	reflect.TypeFor[NameRecordError](): {
+		{"ObjectNameIsAlreadyTaken", reflect.TypeFor[NameRecordErrorObjectNameIsAlreadyTaken]()},
+		{"ObjectDoesNotExist", reflect.TypeFor[NameRecordErrorObjectDoesNotExist]()},
+	},
+	reflect.TypeFor[NonFungibleLocalId](): {
+		{"Integer", reflect.TypeFor[NonFungibleLocalIdInteger]()},
+		{"Str", reflect.TypeFor[NonFungibleLocalIdStr]()},
+		{"Bytes", reflect.TypeFor[NonFungibleLocalIdBytes]()},
+		{"Ruid", reflect.TypeFor[NonFungibleLocalIdRuid]()},
+	},
+	reflect.TypeFor[OwnerRole](): {
+		{"None", reflect.TypeFor[OwnerRoleNone]()},
+		{"Fixed", reflect.TypeFor[OwnerRoleFixed]()},
+		{"Updatable", reflect.TypeFor[OwnerRoleUpdatable]()},
+	},
+	reflect.TypeFor[PublicKey](): {
+		{"Secp256k1", reflect.TypeFor[PublicKeySecp256k1]()},
+		{"Ed25519", reflect.TypeFor[PublicKeyEd25519]()},
+	},
+	reflect.TypeFor[PublicKeyHash](): {
+		{"Secp256k1", reflect.TypeFor[PublicKeyHashSecp256k1]()},
+		{"Ed25519", reflect.TypeFor[PublicKeyHashEd25519]()},
+	},
+	reflect.TypeFor[RecallResourceEvent](): {
+		{"Amount", reflect.TypeFor[RecallResourceEventAmount]()},
+		{"Ids", reflect.TypeFor[RecallResourceEventIds]()},
+	},
+	reflect.TypeFor[ResourceOrNonFungible](): {
+		{"NonFungible", reflect.TypeFor[ResourceOrNonFungibleNonFungible]()},
+		{"Resource", reflect.TypeFor[ResourceOrNonFungibleResource]()},
+	},
+	reflect.TypeFor[ResourcePreferenceUpdate](): {
+		{"Set", reflect.TypeFor[ResourcePreferenceUpdateSet]()},
+		{"Remove", reflect.TypeFor[ResourcePreferenceUpdateRemove]()},
+	},
+	reflect.TypeFor[ResourceSpecifier](): {
+		{"Amount", reflect.TypeFor[ResourceSpecifierAmount]()},
+		{"Ids", reflect.TypeFor[ResourceSpecifierIds]()},
+	},
+	reflect.TypeFor[RoyaltyAmount](): {
+		{"Free", reflect.TypeFor[RoyaltyAmountFree]()},
+		{"Xrd", reflect.TypeFor[RoyaltyAmountXrd]()},
+		{"Usd", reflect.TypeFor[RoyaltyAmountUsd]()},
+	},
+	reflect.TypeFor[ScryptoSborString](): {
+		{"ProgrammaticJson", reflect.TypeFor[ScryptoSborStringProgrammaticJson]()},
+	},
+	reflect.TypeFor[SignatureV1](): {
+		{"Secp256k1", reflect.TypeFor[SignatureV1Secp256k1]()},
+		{"Ed25519", reflect.TypeFor[SignatureV1Ed25519]()},
+	},
+	reflect.TypeFor[SignatureWithPublicKeyV1](): {
+		{"Secp256k1", reflect.TypeFor[SignatureWithPublicKeyV1Secp256k1]()},
+		{"Ed25519", reflect.TypeFor[SignatureWithPublicKeyV1Ed25519]()},
+	},
+	reflect.TypeFor[SimpleFungibleResourceBounds](): {
+		{"Exact", reflect.TypeFor[SimpleFungibleResourceBoundsExact]()},
+		{"AtMost", reflect.TypeFor[SimpleFungibleResourceBoundsAtMost]()},
+		{"AtLeast", reflect.TypeFor[SimpleFungibleResourceBoundsAtLeast]()},
+		{"Between", reflect.TypeFor[SimpleFungibleResourceBoundsBetween]()},
+		{"UnknownAmount", reflect.TypeFor[SimpleFungibleResourceBoundsUnknownAmount]()},
+	},
+	reflect.TypeFor[SimpleNonFungibleResourceBounds](): {
+		{"Exact", reflect.TypeFor[SimpleNonFungibleResourceBoundsExact]()},
+		{"NotExact", reflect.TypeFor[SimpleNonFungibleResourceBoundsNotExact]()},
+	},
+	reflect.TypeFor[TypedAccessControllerBlueprintEvent](): {
+		{"InitiateRecoveryEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventInitiateRecoveryEventValue]()},
+		{"InitiateBadgeWithdrawAttemptEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventInitiateBadgeWithdrawAttemptEventValue]()},
+		{"RuleSetUpdateEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventRuleSetUpdateEventValue]()},
+		{"BadgeWithdrawEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventBadgeWithdrawEventValue]()},
+		{"CancelRecoveryProposalEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventCancelRecoveryProposalEventValue]()},
+		{"CancelBadgeWithdrawAttemptEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventCancelBadgeWithdrawAttemptEventValue]()},
+		{"LockPrimaryRoleEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventLockPrimaryRoleEventValue]()},
+		{"UnlockPrimaryRoleEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventUnlockPrimaryRoleEventValue]()},
+		{"StopTimedRecoveryEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventStopTimedRecoveryEventValue]()},
+		{"DepositRecoveryXrdEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventDepositRecoveryXrdEventValue]()},
+		{"WithdrawRecoveryXrdEventValue", reflect.TypeFor[TypedAccessControllerBlueprintEventWithdrawRecoveryXrdEventValue]()},
+	},
+	reflect.TypeFor[TypedAccessControllerPackageEvent](): {
+		{"AccessController", reflect.TypeFor[TypedAccessControllerPackageEventAccessController]()},
+	},
+	reflect.TypeFor[TypedAccountBlueprintEvent](): {
+		{"AccountWithdrawEventValue", reflect.TypeFor[TypedAccountBlueprintEventAccountWithdrawEventValue]()},
+		{"AccountDepositEventValue", reflect.TypeFor[TypedAccountBlueprintEventAccountDepositEventValue]()},
+		{"AccountRejectedDepositEventValue", reflect.TypeFor[TypedAccountBlueprintEventAccountRejectedDepositEventValue]()},
+		{"AccountSetResourcePreferenceEventValue", reflect.TypeFor[TypedAccountBlueprintEventAccountSetResourcePreferenceEventValue]()},
+		{"AccountRemoveResourcePreferenceEventValue", reflect.TypeFor[TypedAccountBlueprintEventAccountRemoveResourcePreferenceEventValue]()},
+		{"AccountSetDefaultDepositRuleEventValue", reflect.TypeFor[TypedAccountBlueprintEventAccountSetDefaultDepositRuleEventValue]()},
+		{"AccountAddAuthorizedDepositorEventValue", reflect.TypeFor[TypedAccountBlueprintEventAccountAddAuthorizedDepositorEventValue]()},
+		{"AccountRemoveAuthorizedDepositorEventValue", reflect.TypeFor[TypedAccountBlueprintEventAccountRemoveAuthorizedDepositorEventValue]()},
+	},
+	reflect.TypeFor[TypedAccountLockerBlueprintEvent](): {
+		{"StoreEventValue", reflect.TypeFor[TypedAccountLockerBlueprintEventStoreEventValue]()},
+		{"RecoverEventValue", reflect.TypeFor[TypedAccountLockerBlueprintEventRecoverEventValue]()},
+		{"ClaimEventValue", reflect.TypeFor[TypedAccountLockerBlueprintEventClaimEventValue]()},
+	},
+	reflect.TypeFor[TypedAccountPackageEvent](): {
+		{"Account", reflect.TypeFor[TypedAccountPackageEventAccount]()},
+	},
+	reflect.TypeFor[TypedConsensusManagerBlueprintEvent](): {
+		{"RoundChangeEventValue", reflect.TypeFor[TypedConsensusManagerBlueprintEventRoundChangeEventValue]()},
+		{"EpochChangeEventValue", reflect.TypeFor[TypedConsensusManagerBlueprintEventEpochChangeEventValue]()},
+	},
+	reflect.TypeFor[TypedConsensusManagerPackageEvent](): {
+		{"ConsensusManager", reflect.TypeFor[TypedConsensusManagerPackageEventConsensusManager]()},
+		{"Validator", reflect.TypeFor[TypedConsensusManagerPackageEventValidator]()},
+	},
+	reflect.TypeFor[TypedFungibleResourceManagerBlueprintEvent](): {
+		{"VaultCreationEventValue", reflect.TypeFor[TypedFungibleResourceManagerBlueprintEventVaultCreationEventValue]()},
+		{"MintFungibleResourceEventValue", reflect.TypeFor[TypedFungibleResourceManagerBlueprintEventMintFungibleResourceEventValue]()},
+		{"BurnFungibleResourceEventValue", reflect.TypeFor[TypedFungibleResourceManagerBlueprintEventBurnFungibleResourceEventValue]()},
+	},
+	reflect.TypeFor[TypedFungibleVaultBlueprintEvent](): {
+		{"FungibleVaultLockFeeEventValue", reflect.TypeFor[TypedFungibleVaultBlueprintEventFungibleVaultLockFeeEventValue]()},
+		{"FungibleVaultWithdrawEventValue", reflect.TypeFor[TypedFungibleVaultBlueprintEventFungibleVaultWithdrawEventValue]()},
+		{"FungibleVaultDepositEventValue", reflect.TypeFor[TypedFungibleVaultBlueprintEventFungibleVaultDepositEventValue]()},
+		{"FungibleVaultRecallEventValue", reflect.TypeFor[TypedFungibleVaultBlueprintEventFungibleVaultRecallEventValue]()},
+		{"FungibleVaultPayFeeEventValue", reflect.TypeFor[TypedFungibleVaultBlueprintEventFungibleVaultPayFeeEventValue]()},
+	},
+	reflect.TypeFor[TypedLockerPackageEvent](): {
+		{"AccountLocker", reflect.TypeFor[TypedLockerPackageEventAccountLocker]()},
+	},
+	reflect.TypeFor[TypedMetadataBlueprintEvent](): {
+		{"SetMetadataEventValue", reflect.TypeFor[TypedMetadataBlueprintEventSetMetadataEventValue]()},
+		{"RemoveMetadataEventValue", reflect.TypeFor[TypedMetadataBlueprintEventRemoveMetadataEventValue]()},
+	},
+	reflect.TypeFor[TypedMetadataPackageEvent](): {
+		{"Metadata", reflect.TypeFor[TypedMetadataPackageEventMetadata]()},
+	},
+	reflect.TypeFor[TypedMultiResourcePoolBlueprintEvent](): {
+		{"MultiResourcePoolContributionEventValue", reflect.TypeFor[TypedMultiResourcePoolBlueprintEventMultiResourcePoolContributionEventValue]()},
+		{"MultiResourcePoolRedemptionEventValue", reflect.TypeFor[TypedMultiResourcePoolBlueprintEventMultiResourcePoolRedemptionEventValue]()},
+		{"MultiResourcePoolWithdrawEventValue", reflect.TypeFor[TypedMultiResourcePoolBlueprintEventMultiResourcePoolWithdrawEventValue]()},
+		{"MultiResourcePoolDepositEventValue", reflect.TypeFor[TypedMultiResourcePoolBlueprintEventMultiResourcePoolDepositEventValue]()},
+	},
+	reflect.TypeFor[TypedNativeEvent](): {
+		{"AccessController", reflect.TypeFor[TypedNativeEventAccessController]()},
+		{"Account", reflect.TypeFor[TypedNativeEventAccount]()},
+		{"ConsensusManager", reflect.TypeFor[TypedNativeEventConsensusManager]()},
+		{"Pool", reflect.TypeFor[TypedNativeEventPool]()},
+		{"Resource", reflect.TypeFor[TypedNativeEventResource]()},
+		{"RoleAssignment", reflect.TypeFor[TypedNativeEventRoleAssignment]()},
+		{"Metadata", reflect.TypeFor[TypedNativeEventMetadata]()},
+		{"Locker", reflect.TypeFor[TypedNativeEventLocker]()},
+	},
+	reflect.TypeFor[TypedNonFungibleResourceManagerBlueprintEvent](): {
+		{"VaultCreationEventValue", reflect.TypeFor[TypedNonFungibleResourceManagerBlueprintEventVaultCreationEventValue]()},
+		{"MintNonFungibleResourceEventValue", reflect.TypeFor[TypedNonFungibleResourceManagerBlueprintEventMintNonFungibleResourceEventValue]()},
+		{"BurnNonFungibleResourceEventValue", reflect.TypeFor[TypedNonFungibleResourceManagerBlueprintEventBurnNonFungibleResourceEventValue]()},
+	},
+	reflect.TypeFor[TypedNonFungibleVaultBlueprintEvent](): {
+		{"NonFungibleVaultWithdrawEventValue", reflect.TypeFor[TypedNonFungibleVaultBlueprintEventNonFungibleVaultWithdrawEventValue]()},
+		{"NonFungibleVaultDepositEventValue", reflect.TypeFor[TypedNonFungibleVaultBlueprintEventNonFungibleVaultDepositEventValue]()},
+		{"NonFungibleVaultRecallEventValue", reflect.TypeFor[TypedNonFungibleVaultBlueprintEventNonFungibleVaultRecallEventValue]()},
+	},
+	reflect.TypeFor[TypedOneResourcePoolBlueprintEvent](): {
+		{"OneResourcePoolContributionEventValue", reflect.TypeFor[TypedOneResourcePoolBlueprintEventOneResourcePoolContributionEventValue]()},
+		{"OneResourcePoolRedemptionEventValue", reflect.TypeFor[TypedOneResourcePoolBlueprintEventOneResourcePoolRedemptionEventValue]()},
+		{"OneResourcePoolWithdrawEventValue", reflect.TypeFor[TypedOneResourcePoolBlueprintEventOneResourcePoolWithdrawEventValue]()},
+		{"OneResourcePoolDepositEventValue", reflect.TypeFor[TypedOneResourcePoolBlueprintEventOneResourcePoolDepositEventValue]()},
+	},
+	reflect.TypeFor[TypedPoolPackageEvent](): {
+		{"OneResourcePool", reflect.TypeFor[TypedPoolPackageEventOneResourcePool]()},
+		{"TwoResourcePool", reflect.TypeFor[TypedPoolPackageEventTwoResourcePool]()},
+		{"MultiResourcePool", reflect.TypeFor[TypedPoolPackageEventMultiResourcePool]()},
+	},
+	reflect.TypeFor[TypedResourcePackageEvent](): {
+		{"FungibleVault", reflect.TypeFor[TypedResourcePackageEventFungibleVault]()},
+		{"NonFungibleVault", reflect.TypeFor[TypedResourcePackageEventNonFungibleVault]()},
+		{"FungibleResourceManager", reflect.TypeFor[TypedResourcePackageEventFungibleResourceManager]()},
+		{"NonFungibleResourceManager", reflect.TypeFor[TypedResourcePackageEventNonFungibleResourceManager]()},
+	},
+	reflect.TypeFor[TypedRoleAssignmentBlueprintEvent](): {
+		{"SetRoleEventValue", reflect.TypeFor[TypedRoleAssignmentBlueprintEventSetRoleEventValue]()},
+		{"SetOwnerRoleEventValue", reflect.TypeFor[TypedRoleAssignmentBlueprintEventSetOwnerRoleEventValue]()},
+		{"LockOwnerRoleEventValue", reflect.TypeFor[TypedRoleAssignmentBlueprintEventLockOwnerRoleEventValue]()},
+	},
+	reflect.TypeFor[TypedRoleAssignmentPackageEvent](): {
+		{"RoleAssignment", reflect.TypeFor[TypedRoleAssignmentPackageEventRoleAssignment]()},
+	},
+	reflect.TypeFor[TypedTwoResourcePoolBlueprintEvent](): {
+		{"TwoResourcePoolContributionEventValue", reflect.TypeFor[TypedTwoResourcePoolBlueprintEventTwoResourcePoolContributionEventValue]()},
+		{"TwoResourcePoolRedemptionEventValue", reflect.TypeFor[TypedTwoResourcePoolBlueprintEventTwoResourcePoolRedemptionEventValue]()},
+		{"TwoResourcePoolWithdrawEventValue", reflect.TypeFor[TypedTwoResourcePoolBlueprintEventTwoResourcePoolWithdrawEventValue]()},
+		{"TwoResourcePoolDepositEventValue", reflect.TypeFor[TypedTwoResourcePoolBlueprintEventTwoResourcePoolDepositEventValue]()},
+	},
+	reflect.TypeFor[TypedValidatorBlueprintEvent](): {
+		{"RegisterValidatorEventValue", reflect.TypeFor[TypedValidatorBlueprintEventRegisterValidatorEventValue]()},
+		{"UnregisterValidatorEventValue", reflect.TypeFor[TypedValidatorBlueprintEventUnregisterValidatorEventValue]()},
+		{"StakeEventValue", reflect.TypeFor[TypedValidatorBlueprintEventStakeEventValue]()},
+		{"UnstakeEventValue", reflect.TypeFor[TypedValidatorBlueprintEventUnstakeEventValue]()},
+		{"ClaimXrdEventValue", reflect.TypeFor[TypedValidatorBlueprintEventClaimXrdEventValue]()},
+		{"UpdateAcceptingStakeDelegationStateEventValue", reflect.TypeFor[TypedValidatorBlueprintEventUpdateAcceptingStakeDelegationStateEventValue]()},
+		{"ProtocolUpdateReadinessSignalEventValue", reflect.TypeFor[TypedValidatorBlueprintEventProtocolUpdateReadinessSignalEventValue]()},
+		{"ValidatorEmissionAppliedEventValue", reflect.TypeFor[TypedValidatorBlueprintEventValidatorEmissionAppliedEventValue]()},
+		{"ValidatorRewardAppliedEventValue", reflect.TypeFor[TypedValidatorBlueprintEventValidatorRewardAppliedEventValue]()},
+	},
+	reflect.TypeFor[UpperBound](): {
+		{"Inclusive", reflect.TypeFor[UpperBoundInclusive]()},
+		{"Unbounded", reflect.TypeFor[UpperBoundUnbounded]()},
+	},
+	reflect.TypeFor[WithdrawResourceEvent](): {
+		{"Amount", reflect.TypeFor[WithdrawResourceEventAmount]()},
+		{"Ids", reflect.TypeFor[WithdrawResourceEventIds]()},
+	},
+	reflect.TypeFor[WithdrawStrategy](): {
+		{"Exact", reflect.TypeFor[WithdrawStrategyExact]()},
+		{"Rounded", reflect.TypeFor[WithdrawStrategyRounded]()},
+	},
+}
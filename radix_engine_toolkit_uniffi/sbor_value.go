@@ -0,0 +1,361 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// SBOR values
+//
+// The sbor package encodes and decodes Manifest and Scrypto SBOR payloads in
+// pure Go, into values whose custom kinds are kept as their raw bodies. The
+// functions below convert Manifest SBOR values into ManifestValue, and back,
+// and Scrypto SBOR values into the ScryptoSborString of
+// ScryptoSborEncodeStringRepresentation, and back:
+//
+//	value, err := sbor.Decode(payload, sbor.Manifest)
+//	manifestValue, err := ManifestValueFromSbor(value, networkId)
+//	...
+//	value, err = ManifestValueToSbor(manifestValue)
+//	payload, err = sbor.Encode(value, sbor.Manifest)
+//
+// The value kinds of Manifest SBOR are the ManifestValueKind variants, as
+// returned by ManifestValueKindFromSbor.
+
+// sborBasicKinds are the basic value kinds, in the order of ManifestValueKind.
+var sborBasicKinds = []sbor.Kind{
+	sbor.KindBool, sbor.KindI8, sbor.KindI16, sbor.KindI32, sbor.KindI64, sbor.KindI128,
+	sbor.KindU8, sbor.KindU16, sbor.KindU32, sbor.KindU64, sbor.KindU128, sbor.KindString,
+	sbor.KindEnum, sbor.KindArray, sbor.KindTuple, sbor.KindMap,
+}
+
+// ManifestValueKindFromSbor returns the ManifestValueKind of a value kind of
+// the extension. The Scrypto references and owned objects are addresses.
+func ManifestValueKindFromSbor(kind sbor.Kind, extension sbor.Extension) (ManifestValueKind, error) {
+	if kind.IsCustom() && extension == sbor.Manifest {
+		if kind <= sbor.KindManifestAddressReservation {
+			return ManifestValueKindAddressValue + ManifestValueKind(kind-sbor.KindManifestAddress), nil
+		}
+	} else if kind.IsCustom() {
+		switch kind {
+		case sbor.KindScryptoReference, sbor.KindScryptoOwn:
+			return ManifestValueKindAddressValue, nil
+		case sbor.KindScryptoDecimal:
+			return ManifestValueKindDecimalValue, nil
+		case sbor.KindScryptoPreciseDecimal:
+			return ManifestValueKindPreciseDecimalValue, nil
+		case sbor.KindScryptoNonFungibleLocalId:
+			return ManifestValueKindNonFungibleLocalIdValue, nil
+		}
+	}
+	for index, basicKind := range sborBasicKinds {
+		if basicKind == kind {
+			return ManifestValueKind(index + 1), nil
+		}
+	}
+	return 0, sborError(extension, fmt.Sprintf("unknown value kind 0x%02x", byte(kind)))
+}
+
+// ManifestValueKindToSbor returns the Manifest SBOR value kind of a
+// ManifestValueKind.
+func ManifestValueKindToSbor(kind ManifestValueKind) (sbor.Kind, error) {
+	switch {
+	case kind >= ManifestValueKindBoolValue && kind <= ManifestValueKindMapValue:
+		return sborBasicKinds[kind-1], nil
+	case kind >= ManifestValueKindAddressValue && kind <= ManifestValueKindAddressReservationValue:
+		return sbor.KindManifestAddress + sbor.Kind(kind-ManifestValueKindAddressValue), nil
+	}
+	return 0, NewRadixEngineToolkitErrorManifestSborError(fmt.Sprintf("unknown manifest value kind %d", kind))
+}
+
+// ManifestValueFromSbor converts a Manifest SBOR value into a ManifestValue,
+// its static addresses being on the network.
+func ManifestValueFromSbor(value sbor.Value, networkId uint8) (ManifestValue, error) {
+	return sborManifestValue(value, sbor.Manifest, networkId)
+}
+
+// ManifestValueToSbor converts a ManifestValue into a Manifest SBOR value.
+func ManifestValueToSbor(value ManifestValue) (sbor.Value, error) {
+	integer := func(kind sbor.Kind, value int64) sbor.Value {
+		return sbor.Value{Kind: kind, Int: big.NewInt(value)}
+	}
+	unsigned := func(kind sbor.Kind, value uint64) sbor.Value {
+		return sbor.Value{Kind: kind, Int: new(big.Int).SetUint64(value)}
+	}
+	parsed := func(kind sbor.Kind, value string) (sbor.Value, error) {
+		parsed, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return sbor.Value{}, NewRadixEngineToolkitErrorManifestSborError(fmt.Sprintf("invalid integer %q", value))
+		}
+		return sbor.Value{Kind: kind, Int: parsed}, nil
+	}
+	custom := func(kind sbor.Kind, body []byte) sbor.Value {
+		return sbor.Value{Kind: kind, Custom: body}
+	}
+	index := func(value uint32) []byte {
+		return binary.LittleEndian.AppendUint32(nil, value)
+	}
+
+	switch value := value.(type) {
+	case ManifestValueBoolValue:
+		return sbor.Value{Kind: sbor.KindBool, Bool: value.Value}, nil
+	case ManifestValueI8Value:
+		return integer(sbor.KindI8, int64(value.Value)), nil
+	case ManifestValueI16Value:
+		return integer(sbor.KindI16, int64(value.Value)), nil
+	case ManifestValueI32Value:
+		return integer(sbor.KindI32, int64(value.Value)), nil
+	case ManifestValueI64Value:
+		return integer(sbor.KindI64, value.Value), nil
+	case ManifestValueI128Value:
+		return parsed(sbor.KindI128, value.Value)
+	case ManifestValueU8Value:
+		return unsigned(sbor.KindU8, uint64(value.Value)), nil
+	case ManifestValueU16Value:
+		return unsigned(sbor.KindU16, uint64(value.Value)), nil
+	case ManifestValueU32Value:
+		return unsigned(sbor.KindU32, uint64(value.Value)), nil
+	case ManifestValueU64Value:
+		return unsigned(sbor.KindU64, value.Value), nil
+	case ManifestValueU128Value:
+		return parsed(sbor.KindU128, value.Value)
+	case ManifestValueStringValue:
+		return sbor.Value{Kind: sbor.KindString, String: value.Value}, nil
+	case ManifestValueEnumValue:
+		fields, err := manifestValuesToSbor(value.Fields)
+		return sbor.Value{Kind: sbor.KindEnum, Discriminator: value.Discriminator, Elements: fields}, err
+	case ManifestValueArrayValue:
+		elementKind, err := ManifestValueKindToSbor(value.ElementValueKind)
+		if err != nil {
+			return sbor.Value{}, err
+		}
+		elements, err := manifestValuesToSbor(value.Elements)
+		return sbor.Value{Kind: sbor.KindArray, ElementKind: elementKind, Elements: elements}, err
+	case ManifestValueTupleValue:
+		fields, err := manifestValuesToSbor(value.Fields)
+		return sbor.Value{Kind: sbor.KindTuple, Elements: fields}, err
+	case ManifestValueMapValue:
+		keyKind, err := ManifestValueKindToSbor(value.KeyValueKind)
+		if err != nil {
+			return sbor.Value{}, err
+		}
+		valueKind, err := ManifestValueKindToSbor(value.ValueValueKind)
+		if err != nil {
+			return sbor.Value{}, err
+		}
+		entries := make([]sbor.Entry, len(value.Entries))
+		for index, entry := range value.Entries {
+			if entries[index].Key, err = ManifestValueToSbor(entry.Key); err != nil {
+				return sbor.Value{}, err
+			}
+			if entries[index].Value, err = ManifestValueToSbor(entry.Value); err != nil {
+				return sbor.Value{}, err
+			}
+		}
+		return sbor.Value{Kind: sbor.KindMap, KeyKind: keyKind, ValueKind: valueKind, Entries: entries}, nil
+	case ManifestValueAddressValue:
+		switch address := value.Value.(type) {
+		case ManifestAddressStatic:
+			return custom(sbor.KindManifestAddress, append([]byte{0}, address.StaticAddress.Bytes()...)), nil
+		case ManifestAddressNamed:
+			return custom(sbor.KindManifestAddress, append([]byte{1}, index(address.NamedAddressId)...)), nil
+		}
+	case ManifestValueBucketValue:
+		return custom(sbor.KindManifestBucket, index(value.Value.Value)), nil
+	case ManifestValueProofValue:
+		return custom(sbor.KindManifestProof, index(value.Value.Value)), nil
+	case ManifestValueExpressionValue:
+		return custom(sbor.KindManifestExpression, []byte{byte(value.Value - ManifestExpressionEntireWorktop)}), nil
+	case ManifestValueBlobValue:
+		return custom(sbor.KindManifestBlob, value.Value.Value.Bytes()), nil
+	case ManifestValueDecimalValue:
+		return custom(sbor.KindManifestDecimal, value.Value.Value().ToLeBytes()), nil
+	case ManifestValuePreciseDecimalValue:
+		return custom(sbor.KindManifestPreciseDecimal, value.Value.Value().ToLeBytes()), nil
+	case ManifestValueNonFungibleLocalIdValue:
+		return custom(sbor.KindManifestNonFungibleLocalId, nonFungibleLocalIdBody(value.Value)), nil
+	case ManifestValueAddressReservationValue:
+		return custom(sbor.KindManifestAddressReservation, index(value.Value.Value)), nil
+	}
+	return sbor.Value{}, NewRadixEngineToolkitErrorManifestSborError(fmt.Sprintf("unknown manifest value %T", value))
+}
+
+func manifestValuesToSbor(values []ManifestValue) ([]sbor.Value, error) {
+	converted := make([]sbor.Value, len(values))
+	for index, value := range values {
+		var err error
+		if converted[index], err = ManifestValueToSbor(value); err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+// sborManifestValue converts an SBOR value of the extension into the
+// ManifestValue of the same shape. Scrypto references and owned objects
+// convert into the static addresses of their node ids.
+func sborManifestValue(value sbor.Value, extension sbor.Extension, networkId uint8) (ManifestValue, error) {
+	switch value.Kind {
+	case sbor.KindBool:
+		return ManifestValueBoolValue{Value: value.Bool}, nil
+	case sbor.KindI8:
+		return ManifestValueI8Value{Value: int8(value.Int.Int64())}, nil
+	case sbor.KindI16:
+		return ManifestValueI16Value{Value: int16(value.Int.Int64())}, nil
+	case sbor.KindI32:
+		return ManifestValueI32Value{Value: int32(value.Int.Int64())}, nil
+	case sbor.KindI64:
+		return ManifestValueI64Value{Value: value.Int.Int64()}, nil
+	case sbor.KindI128:
+		return ManifestValueI128Value{Value: value.Int.String()}, nil
+	case sbor.KindU8:
+		return ManifestValueU8Value{Value: uint8(value.Int.Uint64())}, nil
+	case sbor.KindU16:
+		return ManifestValueU16Value{Value: uint16(value.Int.Uint64())}, nil
+	case sbor.KindU32:
+		return ManifestValueU32Value{Value: uint32(value.Int.Uint64())}, nil
+	case sbor.KindU64:
+		return ManifestValueU64Value{Value: value.Int.Uint64()}, nil
+	case sbor.KindU128:
+		return ManifestValueU128Value{Value: value.Int.String()}, nil
+	case sbor.KindString:
+		return ManifestValueStringValue{Value: value.String}, nil
+	case sbor.KindArray:
+		elementKind, err := ManifestValueKindFromSbor(value.ElementKind, extension)
+		if err != nil {
+			return nil, err
+		}
+		elements, err := sborManifestValues(value.Elements, extension, networkId)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestValueArrayValue{ElementValueKind: elementKind, Elements: elements}, nil
+	case sbor.KindTuple:
+		fields, err := sborManifestValues(value.Elements, extension, networkId)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestValueTupleValue{Fields: fields}, nil
+	case sbor.KindEnum:
+		fields, err := sborManifestValues(value.Elements, extension, networkId)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestValueEnumValue{Discriminator: value.Discriminator, Fields: fields}, nil
+	case sbor.KindMap:
+		keyKind, err := ManifestValueKindFromSbor(value.KeyKind, extension)
+		if err != nil {
+			return nil, err
+		}
+		valueKind, err := ManifestValueKindFromSbor(value.ValueKind, extension)
+		if err != nil {
+			return nil, err
+		}
+		entries := make([]MapEntry, len(value.Entries))
+		for index, entry := range value.Entries {
+			if entries[index].Key, err = sborManifestValue(entry.Key, extension, networkId); err != nil {
+				return nil, err
+			}
+			if entries[index].Value, err = sborManifestValue(entry.Value, extension, networkId); err != nil {
+				return nil, err
+			}
+		}
+		return ManifestValueMapValue{KeyValueKind: keyKind, ValueValueKind: valueKind, Entries: entries}, nil
+	}
+	if err := extension.CheckCustom(value.Kind, value.Custom); err != nil {
+		return nil, sborError(extension, err.Error())
+	}
+	if extension == sbor.Manifest {
+		return sborManifestCustomValue(value, networkId)
+	}
+	switch value.Kind {
+	case sbor.KindScryptoReference, sbor.KindScryptoOwn:
+		nodeId, err := AddressFromRaw(value.Custom, networkId)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestValueAddressValue{Value: ManifestAddressStatic{StaticAddress: nodeId}}, nil
+	case sbor.KindScryptoDecimal:
+		return ManifestValueDecimalValue{Value: DecimalFromLeBytes(value.Custom)}, nil
+	case sbor.KindScryptoPreciseDecimal:
+		return ManifestValuePreciseDecimalValue{Value: PreciseDecimalFromLeBytes(value.Custom)}, nil
+	case sbor.KindScryptoNonFungibleLocalId:
+		id, err := sborNonFungibleLocalId(value.Custom)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestValueNonFungibleLocalIdValue{Value: id}, nil
+	}
+	return nil, NewRadixEngineToolkitErrorScryptoSborError(fmt.Sprintf("unknown value kind 0x%02x", byte(value.Kind)))
+}
+
+// sborManifestCustomValue converts a value of a custom kind of Manifest SBOR
+// whose body was checked.
+func sborManifestCustomValue(value sbor.Value, networkId uint8) (ManifestValue, error) {
+	body := value.Custom
+	index := func() uint32 {
+		return binary.LittleEndian.Uint32(body[len(body)-4:])
+	}
+	switch value.Kind {
+	case sbor.KindManifestAddress:
+		if body[0] == 1 {
+			return ManifestValueAddressValue{Value: ManifestAddressNamed{NamedAddressId: index()}}, nil
+		}
+		nodeId, err := AddressFromRaw(body[1:], networkId)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestValueAddressValue{Value: ManifestAddressStatic{StaticAddress: nodeId}}, nil
+	case sbor.KindManifestBucket:
+		return ManifestValueBucketValue{Value: ManifestBucket{Value: index()}}, nil
+	case sbor.KindManifestProof:
+		return ManifestValueProofValue{Value: ManifestProof{Value: index()}}, nil
+	case sbor.KindManifestExpression:
+		if body[0] > 1 {
+			return nil, NewRadixEngineToolkitErrorManifestSborError(fmt.Sprintf("invalid manifest expression %d", body[0]))
+		}
+		return ManifestValueExpressionValue{Value: ManifestExpressionEntireWorktop + ManifestExpression(body[0])}, nil
+	case sbor.KindManifestBlob:
+		hash, err := NewHash(body)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestValueBlobValue{Value: ManifestBlobRef{Value: hash}}, nil
+	case sbor.KindManifestDecimal:
+		return ManifestValueDecimalValue{Value: DecimalFromLeBytes(body)}, nil
+	case sbor.KindManifestPreciseDecimal:
+		return ManifestValuePreciseDecimalValue{Value: PreciseDecimalFromLeBytes(body)}, nil
+	case sbor.KindManifestNonFungibleLocalId:
+		id, err := sborNonFungibleLocalId(body)
+		if err != nil {
+			return nil, err
+		}
+		return ManifestValueNonFungibleLocalIdValue{Value: id}, nil
+	case sbor.KindManifestAddressReservation:
+		return ManifestValueAddressReservationValue{Value: ManifestAddressReservation{Value: index()}}, nil
+	}
+	return nil, NewRadixEngineToolkitErrorManifestSborError(fmt.Sprintf("unknown value kind 0x%02x", byte(value.Kind)))
+}
+
+func sborManifestValues(values []sbor.Value, extension sbor.Extension, networkId uint8) ([]ManifestValue, error) {
+	converted := make([]ManifestValue, len(values))
+	for index, value := range values {
+		var err error
+		if converted[index], err = sborManifestValue(value, extension, networkId); err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+// sborError returns the ManifestSborError or ScryptoSborError of the
+// extension.
+func sborError(extension sbor.Extension, reason string) error {
+	if extension == sbor.Manifest {
+		return NewRadixEngineToolkitErrorManifestSborError(reason)
+	}
+	return NewRadixEngineToolkitErrorScryptoSborError(reason)
+}
@@ -0,0 +1,322 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// programmaticValue is a value in the programmatic JSON representation of
+// Scrypto SBOR, without the type and field names a schema would add:
+//
+//	{"kind": "Tuple", "fields": [
+//		{"kind": "Reference", "value": "resource_rdx1…"},
+//		{"kind": "Enum", "variant_id": "1", "fields": [{"kind": "U8", "value": "7"}]},
+//		{"kind": "Bytes", "element_kind": "U8", "hex": "0a0b"}
+//	]}
+type programmaticValue struct {
+	Kind        string               `json:"kind"`
+	Value       json.RawMessage      `json:"value,omitempty"`
+	VariantId   json.RawMessage      `json:"variant_id,omitempty"`
+	ElementKind string               `json:"element_kind,omitempty"`
+	KeyKind     string               `json:"key_kind,omitempty"`
+	ValueKind   string               `json:"value_kind,omitempty"`
+	Hex         *string              `json:"hex,omitempty"`
+	Fields      *[]programmaticValue `json:"fields,omitempty"`
+	Elements    *[]programmaticValue `json:"elements,omitempty"`
+	Entries     *[]programmaticEntry `json:"entries,omitempty"`
+}
+
+type programmaticEntry struct {
+	Key   programmaticValue `json:"key"`
+	Value programmaticValue `json:"value"`
+}
+
+// ScryptoSborStringFromValue converts a Scrypto SBOR value into its
+// programmatic JSON, as accepted by ScryptoSborEncodeStringRepresentation,
+// its references and owned objects being addresses on the network.
+func ScryptoSborStringFromValue(value sbor.Value, networkId uint8) (ScryptoSborString, error) {
+	programmatic, err := sborProgrammaticValue(value, networkId)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(programmatic)
+	if err != nil {
+		return nil, NewRadixEngineToolkitErrorScryptoSborError(err.Error())
+	}
+	return ScryptoSborStringProgrammaticJson{Value: string(encoded)}, nil
+}
+
+// ScryptoSborValueFromString converts the programmatic JSON of a Scrypto SBOR
+// value into the value, the reverse of ScryptoSborStringFromValue. The type,
+// field and variant names of the JSON are ignored, and a variant id may be a
+// number or a string.
+func ScryptoSborValueFromString(representation ScryptoSborString) (sbor.Value, error) {
+	programmaticJson, ok := representation.(ScryptoSborStringProgrammaticJson)
+	if !ok {
+		return sbor.Value{}, NewRadixEngineToolkitErrorScryptoSborError(fmt.Sprintf("unknown representation %T", representation))
+	}
+	var programmatic programmaticValue
+	if err := json.Unmarshal([]byte(programmaticJson.Value), &programmatic); err != nil {
+		return sbor.Value{}, NewRadixEngineToolkitErrorScryptoSborError(err.Error())
+	}
+	return programmaticSborValue(programmatic, "$", 0)
+}
+
+func sborProgrammaticValue(value sbor.Value, networkId uint8) (programmaticValue, error) {
+	kindName, ok := sbor.Scrypto.KindName(value.Kind)
+	if !ok {
+		return programmaticValue{}, NewRadixEngineToolkitErrorScryptoSborError(fmt.Sprintf("unknown value kind 0x%02x", byte(value.Kind)))
+	}
+	programmatic := programmaticValue{Kind: kindName}
+	quoted := func(value string) json.RawMessage {
+		encoded, _ := json.Marshal(value)
+		return encoded
+	}
+	values := func(values []sbor.Value) (*[]programmaticValue, error) {
+		converted := make([]programmaticValue, len(values))
+		for index, value := range values {
+			var err error
+			if converted[index], err = sborProgrammaticValue(value, networkId); err != nil {
+				return nil, err
+			}
+		}
+		return &converted, nil
+	}
+	var err error
+	switch value.Kind {
+	case sbor.KindBool:
+		programmatic.Value = json.RawMessage(strconv.FormatBool(value.Bool))
+	case sbor.KindI8, sbor.KindI16, sbor.KindI32, sbor.KindI64, sbor.KindI128,
+		sbor.KindU8, sbor.KindU16, sbor.KindU32, sbor.KindU64, sbor.KindU128:
+		programmatic.Value = quoted(value.Int.String())
+	case sbor.KindString:
+		programmatic.Value = quoted(value.String)
+	case sbor.KindArray:
+		if programmatic.ElementKind, ok = sbor.Scrypto.KindName(value.ElementKind); !ok {
+			return programmaticValue{}, NewRadixEngineToolkitErrorScryptoSborError(fmt.Sprintf("unknown value kind 0x%02x", byte(value.ElementKind)))
+		}
+		if bytes, ok := value.Bytes(); ok {
+			programmatic.Kind = "Bytes"
+			encoded := hex.EncodeToString(bytes)
+			programmatic.Hex = &encoded
+			break
+		}
+		programmatic.Elements, err = values(value.Elements)
+	case sbor.KindTuple:
+		programmatic.Fields, err = values(value.Elements)
+	case sbor.KindEnum:
+		programmatic.VariantId = quoted(strconv.Itoa(int(value.Discriminator)))
+		programmatic.Fields, err = values(value.Elements)
+	case sbor.KindMap:
+		if programmatic.KeyKind, ok = sbor.Scrypto.KindName(value.KeyKind); !ok {
+			return programmaticValue{}, NewRadixEngineToolkitErrorScryptoSborError(fmt.Sprintf("unknown value kind 0x%02x", byte(value.KeyKind)))
+		}
+		if programmatic.ValueKind, ok = sbor.Scrypto.KindName(value.ValueKind); !ok {
+			return programmaticValue{}, NewRadixEngineToolkitErrorScryptoSborError(fmt.Sprintf("unknown value kind 0x%02x", byte(value.ValueKind)))
+		}
+		entries := make([]programmaticEntry, len(value.Entries))
+		for index, entry := range value.Entries {
+			if entries[index].Key, err = sborProgrammaticValue(entry.Key, networkId); err != nil {
+				return programmaticValue{}, err
+			}
+			if entries[index].Value, err = sborProgrammaticValue(entry.Value, networkId); err != nil {
+				return programmaticValue{}, err
+			}
+		}
+		programmatic.Entries = &entries
+	default:
+		if err := sbor.Scrypto.CheckCustom(value.Kind, value.Custom); err != nil {
+			return programmaticValue{}, NewRadixEngineToolkitErrorScryptoSborError(err.Error())
+		}
+		var text string
+		switch value.Kind {
+		case sbor.KindScryptoReference, sbor.KindScryptoOwn:
+			nodeId, err := address.FromRaw(value.Custom, networkId)
+			if err != nil {
+				return programmaticValue{}, addressError(err)
+			}
+			text = nodeId.AddressString()
+		case sbor.KindScryptoDecimal:
+			decimal, err := DecimalValueFromLeBytes(value.Custom)
+			if err != nil {
+				return programmaticValue{}, err
+			}
+			text = decimal.String()
+		case sbor.KindScryptoPreciseDecimal:
+			decimal, err := PreciseDecimalValueFromLeBytes(value.Custom)
+			if err != nil {
+				return programmaticValue{}, err
+			}
+			text = decimal.String()
+		case sbor.KindScryptoNonFungibleLocalId:
+			id, err := sborNonFungibleLocalId(value.Custom)
+			if err != nil {
+				return programmaticValue{}, err
+			}
+			text = nonFungibleLocalIdString(id)
+		}
+		programmatic.Value = quoted(text)
+	}
+	return programmatic, err
+}
+
+// programmaticSborValue converts the programmatic JSON at path, e.g.
+// "$.fields[1]", into a Scrypto SBOR value.
+func programmaticSborValue(programmatic programmaticValue, path string, depth int) (sbor.Value, error) {
+	invalid := func(format string, args ...any) (sbor.Value, error) {
+		return sbor.Value{}, NewRadixEngineToolkitErrorScryptoSborError(fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+	}
+	if depth >= sbor.Scrypto.MaxDepth() {
+		return invalid("maximum depth %d exceeded", sbor.Scrypto.MaxDepth())
+	}
+	kindOf := func(name string) (sbor.Kind, bool) {
+		if name == "Bytes" {
+			return sbor.KindArray, true
+		}
+		return sbor.Scrypto.KindByName(name)
+	}
+	kind, ok := kindOf(programmatic.Kind)
+	if !ok {
+		return invalid("unknown kind %q", programmatic.Kind)
+	}
+	text := func() (string, error) {
+		var text string
+		if err := json.Unmarshal(programmatic.Value, &text); err != nil {
+			return "", fmt.Errorf("expected a string value")
+		}
+		return text, nil
+	}
+	values := func(values *[]programmaticValue, name string) ([]sbor.Value, error) {
+		if values == nil {
+			return nil, nil
+		}
+		converted := make([]sbor.Value, len(*values))
+		for index, value := range *values {
+			var err error
+			if converted[index], err = programmaticSborValue(value, fmt.Sprintf("%s.%s[%d]", path, name, index), depth+1); err != nil {
+				return nil, err
+			}
+		}
+		return converted, nil
+	}
+
+	value := sbor.Value{Kind: kind}
+	var err error
+	switch kind {
+	case sbor.KindBool:
+		if err := json.Unmarshal(programmatic.Value, &value.Bool); err != nil {
+			return invalid("expected a boolean value")
+		}
+	case sbor.KindI8, sbor.KindI16, sbor.KindI32, sbor.KindI64, sbor.KindI128,
+		sbor.KindU8, sbor.KindU16, sbor.KindU32, sbor.KindU64, sbor.KindU128:
+		integer, err := text()
+		if err != nil {
+			return invalid("%s", err)
+		}
+		if value.Int, ok = new(big.Int).SetString(integer, 10); !ok {
+			return invalid("invalid integer %q", integer)
+		}
+	case sbor.KindString:
+		if value.String, err = text(); err != nil {
+			return invalid("%s", err)
+		}
+	case sbor.KindArray:
+		if value.ElementKind, ok = kindOf(programmatic.ElementKind); !ok {
+			return invalid("unknown element kind %q", programmatic.ElementKind)
+		}
+		if programmatic.Hex != nil {
+			bytes, err := hex.DecodeString(*programmatic.Hex)
+			if err != nil || value.ElementKind != sbor.KindU8 {
+				return invalid("invalid bytes")
+			}
+			value.Elements = make([]sbor.Value, len(bytes))
+			for index, b := range bytes {
+				value.Elements[index] = sbor.Value{Kind: sbor.KindU8, Int: big.NewInt(int64(b))}
+			}
+			break
+		}
+		if value.Elements, err = values(programmatic.Elements, "elements"); err != nil {
+			return sbor.Value{}, err
+		}
+		for index, element := range value.Elements {
+			if element.Kind != value.ElementKind {
+				return invalid("element %d is a %s in an array of %s", index, (*programmatic.Elements)[index].Kind, programmatic.ElementKind)
+			}
+		}
+	case sbor.KindTuple, sbor.KindEnum:
+		if kind == sbor.KindEnum {
+			var variantId any
+			if err := json.Unmarshal(programmatic.VariantId, &variantId); err != nil {
+				return invalid("missing variant id")
+			}
+			discriminator, err := strconv.ParseUint(fmt.Sprint(variantId), 10, 8)
+			if err != nil {
+				return invalid("invalid variant id %v", variantId)
+			}
+			value.Discriminator = uint8(discriminator)
+		}
+		if value.Elements, err = values(programmatic.Fields, "fields"); err != nil {
+			return sbor.Value{}, err
+		}
+	case sbor.KindMap:
+		if value.KeyKind, ok = kindOf(programmatic.KeyKind); !ok {
+			return invalid("unknown key kind %q", programmatic.KeyKind)
+		}
+		if value.ValueKind, ok = kindOf(programmatic.ValueKind); !ok {
+			return invalid("unknown value kind %q", programmatic.ValueKind)
+		}
+		if programmatic.Entries != nil {
+			value.Entries = make([]sbor.Entry, len(*programmatic.Entries))
+		}
+		for index, entry := range value.Entries {
+			programmaticEntry := (*programmatic.Entries)[index]
+			entryPath := fmt.Sprintf("%s.entries[%d]", path, index)
+			if entry.Key, err = programmaticSborValue(programmaticEntry.Key, entryPath+".key", depth+1); err != nil {
+				return sbor.Value{}, err
+			}
+			if entry.Value, err = programmaticSborValue(programmaticEntry.Value, entryPath+".value", depth+1); err != nil {
+				return sbor.Value{}, err
+			}
+			if entry.Key.Kind != value.KeyKind || entry.Value.Kind != value.ValueKind {
+				return invalid("entry %d is not of the kinds of the map", index)
+			}
+			value.Entries[index] = entry
+		}
+	default:
+		custom, err := text()
+		if err != nil {
+			return invalid("%s", err)
+		}
+		switch kind {
+		case sbor.KindScryptoReference, sbor.KindScryptoOwn:
+			decoded, err := address.New(custom)
+			if err != nil {
+				return sbor.Value{}, addressError(err)
+			}
+			value.Custom = decoded.Bytes()
+		case sbor.KindScryptoDecimal:
+			decimal, err := ParseDecimalValue(custom)
+			if err != nil {
+				return sbor.Value{}, err
+			}
+			value.Custom = decimal.ToLeBytes()
+		case sbor.KindScryptoPreciseDecimal:
+			decimal, err := ParsePreciseDecimalValue(custom)
+			if err != nil {
+				return sbor.Value{}, err
+			}
+			value.Custom = decimal.ToLeBytes()
+		case sbor.KindScryptoNonFungibleLocalId:
+			if value.Custom, err = nonFungibleLocalIdSbor(custom); err != nil {
+				return sbor.Value{}, err
+			}
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,128 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/schema"
+)
+
+// UnmarshalScryptoSbor decodes a Scrypto SBOR payload, such as the data of an
+// event or the return value of a method, into the Go value target points to,
+// with the rules of UnmarshalManifestValue. References and owned objects
+// decode as the static addresses of their node ids on the network.
+func UnmarshalScryptoSbor(payload []byte, networkId uint8, target any) error {
+	value, err := sbor.Decode(payload, sbor.Scrypto)
+	if err != nil {
+		return NewRadixEngineToolkitErrorScryptoSborError(err.Error())
+	}
+	manifestValue, err := sborManifestValue(value, sbor.Scrypto, networkId)
+	if err != nil {
+		return err
+	}
+	return UnmarshalManifestValue(manifestValue, target)
+}
+
+// UnmarshalScryptoSborWithSchema decodes a Scrypto SBOR payload into the Go
+// value target points to like UnmarshalScryptoSbor, having first validated it
+// against the type of the schema, typically into the types cmd/schemagen
+// generated from the schema. A payload which is not of the type returns a
+// RadixEngineToolkitErrorSchemaValidationError, from which errors.As extracts
+// a *schema.ValidationError locating the offending value by the names of the
+// schema, e.g. "Swap.amounts[1]", rather than by the Go fields it would have
+// been decoded into.
+func UnmarshalScryptoSborWithSchema(payload []byte, networkId uint8, typeSchema Schema, target any) error {
+	value, err := sbor.Decode(payload, sbor.Scrypto)
+	if err != nil {
+		return NewRadixEngineToolkitErrorScryptoSborError(err.Error())
+	}
+	parsed, err := schema.Decode(typeSchema.Schema)
+	if err != nil {
+		return NewRadixEngineToolkitErrorScryptoSborError(err.Error())
+	}
+	var id schema.LocalTypeId
+	switch localTypeId := typeSchema.LocalTypeId.(type) {
+	case LocalTypeIdWellKnown:
+		id = schema.LocalTypeId{WellKnown: true, Index: uint64(localTypeId.Value)}
+	case LocalTypeIdSchemaLocalIndex:
+		id = schema.LocalTypeId{Index: localTypeId.Value}
+	default:
+		return NewRadixEngineToolkitErrorScryptoSborError("missing local type id")
+	}
+	if err := parsed.Validate(value, id); err != nil {
+		var validationError *schema.ValidationError
+		if errors.As(err, &validationError) {
+			return NewRadixEngineToolkitErrorSchemaValidationError(validationError)
+		}
+		return NewRadixEngineToolkitErrorScryptoSborError(err.Error())
+	}
+	manifestValue, err := sborManifestValue(value, sbor.Scrypto, networkId)
+	if err != nil {
+		return err
+	}
+	return UnmarshalManifestValue(manifestValue, target)
+}
+
+var ErrRadixEngineToolkitErrorSchemaValidationError = fmt.Errorf("RadixEngineToolkitErrorSchemaValidationError")
+
+// RadixEngineToolkitErrorSchemaValidationError reports a payload which is not
+// of the type of its schema. It unwraps to Err.
+type RadixEngineToolkitErrorSchemaValidationError struct {
+	Err *schema.ValidationError
+}
+
+func NewRadixEngineToolkitErrorSchemaValidationError(
+	err *schema.ValidationError,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorSchemaValidationError{
+			Err: err,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorSchemaValidationError) Error() string {
+	return fmt.Sprint("SchemaValidationError",
+		": ",
+
+		"Err=",
+		err.Err,
+	)
+}
+
+func (self RadixEngineToolkitErrorSchemaValidationError) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorSchemaValidationError
+}
+
+func (err RadixEngineToolkitErrorSchemaValidationError) Unwrap() error {
+	return err.Err
+}
+
+// sborNonFungibleLocalId decodes the body of a NonFungibleLocalId value: its
+// type, then the string, the big endian integer, the bytes or the RUID.
+func sborNonFungibleLocalId(body []byte) (NonFungibleLocalId, error) {
+	switch body[0] {
+	case 0:
+		return NonFungibleLocalIdStr{Value: string(skipSborSize(body[1:]))}, nil
+	case 1:
+		return NonFungibleLocalIdInteger{Value: binary.BigEndian.Uint64(body[1:])}, nil
+	case 2:
+		return NonFungibleLocalIdBytes{Value: append([]byte(nil), skipSborSize(body[1:])...)}, nil
+	case 3:
+		return NonFungibleLocalIdRuid{Value: append([]byte(nil), body[1:]...)}, nil
+	}
+	return nil, NewRadixEngineToolkitErrorScryptoSborError(fmt.Sprintf("invalid non-fungible local id type %d", body[0]))
+}
+
+// skipSborSize returns the bytes following the LEB128 size at the start of
+// body.
+func skipSborSize(body []byte) []byte {
+	for index, b := range body {
+		if b&0x80 == 0 {
+			return body[index+1:]
+		}
+	}
+	return nil
+}
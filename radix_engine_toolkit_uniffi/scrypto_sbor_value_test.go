@@ -0,0 +1,51 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/schema"
+)
+
+func TestUnmarshalScryptoSborWithSchemaErrors(t *testing.T) {
+	// A schema of no types, whose payloads are of well known types.
+	emptySchema, err := sbor.Encode(sbor.Value{Kind: sbor.KindEnum, Elements: []sbor.Value{{Kind: sbor.KindTuple, Elements: []sbor.Value{
+		{Kind: sbor.KindArray, ElementKind: sbor.KindEnum},
+		{Kind: sbor.KindArray, ElementKind: sbor.KindTuple},
+		{Kind: sbor.KindArray, ElementKind: sbor.KindEnum},
+	}}}}, sbor.Scrypto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	typeSchema := Schema{LocalTypeId: LocalTypeIdWellKnown{Value: schema.WellKnownString}, Schema: emptySchema}
+	payload, err := sbor.Encode(sbor.Value{Kind: sbor.KindU32, Int: big.NewInt(1)}, sbor.Scrypto)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var output string
+	err = UnmarshalScryptoSborWithSchema(payload, 1, typeSchema, &output)
+	var toolkitError *RadixEngineToolkitError
+	var validationError *schema.ValidationError
+	if !errors.As(err, &toolkitError) || !errors.Is(err, ErrRadixEngineToolkitErrorSchemaValidationError) {
+		t.Errorf("UnmarshalScryptoSborWithSchema of a U32 as a String = %v, want a RadixEngineToolkitErrorSchemaValidationError", err)
+	}
+	if !errors.As(err, &validationError) || validationError.Reason != "expected String, found U32" {
+		t.Errorf("UnmarshalScryptoSborWithSchema of a U32 as a String = %v, want a *schema.ValidationError", err)
+	}
+
+	for _, test := range []struct {
+		name            string
+		payload, schema []byte
+	}{
+		{"invalid payload", payload[:1], emptySchema},
+		{"invalid schema", payload, emptySchema[:2]},
+	} {
+		err := UnmarshalScryptoSborWithSchema(test.payload, 1, Schema{LocalTypeId: typeSchema.LocalTypeId, Schema: test.schema}, &output)
+		if !errors.Is(err, ErrRadixEngineToolkitErrorScryptoSborError) {
+			t.Errorf("UnmarshalScryptoSborWithSchema with an %s = %v, want a RadixEngineToolkitErrorScryptoSborError", test.name, err)
+		}
+	}
+}
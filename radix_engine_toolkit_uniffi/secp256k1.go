@@ -0,0 +1,196 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"crypto/subtle"
+	"errors"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// Constant time Diffie-Hellman on the secp256k1 curve, y² = x³ + 7, for the
+// key agreement of encrypted messages. The decred package parses and
+// serializes keys and provides the constant time field arithmetic; its
+// scalar multiplication is variable time, so the multiplication here is a
+// Montgomery ladder of a fixed 256 steps over the complete projective
+// addition formulas of Renes, Costello and Batina (2015, algorithm 7), with
+// conditional swaps which do not depend on the secret bits through branches
+// or memory accesses.
+
+const secp256k1B3 = 3 * 7
+
+// secp256k1Generator is the compressed encoding of the generator G.
+var secp256k1Generator = []byte{
+	0x02, 0x79, 0xbe, 0x66, 0x7e, 0xf9, 0xdc, 0xbb, 0xac, 0x55, 0xa0, 0x62, 0x95, 0xce, 0x87, 0x0b, 0x07,
+	0x02, 0x9b, 0xfc, 0xdb, 0x2d, 0xce, 0x28, 0xd9, 0x59, 0xf2, 0x81, 0x5b, 0x16, 0xf8, 0x17, 0x98,
+}
+
+// secp256k1Point is a point in homogeneous projective coordinates, (X:Y:Z)
+// standing for (X/Z, Y/Z) and (0:1:0) for the point at infinity. The
+// coordinates are kept normalized.
+type secp256k1Point struct {
+	x, y, z secp256k1.FieldVal
+}
+
+func secp256k1Infinity() secp256k1Point {
+	var point secp256k1Point
+	point.y.SetInt(1)
+	return point
+}
+
+func secp256k1Affine(publicKey *secp256k1.PublicKey) secp256k1Point {
+	var jacobian secp256k1.JacobianPoint
+	publicKey.AsJacobian(&jacobian)
+	return secp256k1Point{x: jacobian.X, y: jacobian.Y, z: jacobian.Z}
+}
+
+func fieldMul(a, b *secp256k1.FieldVal) secp256k1.FieldVal {
+	var result secp256k1.FieldVal
+	result.Mul2(a, b).Normalize()
+	return result
+}
+
+func fieldAdd(a, b *secp256k1.FieldVal) secp256k1.FieldVal {
+	var result secp256k1.FieldVal
+	result.Add2(a, b).Normalize()
+	return result
+}
+
+func fieldSub(a, b *secp256k1.FieldVal) secp256k1.FieldVal {
+	var result secp256k1.FieldVal
+	result.NegateVal(b, 1).Add(a).Normalize()
+	return result
+}
+
+func fieldMulB3(a *secp256k1.FieldVal) secp256k1.FieldVal {
+	var result secp256k1.FieldVal
+	result.Set(a).MulInt(secp256k1B3).Normalize()
+	return result
+}
+
+// secp256k1Add returns p + q. The formulas are complete: they hold for
+// doubling and for the point at infinity as well.
+func secp256k1Add(p, q *secp256k1Point) secp256k1Point {
+	t0 := fieldMul(&p.x, &q.x)
+	t1 := fieldMul(&p.y, &q.y)
+	t2 := fieldMul(&p.z, &q.z)
+	t3 := fieldAdd(&p.x, &p.y)
+	t4 := fieldAdd(&q.x, &q.y)
+	t3 = fieldMul(&t3, &t4)
+	t4 = fieldAdd(&t0, &t1)
+	t3 = fieldSub(&t3, &t4)
+	t4 = fieldAdd(&p.y, &p.z)
+	x3 := fieldAdd(&q.y, &q.z)
+	t4 = fieldMul(&t4, &x3)
+	x3 = fieldAdd(&t1, &t2)
+	t4 = fieldSub(&t4, &x3)
+	x3 = fieldAdd(&p.x, &p.z)
+	y3 := fieldAdd(&q.x, &q.z)
+	x3 = fieldMul(&x3, &y3)
+	y3 = fieldAdd(&t0, &t2)
+	y3 = fieldSub(&x3, &y3)
+	x3 = fieldAdd(&t0, &t0)
+	t0 = fieldAdd(&x3, &t0)
+	t2 = fieldMulB3(&t2)
+	z3 := fieldAdd(&t1, &t2)
+	t1 = fieldSub(&t1, &t2)
+	y3 = fieldMulB3(&y3)
+	x3 = fieldMul(&t4, &y3)
+	t2 = fieldMul(&t3, &t1)
+	x3 = fieldSub(&t2, &x3)
+	y3 = fieldMul(&y3, &t0)
+	t1 = fieldMul(&t1, &z3)
+	y3 = fieldAdd(&t1, &y3)
+	t0 = fieldMul(&t0, &t3)
+	z3 = fieldMul(&z3, &t4)
+	z3 = fieldAdd(&z3, &t0)
+	return secp256k1Point{x: x3, y: y3, z: z3}
+}
+
+// fieldSwap swaps a and b when swap is 1 and leaves them when it is 0.
+func fieldSwap(a, b *secp256k1.FieldVal, swap int) {
+	var aBytes, bBytes, tmp [32]byte
+	a.PutBytes(&aBytes)
+	b.PutBytes(&bBytes)
+	tmp = aBytes
+	subtle.ConstantTimeCopy(swap, aBytes[:], bBytes[:])
+	subtle.ConstantTimeCopy(swap, bBytes[:], tmp[:])
+	a.SetBytes(&aBytes)
+	b.SetBytes(&bBytes)
+}
+
+func secp256k1Swap(p, q *secp256k1Point, swap int) {
+	fieldSwap(&p.x, &q.x, swap)
+	fieldSwap(&p.y, &q.y, swap)
+	fieldSwap(&p.z, &q.z, swap)
+}
+
+// secp256k1ScalarMult returns scalar × point in constant time, scalar being
+// a 32 byte big endian integer.
+func secp256k1ScalarMult(point secp256k1Point, scalar *[32]byte) secp256k1Point {
+	r0, r1 := secp256k1Infinity(), point
+	for index := 0; index < 256; index++ {
+		bit := int(scalar[index/8]>>(7-index%8)) & 1
+		secp256k1Swap(&r0, &r1, bit)
+		r1 = secp256k1Add(&r0, &r1)
+		r0 = secp256k1Add(&r0, &r0)
+		secp256k1Swap(&r0, &r1, bit)
+	}
+	return r0
+}
+
+// secp256k1Scalar checks that a raw private key is a scalar in [1, n).
+func secp256k1Scalar(privateKey []byte) (*[32]byte, error) {
+	var scalar secp256k1.ModNScalar
+	if len(privateKey) != secp256k1KeyLength || scalar.SetByteSlice(privateKey) || scalar.IsZero() {
+		return nil, errors.New("invalid secp256k1 private key")
+	}
+	bytes := scalar.Bytes()
+	return &bytes, nil
+}
+
+// secp256k1PublicKey returns the compressed public key of a private key.
+func secp256k1PublicKey(privateKey []byte) ([]byte, error) {
+	scalar, err := secp256k1Scalar(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	generator, err := secp256k1.ParsePubKey(secp256k1Generator)
+	if err != nil {
+		return nil, err
+	}
+	x, y, err := secp256k1ToAffine(secp256k1ScalarMult(secp256k1Affine(generator), scalar))
+	if err != nil {
+		return nil, err
+	}
+	return secp256k1.NewPublicKey(&x, &y).SerializeCompressed(), nil
+}
+
+// secp256k1SharedSecret returns the x coordinate of privateKey × publicKey,
+// publicKey being a compressed or uncompressed SEC1 public key.
+func secp256k1SharedSecret(privateKey, publicKey []byte) ([]byte, error) {
+	scalar, err := secp256k1Scalar(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := secp256k1.ParsePubKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	x, _, err := secp256k1ToAffine(secp256k1ScalarMult(secp256k1Affine(parsed), scalar))
+	if err != nil {
+		return nil, err
+	}
+	secret := x.Bytes()
+	return secret[:], nil
+}
+
+func secp256k1ToAffine(point secp256k1Point) (x, y secp256k1.FieldVal, err error) {
+	if point.z.IsZero() {
+		return x, y, errors.New("secp256k1 key agreement produced the point at infinity")
+	}
+	var inverse secp256k1.FieldVal
+	inverse.Set(&point.z).Inverse()
+	x = fieldMul(&point.x, &inverse)
+	y = fieldMul(&point.y, &inverse)
+	return x, y, nil
+}
@@ -0,0 +1,325 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// SignerV2 is the fallible counterpart of Signer for signers which live
+// outside of the process, such as a KMS, an HSM or a remote vault. Every
+// operation receives the context of the builder call it was made for and may
+// fail; the failure is reported by the builder as a
+// RadixEngineToolkitErrorSignerError which unwraps to the error of the
+// signer, so that errors.Is(err, context.DeadlineExceeded) and errors.As on
+// the error types of the signer see through it.
+type SignerV2 interface {
+	Sign(ctx context.Context, hash *Hash) ([]byte, error)
+
+	SignToSignature(ctx context.Context, hash *Hash) (SignatureV1, error)
+
+	SignToSignatureWithPublicKey(ctx context.Context, hash *Hash) (SignatureWithPublicKeyV1, error)
+
+	PublicKey(ctx context.Context) (PublicKey, error)
+}
+
+const (
+	secp256k1SignatureLength = 65
+	ed25519SignatureLength   = 64
+	secp256k1PublicKeyLength = 33
+	ed25519PublicKeyLength   = 32
+)
+
+// signerV2Adapter exposes a SignerV2 through the infallible Signer callback
+// interface understood by the native library.
+//
+// The native side cannot be told that a callback failed, so the adapter
+// records the first failure and answers with a well-formed placeholder
+// signature instead. The builder methods taking a SignerV2 discard whatever
+// the native call produced once a failure has been recorded.
+type signerV2Adapter struct {
+	ctx       context.Context
+	signer    SignerV2
+	publicKey PublicKey
+
+	lock sync.Mutex
+	err  error
+}
+
+func newSignerV2Adapter(ctx context.Context, signer SignerV2) (*signerV2Adapter, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, signerError(err)
+	}
+	adapter := &signerV2Adapter{ctx: ctx, signer: signer}
+	publicKey, err := callSigner(func() (PublicKey, error) {
+		return signer.PublicKey(ctx)
+	})
+	if err == nil {
+		err = validatePublicKey(publicKey)
+	}
+	if err != nil {
+		return nil, signerError(err)
+	}
+	adapter.publicKey = publicKey
+	return adapter, nil
+}
+
+// signerFailure is a RadixEngineToolkitErrorSignerError which keeps the
+// error of the signer as its cause.
+type signerFailure struct {
+	*RadixEngineToolkitError
+	cause error
+}
+
+func (err *signerFailure) Unwrap() []error {
+	return []error{err.RadixEngineToolkitError, err.cause}
+}
+
+func signerError(err error) error {
+	return &signerFailure{NewRadixEngineToolkitErrorSignerError(err.Error()), err}
+}
+
+// callSigner invokes a SignerV2 operation, turning a panic of the signer
+// into an error since it would otherwise unwind through the native library.
+func callSigner[T any](operation func() (T, error)) (result T, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("signer panicked: %v", recovered)
+		}
+	}()
+	return operation()
+}
+
+func (adapter *signerV2Adapter) fail(err error) {
+	adapter.lock.Lock()
+	defer adapter.lock.Unlock()
+	if adapter.err == nil {
+		adapter.err = err
+	}
+}
+
+// result returns the first failure recorded while the native library was
+// calling back into the adapter.
+func (adapter *signerV2Adapter) result() error {
+	adapter.lock.Lock()
+	defer adapter.lock.Unlock()
+	if adapter.err != nil {
+		return signerError(adapter.err)
+	}
+	if err := adapter.ctx.Err(); err != nil {
+		return signerError(err)
+	}
+	return nil
+}
+
+func (adapter *signerV2Adapter) isSecp256k1() bool {
+	_, ok := adapter.publicKey.(PublicKeySecp256k1)
+	return ok
+}
+
+func (adapter *signerV2Adapter) signatureLength() int {
+	if adapter.isSecp256k1() {
+		return secp256k1SignatureLength
+	}
+	return ed25519SignatureLength
+}
+
+func (adapter *signerV2Adapter) placeholderSignature() SignatureV1 {
+	if adapter.isSecp256k1() {
+		return SignatureV1Secp256k1{Value: make([]byte, secp256k1SignatureLength)}
+	}
+	return SignatureV1Ed25519{Value: make([]byte, ed25519SignatureLength)}
+}
+
+func (adapter *signerV2Adapter) placeholderSignatureWithPublicKey() SignatureWithPublicKeyV1 {
+	if adapter.isSecp256k1() {
+		return SignatureWithPublicKeyV1Secp256k1{Signature: make([]byte, secp256k1SignatureLength)}
+	}
+	return SignatureWithPublicKeyV1Ed25519{
+		Signature: make([]byte, ed25519SignatureLength),
+		PublicKey: adapter.publicKey.(PublicKeyEd25519).Value,
+	}
+}
+
+func (adapter *signerV2Adapter) Sign(hash *Hash) []byte {
+	signature, err := callSigner(func() ([]byte, error) {
+		return adapter.signer.Sign(adapter.ctx, hash)
+	})
+	if err == nil && len(signature) != adapter.signatureLength() {
+		err = fmt.Errorf("invalid signature length %d, expected %d", len(signature), adapter.signatureLength())
+	}
+	if err != nil {
+		adapter.fail(err)
+		return make([]byte, adapter.signatureLength())
+	}
+	return signature
+}
+
+func (adapter *signerV2Adapter) SignToSignature(hash *Hash) SignatureV1 {
+	signature, err := callSigner(func() (SignatureV1, error) {
+		return adapter.signer.SignToSignature(adapter.ctx, hash)
+	})
+	if err == nil {
+		err = validateSignature(signature)
+	}
+	if err != nil {
+		adapter.fail(err)
+		return adapter.placeholderSignature()
+	}
+	return signature
+}
+
+func (adapter *signerV2Adapter) SignToSignatureWithPublicKey(hash *Hash) SignatureWithPublicKeyV1 {
+	signature, err := callSigner(func() (SignatureWithPublicKeyV1, error) {
+		return adapter.signer.SignToSignatureWithPublicKey(adapter.ctx, hash)
+	})
+	if err == nil {
+		err = validateSignatureWithPublicKey(signature)
+	}
+	if err != nil {
+		adapter.fail(err)
+		return adapter.placeholderSignatureWithPublicKey()
+	}
+	return signature
+}
+
+func (adapter *signerV2Adapter) PublicKey() PublicKey {
+	return adapter.publicKey
+}
+
+func validatePublicKey(publicKey PublicKey) error {
+	switch publicKey := publicKey.(type) {
+	case PublicKeySecp256k1:
+		return validateLength("secp256k1 public key", publicKey.Value, secp256k1PublicKeyLength)
+	case PublicKeyEd25519:
+		return validateLength("ed25519 public key", publicKey.Value, ed25519PublicKeyLength)
+	default:
+		return fmt.Errorf("invalid public key %v", publicKey)
+	}
+}
+
+func validateSignature(signature SignatureV1) error {
+	switch signature := signature.(type) {
+	case SignatureV1Secp256k1:
+		return validateLength("secp256k1 signature", signature.Value, secp256k1SignatureLength)
+	case SignatureV1Ed25519:
+		return validateLength("ed25519 signature", signature.Value, ed25519SignatureLength)
+	default:
+		return fmt.Errorf("invalid signature %v", signature)
+	}
+}
+
+func validateSignatureWithPublicKey(signature SignatureWithPublicKeyV1) error {
+	switch signature := signature.(type) {
+	case SignatureWithPublicKeyV1Secp256k1:
+		return validateLength("secp256k1 signature", signature.Signature, secp256k1SignatureLength)
+	case SignatureWithPublicKeyV1Ed25519:
+		if err := validateLength("ed25519 signature", signature.Signature, ed25519SignatureLength); err != nil {
+			return err
+		}
+		return validateLength("ed25519 public key", signature.PublicKey, ed25519PublicKeyLength)
+	default:
+		return fmt.Errorf("invalid signature %v", signature)
+	}
+}
+
+func validateLength(what string, value []byte, expected int) error {
+	if len(value) != expected {
+		return fmt.Errorf("invalid %s length %d, expected %d", what, len(value), expected)
+	}
+	return nil
+}
+
+// SignWithSignerV2 signs the intent with a SignerV2, returning a
+// RadixEngineToolkitErrorSignerError if the signer fails or ctx is done.
+func (_self *TransactionV1BuilderMessageStep) SignWithSignerV2(ctx context.Context, signer SignerV2) (*TransactionV1BuilderIntentSignaturesStep, error) {
+	adapter, err := newSignerV2Adapter(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	step := _self.SignWithSigner(adapter)
+	if err := adapter.result(); err != nil {
+		step.Destroy()
+		return nil, err
+	}
+	return step, nil
+}
+
+// SignWithSignerV2 signs the intent with a SignerV2, returning a
+// RadixEngineToolkitErrorSignerError if the signer fails or ctx is done.
+func (_self *TransactionV1BuilderIntentSignaturesStep) SignWithSignerV2(ctx context.Context, signer SignerV2) (*TransactionV1BuilderIntentSignaturesStep, error) {
+	adapter, err := newSignerV2Adapter(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	step := _self.SignWithSigner(adapter)
+	if err := adapter.result(); err != nil {
+		step.Destroy()
+		return nil, err
+	}
+	return step, nil
+}
+
+// NotarizeWithSignerV2 notarizes the transaction with a SignerV2, returning a
+// RadixEngineToolkitErrorSignerError if the signer fails or ctx is done.
+func (_self *TransactionV1BuilderIntentSignaturesStep) NotarizeWithSignerV2(ctx context.Context, signer SignerV2) (*NotarizedTransactionV1, error) {
+	adapter, err := newSignerV2Adapter(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := _self.NotarizeWithSigner(adapter)
+	if signerErr := adapter.result(); signerErr != nil {
+		if transaction != nil {
+			transaction.Destroy()
+		}
+		return nil, signerErr
+	}
+	return transaction, err
+}
+
+// SignWithSignerV2 signs the transaction intent with a SignerV2, returning a
+// RadixEngineToolkitErrorSignerError if the signer fails or ctx is done.
+func (_self *TransactionV2BuilderSignatureStep) SignWithSignerV2(ctx context.Context, signer SignerV2) (*TransactionV2BuilderSignatureStep, error) {
+	adapter, err := newSignerV2Adapter(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	step := _self.SignWithSigner(adapter)
+	if err := adapter.result(); err != nil {
+		step.Destroy()
+		return nil, err
+	}
+	return step, nil
+}
+
+// NotarizeWithSignerV2 notarizes the transaction with a SignerV2, returning a
+// RadixEngineToolkitErrorSignerError if the signer fails or ctx is done.
+func (_self *TransactionV2BuilderSignatureStep) NotarizeWithSignerV2(ctx context.Context, signer SignerV2) (*NotarizedTransactionV2, error) {
+	adapter, err := newSignerV2Adapter(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := _self.NotarizeWithSigner(adapter)
+	if signerErr := adapter.result(); signerErr != nil {
+		if transaction != nil {
+			transaction.Destroy()
+		}
+		return nil, signerErr
+	}
+	return transaction, err
+}
+
+// SignWithSignerV2 signs the subintent with a SignerV2, returning a
+// RadixEngineToolkitErrorSignerError if the signer fails or ctx is done.
+func (_self *SignedPartialTransactionV2BuilderSignatureStep) SignWithSignerV2(ctx context.Context, signer SignerV2) (*SignedPartialTransactionV2BuilderSignatureStep, error) {
+	adapter, err := newSignerV2Adapter(ctx, signer)
+	if err != nil {
+		return nil, err
+	}
+	step := _self.SignWithSigner(adapter)
+	if err := adapter.result(); err != nil {
+		step.Destroy()
+		return nil, err
+	}
+	return step, nil
+}
@@ -0,0 +1,95 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+type failingSigner struct {
+	err error
+}
+
+func (signer failingSigner) Sign(ctx context.Context, hash *Hash) ([]byte, error) {
+	return nil, signer.err
+}
+
+func (signer failingSigner) SignToSignature(ctx context.Context, hash *Hash) (SignatureV1, error) {
+	return nil, signer.err
+}
+
+func (signer failingSigner) SignToSignatureWithPublicKey(ctx context.Context, hash *Hash) (SignatureWithPublicKeyV1, error) {
+	return nil, signer.err
+}
+
+func (signer failingSigner) PublicKey(ctx context.Context) (PublicKey, error) {
+	if signer.err != nil {
+		return nil, signer.err
+	}
+	return PublicKeyEd25519{Value: make([]byte, ed25519PublicKeyLength)}, nil
+}
+
+func checkSignerError(t *testing.T, err error, cause error) {
+	t.Helper()
+	if !errors.Is(err, ErrRadixEngineToolkitErrorSignerError) {
+		t.Errorf("%v is not a RadixEngineToolkitErrorSignerError", err)
+	}
+	var toolkitErr *RadixEngineToolkitError
+	if !errors.As(err, &toolkitErr) {
+		t.Errorf("%v is not a *RadixEngineToolkitError", err)
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("%v does not unwrap to %v", err, cause)
+	}
+	if !strings.Contains(err.Error(), cause.Error()) {
+		t.Errorf("%q does not hold the message of %v", err, cause)
+	}
+}
+
+func TestSignerV2AdapterErrors(t *testing.T) {
+	kmsErr := &fs.PathError{Op: "sign", Path: "kms://key", Err: fs.ErrPermission}
+	_, err := newSignerV2Adapter(context.Background(), failingSigner{kmsErr})
+	checkSignerError(t, err, fs.ErrPermission)
+	var pathErr *fs.PathError
+	if !errors.As(err, &pathErr) || pathErr != kmsErr {
+		t.Errorf("errors.As(%v) = %v, want the error of the signer", err, pathErr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = newSignerV2Adapter(ctx, failingSigner{})
+	checkSignerError(t, err, context.Canceled)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	adapter, err := newSignerV2Adapter(ctx, failingSigner{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := adapter.result(); err != nil {
+		t.Fatalf("result = %v, want nil", err)
+	}
+	cancel()
+	checkSignerError(t, adapter.result(), context.Canceled)
+
+	adapter, err = newSignerV2Adapter(context.Background(), failingSigner{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	adapter.signer = failingSigner{context.DeadlineExceeded}
+	if signature := adapter.Sign(nil); len(signature) != ed25519SignatureLength {
+		t.Errorf("placeholder signature of length %d, want %d", len(signature), ed25519SignatureLength)
+	}
+	adapter.fail(errors.New("a later failure"))
+	checkSignerError(t, adapter.result(), context.DeadlineExceeded)
+}
+
+func TestCallSignerPanic(t *testing.T) {
+	_, err := callSigner(func() (PublicKey, error) {
+		panic("out of HSM sessions")
+	})
+	if err == nil || !strings.Contains(err.Error(), "out of HSM sessions") {
+		t.Errorf("callSigner of a panicking signer = %v", err)
+	}
+}
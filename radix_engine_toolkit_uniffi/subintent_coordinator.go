@@ -0,0 +1,646 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// Subintent orchestration
+//
+// A SubintentCoordinator assembles a transaction whose root intent yields to
+// the subintents of counterparties. It collects their signed partial
+// transactions, checking as each arrives that it is valid and that the
+// validity windows of all intents still overlap. The root manifest then
+// uses the children by the hashes AddChild returned, and Notarize checks the
+// subintent tree before signing:
+//
+//   - the children of the root manifest are the children collected;
+//   - every YieldToChild of the root manifest names a child, and every child
+//     is yielded to;
+//   - the VerifyParent rule of every child is not denied by the signers of
+//     the root intent. Only the signature requirements of a rule are known
+//     before execution, the other ones are left to the engine.
+//
+// For example:
+//
+//	coordinator := NewSubintentCoordinator(transactionHeader, intentHeader)
+//	hash, err := coordinator.AddChildPayload(payloadFromCounterparty)
+//	builder, err = builder.UseChild(hash, ManifestBuilderIntent{Name: "swap"})
+//	builder, err = builder.YieldToChild(ManifestBuilderIntent{Name: "swap"}, nil)
+//	transaction, err := coordinator.Notarize(ctx, builder.Build(), MessageV2None{}, signers, notary)
+
+var ErrRadixEngineToolkitErrorInvalidSubintentTree = fmt.Errorf("RadixEngineToolkitErrorInvalidSubintentTree")
+
+// RadixEngineToolkitErrorInvalidSubintentTree reports an inconsistency of a
+// transaction and its subintents, Subintent being the subintent hash of the
+// subintent at fault, or empty for the root intent.
+type RadixEngineToolkitErrorInvalidSubintentTree struct {
+	Subintent string
+	Reason    string
+}
+
+func NewRadixEngineToolkitErrorInvalidSubintentTree(
+	subintent string,
+	reason string,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorInvalidSubintentTree{
+			Subintent: subintent,
+			Reason:    reason,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorInvalidSubintentTree) Error() string {
+	return fmt.Sprint("InvalidSubintentTree",
+		": ",
+
+		"Subintent=",
+		err.Subintent,
+		", ",
+		"Reason=",
+		err.Reason,
+	)
+}
+
+func (self RadixEngineToolkitErrorInvalidSubintentTree) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorInvalidSubintentTree
+}
+
+// SubintentCoordinator collects the children of a transaction and notarizes
+// it. It is safe for concurrent use.
+type SubintentCoordinator struct {
+	transactionHeader TransactionHeaderV2
+	intentHeader      IntentHeaderV2
+
+	lock     sync.Mutex
+	window   intentWindow
+	children []coordinatedChild
+	// subintents are the hashes of all subintents of the children.
+	subintents map[string]bool
+}
+
+type coordinatedChild struct {
+	partial *SignedPartialTransactionV2
+	hash    *TransactionHash
+	// verifyParent are the access rules of the VerifyParent instructions of
+	// the root subintent of the child.
+	verifyParent []sbor.Value
+}
+
+// NewSubintentCoordinator returns a coordinator of a transaction with the
+// headers.
+func NewSubintentCoordinator(transactionHeader TransactionHeaderV2, intentHeader IntentHeaderV2) *SubintentCoordinator {
+	return &SubintentCoordinator{
+		transactionHeader: transactionHeader,
+		intentHeader:      intentHeader,
+		window:            intentWindowOf(intentHeader),
+		subintents:        map[string]bool{},
+	}
+}
+
+// AddChildPayload decodes a signed partial transaction and adds it as a
+// child.
+func (coordinator *SubintentCoordinator) AddChildPayload(payload []byte) (*TransactionHash, error) {
+	partial, err := SignedPartialTransactionV2FromPayloadBytes(payload)
+	if err != nil {
+		return nil, err
+	}
+	return coordinator.AddChild(partial)
+}
+
+// AddChild validates a signed partial transaction and adds it as a child,
+// returning the hash of its root subintent.
+func (coordinator *SubintentCoordinator) AddChild(partial *SignedPartialTransactionV2) (*TransactionHash, error) {
+	networkId := coordinator.intentHeader.NetworkId
+	hash, err := partial.RootSubintentHash()
+	if err != nil {
+		return nil, err
+	}
+	if err := partial.StaticallyValidate(networkId); err != nil {
+		return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(hash.AsStr(), err.Error())
+	}
+	payload, err := partial.ToPayloadBytes()
+	if err != nil {
+		return nil, err
+	}
+	cores, err := decodeSubintentCores(payload)
+	if err != nil {
+		return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(hash.AsStr(), err.Error())
+	}
+	partialTransaction := partial.PartialTransaction()
+	defer partialTransaction.Destroy()
+	nonRoot := partialTransaction.NonRootSubintents()
+	if len(cores) != 1+len(nonRoot) {
+		return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(hash.AsStr(), fmt.Sprintf("found %d subintents in a partial transaction of %d", len(cores), 1+len(nonRoot)))
+	}
+	hashes := []*TransactionHash{hash}
+	for _, subintent := range nonRoot {
+		subintentHash, err := subintent.SubintentHash()
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, subintentHash)
+	}
+
+	coordinator.lock.Lock()
+	defer coordinator.lock.Unlock()
+	window := coordinator.window
+	for index, core := range cores {
+		subintent := hashes[index].AsStr()
+		if coordinator.subintents[subintent] {
+			return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(subintent, "the subintent is already part of the transaction")
+		}
+		if core.header.NetworkId != networkId {
+			return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(subintent, fmt.Sprintf("the subintent is for network %d, the transaction for network %d", core.header.NetworkId, networkId))
+		}
+		subintentWindow := intentWindowOf(core.header)
+		overlap := window.intersect(subintentWindow)
+		if overlap.empty() {
+			return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(subintent, fmt.Sprintf("the validity window %s of the subintent does not overlap the window %s of the transaction", subintentWindow, window))
+		}
+		window = overlap
+	}
+	coordinator.window = window
+	for _, subintent := range hashes {
+		coordinator.subintents[subintent.AsStr()] = true
+	}
+	coordinator.children = append(coordinator.children, coordinatedChild{
+		partial:      partial,
+		hash:         hash,
+		verifyParent: cores[0].verifyParent,
+	})
+	return hash, nil
+}
+
+// Children returns the hashes of the root subintents of the children, in the
+// order they were added.
+func (coordinator *SubintentCoordinator) Children() []*TransactionHash {
+	coordinator.lock.Lock()
+	defer coordinator.lock.Unlock()
+	hashes := make([]*TransactionHash, len(coordinator.children))
+	for index, child := range coordinator.children {
+		hashes[index] = child.hash
+	}
+	return hashes
+}
+
+// Notarize checks the subintent tree of the transaction with the root
+// manifest, signs its intent with the signers and notarizes it.
+func (coordinator *SubintentCoordinator) Notarize(ctx context.Context, manifest *TransactionManifestV2, message MessageV2, signers []SignerV2, notary SignerV2) (*NotarizedTransactionV2, error) {
+	coordinator.lock.Lock()
+	defer coordinator.lock.Unlock()
+	children, err := coordinator.manifestChildren(manifest)
+	if err != nil {
+		return nil, err
+	}
+	badges, err := coordinator.signatureBadges(ctx, signers, notary)
+	if err != nil {
+		return nil, err
+	}
+	for _, child := range children {
+		for _, rule := range child.verifyParent {
+			if badges.accessRule(rule) == ruleUnsatisfied {
+				return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(child.hash.AsStr(), "the VerifyParent rule of the subintent is not satisfied by the signers of the root intent")
+			}
+		}
+	}
+
+	builder := NewTransactionV2Builder().
+		TransactionHeader(coordinator.transactionHeader).
+		IntentHeader(coordinator.intentHeader).
+		Manifest(manifest).
+		Message(message)
+	for _, child := range children {
+		builder = builder.AddChild(child.partial)
+	}
+	step, err := builder.PrepareForSigning()
+	if err != nil {
+		return nil, err
+	}
+	for _, signer := range signers {
+		if step, err = step.SignWithSignerV2(ctx, signer); err != nil {
+			return nil, err
+		}
+	}
+	transaction, err := step.NotarizeWithSignerV2(ctx, notary)
+	if err != nil {
+		return nil, err
+	}
+	if err := transaction.StaticallyValidate(coordinator.intentHeader.NetworkId); err != nil {
+		transaction.Destroy()
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// manifestChildren returns the children in the order of the children of the
+// manifest, checking that the manifest uses and yields to them all.
+func (coordinator *SubintentCoordinator) manifestChildren(manifest *TransactionManifestV2) ([]coordinatedChild, error) {
+	hashes, err := manifest.Children()
+	if err != nil {
+		return nil, err
+	}
+	collected := map[string]coordinatedChild{}
+	for _, child := range coordinator.children {
+		collected[child.hash.AsHash().AsStr()] = child
+	}
+	children := make([]coordinatedChild, len(hashes))
+	for index, hash := range hashes {
+		child, ok := collected[hash.AsStr()]
+		if !ok {
+			return nil, NewRadixEngineToolkitErrorInvalidSubintentTree("", fmt.Sprintf("child %d of the root manifest, %s, was not added to the coordinator", index, hash.AsStr()))
+		}
+		delete(collected, hash.AsStr())
+		children[index] = child
+	}
+	for _, child := range coordinator.children {
+		if _, ok := collected[child.hash.AsHash().AsStr()]; ok {
+			return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(child.hash.AsStr(), "the subintent is not a child of the root manifest")
+		}
+	}
+
+	instructions := manifest.Instructions()
+	defer instructions.Destroy()
+	yielded := make([]bool, len(children))
+	for index, instruction := range instructions.InstructionsList() {
+		yield, ok := instruction.(InstructionV2YieldToChild)
+		if !ok {
+			continue
+		}
+		if int(yield.ChildIndex) >= len(children) {
+			return nil, NewRadixEngineToolkitErrorInvalidSubintentTree("", fmt.Sprintf("instruction %d yields to child %d of a manifest of %d children", index, yield.ChildIndex, len(children)))
+		}
+		yielded[yield.ChildIndex] = true
+	}
+	for index, child := range children {
+		if !yielded[index] {
+			return nil, NewRadixEngineToolkitErrorInvalidSubintentTree(child.hash.AsStr(), "the root manifest never yields to the subintent")
+		}
+	}
+	return children, nil
+}
+
+// signatureBadges returns the signature badges of the signers of the root
+// intent, the notary being one if it is a signatory.
+func (coordinator *SubintentCoordinator) signatureBadges(ctx context.Context, signers []SignerV2, notary SignerV2) (signatureBadges, error) {
+	networkId := coordinator.intentHeader.NetworkId
+	resources := GetKnownAddresses(networkId).ResourceAddresses
+	badges := signatureBadges{
+		resources: [][]byte{resources.Secp256k1SignatureResource.Bytes(), resources.Ed25519SignatureResource.Bytes()},
+		present:   map[string]bool{},
+	}
+	if coordinator.transactionHeader.NotaryIsSignatory {
+		signers = append(slices.Clone(signers), notary)
+	}
+	for _, signer := range signers {
+		publicKey, err := signer.PublicKey(ctx)
+		if err != nil {
+			return signatureBadges{}, signerError(err)
+		}
+		rule, err := AccessRuleRequireSignature(publicKey)
+		if err != nil {
+			return signatureBadges{}, err
+		}
+		for _, entity := range rule.ExtractEntities(networkId) {
+			badge, ok := entity.(ResourceOrNonFungibleNonFungible)
+			if !ok {
+				continue
+			}
+			if id, ok := badge.Value.LocalId().(NonFungibleLocalIdBytes); ok {
+				badges.present[signatureBadgeKey(badge.Value.ResourceAddress().Bytes(), id.Value)] = true
+			}
+		}
+		rule.Destroy()
+	}
+	return badges, nil
+}
+
+// intentWindow is the validity window of an intent, its timestamps being nil
+// when unbounded.
+type intentWindow struct {
+	startEpoch   uint64
+	endEpoch     uint64
+	minTimestamp *int64
+	maxTimestamp *int64
+}
+
+func intentWindowOf(header IntentHeaderV2) intentWindow {
+	return intentWindow{
+		startEpoch:   header.StartEpochInclusive,
+		endEpoch:     header.EndEpochExclusive,
+		minTimestamp: header.MinProposerTimestampInclusive,
+		maxTimestamp: header.MaxProposerTimestampExclusive,
+	}
+}
+
+func (window intentWindow) intersect(other intentWindow) intentWindow {
+	result := window
+	result.startEpoch = max(window.startEpoch, other.startEpoch)
+	result.endEpoch = min(window.endEpoch, other.endEpoch)
+	if other.minTimestamp != nil && (result.minTimestamp == nil || *other.minTimestamp > *result.minTimestamp) {
+		result.minTimestamp = other.minTimestamp
+	}
+	if other.maxTimestamp != nil && (result.maxTimestamp == nil || *other.maxTimestamp < *result.maxTimestamp) {
+		result.maxTimestamp = other.maxTimestamp
+	}
+	return result
+}
+
+func (window intentWindow) empty() bool {
+	return window.startEpoch >= window.endEpoch ||
+		(window.minTimestamp != nil && window.maxTimestamp != nil && *window.minTimestamp >= *window.maxTimestamp)
+}
+
+func (window intentWindow) String() string {
+	timestamp := func(value *int64) string {
+		if value == nil {
+			return "_"
+		}
+		return fmt.Sprint(*value)
+	}
+	return fmt.Sprintf("epochs [%d, %d) timestamps [%s, %s)", window.startEpoch, window.endEpoch, timestamp(window.minTimestamp), timestamp(window.maxTimestamp))
+}
+
+// subintentCore is what the coordinator reads of the IntentCoreV2 of a
+// subintent.
+type subintentCore struct {
+	header       IntentHeaderV2
+	verifyParent []sbor.Value
+}
+
+// instructionV2VerifyParentDiscriminator is the discriminator of
+// VerifyParent in an encoded InstructionV2.
+const instructionV2VerifyParentDiscriminator = 0x62
+
+// decodeSubintentCores returns the intent cores of a signed partial
+// transaction, the one of its root subintent first, then the ones of its
+// non root subintents.
+//
+// IntentCoreV2 { header, blobs, message, children, instructions } is looked
+// for by its shape, so that the transparent wrappers around it, whose
+// encoding differs between versions, do not matter.
+func decodeSubintentCores(payload []byte) ([]subintentCore, error) {
+	value, err := sbor.Decode(payload, sbor.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	var cores []subintentCore
+	var walk func(value sbor.Value) error
+	walk = func(value sbor.Value) error {
+		core, ok, err := subintentCoreOf(value)
+		if err != nil {
+			return err
+		}
+		if ok {
+			cores = append(cores, core)
+			return nil
+		}
+		for _, element := range value.Elements {
+			if err := walk(element); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(value); err != nil {
+		return nil, err
+	}
+	return cores, nil
+}
+
+func subintentCoreOf(value sbor.Value) (subintentCore, bool, error) {
+	if value.Kind != sbor.KindTuple || len(value.Elements) != 5 {
+		return subintentCore{}, false, nil
+	}
+	header := value.Elements[0]
+	instructions := value.Elements[4].Unwrap()
+	if header.Kind != sbor.KindTuple || len(header.Elements) != 6 || header.Elements[0].Kind != sbor.KindU8 ||
+		instructions.Kind != sbor.KindArray || instructions.ElementKind != sbor.KindEnum {
+		return subintentCore{}, false, nil
+	}
+	var core subintentCore
+	var err error
+	if core.header, err = intentHeaderOf(header); err != nil {
+		return subintentCore{}, true, err
+	}
+	for _, instruction := range instructions.Elements {
+		if instruction.Discriminator == instructionV2VerifyParentDiscriminator && len(instruction.Elements) == 1 {
+			core.verifyParent = append(core.verifyParent, instruction.Elements[0])
+		}
+	}
+	return core, true, nil
+}
+
+func intentHeaderOf(value sbor.Value) (IntentHeaderV2, error) {
+	integer := func(index int, kind sbor.Kind) (int64, error) {
+		field := value.Elements[index].Unwrap()
+		if field.Kind != kind || !field.Int.IsInt64() {
+			return 0, fmt.Errorf("invalid field %d of an intent header", index)
+		}
+		return field.Int.Int64(), nil
+	}
+	optional := func(index int) (*int64, error) {
+		field := value.Elements[index]
+		if field.Kind != sbor.KindEnum || field.Discriminator > 1 {
+			return nil, fmt.Errorf("invalid field %d of an intent header", index)
+		}
+		if field.Discriminator == 0 {
+			return nil, nil
+		}
+		timestamp := sbor.Value{Kind: sbor.KindTuple, Elements: field.Elements}.Unwrap()
+		if timestamp.Kind != sbor.KindI64 {
+			return nil, fmt.Errorf("invalid field %d of an intent header", index)
+		}
+		seconds := timestamp.Int.Int64()
+		return &seconds, nil
+	}
+	var header IntentHeaderV2
+	networkId, err := integer(0, sbor.KindU8)
+	if err != nil {
+		return header, err
+	}
+	header.NetworkId = uint8(networkId)
+	for index, field := range []*uint64{&header.StartEpochInclusive, &header.EndEpochExclusive} {
+		epoch, err := integer(1+index, sbor.KindU64)
+		if err != nil {
+			return header, err
+		}
+		*field = uint64(epoch)
+	}
+	if header.MinProposerTimestampInclusive, err = optional(3); err != nil {
+		return header, err
+	}
+	if header.MaxProposerTimestampExclusive, err = optional(4); err != nil {
+		return header, err
+	}
+	discriminator := value.Elements[5].Unwrap()
+	if discriminator.Kind != sbor.KindU64 {
+		return header, fmt.Errorf("invalid field 5 of an intent header")
+	}
+	header.IntentDiscriminator = discriminator.Int.Uint64()
+	return header, nil
+}
+
+// ruleOutcome is what is known before execution of whether an access rule
+// is satisfied.
+type ruleOutcome int
+
+const (
+	ruleUnknown ruleOutcome = iota
+	ruleSatisfied
+	ruleUnsatisfied
+)
+
+// signatureBadges evaluates the signature requirements of access rules
+// against the signature badges of a set of signers.
+type signatureBadges struct {
+	// resources are the addresses of the signature resources.
+	resources [][]byte
+	present   map[string]bool
+}
+
+func signatureBadgeKey(resource []byte, localId []byte) string {
+	return string(resource) + "/" + string(localId)
+}
+
+// accessRule evaluates an encoded AccessRule: AllowAll, DenyAll or
+// Protected(CompositeRequirement).
+func (badges signatureBadges) accessRule(rule sbor.Value) ruleOutcome {
+	if rule.Kind != sbor.KindEnum {
+		return ruleUnknown
+	}
+	switch {
+	case rule.Discriminator == 0:
+		return ruleSatisfied
+	case rule.Discriminator == 1:
+		return ruleUnsatisfied
+	case rule.Discriminator == 2 && len(rule.Elements) == 1:
+		return badges.composite(rule.Elements[0])
+	}
+	return ruleUnknown
+}
+
+// composite evaluates a CompositeRequirement: BasicRequirement, AnyOf or
+// AllOf.
+func (badges signatureBadges) composite(requirement sbor.Value) ruleOutcome {
+	if requirement.Kind != sbor.KindEnum || len(requirement.Elements) != 1 {
+		return ruleUnknown
+	}
+	element := requirement.Elements[0]
+	switch requirement.Discriminator {
+	case 0:
+		return badges.basic(element)
+	case 1, 2:
+		if element.Kind != sbor.KindArray {
+			return ruleUnknown
+		}
+		outcomes := make([]ruleOutcome, len(element.Elements))
+		for index, requirement := range element.Elements {
+			outcomes[index] = badges.composite(requirement)
+		}
+		if requirement.Discriminator == 1 {
+			return countOf(1, outcomes)
+		}
+		return countOf(len(outcomes), outcomes)
+	}
+	return ruleUnknown
+}
+
+// basic evaluates a BasicRequirement: Require, AmountOf, CountOf, AllOf or
+// AnyOf.
+func (badges signatureBadges) basic(requirement sbor.Value) ruleOutcome {
+	if requirement.Kind != sbor.KindEnum {
+		return ruleUnknown
+	}
+	list := func(value sbor.Value) ([]ruleOutcome, bool) {
+		if value.Kind != sbor.KindArray {
+			return nil, false
+		}
+		outcomes := make([]ruleOutcome, len(value.Elements))
+		for index, resource := range value.Elements {
+			outcomes[index] = badges.resourceOrNonFungible(resource)
+		}
+		return outcomes, true
+	}
+	switch {
+	case requirement.Discriminator == 0 && len(requirement.Elements) == 1:
+		return badges.resourceOrNonFungible(requirement.Elements[0])
+	case requirement.Discriminator == 2 && len(requirement.Elements) == 2:
+		count := requirement.Elements[0]
+		if outcomes, ok := list(requirement.Elements[1]); ok && count.Kind == sbor.KindU8 {
+			return countOf(int(count.Int.Int64()), outcomes)
+		}
+	case requirement.Discriminator == 3 && len(requirement.Elements) == 1:
+		if outcomes, ok := list(requirement.Elements[0]); ok {
+			return countOf(len(outcomes), outcomes)
+		}
+	case requirement.Discriminator == 4 && len(requirement.Elements) == 1:
+		if outcomes, ok := list(requirement.Elements[0]); ok {
+			return countOf(1, outcomes)
+		}
+	}
+	return ruleUnknown
+}
+
+// resourceOrNonFungible evaluates the requirement of a ResourceOrNonFungible:
+// a signature badge is known to be present or not, any other proof is not.
+func (badges signatureBadges) resourceOrNonFungible(requirement sbor.Value) ruleOutcome {
+	if requirement.Kind != sbor.KindEnum || requirement.Discriminator != 0 || len(requirement.Elements) != 1 {
+		return ruleUnknown
+	}
+	id := requirement.Elements[0]
+	if id.Kind != sbor.KindTuple || len(id.Elements) != 2 {
+		return ruleUnknown
+	}
+	resource, localId := id.Elements[0], id.Elements[1]
+	if resource.Kind != sbor.KindManifestAddress || len(resource.Custom) != 31 || resource.Custom[0] != 0 {
+		return ruleUnknown
+	}
+	isSignature := false
+	for _, signatureResource := range badges.resources {
+		isSignature = isSignature || bytes.Equal(resource.Custom[1:], signatureResource)
+	}
+	if !isSignature {
+		return ruleUnknown
+	}
+	// A signature badge has a bytes local id: the type 2, the length and the
+	// bytes.
+	if localId.Kind != sbor.KindManifestNonFungibleLocalId || len(localId.Custom) < 2 || localId.Custom[0] != 2 {
+		return ruleUnsatisfied
+	}
+	length, size := binary.Uvarint(localId.Custom[1:])
+	if size <= 0 || uint64(len(localId.Custom)-1-size) != length {
+		return ruleUnsatisfied
+	}
+	if badges.present[signatureBadgeKey(resource.Custom[1:], localId.Custom[1+size:])] {
+		return ruleSatisfied
+	}
+	return ruleUnsatisfied
+}
+
+// countOf evaluates a requirement of count of outcomes being satisfied.
+func countOf(count int, outcomes []ruleOutcome) ruleOutcome {
+	satisfied, unknown := 0, 0
+	for _, outcome := range outcomes {
+		switch outcome {
+		case ruleSatisfied:
+			satisfied++
+		case ruleUnknown:
+			unknown++
+		}
+	}
+	switch {
+	case satisfied >= count:
+		return ruleSatisfied
+	case satisfied+unknown < count:
+		return ruleUnsatisfied
+	}
+	return ruleUnknown
+}
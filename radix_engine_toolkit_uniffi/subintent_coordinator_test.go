@@ -0,0 +1,242 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+func timestamp(seconds int64) *int64 {
+	return &seconds
+}
+
+func TestIntentWindow(t *testing.T) {
+	window := intentWindow{startEpoch: 10, endEpoch: 20, minTimestamp: timestamp(100), maxTimestamp: timestamp(200)}
+	tests := []struct {
+		name  string
+		other intentWindow
+		want  string
+		empty bool
+	}{
+		{"unbounded timestamps", intentWindow{startEpoch: 5, endEpoch: 15}, "epochs [10, 15) timestamps [100, 200)", false},
+		{"narrower", intentWindow{startEpoch: 12, endEpoch: 30, minTimestamp: timestamp(150), maxTimestamp: timestamp(180)}, "epochs [12, 20) timestamps [150, 180)", false},
+		{"wider", intentWindow{startEpoch: 0, endEpoch: 100, minTimestamp: timestamp(0), maxTimestamp: timestamp(1000)}, "epochs [10, 20) timestamps [100, 200)", false},
+		{"disjoint epochs", intentWindow{startEpoch: 20, endEpoch: 30}, "epochs [20, 20) timestamps [100, 200)", true},
+		{"disjoint timestamps", intentWindow{startEpoch: 10, endEpoch: 20, minTimestamp: timestamp(200), maxTimestamp: timestamp(300)}, "epochs [10, 20) timestamps [200, 200)", true},
+		{"timestamps before", intentWindow{startEpoch: 10, endEpoch: 20, maxTimestamp: timestamp(50)}, "epochs [10, 20) timestamps [100, 50)", true},
+	}
+	for _, test := range tests {
+		overlap := window.intersect(test.other)
+		if overlap.String() != test.want || overlap.empty() != test.empty {
+			t.Errorf("%s: intersect = %s, empty %v, want %s, empty %v", test.name, overlap, overlap.empty(), test.want, test.empty)
+		}
+		if reverse := test.other.intersect(window); reverse.String() != overlap.String() {
+			t.Errorf("%s: intersect is not symmetric: %s and %s", test.name, overlap, reverse)
+		}
+	}
+
+	unbounded := intentWindow{startEpoch: 1, endEpoch: 2}
+	if unbounded.empty() || unbounded.String() != "epochs [1, 2) timestamps [_, _)" {
+		t.Errorf("unbounded window %s, empty %v", unbounded, unbounded.empty())
+	}
+}
+
+// The access rules of the badge tests, as the engine encodes them.
+
+var (
+	testSignatureResource = bytes.Repeat([]byte{0x5d}, 30)
+	testOtherResource     = bytes.Repeat([]byte{0x5e}, 30)
+)
+
+func ruleEnum(discriminator uint8, fields ...sbor.Value) sbor.Value {
+	return sbor.Value{Kind: sbor.KindEnum, Discriminator: discriminator, Elements: fields}
+}
+
+func ruleArray(elements ...sbor.Value) sbor.Value {
+	return sbor.Value{Kind: sbor.KindArray, ElementKind: sbor.KindEnum, Elements: elements}
+}
+
+// signatureBadge is the ResourceOrNonFungible of the signature badge of key
+// of resource.
+func signatureBadge(resource []byte, key byte) sbor.Value {
+	return ruleEnum(0, sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{
+		{Kind: sbor.KindManifestAddress, Custom: append([]byte{0}, resource...)},
+		{Kind: sbor.KindManifestNonFungibleLocalId, Custom: []byte{2, 1, key}},
+	}})
+}
+
+// require is the CompositeRequirement of one badge.
+func require(badge sbor.Value) sbor.Value {
+	return ruleEnum(0, ruleEnum(0, badge))
+}
+
+func protected(requirement sbor.Value) sbor.Value {
+	return ruleEnum(2, requirement)
+}
+
+func TestSignatureBadges(t *testing.T) {
+	badges := signatureBadges{
+		resources: [][]byte{testSignatureResource},
+		present:   map[string]bool{signatureBadgeKey(testSignatureResource, []byte{1}): true, signatureBadgeKey(testSignatureResource, []byte{2}): true},
+	}
+	present, otherPresent, absent := signatureBadge(testSignatureResource, 1), signatureBadge(testSignatureResource, 2), signatureBadge(testSignatureResource, 3)
+	// The proofs of other resources are only known during execution.
+	unknown := signatureBadge(testOtherResource, 1)
+	resource := ruleEnum(1, sbor.Value{Kind: sbor.KindManifestAddress, Custom: append([]byte{0}, testSignatureResource...)})
+	countOfBadges := func(count uint8, badges ...sbor.Value) sbor.Value {
+		return ruleEnum(0, ruleEnum(2, sbor.Value{Kind: sbor.KindU8, Int: big.NewInt(int64(count))}, ruleArray(badges...)))
+	}
+
+	tests := []struct {
+		name string
+		rule sbor.Value
+		want ruleOutcome
+	}{
+		{"allow all", ruleEnum(0), ruleSatisfied},
+		{"deny all", ruleEnum(1), ruleUnsatisfied},
+		{"present signature", protected(require(present)), ruleSatisfied},
+		{"absent signature", protected(require(absent)), ruleUnsatisfied},
+		{"other resource", protected(require(unknown)), ruleUnknown},
+		{"whole resource", protected(require(resource)), ruleUnknown},
+		{"any of absent and unknown", protected(ruleEnum(1, ruleArray(require(absent), require(unknown)))), ruleUnknown},
+		{"any of absent and present", protected(ruleEnum(1, ruleArray(require(absent), require(unknown), require(present)))), ruleSatisfied},
+		{"any of absent", protected(ruleEnum(1, ruleArray(require(absent)))), ruleUnsatisfied},
+		{"all of present and unknown", protected(ruleEnum(2, ruleArray(require(present), require(unknown)))), ruleUnknown},
+		{"all of present, unknown and absent", protected(ruleEnum(2, ruleArray(require(present), require(unknown), require(absent)))), ruleUnsatisfied},
+		{"all of present", protected(ruleEnum(2, ruleArray(require(present), require(otherPresent)))), ruleSatisfied},
+		{"nested any of all of", protected(ruleEnum(1, ruleArray(
+			ruleEnum(2, ruleArray(require(absent), require(unknown))),
+			ruleEnum(2, ruleArray(require(present), require(otherPresent))),
+		))), ruleSatisfied},
+		{"count of 2 with 1 present and 1 unknown", protected(countOfBadges(2, present, unknown, absent)), ruleUnknown},
+		{"count of 2 with 2 present", protected(countOfBadges(2, present, unknown, otherPresent)), ruleSatisfied},
+		{"count of 2 with 1 present", protected(countOfBadges(2, present, absent, absent)), ruleUnsatisfied},
+		{"basic all of", protected(ruleEnum(0, ruleEnum(3, ruleArray(present, unknown)))), ruleUnknown},
+		{"basic any of", protected(ruleEnum(0, ruleEnum(4, ruleArray(absent, otherPresent)))), ruleSatisfied},
+		{"amount of", protected(ruleEnum(0, ruleEnum(1, sbor.Value{Kind: sbor.KindManifestDecimal}, resource))), ruleUnknown},
+		{"not an enum", sbor.Value{Kind: sbor.KindTuple}, ruleUnknown},
+		{"unknown variant", ruleEnum(3), ruleUnknown},
+	}
+	for _, test := range tests {
+		if got := badges.accessRule(test.rule); got != test.want {
+			t.Errorf("%s: accessRule = %d, want %d", test.name, got, test.want)
+		}
+	}
+}
+
+func TestCountOf(t *testing.T) {
+	tests := []struct {
+		count    int
+		outcomes []ruleOutcome
+		want     ruleOutcome
+	}{
+		{0, nil, ruleSatisfied},
+		{1, nil, ruleUnsatisfied},
+		{1, []ruleOutcome{ruleUnknown}, ruleUnknown},
+		{1, []ruleOutcome{ruleUnsatisfied, ruleSatisfied}, ruleSatisfied},
+		{2, []ruleOutcome{ruleSatisfied, ruleUnknown, ruleUnsatisfied}, ruleUnknown},
+		{2, []ruleOutcome{ruleSatisfied, ruleUnsatisfied, ruleUnsatisfied}, ruleUnsatisfied},
+		{3, []ruleOutcome{ruleSatisfied, ruleSatisfied}, ruleUnsatisfied},
+	}
+	for _, test := range tests {
+		if got := countOf(test.count, test.outcomes); got != test.want {
+			t.Errorf("countOf(%d, %v) = %d, want %d", test.count, test.outcomes, got, test.want)
+		}
+	}
+}
+
+// testIntentCore is an encoded IntentCoreV2 whose instructions are an
+// unrelated instruction and VerifyParent of each rule.
+func testIntentCore(networkId uint8, startEpoch, endEpoch uint64, maxTimestamp *int64, rules ...sbor.Value) sbor.Value {
+	u64 := func(value uint64) sbor.Value {
+		return sbor.Value{Kind: sbor.KindU64, Int: new(big.Int).SetUint64(value)}
+	}
+	timestamp := ruleEnum(0)
+	if maxTimestamp != nil {
+		timestamp = ruleEnum(1, sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{{Kind: sbor.KindI64, Int: big.NewInt(*maxTimestamp)}}})
+	}
+	header := sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{
+		{Kind: sbor.KindU8, Int: big.NewInt(int64(networkId))},
+		u64(startEpoch),
+		u64(endEpoch),
+		ruleEnum(0),
+		timestamp,
+		u64(7),
+	}}
+	instructions := []sbor.Value{ruleEnum(0x50)}
+	for _, rule := range rules {
+		instructions = append(instructions, ruleEnum(instructionV2VerifyParentDiscriminator, rule))
+	}
+	return sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{
+		header,
+		sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{{Kind: sbor.KindArray, ElementKind: sbor.KindArray}}},
+		ruleEnum(0),
+		sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{{Kind: sbor.KindArray, ElementKind: sbor.KindTuple}}},
+		sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{{Kind: sbor.KindArray, ElementKind: sbor.KindEnum, Elements: instructions}}},
+	}}
+}
+
+func TestDecodeSubintentCores(t *testing.T) {
+	verifyParent := protected(require(signatureBadge(testSignatureResource, 9)))
+	partial := ruleEnum(0, sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{
+		sbor.Value{Kind: sbor.KindTuple, Elements: []sbor.Value{
+			testIntentCore(2, 10, 20, timestamp(500), verifyParent),
+			sbor.Value{Kind: sbor.KindArray, ElementKind: sbor.KindTuple, Elements: []sbor.Value{
+				testIntentCore(2, 15, 25, nil),
+			}},
+		}},
+		sbor.Value{Kind: sbor.KindArray, ElementKind: sbor.KindTuple},
+	}})
+	payload, err := sbor.Encode(partial, sbor.Manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cores, err := decodeSubintentCores(payload)
+	if err != nil {
+		t.Fatalf("decodeSubintentCores = %v", err)
+	}
+	if len(cores) != 2 {
+		t.Fatalf("decodeSubintentCores returned %d cores, want 2", len(cores))
+	}
+	root, child := cores[0], cores[1]
+	if header := root.header; header.NetworkId != 2 || header.StartEpochInclusive != 10 || header.EndEpochExclusive != 20 ||
+		header.MinProposerTimestampInclusive != nil || header.MaxProposerTimestampExclusive == nil || *header.MaxProposerTimestampExclusive != 500 ||
+		header.IntentDiscriminator != 7 {
+		t.Errorf("root header = %+v", header)
+	}
+	if child.header.StartEpochInclusive != 15 || child.header.MaxProposerTimestampExclusive != nil || len(child.verifyParent) != 0 {
+		t.Errorf("child core = %+v", child)
+	}
+	if len(root.verifyParent) != 1 {
+		t.Fatalf("root core has %d VerifyParent rules, want 1", len(root.verifyParent))
+	}
+
+	// The root signers sign with keys 1 and 2, the child requires key 9.
+	signers := signatureBadges{
+		resources: [][]byte{testSignatureResource},
+		present:   map[string]bool{signatureBadgeKey(testSignatureResource, []byte{1}): true, signatureBadgeKey(testSignatureResource, []byte{2}): true},
+	}
+	if outcome := signers.accessRule(root.verifyParent[0]); outcome != ruleUnsatisfied {
+		t.Errorf("VerifyParent rule of key 9 against keys 1 and 2 = %d, want unsatisfied", outcome)
+	}
+	signers.present[signatureBadgeKey(testSignatureResource, []byte{9})] = true
+	if outcome := signers.accessRule(root.verifyParent[0]); outcome != ruleSatisfied {
+		t.Errorf("VerifyParent rule of key 9 against key 9 = %d, want satisfied", outcome)
+	}
+
+	invalid := testIntentCore(2, 10, 20, nil)
+	invalid.Elements[0].Elements[1] = sbor.Value{Kind: sbor.KindU32, Int: big.NewInt(10)}
+	payload, err = sbor.Encode(ruleEnum(0, invalid), sbor.Manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodeSubintentCores(payload); err == nil {
+		t.Error("decodeSubintentCores of an intent header with a U32 epoch succeeded")
+	}
+	if _, err := decodeSubintentCores(payload[:len(payload)-1]); err == nil {
+		t.Error("decodeSubintentCores of a truncated payload succeeded")
+	}
+}
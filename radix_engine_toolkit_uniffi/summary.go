@@ -0,0 +1,506 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"sort"
+	"strings"
+)
+
+// Transaction summaries
+//
+// SummarizeStaticAnalysis and SummarizeDynamicAnalysis turn the analysis of a
+// manifest into summary lines: the withdrawals from accounts, the validator
+// and pool operations of its detailed classification, the deposits into
+// accounts and the updates of account deposit settings, in this order. A
+// line is structured, an action with its account, entity and amounts, and a
+// SummaryRenderer renders it from a catalog of messages, English by default:
+//
+//	renderer := &SummaryRenderer{Resolver: names}
+//	for _, line := range SummarizeDynamicAnalysis(analysis) {
+//		fmt.Println(renderer.Render(line))
+//		// Withdraw 100 XRD from account_rdx128…7n3wpe
+//		// Stake 50 XRD to Validator A, receiving ~49.9 Liquid Stake Units
+//	}
+//
+// The messages hold {account}, {entity}, {resources}, {received} and
+// {setting} placeholders, and the amount messages {amount}, {upper}, {ids}
+// and {resource}. Names come from the resolver of the renderer, falling back
+// to shortened addresses.
+
+// SummaryAction is the action of a summary line, and the key of its message.
+type SummaryAction string
+
+const (
+	SummaryWithdraw                  SummaryAction = "withdraw"
+	SummaryDeposit                   SummaryAction = "deposit"
+	SummaryStake                     SummaryAction = "stake"
+	SummaryUnstake                   SummaryAction = "unstake"
+	SummaryClaim                     SummaryAction = "claim"
+	SummaryContribute                SummaryAction = "contribute"
+	SummaryRedeem                    SummaryAction = "redeem"
+	SummarySetDefaultDepositRule     SummaryAction = "set_default_deposit_rule"
+	SummarySetResourcePreference     SummaryAction = "set_resource_preference"
+	SummaryRemoveResourcePreference  SummaryAction = "remove_resource_preference"
+	SummaryAddAuthorizedDepositor    SummaryAction = "add_authorized_depositor"
+	SummaryRemoveAuthorizedDepositor SummaryAction = "remove_authorized_depositor"
+)
+
+// SummaryBound tells how certain the amount of a SummaryAmount is, and is the
+// key of its message.
+type SummaryBound string
+
+const (
+	SummaryExact         SummaryBound = "amount"
+	SummaryPredicted     SummaryBound = "amount_predicted"
+	SummaryAtLeast       SummaryBound = "amount_at_least"
+	SummaryAtMost        SummaryBound = "amount_at_most"
+	SummaryBetween       SummaryBound = "amount_between"
+	SummaryUnknownAmount SummaryBound = "amount_unknown"
+	// SummaryIds is the bound of the non-fungibles known by their ids only.
+	SummaryIds SummaryBound = "ids"
+	// SummaryResource is the bound of a resource named without an amount,
+	// such as the resource of a deposit preference.
+	SummaryResource SummaryBound = "resource"
+)
+
+// SummaryAmount is an amount of a resource in a summary line. A nil
+// ResourceAddress stands for unknown resources.
+type SummaryAmount struct {
+	ResourceAddress *Address
+	Bound           SummaryBound
+	// Amount is the amount, the lower one of SummaryBetween.
+	Amount *DecimalValue
+	// UpperAmount is the upper amount of SummaryBetween.
+	UpperAmount *DecimalValue
+	// Ids are the local ids of the non-fungibles known, if any.
+	Ids []string
+}
+
+// SummaryLine is an action of a transaction.
+type SummaryLine struct {
+	Action SummaryAction
+	// Account is the account the action applies to, if any.
+	Account *Address
+	// Entity is the validator or the pool of the action, if any.
+	Entity *Address
+	// Resources are the resources withdrawn, deposited, given, or which an
+	// account setting applies to.
+	Resources []SummaryAmount
+	// Received are the resources received in exchange.
+	Received []SummaryAmount
+	// Setting is the AccountDefaultDepositRule or ResourcePreference set, as
+	// its variant name.
+	Setting string
+}
+
+// MetadataResolver resolves the display names of entities, such as the
+// symbol of a resource or the name of a validator.
+type MetadataResolver interface {
+	Name(address *Address) (name string, ok bool)
+}
+
+// MetadataResolverFunc is a function resolving names as a MetadataResolver.
+type MetadataResolverFunc func(address *Address) (string, bool)
+
+func (resolve MetadataResolverFunc) Name(address *Address) (string, bool) {
+	return resolve(address)
+}
+
+// DefaultSummaryMessages are the English messages of summary lines. The
+// messages of settings are keyed by "setting." followed by the variant name,
+// and "separator" joins the amounts of a line.
+var DefaultSummaryMessages = map[string]string{
+	string(SummaryWithdraw):                  "Withdraw {resources} from {account}",
+	string(SummaryDeposit):                   "Deposit {resources} into {account}",
+	string(SummaryStake):                     "Stake {resources} to {entity}, receiving {received}",
+	string(SummaryUnstake):                   "Request unstaking of {resources} from {entity}, receiving {received}",
+	string(SummaryClaim):                     "Claim {received} from {entity} with {resources}",
+	string(SummaryContribute):                "Contribute {resources} to {entity}, receiving {received}",
+	string(SummaryRedeem):                    "Redeem {resources} from {entity}, receiving {received}",
+	string(SummarySetDefaultDepositRule):     "Set the deposit rule of {account} to {setting}",
+	string(SummarySetResourcePreference):     "Set deposits of {resources} into {account} as {setting}",
+	string(SummaryRemoveResourcePreference):  "Remove the deposit preference of {account} for {resources}",
+	string(SummaryAddAuthorizedDepositor):    "Allow holders of {resources} to deposit into {account}",
+	string(SummaryRemoveAuthorizedDepositor): "Stop allowing holders of {resources} to deposit into {account}",
+	string(SummaryExact):                     "{amount} {resource}",
+	string(SummaryPredicted):                 "~{amount} {resource}",
+	string(SummaryAtLeast):                   "at least {amount} {resource}",
+	string(SummaryAtMost):                    "at most {amount} {resource}",
+	string(SummaryBetween):                   "{amount} to {upper} {resource}",
+	string(SummaryUnknownAmount):             "some {resource}",
+	string(SummaryIds):                       "{resource} {ids}",
+	string(SummaryResource):                  "{resource}",
+	"unknown_resources":                      "unknown resources",
+	"separator":                              ", ",
+	"setting.Accept":                         "accept all deposits",
+	"setting.Reject":                         "reject all deposits",
+	"setting.AllowExisting":                  "accept deposits of known resources only",
+	"setting.Allowed":                        "always allowed",
+	"setting.Disallowed":                     "never allowed",
+}
+
+// SummaryRenderer renders summary lines.
+type SummaryRenderer struct {
+	// Messages override DefaultSummaryMessages by key.
+	Messages map[string]string
+	// Resolver, if not nil, names the accounts, entities and resources.
+	Resolver MetadataResolver
+}
+
+// Render renders a summary line.
+func (renderer *SummaryRenderer) Render(line SummaryLine) string {
+	return strings.NewReplacer(
+		"{account}", renderer.name(line.Account),
+		"{entity}", renderer.name(line.Entity),
+		"{resources}", renderer.amounts(line.Resources),
+		"{received}", renderer.amounts(line.Received),
+		"{setting}", renderer.setting(line.Setting),
+	).Replace(renderer.message(string(line.Action)))
+}
+
+// RenderLines renders summary lines.
+func (renderer *SummaryRenderer) RenderLines(lines []SummaryLine) []string {
+	rendered := make([]string, len(lines))
+	for index, line := range lines {
+		rendered[index] = renderer.Render(line)
+	}
+	return rendered
+}
+
+func (renderer *SummaryRenderer) message(key string) string {
+	if message, ok := renderer.Messages[key]; ok {
+		return message
+	}
+	if message, ok := DefaultSummaryMessages[key]; ok {
+		return message
+	}
+	return key
+}
+
+func (renderer *SummaryRenderer) setting(setting string) string {
+	if setting == "" {
+		return ""
+	}
+	key := "setting." + setting
+	if message := renderer.message(key); message != key {
+		return message
+	}
+	return setting
+}
+
+func (renderer *SummaryRenderer) name(address *Address) string {
+	if address == nil {
+		return ""
+	}
+	if renderer.Resolver != nil {
+		if name, ok := renderer.Resolver.Name(address); ok && name != "" {
+			return name
+		}
+	}
+	return shortAddress(address.AsStr())
+}
+
+func (renderer *SummaryRenderer) amounts(amounts []SummaryAmount) string {
+	rendered := make([]string, len(amounts))
+	for index, amount := range amounts {
+		rendered[index] = renderer.amount(amount)
+	}
+	return strings.Join(rendered, renderer.message("separator"))
+}
+
+func (renderer *SummaryRenderer) amount(amount SummaryAmount) string {
+	if amount.ResourceAddress == nil {
+		return renderer.message("unknown_resources")
+	}
+	bound := amount.Bound
+	if amount.Amount == nil && len(amount.Ids) > 0 {
+		bound = SummaryIds
+	}
+	decimal := func(value *DecimalValue) string {
+		if value == nil {
+			return ""
+		}
+		return value.String()
+	}
+	return strings.NewReplacer(
+		"{amount}", decimal(amount.Amount),
+		"{upper}", decimal(amount.UpperAmount),
+		"{ids}", strings.Join(amount.Ids, renderer.message("separator")),
+		"{resource}", renderer.name(amount.ResourceAddress),
+	).Replace(renderer.message(string(bound)))
+}
+
+// shortAddress shortens a bech32 address to its prefix, the first characters
+// of its data and its last ones, e.g. account_rdx128…7n3wpe.
+func shortAddress(address string) string {
+	separator := strings.LastIndexByte(address, '1')
+	if separator < 0 || len(address)-separator < 16 {
+		return address
+	}
+	return address[:separator+3] + "…" + address[len(address)-6:]
+}
+
+// SummarizeStaticAnalysis summarizes the withdrawals and the deposits of a
+// static analysis.
+func SummarizeStaticAnalysis(analysis StaticAnalysis) []SummaryLine {
+	movements := analysis.AccountStaticResourceMovementsSummary
+	var lines []SummaryLine
+	for _, account := range sortedKeys(movements.AccountWithdraws) {
+		line := SummaryLine{Action: SummaryWithdraw, Account: summaryAddress(account)}
+		for _, withdraw := range movements.AccountWithdraws[account] {
+			switch withdraw := withdraw.(type) {
+			case AccountWithdrawAmount:
+				line.Resources = append(line.Resources, summaryExact(withdraw.ResourceAddress, withdraw.Amount))
+			case AccountWithdrawIds:
+				line.Resources = append(line.Resources, summaryIds(withdraw.ResourceAddress, withdraw.Ids, SummaryExact))
+			}
+		}
+		lines = append(lines, line)
+	}
+	for _, account := range sortedKeys(movements.AccountDeposits) {
+		line := SummaryLine{Action: SummaryDeposit, Account: summaryAddress(account)}
+		for _, deposit := range movements.AccountDeposits[account] {
+			line.Resources = append(line.Resources, summaryDeposit(deposit))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// SummarizeDynamicAnalysis summarizes the withdrawals, the operations of the
+// detailed classification, the deposits and the deposit settings updates of
+// a dynamic analysis.
+func SummarizeDynamicAnalysis(analysis DynamicAnalysis) []SummaryLine {
+	movements := analysis.AccountDynamicResourceMovementsSummary
+	var lines []SummaryLine
+	for _, account := range sortedKeys(movements.AccountWithdraws) {
+		lines = append(lines, SummaryLine{
+			Action:    SummaryWithdraw,
+			Account:   summaryAddress(account),
+			Resources: summaryInvocationIo(movements.AccountWithdraws[account]),
+		})
+	}
+	var settings []SummaryLine
+	for _, classification := range analysis.DetailedManifestClassification {
+		switch classification := classification.(type) {
+		case DetailedManifestClassificationValidatorStake:
+			for _, operation := range classification.Value.StakeOperations {
+				xrd := GetKnownAddresses(operation.ValidatorAddress.NetworkId()).ResourceAddresses.Xrd
+				lines = append(lines, SummaryLine{
+					Action:    SummaryStake,
+					Entity:    operation.ValidatorAddress,
+					Resources: []SummaryAmount{summaryExact(xrd, operation.StakedXrdAmount)},
+					Received:  []SummaryAmount{summaryExact(operation.LiquidStakeUnitResourceAddress, operation.LiquidStakeUnitAmount)},
+				})
+			}
+		case DetailedManifestClassificationValidatorUnstake:
+			for _, operation := range classification.Value.UnstakeOperations {
+				lines = append(lines, SummaryLine{
+					Action:    SummaryUnstake,
+					Entity:    operation.ValidatorAddress,
+					Resources: []SummaryAmount{summaryExact(operation.LiquidStakeUnitAddress, operation.LiquidStakeUnitAmount)},
+					Received:  []SummaryAmount{summaryIds(operation.ClaimNftAddress, operation.ClaimNftIds, SummaryExact)},
+				})
+			}
+		case DetailedManifestClassificationValidatorClaimXrd:
+			for _, operation := range classification.Value.ClaimOperations {
+				xrd := GetKnownAddresses(operation.ValidatorAddress.NetworkId()).ResourceAddresses.Xrd
+				lines = append(lines, SummaryLine{
+					Action:    SummaryClaim,
+					Entity:    operation.ValidatorAddress,
+					Resources: []SummaryAmount{summaryIds(operation.ClaimNftAddress, operation.ClaimNftIds, SummaryExact)},
+					Received:  []SummaryAmount{summaryExact(xrd, operation.XrdAmount)},
+				})
+			}
+		case DetailedManifestClassificationPoolContribution:
+			for _, operation := range classification.Value.ContributionOperations {
+				lines = append(lines, SummaryLine{
+					Action:    SummaryContribute,
+					Entity:    operation.PoolAddress,
+					Resources: summaryResourceAmounts(operation.ContributedResources),
+					Received:  []SummaryAmount{summaryExact(operation.PoolUnitsResourceAddress, operation.PoolUnitsAmount)},
+				})
+			}
+		case DetailedManifestClassificationPoolRedemption:
+			for _, operation := range classification.Value.RedemptionOperations {
+				lines = append(lines, SummaryLine{
+					Action:    SummaryRedeem,
+					Entity:    operation.PoolAddress,
+					Resources: []SummaryAmount{summaryExact(operation.PoolUnitsResourceAddress, operation.PoolUnitsAmount)},
+					Received:  summaryResourceAmounts(operation.RedeemedResources),
+				})
+			}
+		case DetailedManifestClassificationAccountDepositSettingsUpdate:
+			settings = append(settings, summarizeSettings(classification.Value)...)
+		}
+	}
+	for _, account := range sortedKeys(movements.AccountDeposits) {
+		lines = append(lines, SummaryLine{
+			Action:    SummaryDeposit,
+			Account:   summaryAddress(account),
+			Resources: summaryInvocationIo(movements.AccountDeposits[account]),
+		})
+	}
+	return append(lines, settings...)
+}
+
+func summarizeSettings(update AccountSettingsUpdateOutput) []SummaryLine {
+	var lines []SummaryLine
+	for _, account := range sortedKeys(update.DefaultDepositRuleUpdates) {
+		setting, _ := update.DefaultDepositRuleUpdates[account].MarshalText()
+		lines = append(lines, SummaryLine{Action: SummarySetDefaultDepositRule, Account: summaryAddress(account), Setting: string(setting)})
+	}
+	for _, account := range sortedKeys(update.ResourcePreferencesUpdates) {
+		preferences := update.ResourcePreferencesUpdates[account]
+		for _, resource := range sortedKeys(preferences) {
+			line := SummaryLine{
+				Action:    SummaryRemoveResourcePreference,
+				Account:   summaryAddress(account),
+				Resources: []SummaryAmount{{ResourceAddress: summaryAddress(resource), Bound: SummaryResource}},
+			}
+			if set, ok := preferences[resource].(ResourcePreferenceUpdateSet); ok {
+				setting, _ := set.Value.MarshalText()
+				line.Action, line.Setting = SummarySetResourcePreference, string(setting)
+			}
+			lines = append(lines, line)
+		}
+	}
+	for _, account := range sortedKeys(update.AuthorizedDepositorsUpdates) {
+		for _, operation := range []Operation{OperationAdded, OperationRemoved} {
+			badges := update.AuthorizedDepositorsUpdates[account][operation]
+			if len(badges) == 0 {
+				continue
+			}
+			line := SummaryLine{Action: SummaryAddAuthorizedDepositor, Account: summaryAddress(account)}
+			if operation == OperationRemoved {
+				line.Action = SummaryRemoveAuthorizedDepositor
+			}
+			for _, badge := range badges {
+				switch badge := badge.(type) {
+				case ResourceOrNonFungibleResource:
+					line.Resources = append(line.Resources, SummaryAmount{ResourceAddress: badge.Value, Bound: SummaryResource})
+				case ResourceOrNonFungibleNonFungible:
+					line.Resources = append(line.Resources, SummaryAmount{
+						ResourceAddress: badge.Value.ResourceAddress(),
+						Bound:           SummaryExact,
+						Ids:             []string{nonFungibleLocalIdString(badge.Value.LocalId())},
+					})
+				}
+			}
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// summaryAddress returns the address of a key of an analysis map, nil if it
+// is not an address.
+func summaryAddress(address string) *Address {
+	account, err := NewAddress(address)
+	if err != nil {
+		return nil
+	}
+	return account
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func summaryExact(resource *Address, amount *Decimal) SummaryAmount {
+	return SummaryAmount{ResourceAddress: resource, Bound: SummaryExact, Amount: summaryDecimal(amount)}
+}
+
+func summaryIds(resource *Address, ids []NonFungibleLocalId, bound SummaryBound) SummaryAmount {
+	return SummaryAmount{ResourceAddress: resource, Bound: bound, Ids: nonFungibleLocalIdStrings(ids)}
+}
+
+func summaryDecimal(decimal *Decimal) *DecimalValue {
+	if decimal == nil {
+		return nil
+	}
+	value := decimal.Value()
+	return &value
+}
+
+// summaryResourceAmounts returns the amounts of a map of resource addresses
+// to amounts, in the order of the addresses.
+func summaryResourceAmounts(amounts map[string]*Decimal) []SummaryAmount {
+	result := make([]SummaryAmount, 0, len(amounts))
+	for _, resource := range sortedKeys(amounts) {
+		result = append(result, summaryExact(summaryAddress(resource), amounts[resource]))
+	}
+	return result
+}
+
+func summaryInvocationIo(items []InvocationIoItem) []SummaryAmount {
+	var amounts []SummaryAmount
+	for _, item := range items {
+		switch item := item.(type) {
+		case InvocationIoItemFungible:
+			switch amount := item.Amount.(type) {
+			case EitherGuaranteedOrPredictedDecimalGuaranteed:
+				amounts = append(amounts, summaryExact(item.Address, amount.Value))
+			case EitherGuaranteedOrPredictedDecimalPredicted:
+				amounts = append(amounts, SummaryAmount{ResourceAddress: item.Address, Bound: SummaryPredicted, Amount: summaryDecimal(amount.Value)})
+			}
+		case InvocationIoItemNonFungible:
+			switch ids := item.Ids.(type) {
+			case EitherGuaranteedOrPredictedNonFungibleIdsGuaranteed:
+				amounts = append(amounts, summaryIds(item.Address, ids.Value, SummaryExact))
+			case EitherGuaranteedOrPredictedNonFungibleIdsPredicted:
+				amounts = append(amounts, summaryIds(item.Address, ids.Value, SummaryPredicted))
+			}
+		}
+	}
+	return amounts
+}
+
+func summaryDeposit(deposit AccountDeposit) SummaryAmount {
+	switch deposit := deposit.(type) {
+	case AccountDepositKnownFungible:
+		amount := SummaryAmount{ResourceAddress: deposit.ResourceAddress, Bound: SummaryUnknownAmount}
+		switch bounds := deposit.Bounds.(type) {
+		case SimpleFungibleResourceBoundsExact:
+			amount.Bound, amount.Amount = SummaryExact, summaryDecimal(bounds.Value)
+		case SimpleFungibleResourceBoundsAtLeast:
+			amount.Bound, amount.Amount = SummaryAtLeast, summaryDecimal(bounds.Value)
+		case SimpleFungibleResourceBoundsAtMost:
+			amount.Bound, amount.Amount = SummaryAtMost, summaryDecimal(bounds.Value)
+		case SimpleFungibleResourceBoundsBetween:
+			amount.Bound = SummaryBetween
+			amount.Amount, amount.UpperAmount = summaryDecimal(bounds.LowerBoundInclusive), summaryDecimal(bounds.UpperBoundInclusive)
+		}
+		return amount
+	case AccountDepositKnownNonFungible:
+		amount := SummaryAmount{ResourceAddress: deposit.ResourceAddress, Bound: SummaryUnknownAmount}
+		switch bounds := deposit.Bounds.(type) {
+		case SimpleNonFungibleResourceBoundsExact:
+			amount.Bound, amount.Amount = SummaryExact, summaryDecimal(bounds.Amount)
+			amount.Ids = nonFungibleLocalIdStrings(bounds.CertainIds)
+		case SimpleNonFungibleResourceBoundsNotExact:
+			amount.Ids = nonFungibleLocalIdStrings(bounds.CertainIds)
+			lower, _ := bounds.LowerBound.(LowerBoundInclusive)
+			switch upper := bounds.UpperBound.(type) {
+			case UpperBoundInclusive:
+				if lower.Value != nil {
+					amount.Bound = SummaryBetween
+					amount.Amount, amount.UpperAmount = summaryDecimal(lower.Value), summaryDecimal(upper.Value)
+				} else {
+					amount.Bound, amount.Amount = SummaryAtMost, summaryDecimal(upper.Value)
+				}
+			case UpperBoundUnbounded:
+				if lower.Value != nil {
+					amount.Bound, amount.Amount = SummaryAtLeast, summaryDecimal(lower.Value)
+				}
+			}
+		}
+		return amount
+	default:
+		return SummaryAmount{Bound: SummaryUnknownAmount}
+	}
+}
@@ -0,0 +1,307 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// Toolkit receipts
+//
+// DynamicallyAnalyze takes the outcome of a preview as a toolkit receipt: a
+// JSON document holding the kind of the outcome and, for committed
+// successes, what the analysis needs of the execution. ToolkitReceipt is the
+// Go form of that document; its JSON encoding is the string DynamicallyAnalyze
+// expects:
+//
+//	{
+//	  "kind": "CommitSuccess",
+//	  "state_updates_summary": {
+//	    "new_entities": ["account_tdx_2_1..."],
+//	    "metadata_updates": {},
+//	    "non_fungible_data_updates": {},
+//	    "newly_minted_non_fungibles": ["resource_tdx_2_1...:#1#"]
+//	  },
+//	  "worktop_changes": {
+//	    "0": [{"kind": "Put", "resource_specifier": {"kind": "Amount", ...}}]
+//	  },
+//	  "fee_summary": {"execution_fees_in_xrd": "0.1", ...},
+//	  "locked_fees": {"contingent": "0", "non_contingent": "10"},
+//	  "events": [{"type_identifier": {...}, "data": "5c22..."}]
+//	}
+//
+// The other kinds, CommitFailure, Reject and Abort, only carry a "reason".
+
+// ToolkitReceiptKind is the outcome of a previewed transaction.
+type ToolkitReceiptKind string
+
+const (
+	ToolkitReceiptKindCommitSuccess ToolkitReceiptKind = "CommitSuccess"
+	ToolkitReceiptKindCommitFailure ToolkitReceiptKind = "CommitFailure"
+	ToolkitReceiptKindReject        ToolkitReceiptKind = "Reject"
+	ToolkitReceiptKindAbort         ToolkitReceiptKind = "Abort"
+)
+
+// ToolkitReceipt is the receipt of a previewed transaction in the format
+// understood by DynamicallyAnalyze. Only Reason is set for receipts which are
+// not a CommitSuccess.
+type ToolkitReceipt struct {
+	Kind ToolkitReceiptKind
+
+	StateUpdatesSummary ToolkitStateUpdatesSummary
+	// changes to the worktop by the index of the instruction making them
+	WorktopChanges map[uint64][]ToolkitWorktopChange
+	FeeSummary     ToolkitFeeSummary
+	LockedFees     ToolkitLockedFees
+	Events         []ToolkitEvent
+
+	Reason string
+}
+
+// ToolkitStateUpdatesSummary summarizes the state changes of a committed
+// transaction.
+type ToolkitStateUpdatesSummary struct {
+	NewEntities     []address.Address
+	MetadataUpdates map[address.Address]map[string]ToolkitMetadataUpdate
+	// SBOR encoded data by non-fungible global id, in the format of
+	// NonFungibleGlobalId.AsStr
+	NonFungibleDataUpdates map[string][]byte
+	// non-fungible global ids, in the format of NonFungibleGlobalId.AsStr
+	NewlyMintedNonFungibles []string
+}
+
+// ToolkitMetadataUpdate is the change of one metadata entry: Value is set
+// for a Set and nil for a Delete.
+type ToolkitMetadataUpdate struct {
+	Value MetadataValue
+}
+
+// ToolkitWorktopChangeKind is the direction of a worktop change.
+type ToolkitWorktopChangeKind string
+
+const (
+	ToolkitWorktopChangeKindTake ToolkitWorktopChangeKind = "Take"
+	ToolkitWorktopChangeKindPut  ToolkitWorktopChangeKind = "Put"
+)
+
+// ToolkitWorktopChange is a resource taken from or put onto the worktop by an
+// instruction.
+type ToolkitWorktopChange struct {
+	Kind              ToolkitWorktopChangeKind
+	ResourceSpecifier ToolkitResourceSpecifier
+}
+
+// ToolkitResourceSpecifier is an amount of a fungible resource or a set of
+// non-fungibles; Amount is nil for the latter.
+type ToolkitResourceSpecifier struct {
+	ResourceAddress address.Address
+	Amount          *DecimalValue
+	// non-fungible local ids, in the format of NonFungibleLocalIdAsStr
+	Ids []string
+}
+
+type ToolkitFeeSummary struct {
+	ExecutionFeesInXrd    DecimalValue `json:"execution_fees_in_xrd"`
+	FinalizationFeesInXrd DecimalValue `json:"finalization_fees_in_xrd"`
+	StorageFeesInXrd      DecimalValue `json:"storage_fees_in_xrd"`
+	RoyaltyFeesInXrd      DecimalValue `json:"royalty_fees_in_xrd"`
+}
+
+type ToolkitLockedFees struct {
+	Contingent    DecimalValue `json:"contingent"`
+	NonContingent DecimalValue `json:"non_contingent"`
+}
+
+// ToolkitEvent is an event emitted by the transaction, Data holding its
+// Scrypto SBOR encoded payload.
+type ToolkitEvent struct {
+	TypeIdentifier EventTypeIdentifier
+	Data           []byte
+}
+
+func (summary *ToolkitFeeSummary) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeToolkitFeeSummary(data, "")
+	if err != nil {
+		return err
+	}
+	*summary = decoded
+	return nil
+}
+
+func (locked *ToolkitLockedFees) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeToolkitLockedFees(data, "")
+	if err != nil {
+		return err
+	}
+	*locked = decoded
+	return nil
+}
+
+type toolkitReceiptJSON struct {
+	Kind                ToolkitReceiptKind                `json:"kind"`
+	StateUpdatesSummary *toolkitStateUpdatesSummaryJSON   `json:"state_updates_summary"`
+	WorktopChanges      map[uint64][]ToolkitWorktopChange `json:"worktop_changes"`
+	FeeSummary          *ToolkitFeeSummary                `json:"fee_summary"`
+	LockedFees          *ToolkitLockedFees                `json:"locked_fees"`
+	Events              []ToolkitEvent                    `json:"events"`
+}
+
+type toolkitReceiptFailureJSON struct {
+	Kind   ToolkitReceiptKind `json:"kind"`
+	Reason string             `json:"reason"`
+}
+
+type toolkitStateUpdatesSummaryJSON struct {
+	NewEntities             []address.Address                                    `json:"new_entities"`
+	MetadataUpdates         map[address.Address]map[string]ToolkitMetadataUpdate `json:"metadata_updates"`
+	NonFungibleDataUpdates  map[string]string                                    `json:"non_fungible_data_updates"`
+	NewlyMintedNonFungibles []string                                             `json:"newly_minted_non_fungibles"`
+}
+
+// JSON returns the receipt as the string taken by DynamicallyAnalyze.
+func (receipt ToolkitReceipt) JSON() (string, error) {
+	encoded, err := json.Marshal(receipt)
+	return string(encoded), err
+}
+
+func (receipt ToolkitReceipt) MarshalJSON() ([]byte, error) {
+	if receipt.Kind != ToolkitReceiptKindCommitSuccess {
+		return json.Marshal(toolkitReceiptFailureJSON{Kind: receipt.Kind, Reason: receipt.Reason})
+	}
+
+	encoded := toolkitReceiptJSON{Kind: receipt.Kind}
+
+	summary := receipt.StateUpdatesSummary
+	encoded.StateUpdatesSummary = &toolkitStateUpdatesSummaryJSON{
+		NewEntities:             nonNilSlice(summary.NewEntities),
+		MetadataUpdates:         summary.MetadataUpdates,
+		NonFungibleDataUpdates:  make(map[string]string, len(summary.NonFungibleDataUpdates)),
+		NewlyMintedNonFungibles: nonNilSlice(summary.NewlyMintedNonFungibles),
+	}
+	if encoded.StateUpdatesSummary.MetadataUpdates == nil {
+		encoded.StateUpdatesSummary.MetadataUpdates = map[address.Address]map[string]ToolkitMetadataUpdate{}
+	}
+	for id, data := range summary.NonFungibleDataUpdates {
+		encoded.StateUpdatesSummary.NonFungibleDataUpdates[id] = hex.EncodeToString(data)
+	}
+	encoded.WorktopChanges = receipt.WorktopChanges
+	if encoded.WorktopChanges == nil {
+		encoded.WorktopChanges = map[uint64][]ToolkitWorktopChange{}
+	}
+	encoded.FeeSummary = &receipt.FeeSummary
+	encoded.LockedFees = &receipt.LockedFees
+	encoded.Events = nonNilSlice(receipt.Events)
+	return json.Marshal(encoded)
+}
+
+func (receipt *ToolkitReceipt) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeToolkitReceipt(data, "")
+	if err != nil {
+		return err
+	}
+	*receipt = decoded
+	return nil
+}
+
+type toolkitMetadataUpdateJSON struct {
+	Kind  string          `json:"kind"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+func (update ToolkitMetadataUpdate) MarshalJSON() ([]byte, error) {
+	if update.Value == nil {
+		return json.Marshal(toolkitMetadataUpdateJSON{Kind: "Delete"})
+	}
+	value, err := json.Marshal(update.Value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(toolkitMetadataUpdateJSON{Kind: "Set", Value: value})
+}
+
+func (update *ToolkitMetadataUpdate) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeToolkitMetadataUpdate(data, "")
+	if err != nil {
+		return err
+	}
+	*update = decoded
+	return nil
+}
+
+type toolkitWorktopChangeJSON struct {
+	Kind              ToolkitWorktopChangeKind `json:"kind"`
+	ResourceSpecifier ToolkitResourceSpecifier `json:"resource_specifier"`
+}
+
+func (change ToolkitWorktopChange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toolkitWorktopChangeJSON(change))
+}
+
+func (change *ToolkitWorktopChange) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeToolkitWorktopChange(data, "")
+	if err != nil {
+		return err
+	}
+	*change = decoded
+	return nil
+}
+
+type toolkitResourceSpecifierJSON struct {
+	Kind            string          `json:"kind"`
+	ResourceAddress address.Address `json:"resource_address"`
+	Amount          *DecimalValue   `json:"amount,omitempty"`
+	Ids             []string        `json:"ids,omitempty"`
+}
+
+func (specifier ToolkitResourceSpecifier) MarshalJSON() ([]byte, error) {
+	if specifier.Amount != nil {
+		return json.Marshal(toolkitResourceSpecifierJSON{
+			Kind:            "Amount",
+			ResourceAddress: specifier.ResourceAddress,
+			Amount:          specifier.Amount,
+		})
+	}
+	return json.Marshal(toolkitResourceSpecifierJSON{
+		Kind:            "Ids",
+		ResourceAddress: specifier.ResourceAddress,
+		Ids:             nonNilSlice(specifier.Ids),
+	})
+}
+
+func (specifier *ToolkitResourceSpecifier) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeToolkitResourceSpecifier(data, "")
+	if err != nil {
+		return err
+	}
+	*specifier = decoded
+	return nil
+}
+
+type toolkitEventJSON struct {
+	TypeIdentifier EventTypeIdentifier `json:"type_identifier"`
+	Data           string              `json:"data"`
+}
+
+func (event ToolkitEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(toolkitEventJSON{
+		TypeIdentifier: event.TypeIdentifier,
+		Data:           hex.EncodeToString(event.Data),
+	})
+}
+
+func (event *ToolkitEvent) UnmarshalJSON(data []byte) error {
+	decoded, err := decodeToolkitEvent(data, "")
+	if err != nil {
+		return err
+	}
+	*event = decoded
+	return nil
+}
+
+func nonNilSlice[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
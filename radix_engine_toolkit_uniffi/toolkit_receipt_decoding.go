@@ -0,0 +1,446 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// The receipt types decode their JSON field by field, so that a malformed
+// receipt is reported with the path of the offending field, e.g.
+// worktop_changes["2"][0].resource_specifier.amount, as a
+// RadixEngineToolkitErrorInvalidReceiptField.
+
+func receiptPathField(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func receiptPathIndex(path string, index int) string {
+	return path + "[" + strconv.Itoa(index) + "]"
+}
+
+func receiptPathKey(path, key string) string {
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+func receiptFieldError(path, format string, args ...any) error {
+	return NewRadixEngineToolkitErrorInvalidReceiptField(path, fmt.Sprintf(format, args...))
+}
+
+func decodeReceiptObject(data json.RawMessage, path string) (map[string]json.RawMessage, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		return nil, receiptFieldError(path, "expected an object")
+	}
+	return object, nil
+}
+
+func requiredReceiptField(object map[string]json.RawMessage, name, path string) (json.RawMessage, string, error) {
+	fieldPath := receiptPathField(path, name)
+	value, ok := object[name]
+	if !ok || bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+		return nil, fieldPath, receiptFieldError(fieldPath, "missing field")
+	}
+	return value, fieldPath, nil
+}
+
+func decodeReceiptArray(data json.RawMessage, path string) ([]json.RawMessage, error) {
+	var array []json.RawMessage
+	if err := json.Unmarshal(data, &array); err != nil || array == nil {
+		return nil, receiptFieldError(path, "expected an array")
+	}
+	return array, nil
+}
+
+func decodeReceiptString(data json.RawMessage, path string) (string, error) {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", receiptFieldError(path, "expected a string")
+	}
+	return value, nil
+}
+
+func decodeReceiptDecimal(data json.RawMessage, path string) (DecimalValue, error) {
+	value, err := decodeReceiptString(data, path)
+	if err != nil {
+		return DecimalValue{}, err
+	}
+	decimal, err := ParseDecimalValue(value)
+	if err != nil {
+		return DecimalValue{}, receiptFieldError(path, "invalid decimal %q", value)
+	}
+	return decimal, nil
+}
+
+func decodeReceiptAddress(data json.RawMessage, path string) (address.Address, error) {
+	value, err := decodeReceiptString(data, path)
+	if err != nil {
+		return address.Address{}, err
+	}
+	decoded, err := address.New(value)
+	if err != nil {
+		return address.Address{}, receiptFieldError(path, "invalid address %q: %v", value, err)
+	}
+	return decoded, nil
+}
+
+func decodeReceiptHex(data json.RawMessage, path string) ([]byte, error) {
+	value, err := decodeReceiptString(data, path)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, receiptFieldError(path, "invalid hex")
+	}
+	return decoded, nil
+}
+
+func decodeToolkitReceipt(data json.RawMessage, path string) (ToolkitReceipt, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	field, fieldPath, err := requiredReceiptField(object, "kind", path)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	kind, err := decodeReceiptString(field, fieldPath)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	receipt := ToolkitReceipt{Kind: ToolkitReceiptKind(kind)}
+	switch receipt.Kind {
+	case ToolkitReceiptKindCommitSuccess:
+	case ToolkitReceiptKindCommitFailure, ToolkitReceiptKindReject, ToolkitReceiptKindAbort:
+		if field, fieldPath, err = requiredReceiptField(object, "reason", path); err != nil {
+			return ToolkitReceipt{}, err
+		}
+		receipt.Reason, err = decodeReceiptString(field, fieldPath)
+		return receipt, err
+	default:
+		return ToolkitReceipt{}, receiptFieldError(fieldPath, "unknown receipt kind %q", kind)
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "state_updates_summary", path); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	if receipt.StateUpdatesSummary, err = decodeToolkitStateUpdatesSummary(field, fieldPath); err != nil {
+		return ToolkitReceipt{}, err
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "worktop_changes", path); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	changes, err := decodeReceiptObject(field, fieldPath)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	receipt.WorktopChanges = make(map[uint64][]ToolkitWorktopChange, len(changes))
+	for key, value := range changes {
+		keyPath := receiptPathKey(fieldPath, key)
+		index, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return ToolkitReceipt{}, receiptFieldError(keyPath, "invalid instruction index")
+		}
+		elements, err := decodeReceiptArray(value, keyPath)
+		if err != nil {
+			return ToolkitReceipt{}, err
+		}
+		instructionChanges := make([]ToolkitWorktopChange, len(elements))
+		for elementIndex, element := range elements {
+			if instructionChanges[elementIndex], err = decodeToolkitWorktopChange(element, receiptPathIndex(keyPath, elementIndex)); err != nil {
+				return ToolkitReceipt{}, err
+			}
+		}
+		receipt.WorktopChanges[index] = instructionChanges
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "fee_summary", path); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	if receipt.FeeSummary, err = decodeToolkitFeeSummary(field, fieldPath); err != nil {
+		return ToolkitReceipt{}, err
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "locked_fees", path); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	if receipt.LockedFees, err = decodeToolkitLockedFees(field, fieldPath); err != nil {
+		return ToolkitReceipt{}, err
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "events", path); err != nil {
+		return ToolkitReceipt{}, err
+	}
+	events, err := decodeReceiptArray(field, fieldPath)
+	if err != nil {
+		return ToolkitReceipt{}, err
+	}
+	receipt.Events = make([]ToolkitEvent, len(events))
+	for index, event := range events {
+		if receipt.Events[index], err = decodeToolkitEvent(event, receiptPathIndex(fieldPath, index)); err != nil {
+			return ToolkitReceipt{}, err
+		}
+	}
+	return receipt, nil
+}
+
+func decodeToolkitStateUpdatesSummary(data json.RawMessage, path string) (ToolkitStateUpdatesSummary, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	var summary ToolkitStateUpdatesSummary
+
+	field, fieldPath, err := requiredReceiptField(object, "new_entities", path)
+	if err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	entities, err := decodeReceiptArray(field, fieldPath)
+	if err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	summary.NewEntities = make([]address.Address, len(entities))
+	for index, entity := range entities {
+		if summary.NewEntities[index], err = decodeReceiptAddress(entity, receiptPathIndex(fieldPath, index)); err != nil {
+			return ToolkitStateUpdatesSummary{}, err
+		}
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "metadata_updates", path); err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	entitiesUpdates, err := decodeReceiptObject(field, fieldPath)
+	if err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	summary.MetadataUpdates = make(map[address.Address]map[string]ToolkitMetadataUpdate, len(entitiesUpdates))
+	for entity, value := range entitiesUpdates {
+		entityPath := receiptPathKey(fieldPath, entity)
+		entityAddress, err := address.New(entity)
+		if err != nil {
+			return ToolkitStateUpdatesSummary{}, receiptFieldError(entityPath, "invalid address %q: %v", entity, err)
+		}
+		updates, err := decodeReceiptObject(value, entityPath)
+		if err != nil {
+			return ToolkitStateUpdatesSummary{}, err
+		}
+		summary.MetadataUpdates[entityAddress] = make(map[string]ToolkitMetadataUpdate, len(updates))
+		for key, update := range updates {
+			if summary.MetadataUpdates[entityAddress][key], err = decodeToolkitMetadataUpdate(update, receiptPathKey(entityPath, key)); err != nil {
+				return ToolkitStateUpdatesSummary{}, err
+			}
+		}
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "non_fungible_data_updates", path); err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	dataUpdates, err := decodeReceiptObject(field, fieldPath)
+	if err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	summary.NonFungibleDataUpdates = make(map[string][]byte, len(dataUpdates))
+	for id, value := range dataUpdates {
+		if summary.NonFungibleDataUpdates[id], err = decodeReceiptHex(value, receiptPathKey(fieldPath, id)); err != nil {
+			return ToolkitStateUpdatesSummary{}, err
+		}
+	}
+
+	if field, fieldPath, err = requiredReceiptField(object, "newly_minted_non_fungibles", path); err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	minted, err := decodeReceiptArray(field, fieldPath)
+	if err != nil {
+		return ToolkitStateUpdatesSummary{}, err
+	}
+	summary.NewlyMintedNonFungibles = make([]string, len(minted))
+	for index, id := range minted {
+		if summary.NewlyMintedNonFungibles[index], err = decodeReceiptString(id, receiptPathIndex(fieldPath, index)); err != nil {
+			return ToolkitStateUpdatesSummary{}, err
+		}
+	}
+	return summary, nil
+}
+
+func decodeToolkitMetadataUpdate(data json.RawMessage, path string) (ToolkitMetadataUpdate, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitMetadataUpdate{}, err
+	}
+	field, fieldPath, err := requiredReceiptField(object, "kind", path)
+	if err != nil {
+		return ToolkitMetadataUpdate{}, err
+	}
+	kind, err := decodeReceiptString(field, fieldPath)
+	if err != nil {
+		return ToolkitMetadataUpdate{}, err
+	}
+	switch kind {
+	case "Delete":
+		return ToolkitMetadataUpdate{}, nil
+	case "Set":
+		if field, fieldPath, err = requiredReceiptField(object, "value", path); err != nil {
+			return ToolkitMetadataUpdate{}, err
+		}
+		value, err := MetadataValueFromJSON(field)
+		if err != nil {
+			return ToolkitMetadataUpdate{}, receiptFieldError(fieldPath, "invalid metadata value: %v", err)
+		}
+		return ToolkitMetadataUpdate{Value: value}, nil
+	}
+	return ToolkitMetadataUpdate{}, receiptFieldError(fieldPath, "unknown metadata update kind %q", kind)
+}
+
+func decodeToolkitWorktopChange(data json.RawMessage, path string) (ToolkitWorktopChange, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitWorktopChange{}, err
+	}
+	field, fieldPath, err := requiredReceiptField(object, "kind", path)
+	if err != nil {
+		return ToolkitWorktopChange{}, err
+	}
+	kind, err := decodeReceiptString(field, fieldPath)
+	if err != nil {
+		return ToolkitWorktopChange{}, err
+	}
+	change := ToolkitWorktopChange{Kind: ToolkitWorktopChangeKind(kind)}
+	if change.Kind != ToolkitWorktopChangeKindTake && change.Kind != ToolkitWorktopChangeKindPut {
+		return ToolkitWorktopChange{}, receiptFieldError(fieldPath, "unknown worktop change kind %q", kind)
+	}
+	if field, fieldPath, err = requiredReceiptField(object, "resource_specifier", path); err != nil {
+		return ToolkitWorktopChange{}, err
+	}
+	change.ResourceSpecifier, err = decodeToolkitResourceSpecifier(field, fieldPath)
+	return change, err
+}
+
+func decodeToolkitResourceSpecifier(data json.RawMessage, path string) (ToolkitResourceSpecifier, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitResourceSpecifier{}, err
+	}
+	field, kindPath, err := requiredReceiptField(object, "kind", path)
+	if err != nil {
+		return ToolkitResourceSpecifier{}, err
+	}
+	kind, err := decodeReceiptString(field, kindPath)
+	if err != nil {
+		return ToolkitResourceSpecifier{}, err
+	}
+	var specifier ToolkitResourceSpecifier
+	field, fieldPath, err := requiredReceiptField(object, "resource_address", path)
+	if err != nil {
+		return ToolkitResourceSpecifier{}, err
+	}
+	if specifier.ResourceAddress, err = decodeReceiptAddress(field, fieldPath); err != nil {
+		return ToolkitResourceSpecifier{}, err
+	}
+	switch kind {
+	case "Amount":
+		if field, fieldPath, err = requiredReceiptField(object, "amount", path); err != nil {
+			return ToolkitResourceSpecifier{}, err
+		}
+		amount, err := decodeReceiptDecimal(field, fieldPath)
+		if err != nil {
+			return ToolkitResourceSpecifier{}, err
+		}
+		specifier.Amount = &amount
+	case "Ids":
+		if field, fieldPath, err = requiredReceiptField(object, "ids", path); err != nil {
+			return ToolkitResourceSpecifier{}, err
+		}
+		ids, err := decodeReceiptArray(field, fieldPath)
+		if err != nil {
+			return ToolkitResourceSpecifier{}, err
+		}
+		specifier.Ids = make([]string, len(ids))
+		for index, id := range ids {
+			if specifier.Ids[index], err = decodeReceiptString(id, receiptPathIndex(fieldPath, index)); err != nil {
+				return ToolkitResourceSpecifier{}, err
+			}
+		}
+	default:
+		return ToolkitResourceSpecifier{}, receiptFieldError(kindPath, "unknown resource specifier kind %q", kind)
+	}
+	return specifier, nil
+}
+
+func decodeToolkitFeeSummary(data json.RawMessage, path string) (ToolkitFeeSummary, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitFeeSummary{}, err
+	}
+	var summary ToolkitFeeSummary
+	for _, fee := range []struct {
+		name  string
+		value *DecimalValue
+	}{
+		{"execution_fees_in_xrd", &summary.ExecutionFeesInXrd},
+		{"finalization_fees_in_xrd", &summary.FinalizationFeesInXrd},
+		{"storage_fees_in_xrd", &summary.StorageFeesInXrd},
+		{"royalty_fees_in_xrd", &summary.RoyaltyFeesInXrd},
+	} {
+		field, fieldPath, err := requiredReceiptField(object, fee.name, path)
+		if err != nil {
+			return ToolkitFeeSummary{}, err
+		}
+		if *fee.value, err = decodeReceiptDecimal(field, fieldPath); err != nil {
+			return ToolkitFeeSummary{}, err
+		}
+	}
+	return summary, nil
+}
+
+func decodeToolkitLockedFees(data json.RawMessage, path string) (ToolkitLockedFees, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitLockedFees{}, err
+	}
+	var locked ToolkitLockedFees
+	field, fieldPath, err := requiredReceiptField(object, "contingent", path)
+	if err != nil {
+		return ToolkitLockedFees{}, err
+	}
+	if locked.Contingent, err = decodeReceiptDecimal(field, fieldPath); err != nil {
+		return ToolkitLockedFees{}, err
+	}
+	if field, fieldPath, err = requiredReceiptField(object, "non_contingent", path); err != nil {
+		return ToolkitLockedFees{}, err
+	}
+	if locked.NonContingent, err = decodeReceiptDecimal(field, fieldPath); err != nil {
+		return ToolkitLockedFees{}, err
+	}
+	return locked, nil
+}
+
+func decodeToolkitEvent(data json.RawMessage, path string) (ToolkitEvent, error) {
+	object, err := decodeReceiptObject(data, path)
+	if err != nil {
+		return ToolkitEvent{}, err
+	}
+	var event ToolkitEvent
+	field, fieldPath, err := requiredReceiptField(object, "type_identifier", path)
+	if err != nil {
+		return ToolkitEvent{}, err
+	}
+	if err := json.Unmarshal(field, &event.TypeIdentifier); err != nil {
+		return ToolkitEvent{}, receiptFieldError(fieldPath, "invalid event type identifier: %v", err)
+	}
+	if field, fieldPath, err = requiredReceiptField(object, "data", path); err != nil {
+		return ToolkitEvent{}, err
+	}
+	if event.Data, err = decodeReceiptHex(field, fieldPath); err != nil {
+		return ToolkitEvent{}, err
+	}
+	return event, nil
+}
@@ -0,0 +1,291 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+var ErrRadixEngineToolkitErrorInvalidReceiptField = fmt.Errorf("RadixEngineToolkitErrorInvalidReceiptField")
+
+// RadixEngineToolkitErrorInvalidReceiptField reports the field of a toolkit
+// receipt which cannot be decoded or is invalid, Path being the path of the
+// field in the JSON encoding of the receipt, e.g. events[3].data. It also
+// matches ErrRadixEngineToolkitErrorInvalidReceipt.
+type RadixEngineToolkitErrorInvalidReceiptField struct {
+	Path   string
+	Reason string
+}
+
+func NewRadixEngineToolkitErrorInvalidReceiptField(
+	path string,
+	reason string,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorInvalidReceiptField{
+			Path:   path,
+			Reason: reason,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorInvalidReceiptField) Error() string {
+	return fmt.Sprint("InvalidReceiptField",
+		": ",
+
+		"Path=",
+		err.Path,
+		", ",
+		"Reason=",
+		err.Reason,
+	)
+}
+
+func (self RadixEngineToolkitErrorInvalidReceiptField) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorInvalidReceiptField || target == ErrRadixEngineToolkitErrorInvalidReceipt
+}
+
+// ParseToolkitReceipt decodes a toolkit receipt from its JSON encoding.
+func ParseToolkitReceipt(value string) (ToolkitReceipt, error) {
+	return decodeToolkitReceipt(json.RawMessage(value), "")
+}
+
+// Validate checks that the receipt is well formed for a transaction of the
+// network: known kinds, addresses of the network and of the right entity
+// types, non-negative amounts, valid non-fungible ids and SBOR payloads.
+func (receipt ToolkitReceipt) Validate(networkId uint8) error {
+	switch receipt.Kind {
+	case ToolkitReceiptKindCommitSuccess:
+	case ToolkitReceiptKindCommitFailure, ToolkitReceiptKindReject, ToolkitReceiptKindAbort:
+		return nil
+	default:
+		return receiptFieldError("kind", "unknown receipt kind %q", receipt.Kind)
+	}
+
+	summaryPath := "state_updates_summary"
+	for index, entity := range receipt.StateUpdatesSummary.NewEntities {
+		if err := validateReceiptAddress(entity, networkId, receiptPathIndex(receiptPathField(summaryPath, "new_entities"), index)); err != nil {
+			return err
+		}
+	}
+	for entity, updates := range receipt.StateUpdatesSummary.MetadataUpdates {
+		entityPath := receiptPathKey(receiptPathField(summaryPath, "metadata_updates"), entity.AddressString())
+		if err := validateReceiptAddress(entity, networkId, entityPath); err != nil {
+			return err
+		}
+		for key := range updates {
+			if key == "" {
+				return receiptFieldError(receiptPathKey(entityPath, key), "empty metadata key")
+			}
+		}
+	}
+	for id, data := range receipt.StateUpdatesSummary.NonFungibleDataUpdates {
+		idPath := receiptPathKey(receiptPathField(summaryPath, "non_fungible_data_updates"), id)
+		if err := validateReceiptNonFungibleGlobalId(id, networkId, idPath); err != nil {
+			return err
+		}
+		if err := validateReceiptSbor(data, idPath); err != nil {
+			return err
+		}
+	}
+	for index, id := range receipt.StateUpdatesSummary.NewlyMintedNonFungibles {
+		if err := validateReceiptNonFungibleGlobalId(id, networkId, receiptPathIndex(receiptPathField(summaryPath, "newly_minted_non_fungibles"), index)); err != nil {
+			return err
+		}
+	}
+
+	instructionIndices := make([]uint64, 0, len(receipt.WorktopChanges))
+	for instructionIndex := range receipt.WorktopChanges {
+		instructionIndices = append(instructionIndices, instructionIndex)
+	}
+	slices.Sort(instructionIndices)
+	for _, instructionIndex := range instructionIndices {
+		changes := receipt.WorktopChanges[instructionIndex]
+		instructionPath := receiptPathKey("worktop_changes", strconv.FormatUint(instructionIndex, 10))
+		for index, change := range changes {
+			changePath := receiptPathIndex(instructionPath, index)
+			if change.Kind != ToolkitWorktopChangeKindTake && change.Kind != ToolkitWorktopChangeKindPut {
+				return receiptFieldError(receiptPathField(changePath, "kind"), "unknown worktop change kind %q", change.Kind)
+			}
+			if err := validateReceiptResourceSpecifier(change.ResourceSpecifier, networkId, receiptPathField(changePath, "resource_specifier")); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, fee := range []struct {
+		name  string
+		value DecimalValue
+	}{
+		{"fee_summary.execution_fees_in_xrd", receipt.FeeSummary.ExecutionFeesInXrd},
+		{"fee_summary.finalization_fees_in_xrd", receipt.FeeSummary.FinalizationFeesInXrd},
+		{"fee_summary.storage_fees_in_xrd", receipt.FeeSummary.StorageFeesInXrd},
+		{"fee_summary.royalty_fees_in_xrd", receipt.FeeSummary.RoyaltyFeesInXrd},
+		{"locked_fees.contingent", receipt.LockedFees.Contingent},
+		{"locked_fees.non_contingent", receipt.LockedFees.NonContingent},
+	} {
+		if fee.value.IsNegative() {
+			return receiptFieldError(fee.name, "negative amount %s", fee.value)
+		}
+	}
+
+	for index, event := range receipt.Events {
+		eventPath := receiptPathIndex("events", index)
+		if err := validateReceiptEventTypeIdentifier(event.TypeIdentifier, networkId, receiptPathField(eventPath, "type_identifier")); err != nil {
+			return err
+		}
+		if err := validateReceiptSbor(event.Data, receiptPathField(eventPath, "data")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateInstructionCount checks that the worktop changes of the receipt
+// refer to instructions of a manifest of count instructions.
+func (receipt ToolkitReceipt) validateInstructionCount(count int) error {
+	for instructionIndex := range receipt.WorktopChanges {
+		if instructionIndex >= uint64(count) {
+			return receiptFieldError(
+				receiptPathKey("worktop_changes", strconv.FormatUint(instructionIndex, 10)),
+				"the manifest has %d instructions", count,
+			)
+		}
+	}
+	return nil
+}
+
+func validateReceiptAddress(value address.Address, networkId uint8, path string) error {
+	if value.NetworkId() != networkId {
+		return receiptFieldError(path, "address %s is not an address of network %d", value, networkId)
+	}
+	return nil
+}
+
+func validateReceiptResourceAddress(value address.Address, networkId uint8, path string) error {
+	if err := validateReceiptAddress(value, networkId, path); err != nil {
+		return err
+	}
+	if !value.IsGlobalResourceManager() {
+		return receiptFieldError(path, "address %s is not a resource address", value)
+	}
+	return nil
+}
+
+func validateReceiptResourceSpecifier(specifier ToolkitResourceSpecifier, networkId uint8, path string) error {
+	addressPath := receiptPathField(path, "resource_address")
+	if err := validateReceiptResourceAddress(specifier.ResourceAddress, networkId, addressPath); err != nil {
+		return err
+	}
+	if specifier.Amount != nil {
+		if specifier.Amount.IsNegative() {
+			return receiptFieldError(receiptPathField(path, "amount"), "negative amount %s", specifier.Amount)
+		}
+		return nil
+	}
+	idsPath := receiptPathField(path, "ids")
+	if !specifier.ResourceAddress.IsGlobalNonFungibleResourceManager() {
+		return receiptFieldError(idsPath, "ids of the fungible resource %s", specifier.ResourceAddress)
+	}
+	for index, id := range specifier.Ids {
+		if _, err := nonFungibleLocalIdFromString(id); err != nil {
+			return receiptFieldError(receiptPathIndex(idsPath, index), "invalid non-fungible local id %q", id)
+		}
+	}
+	return nil
+}
+
+// validateReceiptNonFungibleGlobalId checks a non-fungible global id in the
+// format of NonFungibleGlobalId.AsStr, <resource address>:<local id>.
+func validateReceiptNonFungibleGlobalId(value string, networkId uint8, path string) error {
+	resourceAddress, localId, ok := strings.Cut(value, ":")
+	if !ok {
+		return receiptFieldError(path, "invalid non-fungible global id %q", value)
+	}
+	resource, err := address.New(resourceAddress)
+	if err != nil {
+		return receiptFieldError(path, "invalid address %q: %v", resourceAddress, err)
+	}
+	if err := validateReceiptAddress(resource, networkId, path); err != nil {
+		return err
+	}
+	if !resource.IsGlobalNonFungibleResourceManager() {
+		return receiptFieldError(path, "address %s is not a non-fungible resource address", resource)
+	}
+	if _, err := nonFungibleLocalIdFromString(localId); err != nil {
+		return receiptFieldError(path, "invalid non-fungible local id %q", localId)
+	}
+	return nil
+}
+
+func validateReceiptEventTypeIdentifier(identifier EventTypeIdentifier, networkId uint8, path string) error {
+	if identifier.EventName == "" {
+		return receiptFieldError(receiptPathField(path, "event_name"), "empty event name")
+	}
+	emitterPath := receiptPathField(path, "emitter")
+	var emitterAddress *Address
+	switch emitter := identifier.Emitter.(type) {
+	case EmitterFunction:
+		if emitter.BlueprintName == "" {
+			return receiptFieldError(receiptPathField(emitterPath, "blueprint_name"), "empty blueprint name")
+		}
+		emitterAddress = emitter.Address
+	case EmitterMethod:
+		emitterAddress = emitter.Address
+	default:
+		return receiptFieldError(emitterPath, "missing emitter")
+	}
+	addressPath := receiptPathField(emitterPath, "address")
+	if emitterAddress == nil {
+		return receiptFieldError(addressPath, "missing field")
+	}
+	if emitterAddress.NetworkId() != networkId {
+		return receiptFieldError(addressPath, "address %s is not an address of network %d", emitterAddress.AsStr(), networkId)
+	}
+	return nil
+}
+
+func validateReceiptSbor(data []byte, path string) error {
+	if !bytes.HasPrefix(data, []byte{sbor.Scrypto.Prefix()}) || len(data) < 2 {
+		return receiptFieldError(path, "not a Scrypto SBOR payload")
+	}
+	return nil
+}
+
+// DynamicallyAnalyzeReceipt is DynamicallyAnalyze taking a typed receipt,
+// which is validated against the network and the manifest first.
+func (_self *TransactionManifestV1) DynamicallyAnalyzeReceipt(networkId uint8, receipt ToolkitReceipt) (DynamicAnalysis, error) {
+	if err := receipt.Validate(networkId); err != nil {
+		return DynamicAnalysis{}, err
+	}
+	if err := receipt.validateInstructionCount(len(_self.Instructions().InstructionsList())); err != nil {
+		return DynamicAnalysis{}, err
+	}
+	encoded, err := receipt.JSON()
+	if err != nil {
+		return DynamicAnalysis{}, err
+	}
+	return _self.DynamicallyAnalyze(networkId, encoded)
+}
+
+// DynamicallyAnalyzeReceipt is DynamicallyAnalyze taking a typed receipt,
+// which is validated against the network and the manifest first.
+func (_self *TransactionManifestV2) DynamicallyAnalyzeReceipt(networkId uint8, receipt ToolkitReceipt) (DynamicAnalysis, error) {
+	if err := receipt.Validate(networkId); err != nil {
+		return DynamicAnalysis{}, err
+	}
+	if err := receipt.validateInstructionCount(len(_self.Instructions().InstructionsList())); err != nil {
+		return DynamicAnalysis{}, err
+	}
+	encoded, err := receipt.JSON()
+	if err != nil {
+		return DynamicAnalysis{}, err
+	}
+	return _self.DynamicallyAnalyze(networkId, encoded)
+}
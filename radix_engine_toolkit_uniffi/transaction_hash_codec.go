@@ -0,0 +1,41 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/transactionhash"
+)
+
+// Pure Go transaction hashes
+//
+// The transactionhash package computes the hashes of transaction payloads
+// and encodes them without the native library. The functions below are its
+// entry points for users of this package, reporting failures with the
+// RadixEngineToolkitError variants of the address functions:
+//
+//	hashes, err := ComputeTransactionHashes(notarized)
+//	fmt.Println(hashes.TransactionIntent.AsStr()) // txid_rdx1…
+
+// ComputeTransactionHashes returns the hashes of a transaction payload, as
+// returned by the ToPayloadBytes methods, without calling into the native
+// library.
+func ComputeTransactionHashes(payload []byte) (transactionhash.Hashes, error) {
+	return transactionhash.Compute(payload)
+}
+
+// ParseTransactionHash decodes a bech32m encoded hash like
+// TransactionHashFromStr, without calling into the native library. Its
+// network is read from its hrp rather than passed in.
+func ParseTransactionHash(value string) (transactionhash.Hash, error) {
+	decoded, err := transactionhash.New(value)
+	return decoded, addressError(err)
+}
+
+// TransactionHashFromValue converts a pure Go hash into a native
+// *TransactionHash.
+func TransactionHashFromValue(value transactionhash.Hash) (*TransactionHash, error) {
+	return TransactionHashFromStr(value.AsStr(), value.NetworkId())
+}
+
+// Value converts the native hash into a pure Go hash.
+func (_self *TransactionHash) Value() (transactionhash.Hash, error) {
+	return ParseTransactionHash(_self.AsStr())
+}
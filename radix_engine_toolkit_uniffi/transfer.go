@@ -0,0 +1,372 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// Transfers
+//
+// Transfer and BatchTransfer add a payout to a manifest: the resources of
+// all recipients are withdrawn from the sending account at once, one
+// withdrawal per resource, then taken from the worktop into a bucket per
+// recipient and resource and deposited into the accounts of the recipients:
+//
+//	builder, err = builder.BatchTransfer(sender, []TransferRecipient{
+//		{Account: alice, Fungibles: []FungibleTransfer{{xrd, amount}}},
+//		{Account: bob, NonFungibles: []NonFungibleTransfer{{badge, ids}}},
+//	}, TransferOptions{LockFee: fee})
+//
+// The manifest has only the instructions of a transfer, so StaticallyAnalyze
+// classifies it as ManifestClassificationTransfer.
+
+var ErrRadixEngineToolkitErrorInvalidTransfer = fmt.Errorf("RadixEngineToolkitErrorInvalidTransfer")
+
+// RadixEngineToolkitErrorInvalidTransfer reports the argument of a transfer
+// which is invalid, Path being the path of the field, e.g.
+// recipients[1].fungibles[0].amount.
+type RadixEngineToolkitErrorInvalidTransfer struct {
+	Path   string
+	Reason string
+}
+
+func NewRadixEngineToolkitErrorInvalidTransfer(
+	path string,
+	reason string,
+) *RadixEngineToolkitError {
+	return &RadixEngineToolkitError{
+		err: &RadixEngineToolkitErrorInvalidTransfer{
+			Path:   path,
+			Reason: reason,
+		},
+	}
+}
+
+func (err RadixEngineToolkitErrorInvalidTransfer) Error() string {
+	return fmt.Sprint("InvalidTransfer",
+		": ",
+
+		"Path=",
+		err.Path,
+		", ",
+		"Reason=",
+		err.Reason,
+	)
+}
+
+func (self RadixEngineToolkitErrorInvalidTransfer) Is(target error) bool {
+	return target == ErrRadixEngineToolkitErrorInvalidTransfer
+}
+
+// TransferDepositMode selects the account method the buckets of a transfer
+// are deposited with.
+type TransferDepositMode int
+
+const (
+	// TransferDepositOrAbort deposits with try_deposit_or_abort: the
+	// transaction fails if a recipient does not accept the deposit.
+	TransferDepositOrAbort TransferDepositMode = iota
+	// TransferDepositOrRefund deposits with try_deposit_or_refund: the
+	// resources a recipient does not accept are returned to the worktop.
+	TransferDepositOrRefund
+)
+
+// FungibleTransfer is an amount of a resource to transfer.
+type FungibleTransfer struct {
+	ResourceAddress *Address
+	Amount          *Decimal
+}
+
+// NonFungibleTransfer is a set of non-fungibles of a resource to transfer.
+type NonFungibleTransfer struct {
+	ResourceAddress *Address
+	Ids             []NonFungibleLocalId
+}
+
+// TransferRecipient is an account and the resources it receives.
+type TransferRecipient struct {
+	Account      *Address
+	Fungibles    []FungibleTransfer
+	NonFungibles []NonFungibleTransfer
+}
+
+// TransferOptions are the options of BatchTransfer.
+type TransferOptions struct {
+	DepositMode TransferDepositMode
+	// AuthorizedDepositorBadge is presented to the accounts of the
+	// recipients, which accept deposits from its holders.
+	AuthorizedDepositorBadge *ResourceOrNonFungible
+	// LockFee, if not nil, is locked on the sending account before the
+	// withdrawals.
+	LockFee *Decimal
+	// Handles, if not nil, allocates the names of the buckets.
+	Handles *ManifestHandles
+}
+
+// Transfer adds the transfer of an amount of a resource from an account to
+// another.
+func (_self *ManifestV1Builder) Transfer(from *Address, to *Address, resourceAddress *Address, amount *Decimal) (*ManifestV1Builder, error) {
+	return _self.BatchTransfer(from, []TransferRecipient{singleTransfer(to, resourceAddress, amount)}, TransferOptions{})
+}
+
+// BatchTransfer adds the transfer of resources from an account to the
+// accounts of recipients.
+func (_self *ManifestV1Builder) BatchTransfer(from *Address, recipients []TransferRecipient, options TransferOptions) (*ManifestV1Builder, error) {
+	return batchTransfer(_self, from, recipients, options)
+}
+
+// Transfer adds the transfer of an amount of a resource from an account to
+// another.
+func (_self *ManifestV2Builder) Transfer(from *Address, to *Address, resourceAddress *Address, amount *Decimal) (*ManifestV2Builder, error) {
+	return _self.BatchTransfer(from, []TransferRecipient{singleTransfer(to, resourceAddress, amount)}, TransferOptions{})
+}
+
+// BatchTransfer adds the transfer of resources from an account to the
+// accounts of recipients.
+func (_self *ManifestV2Builder) BatchTransfer(from *Address, recipients []TransferRecipient, options TransferOptions) (*ManifestV2Builder, error) {
+	return batchTransfer(_self, from, recipients, options)
+}
+
+func singleTransfer(to *Address, resourceAddress *Address, amount *Decimal) TransferRecipient {
+	return TransferRecipient{
+		Account:   to,
+		Fungibles: []FungibleTransfer{{ResourceAddress: resourceAddress, Amount: amount}},
+	}
+}
+
+// transferBuilder is the part of ManifestV1Builder and ManifestV2Builder
+// which transfers use.
+type transferBuilder[B any] interface {
+	AccountLockFee(address *Address, amount *Decimal) (B, error)
+	AccountWithdraw(address *Address, resourceAddress *Address, amount *Decimal) (B, error)
+	AccountWithdrawNonFungibles(address *Address, resourceAddress *Address, ids []NonFungibleLocalId) (B, error)
+	TakeFromWorktop(resourceAddress *Address, amount *Decimal, intoBucket ManifestBuilderBucket) (B, error)
+	TakeNonFungiblesFromWorktop(resourceAddress *Address, ids []NonFungibleLocalId, intoBucket ManifestBuilderBucket) (B, error)
+	AccountTryDepositOrAbort(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (B, error)
+	AccountTryDepositOrRefund(address *Address, bucket ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (B, error)
+	AccountTryDepositBatchOrAbort(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (B, error)
+	AccountTryDepositBatchOrRefund(address *Address, buckets []ManifestBuilderBucket, authorizedDepositorBadge *ResourceOrNonFungible) (B, error)
+}
+
+// transferBuckets names the buckets of a transfer. Without ManifestHandles
+// they are transfer_bucket_1, transfer_bucket_2, ... counted from 1 on every
+// call, skipping the names the builder already has, so that the same calls
+// always give the same manifest.
+type transferBuckets struct {
+	handles *ManifestHandles
+	count   int
+}
+
+// takeTransferBucket adds take, an instruction which creates a bucket, under
+// the next bucket name of buckets.
+func takeTransferBucket[B any](buckets *transferBuckets, take func(bucket ManifestBuilderBucket) (B, error)) (B, ManifestBuilderBucket, error) {
+	if buckets.handles != nil {
+		bucket := buckets.handles.NewBucket().Bucket()
+		builder, err := take(bucket)
+		return builder, bucket, err
+	}
+	for {
+		buckets.count++
+		bucket := ManifestBuilderBucket{Name: fmt.Sprintf("transfer_bucket_%d", buckets.count)}
+		builder, err := take(bucket)
+		if !isNameTaken(err) {
+			return builder, bucket, err
+		}
+	}
+}
+
+// isNameTaken reports whether err is the builder's error for a name which
+// is already taken.
+func isNameTaken(err error) bool {
+	var nameRecordError *RadixEngineToolkitErrorManifestBuilderNameRecordError
+	if !errors.As(err, &nameRecordError) {
+		return false
+	}
+	_, ok := nameRecordError.Error_.(NameRecordErrorObjectNameIsAlreadyTaken)
+	return ok
+}
+
+// transferWithdrawal is the total of a resource withdrawn for a transfer.
+type transferWithdrawal struct {
+	resourceAddress *Address
+	amount          *Decimal
+	ids             []NonFungibleLocalId
+	seen            map[string]bool
+}
+
+func batchTransfer[B transferBuilder[B]](builder B, from *Address, recipients []TransferRecipient, options TransferOptions) (B, error) {
+	var zero B
+	withdrawals, err := transferWithdrawals(from, recipients, options)
+	if err != nil {
+		return zero, err
+	}
+
+	if options.LockFee != nil {
+		if builder, err = builder.AccountLockFee(from, options.LockFee); err != nil {
+			return zero, err
+		}
+	}
+	for _, withdrawal := range withdrawals {
+		if withdrawal.amount != nil {
+			builder, err = builder.AccountWithdraw(from, withdrawal.resourceAddress, withdrawal.amount)
+		} else {
+			builder, err = builder.AccountWithdrawNonFungibles(from, withdrawal.resourceAddress, withdrawal.ids)
+		}
+		if err != nil {
+			return zero, err
+		}
+	}
+
+	names := &transferBuckets{handles: options.Handles}
+	for _, recipient := range recipients {
+		var buckets []ManifestBuilderBucket
+		for _, transfer := range recipient.Fungibles {
+			var bucket ManifestBuilderBucket
+			builder, bucket, err = takeTransferBucket(names, func(bucket ManifestBuilderBucket) (B, error) {
+				return builder.TakeFromWorktop(transfer.ResourceAddress, transfer.Amount, bucket)
+			})
+			if err != nil {
+				return zero, err
+			}
+			buckets = append(buckets, bucket)
+		}
+		for _, transfer := range recipient.NonFungibles {
+			var bucket ManifestBuilderBucket
+			builder, bucket, err = takeTransferBucket(names, func(bucket ManifestBuilderBucket) (B, error) {
+				return builder.TakeNonFungiblesFromWorktop(transfer.ResourceAddress, transfer.Ids, bucket)
+			})
+			if err != nil {
+				return zero, err
+			}
+			buckets = append(buckets, bucket)
+		}
+
+		badge := options.AuthorizedDepositorBadge
+		switch {
+		case len(buckets) == 1 && options.DepositMode == TransferDepositOrRefund:
+			builder, err = builder.AccountTryDepositOrRefund(recipient.Account, buckets[0], badge)
+		case len(buckets) == 1:
+			builder, err = builder.AccountTryDepositOrAbort(recipient.Account, buckets[0], badge)
+		case options.DepositMode == TransferDepositOrRefund:
+			builder, err = builder.AccountTryDepositBatchOrRefund(recipient.Account, buckets, badge)
+		default:
+			builder, err = builder.AccountTryDepositBatchOrAbort(recipient.Account, buckets, badge)
+		}
+		if err != nil {
+			return zero, err
+		}
+	}
+	return builder, nil
+}
+
+// transferWithdrawals checks the arguments of a transfer and returns the
+// resources to withdraw, in the order the recipients first name them.
+func transferWithdrawals(from *Address, recipients []TransferRecipient, options TransferOptions) ([]*transferWithdrawal, error) {
+	if err := validateTransferAccount(from, "from"); err != nil {
+		return nil, err
+	}
+	if len(recipients) == 0 {
+		return nil, NewRadixEngineToolkitErrorInvalidTransfer("recipients", "no recipients")
+	}
+	if options.DepositMode != TransferDepositOrAbort && options.DepositMode != TransferDepositOrRefund {
+		return nil, NewRadixEngineToolkitErrorInvalidTransfer("options.deposit_mode", fmt.Sprintf("unknown deposit mode %d", options.DepositMode))
+	}
+
+	var withdrawals []*transferWithdrawal
+	byResource := map[string]*transferWithdrawal{}
+	withdrawal := func(resourceAddress *Address, fungible bool, path string) (*transferWithdrawal, error) {
+		if resourceAddress == nil {
+			return nil, NewRadixEngineToolkitErrorInvalidTransfer(path, "no resource address")
+		}
+		key := resourceAddress.AsStr()
+		result, ok := byResource[key]
+		if !ok {
+			result = &transferWithdrawal{resourceAddress: resourceAddress}
+			if fungible {
+				result.amount = DecimalZero()
+			} else {
+				result.seen = map[string]bool{}
+			}
+			byResource[key] = result
+			withdrawals = append(withdrawals, result)
+		}
+		if fungible != (result.amount != nil) {
+			return nil, NewRadixEngineToolkitErrorInvalidTransfer(path, fmt.Sprintf("%s is transferred both by amount and by ids", key))
+		}
+		return result, nil
+	}
+
+	for index, recipient := range recipients {
+		path := fmt.Sprintf("recipients[%d]", index)
+		if err := validateTransferAccount(recipient.Account, path+".account"); err != nil {
+			return nil, err
+		}
+		if len(recipient.Fungibles) == 0 && len(recipient.NonFungibles) == 0 {
+			return nil, NewRadixEngineToolkitErrorInvalidTransfer(path, "no resources")
+		}
+		for index, transfer := range recipient.Fungibles {
+			transferPath := fmt.Sprintf("%s.fungibles[%d]", path, index)
+			total, err := withdrawal(transfer.ResourceAddress, true, transferPath+".resource_address")
+			if err != nil {
+				return nil, err
+			}
+			if transfer.Amount == nil || !transfer.Amount.IsPositive() {
+				return nil, NewRadixEngineToolkitErrorInvalidTransfer(transferPath+".amount", "not a positive amount")
+			}
+			if total.amount, err = total.amount.Add(transfer.Amount); err != nil {
+				return nil, err
+			}
+		}
+		for index, transfer := range recipient.NonFungibles {
+			transferPath := fmt.Sprintf("%s.non_fungibles[%d]", path, index)
+			total, err := withdrawal(transfer.ResourceAddress, false, transferPath+".resource_address")
+			if err != nil {
+				return nil, err
+			}
+			if len(transfer.Ids) == 0 {
+				return nil, NewRadixEngineToolkitErrorInvalidTransfer(transferPath+".ids", "no ids")
+			}
+			for index, id := range transfer.Ids {
+				idPath := fmt.Sprintf("%s.ids[%d]", transferPath, index)
+				if id == nil {
+					return nil, NewRadixEngineToolkitErrorInvalidTransfer(idPath, "no id")
+				}
+				key := nonFungibleLocalIdString(id)
+				if total.seen[key] {
+					return nil, NewRadixEngineToolkitErrorInvalidTransfer(idPath, fmt.Sprintf("%s is transferred twice", key))
+				}
+				total.seen[key] = true
+				total.ids = append(total.ids, id)
+			}
+		}
+	}
+	return withdrawals, nil
+}
+
+func validateTransferAccount(account *Address, path string) error {
+	if account == nil {
+		return NewRadixEngineToolkitErrorInvalidTransfer(path, "no account")
+	}
+	value, err := account.Value()
+	if err != nil {
+		return err
+	}
+	if isAccount(value) {
+		return nil
+	}
+	return NewRadixEngineToolkitErrorInvalidTransfer(path, fmt.Sprintf("%s is not an account", value))
+}
+
+// isAccount returns whether value is the address of an account, allocated
+// or preallocated.
+func isAccount(value address.Address) bool {
+	switch value.EntityType() {
+	case address.EntityTypeGlobalAccount,
+		address.EntityTypeGlobalPreallocatedSecp256k1Account,
+		address.EntityTypeGlobalPreallocatedEd25519Account:
+		return true
+	}
+	return false
+}
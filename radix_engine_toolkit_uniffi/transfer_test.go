@@ -0,0 +1,210 @@
+package radix_engine_toolkit_uniffi
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+// testAddress returns the mainnet address of the entity type whose node id
+// is filled with fill.
+func testAddress(t *testing.T, entityType address.EntityType, fill byte) *Address {
+	t.Helper()
+	nodeId := bytes.Repeat([]byte{fill}, address.NodeIdLength)
+	nodeId[0] = entityType.Byte()
+	value, err := address.FromRaw(nodeId, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := AddressFromValue(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func testDecimal(t *testing.T, value string) *Decimal {
+	t.Helper()
+	result, err := NewDecimal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+// transferAddresses are the accounts and resources of the transfer tests.
+type transferAddresses struct {
+	sender, alice, bob *Address
+	xrd, badge         *Address
+}
+
+func newTransferAddresses(t *testing.T) transferAddresses {
+	return transferAddresses{
+		sender: testAddress(t, address.EntityTypeGlobalAccount, 1),
+		alice:  testAddress(t, address.EntityTypeGlobalAccount, 2),
+		bob:    testAddress(t, address.EntityTypeGlobalPreallocatedEd25519Account, 3),
+		xrd:    testAddress(t, address.EntityTypeGlobalFungibleResourceManager, 4),
+		badge:  testAddress(t, address.EntityTypeGlobalNonFungibleResourceManager, 5),
+	}
+}
+
+func manifestV2Text(t *testing.T, builder *ManifestV2Builder) string {
+	t.Helper()
+	text, err := builder.Build().Instructions().AsStr()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return text
+}
+
+func TestBatchTransferClassification(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	recipients := []TransferRecipient{
+		{Account: addresses.alice, Fungibles: []FungibleTransfer{{addresses.xrd, testDecimal(t, "10")}}},
+		{Account: addresses.bob, Fungibles: []FungibleTransfer{{addresses.xrd, testDecimal(t, "2.5")}},
+			NonFungibles: []NonFungibleTransfer{{addresses.badge, []NonFungibleLocalId{NonFungibleLocalIdInteger{Value: 1}}}}},
+	}
+
+	builderV2, err := NewManifestV2Builder(1).BatchTransfer(addresses.sender, recipients, TransferOptions{LockFee: testDecimal(t, "5")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	analysisV2, err := builderV2.Build().StaticallyAnalyze(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(analysisV2.ManifestClassification, ManifestClassificationTransfer) {
+		t.Errorf("V2 BatchTransfer classified as %v, want ManifestClassificationTransfer", analysisV2.ManifestClassification)
+	}
+
+	builderV1, err := NewManifestV1Builder().Transfer(addresses.sender, addresses.alice, addresses.xrd, testDecimal(t, "1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	analysisV1, err := builderV1.Build(1).StaticallyAnalyze(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(analysisV1.ManifestClassification, ManifestClassificationTransfer) {
+		t.Errorf("V1 Transfer classified as %v, want ManifestClassificationTransfer", analysisV1.ManifestClassification)
+	}
+}
+
+func TestBatchTransferErrors(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	one := testDecimal(t, "1")
+	xrd := func(amount *Decimal) []FungibleTransfer {
+		return []FungibleTransfer{{addresses.xrd, amount}}
+	}
+	badges := func(ids ...NonFungibleLocalId) []NonFungibleTransfer {
+		return []NonFungibleTransfer{{addresses.badge, ids}}
+	}
+	id := NonFungibleLocalIdInteger{Value: 1}
+
+	tests := []struct {
+		name       string
+		from       *Address
+		recipients []TransferRecipient
+		options    TransferOptions
+		path       string
+	}{
+		{"no sender", nil, []TransferRecipient{{Account: addresses.alice, Fungibles: xrd(one)}}, TransferOptions{}, "from"},
+		{"sender not an account", addresses.xrd, []TransferRecipient{{Account: addresses.alice, Fungibles: xrd(one)}}, TransferOptions{}, "from"},
+		{"no recipients", addresses.sender, nil, TransferOptions{}, "recipients"},
+		{"unknown deposit mode", addresses.sender, []TransferRecipient{{Account: addresses.alice, Fungibles: xrd(one)}}, TransferOptions{DepositMode: 7}, "options.deposit_mode"},
+		{"no recipient account", addresses.sender, []TransferRecipient{{Fungibles: xrd(one)}}, TransferOptions{}, "recipients[0].account"},
+		{"recipient not an account", addresses.sender, []TransferRecipient{{Account: addresses.badge, Fungibles: xrd(one)}}, TransferOptions{}, "recipients[0].account"},
+		{"no resources", addresses.sender, []TransferRecipient{{Account: addresses.alice}}, TransferOptions{}, "recipients[0]"},
+		{"no resource address", addresses.sender, []TransferRecipient{{Account: addresses.alice, Fungibles: []FungibleTransfer{{nil, one}}}}, TransferOptions{}, "recipients[0].fungibles[0].resource_address"},
+		{"no amount", addresses.sender, []TransferRecipient{{Account: addresses.alice, Fungibles: xrd(nil)}}, TransferOptions{}, "recipients[0].fungibles[0].amount"},
+		{"zero amount", addresses.sender, []TransferRecipient{{Account: addresses.alice, Fungibles: xrd(DecimalZero())}}, TransferOptions{}, "recipients[0].fungibles[0].amount"},
+		{"negative amount", addresses.sender, []TransferRecipient{{Account: addresses.alice, Fungibles: xrd(testDecimal(t, "-1"))}}, TransferOptions{}, "recipients[0].fungibles[0].amount"},
+		{"no ids", addresses.sender, []TransferRecipient{{Account: addresses.alice, NonFungibles: badges()}}, TransferOptions{}, "recipients[0].non_fungibles[0].ids"},
+		{"nil id", addresses.sender, []TransferRecipient{{Account: addresses.alice, NonFungibles: badges(id, nil)}}, TransferOptions{}, "recipients[0].non_fungibles[0].ids[1]"},
+		{"duplicate id", addresses.sender, []TransferRecipient{{Account: addresses.alice, NonFungibles: badges(id, id)}}, TransferOptions{}, "recipients[0].non_fungibles[0].ids[1]"},
+		{
+			"duplicate id of another recipient",
+			addresses.sender,
+			[]TransferRecipient{{Account: addresses.alice, NonFungibles: badges(id)}, {Account: addresses.bob, NonFungibles: badges(id)}},
+			TransferOptions{},
+			"recipients[1].non_fungibles[0].ids[0]",
+		},
+		{
+			"resource by amount and by ids",
+			addresses.sender,
+			[]TransferRecipient{{Account: addresses.alice, NonFungibles: badges(id)}, {Account: addresses.bob, Fungibles: []FungibleTransfer{{addresses.badge, one}}}},
+			TransferOptions{},
+			"recipients[1].fungibles[0].resource_address",
+		},
+	}
+	for _, test := range tests {
+		builder, err := NewManifestV2Builder(1).BatchTransfer(test.from, test.recipients, test.options)
+		var invalid *RadixEngineToolkitErrorInvalidTransfer
+		if builder != nil || !errors.Is(err, ErrRadixEngineToolkitErrorInvalidTransfer) || !errors.As(err, &invalid) {
+			t.Errorf("%s: BatchTransfer = %v, %v, want a RadixEngineToolkitErrorInvalidTransfer error", test.name, builder, err)
+			continue
+		}
+		if invalid.Path != test.path {
+			t.Errorf("%s: BatchTransfer error at %s, want %s", test.name, invalid.Path, test.path)
+		}
+	}
+}
+
+func TestTransferWithdrawals(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	recipients := []TransferRecipient{
+		{Account: addresses.alice, NonFungibles: []NonFungibleTransfer{{addresses.badge, []NonFungibleLocalId{NonFungibleLocalIdInteger{Value: 2}}}}},
+		{Account: addresses.bob, Fungibles: []FungibleTransfer{{addresses.xrd, testDecimal(t, "1.5")}, {addresses.xrd, testDecimal(t, "2")}},
+			NonFungibles: []NonFungibleTransfer{{addresses.badge, []NonFungibleLocalId{NonFungibleLocalIdStr{Value: "one"}}}}},
+	}
+	withdrawals, err := transferWithdrawals(addresses.sender, recipients, TransferOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(withdrawals) != 2 {
+		t.Fatalf("transferWithdrawals returned %d withdrawals, want 2", len(withdrawals))
+	}
+	badges, xrd := withdrawals[0], withdrawals[1]
+	if badges.resourceAddress != addresses.badge || badges.amount != nil || len(badges.ids) != 2 {
+		t.Errorf("first withdrawal = %s of %v, %d ids, want 2 ids of %s", badges.resourceAddress.AsStr(), badges.amount, len(badges.ids), addresses.badge.AsStr())
+	}
+	if xrd.resourceAddress != addresses.xrd || xrd.amount == nil || xrd.amount.AsStr() != "3.5" {
+		t.Errorf("second withdrawal = %s of %v, want 3.5 of %s", xrd.resourceAddress.AsStr(), xrd.amount, addresses.xrd.AsStr())
+	}
+}
+
+func TestBatchTransferBucketNames(t *testing.T) {
+	addresses := newTransferAddresses(t)
+	one := testDecimal(t, "1")
+	builder, err := NewManifestV2Builder(1).AccountWithdraw(addresses.sender, addresses.xrd, one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if builder, err = builder.TakeFromWorktop(addresses.xrd, one, ManifestBuilderBucket{Name: "transfer_bucket_1"}); err != nil {
+		t.Fatal(err)
+	}
+	if builder, err = builder.AccountTryDepositOrAbort(addresses.alice, ManifestBuilderBucket{Name: "transfer_bucket_1"}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	builder, err = builder.BatchTransfer(addresses.sender, []TransferRecipient{
+		{Account: addresses.alice, Fungibles: []FungibleTransfer{{addresses.xrd, one}}},
+		{Account: addresses.bob, Fungibles: []FungibleTransfer{{addresses.xrd, one}}},
+	}, TransferOptions{})
+	if err != nil {
+		t.Fatalf("BatchTransfer with a taken bucket name = %v", err)
+	}
+	text := manifestV2Text(t, builder)
+	for _, name := range []string{"transfer_bucket_2", "transfer_bucket_3"} {
+		if strings.Count(text, `"`+name+`"`) != 2 {
+			t.Errorf("bucket %s not taken and deposited once in\n%s", name, text)
+		}
+	}
+	if strings.Contains(text, "transfer_bucket_4") {
+		t.Errorf("unexpected bucket transfer_bucket_4 in\n%s", text)
+	}
+}
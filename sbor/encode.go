@@ -0,0 +1,179 @@
+package sbor
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"unicode/utf8"
+)
+
+// EncodeError reports a value which cannot be encoded, Path locating it in
+// the encoded value, e.g. "[1][0]" for the first element of the second field.
+type EncodeError struct {
+	Path   string
+	Reason string
+}
+
+func (err *EncodeError) Error() string {
+	if err.Path == "" {
+		return "sbor: " + err.Reason
+	}
+	return fmt.Sprintf("sbor: %s at %s", err.Reason, err.Path)
+}
+
+// Encode encodes a value as a payload of the extension, prefix included.
+func Encode(value Value, extension Extension) ([]byte, error) {
+	var payload bytes.Buffer
+	if err := NewEncoder(&payload, extension).Encode(value); err != nil {
+		return nil, err
+	}
+	return payload.Bytes(), nil
+}
+
+// Encoder writes payloads of an extension one after the other to a stream.
+type Encoder struct {
+	// MaxDepth is the maximum depth of the values of a payload, the one of
+	// the extension unless changed.
+	MaxDepth int
+
+	writer    *bufio.Writer
+	extension Extension
+}
+
+// NewEncoder returns an encoder writing payloads of the extension to writer.
+func NewEncoder(writer io.Writer, extension Extension) *Encoder {
+	return &Encoder{MaxDepth: extension.MaxDepth(), writer: bufio.NewWriter(writer), extension: extension}
+}
+
+// Encode writes a value as a payload, prefix included. The value is written
+// as it is encoded: when it cannot be, an *EncodeError is returned and the
+// start of the payload may have been written.
+func (encoder *Encoder) Encode(value Value) error {
+	encoder.writer.WriteByte(encoder.extension.Prefix())
+	if err := encoder.value(value, "", 0); err != nil {
+		encoder.writer.Flush()
+		return err
+	}
+	return encoder.writer.Flush()
+}
+
+func (encoder *Encoder) errorf(path string, format string, args ...any) error {
+	return &EncodeError{Path: path, Reason: fmt.Sprintf(format, args...)}
+}
+
+func (encoder *Encoder) value(value Value, path string, depth int) error {
+	encoder.writer.WriteByte(byte(value.Kind))
+	return encoder.body(value, value.Kind, path, depth)
+}
+
+func (encoder *Encoder) size(size int) {
+	for size >= 0x80 {
+		encoder.writer.WriteByte(byte(size) | 0x80)
+		size >>= 7
+	}
+	encoder.writer.WriteByte(byte(size))
+}
+
+// body writes the body of a value of the kind, the element, key or value
+// kind of its collection.
+func (encoder *Encoder) body(value Value, kind Kind, path string, depth int) error {
+	if depth >= encoder.MaxDepth {
+		return encoder.errorf(path, "maximum depth %d exceeded", encoder.MaxDepth)
+	}
+	if value.Kind != kind {
+		return encoder.errorf(path, "expected a value of kind 0x%02x, found kind 0x%02x", byte(kind), byte(value.Kind))
+	}
+	switch kind {
+	case KindBool:
+		if value.Bool {
+			encoder.writer.WriteByte(1)
+		} else {
+			encoder.writer.WriteByte(0)
+		}
+	case KindI8, KindI16, KindI32, KindI64, KindI128, KindU8, KindU16, KindU32, KindU64, KindU128:
+		bytes, err := leBytes(value.Int, integerLength(kind), kind <= KindI128)
+		if err != nil {
+			return encoder.errorf(path, "%s", err)
+		}
+		encoder.writer.Write(bytes)
+	case KindString:
+		if !utf8.ValidString(value.String) {
+			return encoder.errorf(path, "invalid UTF-8 string")
+		}
+		encoder.size(len(value.String))
+		encoder.writer.WriteString(value.String)
+	case KindArray:
+		encoder.writer.WriteByte(byte(value.ElementKind))
+		encoder.size(len(value.Elements))
+		for index, element := range value.Elements {
+			if err := encoder.body(element, value.ElementKind, fmt.Sprintf("%s[%d]", path, index), depth+1); err != nil {
+				return err
+			}
+		}
+	case KindTuple, KindEnum:
+		if kind == KindEnum {
+			encoder.writer.WriteByte(value.Discriminator)
+		}
+		encoder.size(len(value.Elements))
+		for index, element := range value.Elements {
+			if err := encoder.value(element, fmt.Sprintf("%s[%d]", path, index), depth+1); err != nil {
+				return err
+			}
+		}
+	case KindMap:
+		encoder.writer.Write([]byte{byte(value.KeyKind), byte(value.ValueKind)})
+		encoder.size(len(value.Entries))
+		for index, entry := range value.Entries {
+			entryPath := fmt.Sprintf("%s{%d}", path, index)
+			if err := encoder.body(entry.Key, value.KeyKind, entryPath+".key", depth+1); err != nil {
+				return err
+			}
+			if err := encoder.body(entry.Value, value.ValueKind, entryPath+".value", depth+1); err != nil {
+				return err
+			}
+		}
+	default:
+		if !kind.IsCustom() {
+			return encoder.errorf(path, "unknown value kind 0x%02x", byte(kind))
+		}
+		if err := encoder.extension.CheckCustom(kind, value.Custom); err != nil {
+			var decodeError *DecodeError
+			if errors.As(err, &decodeError) {
+				err = errors.New(decodeError.Reason)
+			}
+			return encoder.errorf(path, "invalid body of a value of kind 0x%02x: %s", byte(kind), err)
+		}
+		encoder.writer.Write(value.Custom)
+	}
+	return nil
+}
+
+// leBytes encodes an integer in length little endian bytes, in two's
+// complement when signed.
+func leBytes(integer *big.Int, length int, signed bool) ([]byte, error) {
+	if integer == nil {
+		return nil, fmt.Errorf("missing integer")
+	}
+	bits := 8 * length
+	lower, upper := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		upper.Rsh(upper, 1)
+		lower.Neg(upper)
+	}
+	if integer.Cmp(lower) < 0 || integer.Cmp(upper) >= 0 {
+		return nil, fmt.Errorf("integer %s out of the range of %d bits", integer, bits)
+	}
+	unsigned := integer
+	if integer.Sign() < 0 {
+		unsigned = new(big.Int).Add(integer, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+	}
+	bytes := make([]byte, length)
+	unsigned.FillBytes(bytes)
+	for index := 0; index < length/2; index++ {
+		bytes[index], bytes[length-1-index] = bytes[length-1-index], bytes[index]
+	}
+	return bytes, nil
+}
@@ -0,0 +1,75 @@
+package sbor
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	value := Value{Kind: KindTuple, Elements: []Value{
+		{Kind: KindBool, Bool: true},
+		{Kind: KindI16, Int: big.NewInt(-2)},
+		{Kind: KindString, String: "hi"},
+		{Kind: KindArray, ElementKind: KindU8, Elements: []Value{{Kind: KindU8, Int: big.NewInt(7)}}},
+		{Kind: KindEnum, Discriminator: 1, Elements: []Value{{Kind: KindU32, Int: big.NewInt(258)}}},
+		{Kind: KindMap, KeyKind: KindU8, ValueKind: KindBool, Entries: []Entry{
+			{Key: Value{Kind: KindU8, Int: big.NewInt(1)}, Value: Value{Kind: KindBool}},
+		}},
+		{Kind: KindManifestBucket, Custom: []byte{3, 0, 0, 0}},
+	}}
+	encoded, err := Encode(value, Manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := mustDecodeHex(t, "4d2107"+"0101"+"03feff"+"0c026869"+"20070107"+"2201010902010000"+"230701"+"010100"+"8103000000")
+	if !bytes.Equal(encoded, expected) {
+		t.Errorf("Encode = %x, want %x", encoded, expected)
+	}
+
+	var payloads bytes.Buffer
+	encoder := NewEncoder(&payloads, Scrypto)
+	for _, value := range []Value{{Kind: KindU8, Int: big.NewInt(1)}, {Kind: KindString, String: strings.Repeat("a", 128)}} {
+		if err := encoder.Encode(value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expected := "5c0701" + "5c0c8001" + strings.Repeat("61", 128); !bytes.Equal(payloads.Bytes(), mustDecodeHex(t, expected)) {
+		t.Errorf("Encoder wrote %x, want %s", payloads.Bytes(), expected)
+	}
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	tests := []struct {
+		value  Value
+		path   string
+		reason string
+	}{
+		{Value{Kind: KindU8, Int: big.NewInt(256)}, "", "out of the range"},
+		{Value{Kind: KindI8, Int: big.NewInt(-129)}, "", "out of the range"},
+		{Value{Kind: KindU64}, "", "missing integer"},
+		{Value{Kind: KindString, String: "\xff"}, "", "invalid UTF-8"},
+		{Value{Kind: KindArray, ElementKind: KindU8, Elements: []Value{{Kind: KindU8, Int: big.NewInt(0)}, {Kind: KindU16, Int: big.NewInt(0)}}}, "[1]", "expected a value of kind 0x07"},
+		{Value{Kind: KindTuple, Elements: []Value{{Kind: 0x30}}}, "[0]", "unknown value kind"},
+		{Value{Kind: KindManifestDecimal, Custom: []byte{1, 2}}, "", "invalid body"},
+		{Value{Kind: KindScryptoDecimal, Custom: make([]byte, 24)}, "", "invalid body"},
+		{Value{Kind: KindMap, KeyKind: KindU8, ValueKind: KindU8, Entries: []Entry{{Key: Value{Kind: KindU8, Int: big.NewInt(0)}, Value: Value{Kind: KindBool}}}}, "{0}.value", "expected a value of kind 0x07"},
+	}
+	for _, test := range tests {
+		_, err := Encode(test.value, Manifest)
+		var encodeError *EncodeError
+		if !errors.As(err, &encodeError) || encodeError.Path != test.path || !strings.Contains(encodeError.Reason, test.reason) {
+			t.Errorf("Encode(%v) = %v, want %q at %q", test.value, err, test.reason, test.path)
+		}
+	}
+
+	value, err := Decode(nested(Scrypto, Manifest.MaxDepth()+1), Scrypto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Encode(value, Manifest); err == nil || !strings.Contains(err.Error(), "maximum depth 24") {
+		t.Errorf("Encode of a Manifest value of depth 25 = %v", err)
+	}
+}
@@ -0,0 +1,612 @@
+// Package sbor encodes and decodes Manifest and Scrypto SBOR payloads in pure
+// Go, to and from untyped value trees.
+//
+// It serves the programs and helpers which work with SBOR without the native
+// library, e.g. cmd/blueprintgen reading package definitions or the
+// transactionhash package. The custom values of both extensions are kept as
+// their raw bodies, to be interpreted by the caller; the
+// radix_engine_toolkit_uniffi package converts values into ManifestValue and
+// ScryptoSborString, and back.
+package sbor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"unicode/utf8"
+)
+
+// Extension is the set of custom value kinds of a payload.
+type Extension int
+
+const (
+	Manifest Extension = iota
+	Scrypto
+)
+
+// Prefix returns the byte payloads of the extension start with.
+func (extension Extension) Prefix() byte {
+	if extension == Manifest {
+		return 0x4d
+	}
+	return 0x5c
+}
+
+// MaxDepth returns the maximum depth of the values of a payload of the
+// extension, as in the engine: 24 for Manifest and 64 for Scrypto.
+func (extension Extension) MaxDepth() int {
+	if extension == Manifest {
+		return 24
+	}
+	return 64
+}
+
+func (extension Extension) String() string {
+	if extension == Manifest {
+		return "Manifest"
+	}
+	return "Scrypto"
+}
+
+// Kind is the value kind of an SBOR value.
+type Kind byte
+
+const (
+	KindBool   Kind = 0x01
+	KindI8     Kind = 0x02
+	KindI16    Kind = 0x03
+	KindI32    Kind = 0x04
+	KindI64    Kind = 0x05
+	KindI128   Kind = 0x06
+	KindU8     Kind = 0x07
+	KindU16    Kind = 0x08
+	KindU32    Kind = 0x09
+	KindU64    Kind = 0x0a
+	KindU128   Kind = 0x0b
+	KindString Kind = 0x0c
+	KindArray  Kind = 0x20
+	KindTuple  Kind = 0x21
+	KindEnum   Kind = 0x22
+	KindMap    Kind = 0x23
+)
+
+// The custom value kinds of Manifest SBOR.
+const (
+	KindManifestAddress            Kind = 0x80
+	KindManifestBucket             Kind = 0x81
+	KindManifestProof              Kind = 0x82
+	KindManifestExpression         Kind = 0x83
+	KindManifestBlob               Kind = 0x84
+	KindManifestDecimal            Kind = 0x85
+	KindManifestPreciseDecimal     Kind = 0x86
+	KindManifestNonFungibleLocalId Kind = 0x87
+	KindManifestAddressReservation Kind = 0x88
+)
+
+// The custom value kinds of Scrypto SBOR.
+const (
+	KindScryptoReference          Kind = 0x80
+	KindScryptoOwn                Kind = 0x90
+	KindScryptoDecimal            Kind = 0xa0
+	KindScryptoPreciseDecimal     Kind = 0xb0
+	KindScryptoNonFungibleLocalId Kind = 0xc0
+)
+
+// IsCustom reports whether the kind is a custom value kind.
+func (kind Kind) IsCustom() bool {
+	return kind >= 0x80
+}
+
+var basicKindNames = map[Kind]string{
+	KindBool: "Bool", KindI8: "I8", KindI16: "I16", KindI32: "I32", KindI64: "I64", KindI128: "I128",
+	KindU8: "U8", KindU16: "U16", KindU32: "U32", KindU64: "U64", KindU128: "U128", KindString: "String",
+	KindArray: "Array", KindTuple: "Tuple", KindEnum: "Enum", KindMap: "Map",
+}
+
+var customKindNames = map[Extension]map[Kind]string{
+	Manifest: {
+		KindManifestAddress: "Address", KindManifestBucket: "Bucket", KindManifestProof: "Proof",
+		KindManifestExpression: "Expression", KindManifestBlob: "Blob", KindManifestDecimal: "Decimal",
+		KindManifestPreciseDecimal: "PreciseDecimal", KindManifestNonFungibleLocalId: "NonFungibleLocalId",
+		KindManifestAddressReservation: "AddressReservation",
+	},
+	Scrypto: {
+		KindScryptoReference: "Reference", KindScryptoOwn: "Own", KindScryptoDecimal: "Decimal",
+		KindScryptoPreciseDecimal: "PreciseDecimal", KindScryptoNonFungibleLocalId: "NonFungibleLocalId",
+	},
+}
+
+// KindName returns the name of a value kind of the extension, e.g. "U8" or
+// "Reference", as in the string representations of the native library, or
+// false if the kind is unknown.
+func (extension Extension) KindName(kind Kind) (string, bool) {
+	if name, ok := basicKindNames[kind]; ok {
+		return name, true
+	}
+	name, ok := customKindNames[extension][kind]
+	return name, ok
+}
+
+// KindByName returns the value kind of the extension with the name, the
+// reverse of KindName.
+func (extension Extension) KindByName(name string) (Kind, bool) {
+	for _, names := range []map[Kind]string{basicKindNames, customKindNames[extension]} {
+		for kind, kindName := range names {
+			if kindName == name {
+				return kind, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// maxPreallocated bounds the elements allocated ahead of decoding them, as
+// the size of a collection read from a stream is not checked against the
+// length of the payload.
+const maxPreallocated = 1024
+
+// Bytes are read from a stream at least minFillChunk and at most
+// maxFillChunk at a time, so that a size read from the stream allocates no
+// more than the bytes which follow it.
+const (
+	minFillChunk = 4 << 10
+	maxFillChunk = 64 << 10
+)
+
+// Value is an SBOR value. Which fields are set depends on its Kind:
+//
+//   - Bool for KindBool;
+//   - Int for the integer kinds;
+//   - String for KindString;
+//   - ElementKind and Elements for KindArray;
+//   - Elements for KindTuple, and Discriminator too for KindEnum;
+//   - KeyKind, ValueKind and Entries for KindMap;
+//   - Custom, the raw body of the value, for the custom kinds.
+//
+// Body is the encoding of a decoded value without its value kind, as found
+// in the payload, for every kind. Encoding ignores it.
+type Value struct {
+	Kind Kind
+
+	Bool          bool
+	Int           *big.Int
+	String        string
+	Discriminator uint8
+	ElementKind   Kind
+	KeyKind       Kind
+	ValueKind     Kind
+	Elements      []Value
+	Entries       []Entry
+	Custom        []byte
+	Body          []byte
+}
+
+// Entry is an entry of a map.
+type Entry struct {
+	Key   Value
+	Value Value
+}
+
+// Field returns the index-th element of a tuple or enum, or an error if the
+// value has fewer elements.
+func (value Value) Field(index int) (Value, error) {
+	if value.Kind != KindTuple && value.Kind != KindEnum {
+		return Value{}, fmt.Errorf("expected a tuple or enum, found kind 0x%02x", byte(value.Kind))
+	}
+	if index >= len(value.Elements) {
+		return Value{}, fmt.Errorf("field %d of a value of %d fields", index, len(value.Elements))
+	}
+	return value.Elements[index], nil
+}
+
+// Unwrap returns the value inside the single field tuples around it, e.g.
+// the content of a transparent newtype.
+func (value Value) Unwrap() Value {
+	for value.Kind == KindTuple && len(value.Elements) == 1 {
+		value = value.Elements[0]
+	}
+	return value
+}
+
+// Bytes returns the content of an array of U8, or false if the value is not
+// one.
+func (value Value) Bytes() ([]byte, bool) {
+	if value.Kind != KindArray || value.ElementKind != KindU8 {
+		return nil, false
+	}
+	bytes := make([]byte, len(value.Elements))
+	for index, element := range value.Elements {
+		bytes[index] = byte(element.Int.Uint64())
+	}
+	return bytes, true
+}
+
+// DecodeError reports a payload which cannot be decoded, Offset being the
+// offset of the offending byte.
+type DecodeError struct {
+	Offset int
+	Reason string
+}
+
+func (err *DecodeError) Error() string {
+	return fmt.Sprintf("sbor: %s at offset %d", err.Reason, err.Offset)
+}
+
+// Decode decodes a payload of the extension, prefix included.
+func Decode(payload []byte, extension Extension) (Value, error) {
+	decoder := decoder{payload: payload, extension: extension, maxDepth: extension.MaxDepth()}
+	value, err := decoder.decode()
+	if err != nil {
+		return Value{}, err
+	}
+	if decoder.offset != len(payload) {
+		return Value{}, decoder.errorf(decoder.offset, "%d trailing bytes", len(payload)-decoder.offset)
+	}
+	return value, nil
+}
+
+// Decoder reads payloads of an extension one after the other from a stream.
+type Decoder struct {
+	// MaxDepth is the maximum depth of the values of a payload, the one of
+	// the extension unless changed before the first call to Decode.
+	MaxDepth int
+
+	decoder decoder
+}
+
+// NewDecoder returns a decoder reading payloads of the extension from
+// reader. The decoder may read past the end of a payload; the bytes read are
+// kept for the next one.
+func NewDecoder(reader io.Reader, extension Extension) *Decoder {
+	return &Decoder{MaxDepth: extension.MaxDepth(), decoder: decoder{reader: reader, extension: extension}}
+}
+
+// Decode reads the next payload, prefix included, and returns its value. It
+// returns io.EOF when the stream ends before a payload, and a *DecodeError,
+// whose Offset is relative to the start of the payload, when the stream
+// holds an invalid or truncated payload.
+func (decoder *Decoder) Decode() (Value, error) {
+	state := &decoder.decoder
+	// The bodies of the values returned before point into the payload, so
+	// the remaining bytes move to a new one.
+	state.payload = append([]byte(nil), state.payload[state.offset:]...)
+	state.offset = 0
+	state.maxDepth = decoder.MaxDepth
+	if len(state.payload) == 0 {
+		if err := state.fill(1); err != nil {
+			return Value{}, err
+		}
+		if len(state.payload) == 0 {
+			return Value{}, io.EOF
+		}
+	}
+	return state.decode()
+}
+
+// decoder decodes from payload, read from reader as far as needed when
+// reader is set.
+type decoder struct {
+	payload   []byte
+	offset    int
+	reader    io.Reader
+	extension Extension
+	maxDepth  int
+}
+
+func (decoder *decoder) errorf(offset int, format string, args ...any) error {
+	return &DecodeError{Offset: offset, Reason: fmt.Sprintf(format, args...)}
+}
+
+// decode decodes a payload, prefix included, starting at offset.
+func (decoder *decoder) decode() (Value, error) {
+	prefix, err := decoder.byte()
+	if err != nil {
+		return Value{}, err
+	}
+	if expected := decoder.extension.Prefix(); prefix != expected {
+		return Value{}, decoder.errorf(decoder.offset-1, "expected the %s prefix 0x%02x, found 0x%02x", decoder.extension, expected, prefix)
+	}
+	return decoder.value(0)
+}
+
+// fill reads from the reader until length bytes follow offset, or the stream
+// ends, growing the payload by at most maxFillChunk bytes a read.
+func (decoder *decoder) fill(length int) error {
+	for decoder.reader != nil && len(decoder.payload)-decoder.offset < length {
+		end := len(decoder.payload)
+		chunk := min(max(length-(end-decoder.offset), minFillChunk), maxFillChunk)
+		decoder.payload = slices.Grow(decoder.payload, chunk)
+		read, err := decoder.reader.Read(decoder.payload[end : end+chunk])
+		decoder.payload = decoder.payload[:end+read]
+		if err == io.EOF {
+			decoder.reader = nil
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (decoder *decoder) bytes(length int) ([]byte, error) {
+	if length < 0 {
+		return nil, decoder.errorf(decoder.offset, "unexpected end of payload")
+	}
+	if err := decoder.fill(length); err != nil {
+		return nil, err
+	}
+	if len(decoder.payload)-decoder.offset < length {
+		return nil, decoder.errorf(decoder.offset, "unexpected end of payload")
+	}
+	bytes := decoder.payload[decoder.offset : decoder.offset+length]
+	decoder.offset += length
+	return bytes, nil
+}
+
+func (decoder *decoder) byte() (byte, error) {
+	bytes, err := decoder.bytes(1)
+	if err != nil {
+		return 0, err
+	}
+	return bytes[0], nil
+}
+
+// size decodes the LEB128 size of a collection, of at most 4 bytes.
+func (decoder *decoder) size() (int, error) {
+	start := decoder.offset
+	size := 0
+	for shift := 0; ; shift += 7 {
+		if shift >= 28 {
+			return 0, decoder.errorf(start, "size too large")
+		}
+		next, err := decoder.byte()
+		if err != nil {
+			return 0, err
+		}
+		size |= int(next&0x7f) << shift
+		if next&0x80 == 0 {
+			break
+		}
+	}
+	if decoder.reader == nil && size > len(decoder.payload)-decoder.offset {
+		// Every element takes at least one byte.
+		return 0, decoder.errorf(start, "size %d exceeds the payload", size)
+	}
+	return size, nil
+}
+
+func (decoder *decoder) value(depth int) (Value, error) {
+	kind, err := decoder.byte()
+	if err != nil {
+		return Value{}, err
+	}
+	return decoder.body(Kind(kind), depth)
+}
+
+func (decoder *decoder) body(kind Kind, depth int) (Value, error) {
+	if depth >= decoder.maxDepth {
+		return Value{}, decoder.errorf(decoder.offset, "maximum depth %d exceeded", decoder.maxDepth)
+	}
+	value := Value{Kind: kind}
+	start := decoder.offset
+	switch kind {
+	case KindBool:
+		b, err := decoder.byte()
+		if err != nil {
+			return Value{}, err
+		}
+		if b > 1 {
+			return Value{}, decoder.errorf(start, "invalid bool 0x%02x", b)
+		}
+		value.Bool = b == 1
+	case KindI8, KindI16, KindI32, KindI64, KindI128, KindU8, KindU16, KindU32, KindU64, KindU128:
+		length := integerLength(kind)
+		bytes, err := decoder.bytes(length)
+		if err != nil {
+			return Value{}, err
+		}
+		value.Int = leInteger(bytes, kind <= KindI128)
+	case KindString:
+		size, err := decoder.size()
+		if err != nil {
+			return Value{}, err
+		}
+		bytes, err := decoder.bytes(size)
+		if err != nil {
+			return Value{}, err
+		}
+		if !utf8.Valid(bytes) {
+			return Value{}, decoder.errorf(start, "invalid UTF-8 string")
+		}
+		value.String = string(bytes)
+	case KindArray:
+		elementKind, err := decoder.byte()
+		if err != nil {
+			return Value{}, err
+		}
+		value.ElementKind = Kind(elementKind)
+		size, err := decoder.size()
+		if err != nil {
+			return Value{}, err
+		}
+		value.Elements = make([]Value, 0, min(size, maxPreallocated))
+		for range size {
+			element, err := decoder.body(value.ElementKind, depth+1)
+			if err != nil {
+				return Value{}, err
+			}
+			value.Elements = append(value.Elements, element)
+		}
+	case KindTuple, KindEnum:
+		if kind == KindEnum {
+			discriminator, err := decoder.byte()
+			if err != nil {
+				return Value{}, err
+			}
+			value.Discriminator = discriminator
+		}
+		size, err := decoder.size()
+		if err != nil {
+			return Value{}, err
+		}
+		value.Elements = make([]Value, 0, min(size, maxPreallocated))
+		for range size {
+			element, err := decoder.value(depth + 1)
+			if err != nil {
+				return Value{}, err
+			}
+			value.Elements = append(value.Elements, element)
+		}
+	case KindMap:
+		kinds, err := decoder.bytes(2)
+		if err != nil {
+			return Value{}, err
+		}
+		value.KeyKind, value.ValueKind = Kind(kinds[0]), Kind(kinds[1])
+		size, err := decoder.size()
+		if err != nil {
+			return Value{}, err
+		}
+		value.Entries = make([]Entry, 0, min(size, maxPreallocated))
+		for range size {
+			var entry Entry
+			if entry.Key, err = decoder.body(value.KeyKind, depth+1); err != nil {
+				return Value{}, err
+			}
+			if entry.Value, err = decoder.body(value.ValueKind, depth+1); err != nil {
+				return Value{}, err
+			}
+			value.Entries = append(value.Entries, entry)
+		}
+	default:
+		if !kind.IsCustom() {
+			return Value{}, decoder.errorf(max(start-1, 0), "unknown value kind 0x%02x", byte(kind))
+		}
+		if err := decoder.custom(kind); err != nil {
+			return Value{}, err
+		}
+		value.Custom = decoder.payload[start:decoder.offset]
+	}
+	value.Body = decoder.payload[start:decoder.offset]
+	return value, nil
+}
+
+// custom skips the body of a custom value.
+func (decoder *decoder) custom(kind Kind) error {
+	start := decoder.offset
+	var err error
+	if decoder.extension == Manifest {
+		switch kind {
+		case KindManifestAddress:
+			var discriminator byte
+			if discriminator, err = decoder.byte(); err != nil {
+				return err
+			}
+			switch discriminator {
+			case 0:
+				_, err = decoder.bytes(30)
+			case 1:
+				_, err = decoder.bytes(4)
+			default:
+				return decoder.errorf(start, "invalid manifest address discriminator %d", discriminator)
+			}
+		case KindManifestBucket, KindManifestProof, KindManifestAddressReservation:
+			_, err = decoder.bytes(4)
+		case KindManifestExpression:
+			_, err = decoder.bytes(1)
+		case KindManifestBlob, KindManifestPreciseDecimal:
+			_, err = decoder.bytes(32)
+		case KindManifestDecimal:
+			_, err = decoder.bytes(24)
+		case KindManifestNonFungibleLocalId:
+			err = decoder.nonFungibleLocalId()
+		default:
+			return decoder.errorf(max(start-1, 0), "unknown Manifest value kind 0x%02x", byte(kind))
+		}
+		return err
+	}
+	switch kind {
+	case KindScryptoReference, KindScryptoOwn:
+		_, err = decoder.bytes(30)
+	case KindScryptoDecimal:
+		_, err = decoder.bytes(24)
+	case KindScryptoPreciseDecimal:
+		_, err = decoder.bytes(32)
+	case KindScryptoNonFungibleLocalId:
+		err = decoder.nonFungibleLocalId()
+	default:
+		return decoder.errorf(max(start-1, 0), "unknown Scrypto value kind 0x%02x", byte(kind))
+	}
+	return err
+}
+
+// CheckCustom returns a *DecodeError if body is not the body of a value of
+// the custom kind of the extension.
+func (extension Extension) CheckCustom(kind Kind, body []byte) error {
+	check := decoder{payload: body, extension: extension}
+	if err := check.custom(kind); err != nil {
+		return err
+	}
+	if check.offset != len(body) {
+		return check.errorf(check.offset, "%d trailing bytes", len(body)-check.offset)
+	}
+	return nil
+}
+
+func (decoder *decoder) nonFungibleLocalId() error {
+	start := decoder.offset
+	idType, err := decoder.byte()
+	if err != nil {
+		return err
+	}
+	switch idType {
+	case 0, 2:
+		size, err := decoder.size()
+		if err != nil {
+			return err
+		}
+		_, err = decoder.bytes(size)
+		return err
+	case 1:
+		_, err = decoder.bytes(8)
+		return err
+	case 3:
+		_, err = decoder.bytes(32)
+		return err
+	}
+	return decoder.errorf(start, "invalid non-fungible local id type %d", idType)
+}
+
+func integerLength(kind Kind) int {
+	switch kind {
+	case KindI8, KindU8:
+		return 1
+	case KindI16, KindU16:
+		return 2
+	case KindI32, KindU32:
+		return 4
+	case KindI64, KindU64:
+		return 8
+	}
+	return 16
+}
+
+// leInteger decodes a little endian two's complement or unsigned integer.
+func leInteger(bytes []byte, signed bool) *big.Int {
+	if len(bytes) == 8 {
+		if signed {
+			return big.NewInt(int64(binary.LittleEndian.Uint64(bytes)))
+		}
+		return new(big.Int).SetUint64(binary.LittleEndian.Uint64(bytes))
+	}
+	be := make([]byte, len(bytes))
+	for index, b := range bytes {
+		be[len(bytes)-1-index] = b
+	}
+	integer := new(big.Int).SetBytes(be)
+	if signed && bytes[len(bytes)-1]&0x80 != 0 {
+		integer.Sub(integer, new(big.Int).Lsh(big.NewInt(1), uint(8*len(bytes))))
+	}
+	return integer
+}
@@ -0,0 +1,235 @@
+package sbor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func mustDecodeHex(t *testing.T, value string) []byte {
+	t.Helper()
+	decoded, err := hex.DecodeString(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+// nested returns the payload of a U8 inside depth - 1 tuples, a value of
+// depth levels.
+func nested(extension Extension, depth int) []byte {
+	payload := []byte{extension.Prefix()}
+	for range depth - 1 {
+		payload = append(payload, byte(KindTuple), 1)
+	}
+	return append(payload, byte(KindU8), 0)
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		extension Extension
+		payload   string
+	}{
+		{Manifest, "4d0101"},
+		{Manifest, "4d02ff"},
+		{Manifest, "4d06feffffffffffffffffffffffffffffff"},
+		{Manifest, "4d0bffffffffffffffffffffffffffffffff"},
+		{Manifest, "4d0a0807060504030201"},
+		{Manifest, "4d0c0668c3a96c6c6f"},
+		{Manifest, "4d200703010203"},
+		{Manifest, "4d210207050c0161"},
+		{Manifest, "4d2203010100"},
+		{Manifest, "4d230c0701016102"},
+		{Manifest, "4d8000" + strings.Repeat("5d", 30)},
+		{Manifest, "4d800101000000"},
+		{Manifest, "4d8102000000"},
+		{Manifest, "4d8300"},
+		{Manifest, "4d85" + strings.Repeat("00", 23) + "01"},
+		{Manifest, "4d87010000000000000001"},
+		{Manifest, "4d870003616263"},
+		{Manifest, "4d0cc801" + strings.Repeat("61", 200)},
+		{Manifest, "4d208501" + strings.Repeat("0f", 24)},
+		{Scrypto, "5c80" + strings.Repeat("5d", 30)},
+		{Scrypto, "5ca0" + strings.Repeat("00", 24)},
+		{Scrypto, "5cc003" + strings.Repeat("ab", 32)},
+		{Scrypto, "5c2102a0" + strings.Repeat("00", 24) + "2007020102"},
+	}
+	for _, test := range tests {
+		payload := mustDecodeHex(t, test.payload)
+		value, err := Decode(payload, test.extension)
+		if err != nil {
+			t.Errorf("Decode(%x): %v", payload, err)
+			continue
+		}
+		if !bytes.Equal(value.Body, payload[2:]) {
+			t.Errorf("Decode(%x).Body = %x", payload, value.Body)
+		}
+		encoded, err := Encode(value, test.extension)
+		if err != nil || !bytes.Equal(encoded, payload) {
+			t.Errorf("Encode(Decode(%x)) = %x, %v", payload, encoded, err)
+		}
+	}
+}
+
+func TestDecodeValues(t *testing.T) {
+	value, err := Decode(mustDecodeHex(t, "4d210402ff06feffffffffffffffffffffffffffffff0c0568656c6c6f230c0701016102"), Manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(value.Elements) != 4 {
+		t.Fatalf("%d fields, want 4", len(value.Elements))
+	}
+	if value.Elements[0].Int.Int64() != -1 || value.Elements[1].Int.Int64() != -2 {
+		t.Errorf("integers %s and %s, want -1 and -2", value.Elements[0].Int, value.Elements[1].Int)
+	}
+	if value.Elements[2].String != "hello" {
+		t.Errorf("string %q, want hello", value.Elements[2].String)
+	}
+	entries := value.Elements[3].Entries
+	if len(entries) != 1 || entries[0].Key.String != "a" || entries[0].Value.Int.Int64() != 2 {
+		t.Errorf("map entries %v", entries)
+	}
+
+	bytesValue, err := Decode(mustDecodeHex(t, "4d210120070201ff"), Manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, ok := bytesValue.Unwrap().Bytes(); !ok || !bytes.Equal(content, []byte{1, 0xff}) {
+		t.Errorf("Unwrap().Bytes() = %x, %t", content, ok)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		extension Extension
+		payload   string
+		reason    string
+	}{
+		{Manifest, "", "unexpected end of payload"},
+		{Manifest, "5c0101", "expected the Manifest prefix"},
+		{Scrypto, "4d0101", "expected the Scrypto prefix"},
+		{Manifest, "4d010100", "trailing bytes"},
+		{Manifest, "4d0102", "invalid bool"},
+		{Manifest, "4d0c01ff", "invalid UTF-8"},
+		{Manifest, "4d30", "unknown value kind"},
+		{Manifest, "4da0" + strings.Repeat("00", 24), "unknown Manifest value kind"},
+		{Scrypto, "5c85" + strings.Repeat("00", 24), "unknown Scrypto value kind"},
+		{Manifest, "4d090102", "unexpected end of payload"},
+		{Manifest, "4d0cffffff7f", "exceeds the payload"},
+		{Manifest, "4d0c8080808001", "size too large"},
+		{Manifest, "4d0c8080808000", "size too large"},
+		{Manifest, "4d8002", "invalid manifest address discriminator"},
+		{Manifest, "4d8704", "invalid non-fungible local id type"},
+		{Manifest, "4d200701", "exceeds the payload"},
+	}
+	for _, test := range tests {
+		_, err := Decode(mustDecodeHex(t, test.payload), test.extension)
+		var decodeError *DecodeError
+		if !errors.As(err, &decodeError) || !strings.Contains(decodeError.Reason, test.reason) {
+			t.Errorf("Decode(%s) = %v, want %q", test.payload, err, test.reason)
+		}
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	for _, test := range []struct {
+		extension Extension
+		maxDepth  int
+	}{
+		{Manifest, 24},
+		{Scrypto, 64},
+	} {
+		if test.extension.MaxDepth() != test.maxDepth {
+			t.Errorf("%s.MaxDepth() = %d, want %d", test.extension, test.extension.MaxDepth(), test.maxDepth)
+		}
+		value, err := Decode(nested(test.extension, test.maxDepth), test.extension)
+		if err != nil {
+			t.Errorf("Decode of a %s value of depth %d: %v", test.extension, test.maxDepth, err)
+		}
+		if _, err := Encode(value, test.extension); err != nil {
+			t.Errorf("Encode of a %s value of depth %d: %v", test.extension, test.maxDepth, err)
+		}
+
+		deep := nested(test.extension, test.maxDepth+1)
+		if _, err := Decode(deep, test.extension); err == nil || !strings.Contains(err.Error(), "maximum depth") {
+			t.Errorf("Decode of a %s value of depth %d = %v", test.extension, test.maxDepth+1, err)
+		}
+		decoder := NewDecoder(bytes.NewReader(deep), test.extension)
+		decoder.MaxDepth++
+		if _, err := decoder.Decode(); err != nil {
+			t.Errorf("Decoder with MaxDepth %d: %v", decoder.MaxDepth, err)
+		}
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	stream := mustDecodeHex(t, "4d0101"+"4d0c03616263"+"4d2007020102")
+	decoder := NewDecoder(iotest.OneByteReader(bytes.NewReader(stream)), Manifest)
+	var kinds []Kind
+	for {
+		value, err := decoder.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		kinds = append(kinds, value.Kind)
+	}
+	if len(kinds) != 3 || kinds[0] != KindBool || kinds[1] != KindString || kinds[2] != KindArray {
+		t.Errorf("decoded kinds %v", kinds)
+	}
+
+	truncated := NewDecoder(bytes.NewReader(mustDecodeHex(t, "4d0c0361")), Manifest)
+	var decodeError *DecodeError
+	if _, err := truncated.Decode(); !errors.As(err, &decodeError) {
+		t.Errorf("Decode of a truncated payload = %v", err)
+	}
+	oversized := NewDecoder(bytes.NewReader(mustDecodeHex(t, "4d0c8080808001")), Manifest)
+	if _, err := oversized.Decode(); !errors.As(err, &decodeError) || !strings.Contains(decodeError.Reason, "size too large") {
+		t.Errorf("Decode of a 5 byte size = %v", err)
+	}
+}
+
+// readSizes records the sizes of the buffers read into.
+type readSizes struct {
+	reader  io.Reader
+	largest int
+}
+
+func (reader *readSizes) Read(buffer []byte) (int, error) {
+	reader.largest = max(reader.largest, len(buffer))
+	return reader.reader.Read(buffer)
+}
+
+func TestDecoderLargeSize(t *testing.T) {
+	// A string of 256 MiB - 1 followed by 3 bytes.
+	reader := &readSizes{reader: bytes.NewReader(mustDecodeHex(t, "4d0cffffff7f616263"))}
+	decoder := NewDecoder(reader, Manifest)
+	var decodeError *DecodeError
+	if _, err := decoder.Decode(); !errors.As(err, &decodeError) || decodeError.Reason != "unexpected end of payload" {
+		t.Errorf("Decode of a truncated 256 MiB string = %v", err)
+	}
+	if reader.largest > maxFillChunk || cap(decoder.decoder.payload) > 2*maxFillChunk {
+		t.Errorf("Decode of a truncated 256 MiB string read %d bytes at once into %d bytes", reader.largest, cap(decoder.decoder.payload))
+	}
+
+	// Longer values are read over several chunks.
+	long := strings.Repeat("radix", maxFillChunk)
+	payload, err := Encode(Value{Kind: KindString, String: long}, Manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader = &readSizes{reader: bytes.NewReader(payload)}
+	value, err := NewDecoder(reader, Manifest).Decode()
+	if err != nil || value.String != long {
+		t.Errorf("Decode of a %d byte string = %d bytes, %v", len(long), len(value.String), err)
+	}
+	if reader.largest > maxFillChunk {
+		t.Errorf("Decode of a %d byte string read %d bytes at once", len(long), reader.largest)
+	}
+}
@@ -0,0 +1,436 @@
+// Package schema reads the SBOR schemas of Scrypto types, such as the
+// schemas of the events, functions and key-value stores of a blueprint, and
+// validates Scrypto SBOR values against them in pure Go.
+//
+// A schema is a SchemaV1 { type_kinds, type_metadata, type_validations },
+// usually encoded as a VersionedScryptoSchema. Its types refer to each other
+// and are referred to by LocalTypeIds, either the well-known types shared by
+// every schema or the indices of the types of the schema. cmd/schemagen
+// generates Go types from a schema, and the radix_engine_toolkit_uniffi
+// package decodes payloads into them.
+package schema
+
+import (
+	"fmt"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// LocalTypeId is a well-known type or the index of a type of a schema.
+type LocalTypeId struct {
+	WellKnown bool
+	Index     uint64
+}
+
+// The well-known types of the basic and Scrypto schemas which have a value
+// kind of their own. The ids from WellKnownReference and WellKnownOwn up to
+// the next one are the references and owned objects of particular entity
+// types, e.g. 0x91 an owned bucket.
+const (
+	WellKnownBool           = 0x01
+	WellKnownI8             = 0x02
+	WellKnownI16            = 0x03
+	WellKnownI32            = 0x04
+	WellKnownI64            = 0x05
+	WellKnownI128           = 0x06
+	WellKnownU8             = 0x07
+	WellKnownU16            = 0x08
+	WellKnownU32            = 0x09
+	WellKnownU64            = 0x0a
+	WellKnownU128           = 0x0b
+	WellKnownString         = 0x0c
+	WellKnownAny            = 0x40
+	WellKnownBytes          = 0x41
+	WellKnownUnit           = 0x42
+	WellKnownReference      = 0x80
+	WellKnownOwn            = 0x90
+	WellKnownOwnBucket      = 0x91
+	WellKnownOwnProof       = 0x94
+	WellKnownOwnReservation = 0x9b
+	WellKnownDecimal        = 0xa0
+	WellKnownPreciseDecimal = 0xb0
+	WellKnownLocalId        = 0xc0
+)
+
+// TypeKind is a variant of TypeKind.
+type TypeKind int
+
+const (
+	TypeKindAny TypeKind = iota
+	TypeKindBool
+	TypeKindI8
+	TypeKindI16
+	TypeKindI32
+	TypeKindI64
+	TypeKindI128
+	TypeKindU8
+	TypeKindU16
+	TypeKindU32
+	TypeKindU64
+	TypeKindU128
+	TypeKindString
+	TypeKindArray
+	TypeKindTuple
+	TypeKindEnum
+	TypeKindMap
+	TypeKindCustom
+)
+
+// CustomTypeKind is a variant of ScryptoCustomTypeKind.
+type CustomTypeKind int
+
+const (
+	CustomTypeKindReference CustomTypeKind = iota
+	CustomTypeKindOwn
+	CustomTypeKindDecimal
+	CustomTypeKindPreciseDecimal
+	CustomTypeKindNonFungibleLocalId
+)
+
+// OwnValidation is a variant of OwnValidation, the object an Own type
+// accepts.
+type OwnValidation int
+
+const (
+	OwnValidationNone OwnValidation = iota - 1
+	OwnValidationIsBucket
+	OwnValidationIsProof
+	OwnValidationIsVault
+	OwnValidationIsKeyValueStore
+	OwnValidationIsGlobalAddressReservation
+	OwnValidationIsTypedObject
+)
+
+// Schema is a SchemaV1.
+type Schema struct {
+	Types []Type
+}
+
+// Type is a type of a schema, with its metadata. Which fields are set
+// depends on its Kind:
+//
+//   - Element for TypeKindArray;
+//   - Fields, and FieldNames if they are named, for TypeKindTuple;
+//   - Variants for TypeKindEnum;
+//   - Key and Value for TypeKindMap;
+//   - Custom, and OwnValidation for an Own, for TypeKindCustom.
+//
+// Name is empty when the schema does not name the type.
+type Type struct {
+	Kind          TypeKind
+	Name          string
+	Element       LocalTypeId
+	Fields        []LocalTypeId
+	FieldNames    []string
+	Variants      []Variant
+	Key           LocalTypeId
+	Value         LocalTypeId
+	Custom        CustomTypeKind
+	OwnValidation OwnValidation
+}
+
+// Variant is a variant of an enum type.
+type Variant struct {
+	Discriminator uint8
+	Name          string
+	Fields        []LocalTypeId
+	FieldNames    []string
+}
+
+// Type returns the type of the schema id refers to, or false if id is
+// well-known or out of the schema.
+func (schema *Schema) Type(id LocalTypeId) (*Type, bool) {
+	if id.WellKnown || id.Index >= uint64(len(schema.Types)) {
+		return nil, false
+	}
+	return &schema.Types[id.Index], true
+}
+
+// Variant returns the variant of an enum type with the discriminator, or
+// false if it has none.
+func (t *Type) Variant(discriminator uint8) (*Variant, bool) {
+	for index := range t.Variants {
+		if t.Variants[index].Discriminator == discriminator {
+			return &t.Variants[index], true
+		}
+	}
+	return nil, false
+}
+
+// Option returns the type of the Some variant of an Option, or false if the
+// type is not an Option.
+func (t *Type) Option() (LocalTypeId, bool) {
+	if t.Kind != TypeKindEnum || t.Name != "Option" || len(t.Variants) != 2 {
+		return LocalTypeId{}, false
+	}
+	none, some := t.Variants[0], t.Variants[1]
+	if none.Discriminator != 0 || len(none.Fields) != 0 || some.Discriminator != 1 || len(some.Fields) != 1 {
+		return LocalTypeId{}, false
+	}
+	return some.Fields[0], true
+}
+
+// Decode decodes a Scrypto SBOR encoded VersionedScryptoSchema, such as the
+// Schema of a radix_engine_toolkit_uniffi.Schema.
+func Decode(payload []byte) (*Schema, error) {
+	value, err := sbor.Decode(payload, sbor.Scrypto)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(value)
+}
+
+// Parse parses a VersionedScryptoSchema, or the SchemaV1 inside one, from
+// its SBOR value.
+func Parse(value sbor.Value) (*Schema, error) {
+	if value.Kind == sbor.KindEnum {
+		if len(value.Elements) != 1 {
+			return nil, fmt.Errorf("schema: invalid versioned schema")
+		}
+		value = value.Elements[0]
+	}
+	kinds, err := value.Field(0)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	metadata, err := value.Field(1)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	validations, err := value.Field(2)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %w", err)
+	}
+	if len(kinds.Elements) != len(metadata.Elements) || len(kinds.Elements) != len(validations.Elements) {
+		return nil, fmt.Errorf("schema: %d type kinds, %d type metadata and %d type validations",
+			len(kinds.Elements), len(metadata.Elements), len(validations.Elements))
+	}
+
+	schema := &Schema{Types: make([]Type, len(kinds.Elements))}
+	for index := range kinds.Elements {
+		t := &schema.Types[index]
+		if err := parseTypeKind(kinds.Elements[index], t); err != nil {
+			return nil, fmt.Errorf("schema: type kind %d: %w", index, err)
+		}
+		if err := parseTypeMetadata(metadata.Elements[index], t); err != nil {
+			return nil, fmt.Errorf("schema: type metadata %d: %w", index, err)
+		}
+		t.OwnValidation = parseOwnValidation(validations.Elements[index])
+	}
+	return schema, nil
+}
+
+// ParseLocalTypeId parses a LocalTypeId from its SBOR value.
+func ParseLocalTypeId(value sbor.Value) (LocalTypeId, error) {
+	if value.Kind != sbor.KindEnum || len(value.Elements) != 1 {
+		return LocalTypeId{}, fmt.Errorf("invalid local type id")
+	}
+	// WellKnownTypeId is a newtype over u8.
+	index, err := integerValue(value.Elements[0].Unwrap())
+	if err != nil {
+		return LocalTypeId{}, err
+	}
+	switch value.Discriminator {
+	case 0:
+		return LocalTypeId{WellKnown: true, Index: index}, nil
+	case 1:
+		return LocalTypeId{Index: index}, nil
+	}
+	return LocalTypeId{}, fmt.Errorf("invalid local type id variant %d", value.Discriminator)
+}
+
+func parseTypeKind(value sbor.Value, t *Type) error {
+	if value.Kind != sbor.KindEnum {
+		return fmt.Errorf("invalid type kind")
+	}
+	t.Kind = TypeKind(value.Discriminator)
+	var err error
+	switch t.Kind {
+	case TypeKindArray:
+		if len(value.Elements) != 1 {
+			return fmt.Errorf("invalid array type kind")
+		}
+		t.Element, err = ParseLocalTypeId(value.Elements[0])
+	case TypeKindTuple:
+		if len(value.Elements) != 1 {
+			return fmt.Errorf("invalid tuple type kind")
+		}
+		t.Fields, err = parseLocalTypeIds(value.Elements[0])
+	case TypeKindEnum:
+		if len(value.Elements) != 1 {
+			return fmt.Errorf("invalid enum type kind")
+		}
+		entries, err := mapEntries(value.Elements[0])
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			discriminator, err := integerValue(entry.Key)
+			if err != nil {
+				return err
+			}
+			fields, err := parseLocalTypeIds(entry.Value)
+			if err != nil {
+				return err
+			}
+			t.Variants = append(t.Variants, Variant{Discriminator: uint8(discriminator), Fields: fields})
+		}
+	case TypeKindMap:
+		if len(value.Elements) != 2 {
+			return fmt.Errorf("invalid map type kind")
+		}
+		if t.Key, err = ParseLocalTypeId(value.Elements[0]); err != nil {
+			return err
+		}
+		t.Value, err = ParseLocalTypeId(value.Elements[1])
+	case TypeKindCustom:
+		if len(value.Elements) != 1 || value.Elements[0].Kind != sbor.KindEnum {
+			return fmt.Errorf("invalid custom type kind")
+		}
+		t.Custom = CustomTypeKind(value.Elements[0].Discriminator)
+	default:
+		if t.Kind > TypeKindCustom {
+			return fmt.Errorf("unknown type kind %d", t.Kind)
+		}
+	}
+	return err
+}
+
+func parseLocalTypeIds(value sbor.Value) ([]LocalTypeId, error) {
+	if value.Kind != sbor.KindArray {
+		return nil, fmt.Errorf("expected an array of type ids")
+	}
+	ids := make([]LocalTypeId, len(value.Elements))
+	for index, element := range value.Elements {
+		var err error
+		if ids[index], err = ParseLocalTypeId(element); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// parseTypeMetadata parses a TypeMetadata { type_name: Option<String>,
+// child_names: Option<ChildNames> }, ChildNames being
+// NamedFields(Vec<String>) or EnumVariants(IndexMap<u8, TypeMetadata>), into
+// the names of a type.
+func parseTypeMetadata(value sbor.Value, t *Type) error {
+	name, fieldNames, variants, err := parseNames(value)
+	if err != nil {
+		return err
+	}
+	t.Name, t.FieldNames = name, fieldNames
+	for index := range t.Variants {
+		if variant, ok := variants[t.Variants[index].Discriminator]; ok {
+			t.Variants[index].Name, t.Variants[index].FieldNames = variant.Name, variant.FieldNames
+		}
+	}
+	return nil
+}
+
+func parseNames(value sbor.Value) (string, []string, map[uint8]Variant, error) {
+	var name string
+	option, err := optionValue(value, 0)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if option != nil {
+		if name, err = stringValue(*option); err != nil {
+			return "", nil, nil, err
+		}
+	}
+	childNames, err := optionValue(value, 1)
+	if err != nil || childNames == nil {
+		return name, nil, nil, err
+	}
+	if childNames.Kind != sbor.KindEnum || len(childNames.Elements) != 1 {
+		return "", nil, nil, fmt.Errorf("invalid child names")
+	}
+	switch childNames.Discriminator {
+	case 0:
+		var fieldNames []string
+		for _, fieldName := range childNames.Elements[0].Elements {
+			fieldName, err := stringValue(fieldName)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			fieldNames = append(fieldNames, fieldName)
+		}
+		return name, fieldNames, nil, nil
+	case 1:
+		entries, err := mapEntries(childNames.Elements[0])
+		if err != nil {
+			return "", nil, nil, err
+		}
+		variants := make(map[uint8]Variant, len(entries))
+		for _, entry := range entries {
+			discriminator, err := integerValue(entry.Key)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			variantName, fieldNames, _, err := parseNames(entry.Value)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			variants[uint8(discriminator)] = Variant{Name: variantName, FieldNames: fieldNames}
+		}
+		return name, nil, variants, nil
+	}
+	return name, nil, nil, nil
+}
+
+// parseOwnValidation returns the OwnValidation of a
+// TypeValidation::Custom(ScryptoCustomTypeValidation::Own(..)).
+func parseOwnValidation(value sbor.Value) OwnValidation {
+	const typeValidationCustom, customTypeValidationOwn = 14, 1
+	if value.Kind != sbor.KindEnum || value.Discriminator != typeValidationCustom || len(value.Elements) != 1 {
+		return OwnValidationNone
+	}
+	custom := value.Elements[0]
+	if custom.Kind != sbor.KindEnum || custom.Discriminator != customTypeValidationOwn || len(custom.Elements) != 1 {
+		return OwnValidationNone
+	}
+	own := custom.Elements[0]
+	if own.Kind != sbor.KindEnum {
+		return OwnValidationNone
+	}
+	return OwnValidation(own.Discriminator)
+}
+
+// optionValue returns the value of the Option field index of value, or nil
+// for None.
+func optionValue(value sbor.Value, index int) (*sbor.Value, error) {
+	option, err := value.Field(index)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case option.Kind != sbor.KindEnum:
+	case option.Discriminator == 0 && len(option.Elements) == 0:
+		return nil, nil
+	case option.Discriminator == 1 && len(option.Elements) == 1:
+		return &option.Elements[0], nil
+	}
+	return nil, fmt.Errorf("invalid option")
+}
+
+func mapEntries(value sbor.Value) ([]sbor.Entry, error) {
+	if value.Kind != sbor.KindMap {
+		return nil, fmt.Errorf("expected a map")
+	}
+	return value.Entries, nil
+}
+
+func stringValue(value sbor.Value) (string, error) {
+	if value.Kind != sbor.KindString {
+		return "", fmt.Errorf("expected a string")
+	}
+	return value.String, nil
+}
+
+func integerValue(value sbor.Value) (uint64, error) {
+	if value.Int == nil || value.Int.Sign() < 0 || !value.Int.IsUint64() {
+		return 0, fmt.Errorf("expected an unsigned integer")
+	}
+	return value.Int.Uint64(), nil
+}
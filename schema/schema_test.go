@@ -0,0 +1,248 @@
+package schema
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+func enum(discriminator uint8, fields ...sbor.Value) sbor.Value {
+	return sbor.Value{Kind: sbor.KindEnum, Discriminator: discriminator, Elements: fields}
+}
+
+func tuple(fields ...sbor.Value) sbor.Value {
+	return sbor.Value{Kind: sbor.KindTuple, Elements: fields}
+}
+
+func array(kind sbor.Kind, elements ...sbor.Value) sbor.Value {
+	return sbor.Value{Kind: sbor.KindArray, ElementKind: kind, Elements: elements}
+}
+
+func integer(kind sbor.Kind, value int64) sbor.Value {
+	return sbor.Value{Kind: kind, Int: big.NewInt(value)}
+}
+
+func str(value string) sbor.Value {
+	return sbor.Value{Kind: sbor.KindString, String: value}
+}
+
+func wellKnown(id uint8) sbor.Value {
+	return enum(0, tuple(integer(sbor.KindU8, int64(id))))
+}
+
+func schemaLocal(index uint64) sbor.Value {
+	return enum(1, integer(sbor.KindU64, int64(index)))
+}
+
+func typeIds(ids ...sbor.Value) sbor.Value {
+	return array(sbor.KindEnum, ids...)
+}
+
+func metadata(name string, childNames ...sbor.Value) sbor.Value {
+	typeName := enum(0)
+	if name != "" {
+		typeName = enum(1, str(name))
+	}
+	if len(childNames) == 0 {
+		return tuple(typeName, enum(0))
+	}
+	return tuple(typeName, enum(1, childNames[0]))
+}
+
+func fieldNames(names ...string) sbor.Value {
+	values := make([]sbor.Value, len(names))
+	for index, name := range names {
+		values[index] = str(name)
+	}
+	return enum(0, array(sbor.KindString, values...))
+}
+
+func variantNames(variants map[uint8]sbor.Value) sbor.Value {
+	names := sbor.Value{Kind: sbor.KindMap, KeyKind: sbor.KindU8, ValueKind: sbor.KindTuple}
+	for discriminator := range uint8(len(variants)) {
+		names.Entries = append(names.Entries, sbor.Entry{Key: integer(sbor.KindU8, int64(discriminator)), Value: variants[discriminator]})
+	}
+	return enum(1, names)
+}
+
+func variants(fields ...sbor.Value) sbor.Value {
+	kinds := sbor.Value{Kind: sbor.KindMap, KeyKind: sbor.KindU8, ValueKind: sbor.KindArray}
+	for discriminator, variantFields := range fields {
+		kinds.Entries = append(kinds.Entries, sbor.Entry{Key: integer(sbor.KindU8, int64(discriminator)), Value: variantFields})
+	}
+	return kinds
+}
+
+// testSchema is the schema of
+//
+//	struct Swap { amount: Decimal, amounts: Vec<u32>, status: Status, tags: IndexMap<String, bool>, bucket: Bucket }
+//	enum Status { Inactive, Active { since: i64 } }
+//	Option<u8>
+func testSchema() sbor.Value {
+	kinds := array(sbor.KindEnum,
+		enum(uint8(TypeKindTuple), typeIds(wellKnown(WellKnownDecimal), schemaLocal(1), schemaLocal(2), schemaLocal(3), schemaLocal(4))),
+		enum(uint8(TypeKindArray), wellKnown(WellKnownU32)),
+		enum(uint8(TypeKindEnum), variants(typeIds(), typeIds(wellKnown(WellKnownI64)))),
+		enum(uint8(TypeKindMap), wellKnown(WellKnownString), wellKnown(WellKnownBool)),
+		enum(uint8(TypeKindCustom), enum(uint8(CustomTypeKindOwn))),
+		enum(uint8(TypeKindEnum), variants(typeIds(), typeIds(wellKnown(WellKnownU8)))),
+	)
+	typeMetadata := array(sbor.KindTuple,
+		metadata("Swap", fieldNames("amount", "amounts", "status", "tags", "bucket")),
+		metadata(""),
+		metadata("Status", variantNames(map[uint8]sbor.Value{0: metadata("Inactive"), 1: metadata("Active", fieldNames("since"))})),
+		metadata(""),
+		metadata("Bucket"),
+		metadata("Option", variantNames(map[uint8]sbor.Value{0: metadata("None"), 1: metadata("Some")})),
+	)
+	validations := array(sbor.KindEnum, enum(0), enum(0), enum(0), enum(0), enum(14, enum(1, enum(uint8(OwnValidationIsBucket)))), enum(0))
+	return enum(0, tuple(kinds, typeMetadata, validations))
+}
+
+func decodeTestSchema(t *testing.T) *Schema {
+	t.Helper()
+	payload, err := sbor.Encode(testSchema(), sbor.Scrypto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := Decode(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+func TestDecode(t *testing.T) {
+	schema := decodeTestSchema(t)
+	if len(schema.Types) != 6 {
+		t.Fatalf("%d types, want 6", len(schema.Types))
+	}
+
+	swap, ok := schema.Type(LocalTypeId{Index: 0})
+	if !ok || swap.Kind != TypeKindTuple || swap.Name != "Swap" || len(swap.Fields) != 5 {
+		t.Fatalf("type 0 = %+v", swap)
+	}
+	if swap.Fields[0] != (LocalTypeId{WellKnown: true, Index: WellKnownDecimal}) || swap.Fields[1] != (LocalTypeId{Index: 1}) {
+		t.Errorf("Swap fields %v", swap.Fields)
+	}
+	if strings.Join(swap.FieldNames, ",") != "amount,amounts,status,tags,bucket" {
+		t.Errorf("Swap field names %v", swap.FieldNames)
+	}
+
+	status := schema.Types[2]
+	active, ok := status.Variant(1)
+	if !ok || active.Name != "Active" || len(active.FieldNames) != 1 || active.FieldNames[0] != "since" {
+		t.Errorf("Status::1 = %+v", active)
+	}
+	if _, ok := status.Variant(2); ok {
+		t.Error("Status has a variant 2")
+	}
+	if _, ok := status.Option(); ok {
+		t.Error("Status is an Option")
+	}
+	if some, ok := schema.Types[5].Option(); !ok || some != (LocalTypeId{WellKnown: true, Index: WellKnownU8}) {
+		t.Errorf("Option() = %v, %t", some, ok)
+	}
+
+	if tags := schema.Types[3]; tags.Kind != TypeKindMap || tags.Key.Index != WellKnownString || tags.Value.Index != WellKnownBool {
+		t.Errorf("type 3 = %+v", tags)
+	}
+	if bucket := schema.Types[4]; bucket.Kind != TypeKindCustom || bucket.Custom != CustomTypeKindOwn || bucket.OwnValidation != OwnValidationIsBucket {
+		t.Errorf("type 4 = %+v", bucket)
+	}
+	if schema.Types[1].OwnValidation != OwnValidationNone {
+		t.Errorf("type 1 has the own validation %d", schema.Types[1].OwnValidation)
+	}
+	if _, ok := schema.Type(LocalTypeId{Index: 6}); ok {
+		t.Error("Type of an index out of the schema succeeded")
+	}
+	if _, ok := schema.Type(LocalTypeId{WellKnown: true, Index: WellKnownU8}); ok {
+		t.Error("Type of a well-known type succeeded")
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(schema *sbor.Value)
+	}{
+		{"missing metadata", func(schema *sbor.Value) {
+			schema.Elements[0].Elements[1].Elements = schema.Elements[0].Elements[1].Elements[:5]
+		}},
+		{"unknown type kind", func(schema *sbor.Value) {
+			schema.Elements[0].Elements[0].Elements[1] = enum(18)
+		}},
+		{"invalid local type id", func(schema *sbor.Value) {
+			schema.Elements[0].Elements[0].Elements[1] = enum(uint8(TypeKindArray), enum(2, integer(sbor.KindU64, 0)))
+		}},
+		{"invalid option", func(schema *sbor.Value) {
+			schema.Elements[0].Elements[1].Elements[1] = tuple(enum(2), enum(0))
+		}},
+		{"not a schema", func(schema *sbor.Value) {
+			*schema = enum(0, integer(sbor.KindU8, 0))
+		}},
+	}
+	for _, test := range tests {
+		schema := testSchema()
+		test.modify(&schema)
+		if _, err := Parse(schema); err == nil {
+			t.Errorf("Parse of a schema with %s succeeded", test.name)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	schema := decodeTestSchema(t)
+	decimal := sbor.Value{Kind: sbor.KindScryptoDecimal, Custom: make([]byte, 24)}
+	bucket := sbor.Value{Kind: sbor.KindScryptoOwn, Custom: make([]byte, 30)}
+	tags := sbor.Value{Kind: sbor.KindMap, KeyKind: sbor.KindString, ValueKind: sbor.KindBool, Entries: []sbor.Entry{
+		{Key: str("fast"), Value: sbor.Value{Kind: sbor.KindBool, Bool: true}},
+	}}
+	swap := func(amount, amounts, status, tags sbor.Value) sbor.Value {
+		return tuple(amount, amounts, status, tags, bucket)
+	}
+	amounts := array(sbor.KindU32, integer(sbor.KindU32, 1), integer(sbor.KindU32, 2))
+	active := enum(1, integer(sbor.KindI64, 5))
+
+	if err := schema.Validate(swap(decimal, amounts, active, tags), LocalTypeId{Index: 0}); err != nil {
+		t.Errorf("Validate of a valid Swap: %v", err)
+	}
+	if err := schema.Validate(swap(decimal, array(sbor.KindU32), enum(0), tags), LocalTypeId{Index: 0}); err != nil {
+		t.Errorf("Validate of a valid inactive Swap: %v", err)
+	}
+	if err := schema.Validate(integer(sbor.KindU8, 1), LocalTypeId{WellKnown: true, Index: WellKnownU8}); err != nil {
+		t.Errorf("Validate of a U8: %v", err)
+	}
+
+	tests := []struct {
+		value  sbor.Value
+		path   string
+		reason string
+	}{
+		{swap(integer(sbor.KindU8, 1), amounts, active, tags), "Swap.amount", "expected Decimal, found U8"},
+		{swap(decimal, array(sbor.KindU64), active, tags), "Swap.amounts[]", "expected U32, found U64"},
+		{swap(decimal, amounts, enum(1, integer(sbor.KindU8, 5)), tags), "Swap.status::Active.since", "expected I64, found U8"},
+		{swap(decimal, amounts, enum(2), tags), "Swap.status", "unknown variant 2"},
+		{swap(decimal, amounts, enum(1), tags), "Swap.status::Active", "expected 1 fields, found 0"},
+		{swap(decimal, amounts, active, sbor.Value{Kind: sbor.KindMap, KeyKind: sbor.KindU8, ValueKind: sbor.KindBool}), "Swap.tags{}.key", "expected String, found U8"},
+		{tuple(decimal), "Swap", "expected 5 fields, found 1"},
+		{integer(sbor.KindU8, 1), "Swap", "expected Tuple, found U8"},
+	}
+	for _, test := range tests {
+		err := schema.Validate(test.value, LocalTypeId{Index: 0})
+		var validationError *ValidationError
+		if !errors.As(err, &validationError) || validationError.Path != test.path || validationError.Reason != test.reason {
+			t.Errorf("Validate = %v, want %q at %q", err, test.reason, test.path)
+		}
+	}
+
+	if err := schema.Validate(tuple(), LocalTypeId{Index: 9}); err == nil {
+		t.Error("Validate against a type out of the schema succeeded")
+	}
+	if err := schema.Validate(tuple(integer(sbor.KindU8, 1)), LocalTypeId{WellKnown: true, Index: WellKnownUnit}); err == nil {
+		t.Error("Validate of a non empty unit succeeded")
+	}
+}
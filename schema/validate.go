@@ -0,0 +1,189 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// ValidationError reports a value which does not match its type, Path
+// locating it by the names of the schema, e.g. "Swap.amounts[1]" for the
+// second element of the amounts field of a Swap, "Status::Active.since" for
+// a field of its Active variant, or "[0]" for the first field of an unnamed
+// tuple.
+type ValidationError struct {
+	Path   string
+	Reason string
+}
+
+func (err *ValidationError) Error() string {
+	if err.Path == "" {
+		return "schema: " + err.Reason
+	}
+	return fmt.Sprintf("schema: %s at %s", err.Reason, err.Path)
+}
+
+// Validate checks that a Scrypto SBOR value is of the type id refers to: the
+// kinds of its values, the fields of its tuples and the variants of its
+// enums. The bounds of the type validations, such as the lengths of
+// collections and the ranges of integers, are not checked, nor are the
+// well-known types without a value kind of their own.
+func (schema *Schema) Validate(value sbor.Value, id LocalTypeId) error {
+	var path string
+	if t, ok := schema.Type(id); ok {
+		path = t.Name
+	}
+	return schema.validate(value, id, path)
+}
+
+func (schema *Schema) validate(value sbor.Value, id LocalTypeId, path string) error {
+	if kind, ok := schema.kind(id); ok && value.Kind != kind {
+		return mismatch(value.Kind, kind, path)
+	}
+	if id.WellKnown {
+		switch id.Index {
+		case WellKnownBytes:
+			if value.ElementKind != sbor.KindU8 {
+				return mismatch(value.ElementKind, sbor.KindU8, path+"[]")
+			}
+		case WellKnownUnit:
+			if len(value.Elements) != 0 {
+				return &ValidationError{Path: path, Reason: fmt.Sprintf("expected a unit, found %d fields", len(value.Elements))}
+			}
+		}
+		return nil
+	}
+	t, ok := schema.Type(id)
+	if !ok {
+		return &ValidationError{Path: path, Reason: fmt.Sprintf("type %d out of the schema", id.Index)}
+	}
+
+	switch t.Kind {
+	case TypeKindArray:
+		if kind, ok := schema.kind(t.Element); ok && value.ElementKind != kind {
+			return mismatch(value.ElementKind, kind, path+"[]")
+		}
+		for index, element := range value.Elements {
+			if err := schema.validate(element, t.Element, fmt.Sprintf("%s[%d]", path, index)); err != nil {
+				return err
+			}
+		}
+	case TypeKindTuple:
+		return schema.validateFields(value.Elements, t.Fields, t.FieldNames, path)
+	case TypeKindEnum:
+		variant, ok := t.Variant(value.Discriminator)
+		if !ok {
+			return &ValidationError{Path: path, Reason: fmt.Sprintf("unknown variant %d", value.Discriminator)}
+		}
+		variantPath := fmt.Sprintf("%s::%d", path, variant.Discriminator)
+		if variant.Name != "" {
+			variantPath = path + "::" + variant.Name
+		}
+		return schema.validateFields(value.Elements, variant.Fields, variant.FieldNames, variantPath)
+	case TypeKindMap:
+		for _, entryKind := range []struct {
+			kind sbor.Kind
+			id   LocalTypeId
+			name string
+		}{{value.KeyKind, t.Key, "key"}, {value.ValueKind, t.Value, "value"}} {
+			if kind, ok := schema.kind(entryKind.id); ok && entryKind.kind != kind {
+				return mismatch(entryKind.kind, kind, fmt.Sprintf("%s{}.%s", path, entryKind.name))
+			}
+		}
+		for index, entry := range value.Entries {
+			entryPath := fmt.Sprintf("%s{%d}", path, index)
+			if err := schema.validate(entry.Key, t.Key, entryPath+".key"); err != nil {
+				return err
+			}
+			if err := schema.validate(entry.Value, t.Value, entryPath+".value"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (schema *Schema) validateFields(values []sbor.Value, fields []LocalTypeId, names []string, path string) error {
+	if len(values) != len(fields) {
+		return &ValidationError{Path: path, Reason: fmt.Sprintf("expected %d fields, found %d", len(fields), len(values))}
+	}
+	for index, value := range values {
+		fieldPath := fmt.Sprintf("%s[%d]", path, index)
+		if len(names) == len(fields) && names[index] != "" {
+			fieldPath = path + "." + names[index]
+			if path == "" {
+				fieldPath = names[index]
+			}
+		}
+		if err := schema.validate(value, fields[index], fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// kind returns the value kind of the values of the type id refers to, or
+// false if they may be of any kind.
+func (schema *Schema) kind(id LocalTypeId) (sbor.Kind, bool) {
+	if id.WellKnown {
+		switch {
+		case id.Index >= WellKnownBool && id.Index <= WellKnownString:
+			// The basic well-known types and value kinds are numbered alike.
+			return sbor.Kind(id.Index), true
+		case id.Index == WellKnownBytes:
+			return sbor.KindArray, true
+		case id.Index == WellKnownUnit:
+			return sbor.KindTuple, true
+		case id.Index >= WellKnownReference && id.Index < WellKnownOwn:
+			return sbor.KindScryptoReference, true
+		case id.Index >= WellKnownOwn && id.Index < WellKnownDecimal:
+			return sbor.KindScryptoOwn, true
+		case id.Index == WellKnownDecimal:
+			return sbor.KindScryptoDecimal, true
+		case id.Index == WellKnownPreciseDecimal:
+			return sbor.KindScryptoPreciseDecimal, true
+		case id.Index == WellKnownLocalId:
+			return sbor.KindScryptoNonFungibleLocalId, true
+		}
+		return 0, false
+	}
+	t, ok := schema.Type(id)
+	if !ok {
+		return 0, false
+	}
+	switch t.Kind {
+	case TypeKindAny:
+		return 0, false
+	case TypeKindArray:
+		return sbor.KindArray, true
+	case TypeKindTuple:
+		return sbor.KindTuple, true
+	case TypeKindEnum:
+		return sbor.KindEnum, true
+	case TypeKindMap:
+		return sbor.KindMap, true
+	case TypeKindCustom:
+		return customKinds[t.Custom], customKinds[t.Custom] != 0
+	}
+	// The basic type kinds and value kinds are numbered alike.
+	return sbor.Kind(t.Kind), true
+}
+
+var customKinds = map[CustomTypeKind]sbor.Kind{
+	CustomTypeKindReference:          sbor.KindScryptoReference,
+	CustomTypeKindOwn:                sbor.KindScryptoOwn,
+	CustomTypeKindDecimal:            sbor.KindScryptoDecimal,
+	CustomTypeKindPreciseDecimal:     sbor.KindScryptoPreciseDecimal,
+	CustomTypeKindNonFungibleLocalId: sbor.KindScryptoNonFungibleLocalId,
+}
+
+func mismatch(found, expected sbor.Kind, path string) error {
+	return &ValidationError{Path: path, Reason: fmt.Sprintf("expected %s, found %s", kindName(expected), kindName(found))}
+}
+
+func kindName(kind sbor.Kind) string {
+	if name, ok := sbor.Scrypto.KindName(kind); ok {
+		return name
+	}
+	return fmt.Sprintf("kind 0x%02x", byte(kind))
+}
@@ -0,0 +1,151 @@
+// Package transactionhash computes, encodes and decodes the hashes of Radix
+// transaction payloads in pure Go.
+//
+// It implements the subset of radix_engine_toolkit_uniffi.TransactionHash
+// and of the Hash methods of the transaction objects which does not need the
+// native library: hashing the prepared form of V1 and V2 intents, signed
+// intents, notarized transactions, subintents and partial transactions, and
+// the bech32m encoding of their hashes, e.g. "txid_rdx1…" or
+// "subtxid_tdx_2_1…". Its errors are the ones of the address package, which
+// the radix_engine_toolkit_uniffi package converts into their
+// RadixEngineToolkitError counterparts.
+package transactionhash
+
+import (
+	"fmt"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/internal/blake2b"
+)
+
+// Length is the length of a hash.
+const Length = blake2b.Size
+
+// Kind is what a hash identifies, which sets the hrp of its bech32m encoding.
+type Kind int
+
+const (
+	KindTransactionIntent Kind = iota
+	KindSignedTransactionIntent
+	KindSubintent
+	KindNotarizedTransaction
+)
+
+var kindHrpPrefixes = map[Kind]string{
+	KindTransactionIntent:       "txid",
+	KindSignedTransactionIntent: "signedintent",
+	KindSubintent:               "subtxid",
+	KindNotarizedTransaction:    "notarizedtransaction",
+}
+
+// HrpPrefix returns the network independent part of the hrp of the hashes of
+// the kind, e.g. "txid".
+func (kind Kind) HrpPrefix() string {
+	return kindHrpPrefixes[kind]
+}
+
+func (kind Kind) String() string {
+	switch kind {
+	case KindTransactionIntent:
+		return "TransactionIntent"
+	case KindSignedTransactionIntent:
+		return "SignedTransactionIntent"
+	case KindSubintent:
+		return "Subintent"
+	case KindNotarizedTransaction:
+		return "NotarizedTransaction"
+	}
+	return fmt.Sprintf("Kind(%d)", int(kind))
+}
+
+// Hrp returns the hrp of the hashes of a kind on a network, e.g. "txid_rdx"
+// or "subtxid_tdx_2_".
+func Hrp(kind Kind, networkId uint8) string {
+	return kind.HrpPrefix() + "_" + address.NetworkHrpSuffix(networkId)
+}
+
+// Hash is the hash of a transaction payload on a network.
+type Hash struct {
+	kind      Kind
+	hash      [Length]byte
+	networkId uint8
+}
+
+// New decodes a bech32m encoded hash, like
+// radix_engine_toolkit_uniffi.TransactionHashFromStr, its kind and network
+// being read from its hrp.
+func New(hash string) (Hash, error) {
+	networkId, ok := address.NetworkIdFromBech32(hash)
+	if !ok {
+		return Hash{}, &address.FailedToExtractNetworkError{Address: hash}
+	}
+	hrp, data, err := address.Bech32mDecode(hash)
+	if err != nil {
+		return Hash{}, err
+	}
+	for kind := range kindHrpPrefixes {
+		if hrp == Hrp(kind, networkId) {
+			return FromRaw(kind, data, networkId)
+		}
+	}
+	return Hash{}, &address.Bech32DecodeError{Error_: "InvalidHrp"}
+}
+
+// FromRaw returns the hash of a kind with the given bytes on a network.
+func FromRaw(kind Kind, hash []byte, networkId uint8) (Hash, error) {
+	if len(hash) != Length {
+		return Hash{}, &address.InvalidLengthError{
+			Expected: Length,
+			Actual:   uint64(len(hash)),
+			Data:     hash,
+		}
+	}
+	if _, ok := kindHrpPrefixes[kind]; !ok {
+		return Hash{}, fmt.Errorf("transactionhash: unknown hash kind %d", int(kind))
+	}
+	decoded := Hash{kind: kind, networkId: networkId}
+	copy(decoded.hash[:], hash)
+	return decoded, nil
+}
+
+// Kind returns what the hash identifies.
+func (hash Hash) Kind() Kind {
+	return hash.kind
+}
+
+// Bytes returns the bytes of the hash.
+func (hash Hash) Bytes() []byte {
+	return hash.hash[:]
+}
+
+// NetworkId returns the id of the network of the hash.
+func (hash Hash) NetworkId() uint8 {
+	return hash.networkId
+}
+
+// AsStr returns the bech32m encoding of the hash, like
+// radix_engine_toolkit_uniffi.TransactionHash.AsStr.
+func (hash Hash) AsStr() string {
+	encoded, err := address.Bech32mEncode(Hrp(hash.kind, hash.networkId), hash.hash[:])
+	if err != nil {
+		panic(err)
+	}
+	return encoded
+}
+
+func (hash Hash) String() string {
+	return hash.AsStr()
+}
+
+func (hash Hash) MarshalText() ([]byte, error) {
+	return []byte(hash.AsStr()), nil
+}
+
+func (hash *Hash) UnmarshalText(text []byte) error {
+	decoded, err := New(string(text))
+	if err != nil {
+		return err
+	}
+	*hash = decoded
+	return nil
+}
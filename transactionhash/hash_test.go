@@ -0,0 +1,64 @@
+package transactionhash
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/address"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		hash      string
+		kind      Kind
+		networkId uint8
+	}{
+		{intentV1Hash, KindTransactionIntent, 1},
+		{signedIntentV1Hash, KindSignedTransactionIntent, 1},
+		{notarizedTransactionHash, KindNotarizedTransaction, 1},
+		{subintentV2Hash, KindSubintent, 2},
+	}
+	for _, test := range tests {
+		hash, err := New(test.hash)
+		if err != nil {
+			t.Errorf("New(%s): %v", test.hash, err)
+			continue
+		}
+		if hash.Kind() != test.kind || hash.NetworkId() != test.networkId || hash.AsStr() != test.hash {
+			t.Errorf("New(%s) = %s %s on network %d", test.hash, hash.Kind(), hash.AsStr(), hash.NetworkId())
+		}
+		raw, err := FromRaw(hash.Kind(), hash.Bytes(), hash.NetworkId())
+		if err != nil || raw != hash {
+			t.Errorf("FromRaw(%x) = %v, %v", hash.Bytes(), raw, err)
+		}
+
+		var unmarshaled Hash
+		text, _ := hash.MarshalText()
+		if err := unmarshaled.UnmarshalText(text); err != nil || unmarshaled != hash {
+			t.Errorf("UnmarshalText(%s) = %v, %v", text, unmarshaled, err)
+		}
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	var network *address.FailedToExtractNetworkError
+	if _, err := New("txid_nowhere1xh4a7f2578fe32qsc083dqv009hfv48nstvga5jtwfanv2267e2qjfpv9h"); !errors.As(err, &network) {
+		t.Errorf("New of an unknown network = %v", err)
+	}
+	var decode *address.Bech32DecodeError
+	if _, err := New("account_rdx12xsvygvltz4uhsht6tdrfxktzpmnl77r0d40j8agmujgdj022sudkk"); !errors.As(err, &decode) || decode.Error_ != "InvalidHrp" {
+		t.Errorf("New of an address = %v, want InvalidHrp", err)
+	}
+	if _, err := New(intentV1Hash[:len(intentV1Hash)-1] + "q"); err == nil {
+		t.Error("New of a hash with a bad checksum succeeded")
+	}
+
+	var length *address.InvalidLengthError
+	if _, err := FromRaw(KindSubintent, bytes.Repeat([]byte{1}, 31), 1); !errors.As(err, &length) || length.Expected != Length {
+		t.Errorf("FromRaw of 31 bytes = %v", err)
+	}
+	if _, err := FromRaw(Kind(7), make([]byte, Length), 1); err == nil {
+		t.Error("FromRaw of an unknown kind succeeded")
+	}
+}
@@ -0,0 +1,398 @@
+package transactionhash
+
+import (
+	"fmt"
+
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/internal/blake2b"
+	"github.com/radixdlt/radix-engine-toolkit-go/v2/sbor"
+)
+
+// The hash of a payload is the one of its prepared form, as in the engine:
+// each part of the payload is hashed on its own, and the hash of the payload
+// is the hash of the concatenation of the hashes of its parts, after the
+// hashable payload prefix and the discriminator of the payload:
+//
+//   - headers, messages and signatures are hashed as encoded, value kind
+//     included;
+//   - instructions are hashed as encoded, without their value kind;
+//   - blobs are the hash of the concatenation of the hashes of their
+//     contents, and child subintents of the concatenation of their hashes;
+//   - an intent core is the hash of the concatenation of the hashes of its
+//     parts, without prefix, and non root subintents the hash of the
+//     concatenation of the hashes of the subintents.
+//
+// A payload nested in another one, e.g. the intent of a signed intent, is
+// hashed like the payload on its own.
+
+// hashablePayloadPrefix starts the hashed data of every payload.
+const hashablePayloadPrefix = 0x54
+
+// PayloadKind is the kind of a transaction payload, the discriminator of its
+// outer enum. The values are those of the TransactionDiscriminator of the
+// engine, where 4 to 8 are the system, round update, preview, ledger and
+// flash V1 transactions and 15 the V2 preview transaction.
+type PayloadKind uint8
+
+const (
+	PayloadIntentV1                   PayloadKind = 1
+	PayloadSignedIntentV1             PayloadKind = 2
+	PayloadNotarizedTransactionV1     PayloadKind = 3
+	PayloadTransactionIntentV2        PayloadKind = 9
+	PayloadSignedTransactionIntentV2  PayloadKind = 10
+	PayloadSubintentV2                PayloadKind = 11
+	PayloadNotarizedTransactionV2     PayloadKind = 12
+	PayloadPartialTransactionV2       PayloadKind = 13
+	PayloadSignedPartialTransactionV2 PayloadKind = 14
+)
+
+func (kind PayloadKind) String() string {
+	switch kind {
+	case PayloadIntentV1:
+		return "IntentV1"
+	case PayloadSignedIntentV1:
+		return "SignedIntentV1"
+	case PayloadNotarizedTransactionV1:
+		return "NotarizedTransactionV1"
+	case PayloadTransactionIntentV2:
+		return "TransactionIntentV2"
+	case PayloadSignedTransactionIntentV2:
+		return "SignedTransactionIntentV2"
+	case PayloadSubintentV2:
+		return "SubintentV2"
+	case PayloadNotarizedTransactionV2:
+		return "NotarizedTransactionV2"
+	case PayloadPartialTransactionV2:
+		return "PartialTransactionV2"
+	case PayloadSignedPartialTransactionV2:
+		return "SignedPartialTransactionV2"
+	}
+	return fmt.Sprintf("PayloadKind(%d)", uint8(kind))
+}
+
+// InvalidPayloadError reports a payload which is not a transaction payload
+// of a known kind.
+type InvalidPayloadError struct {
+	Reason string
+}
+
+func (err InvalidPayloadError) Error() string {
+	return "transactionhash: invalid payload: " + err.Reason
+}
+
+func invalidPayload(format string, args ...any) error {
+	return &InvalidPayloadError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// Hashes are the hashes of a transaction payload and of the payloads it
+// encloses. Which hashes are set depends on the kind of the payload:
+//
+//   - TransactionIntent for intents and the transactions enclosing them;
+//   - SignedTransactionIntent for signed intents and notarized transactions;
+//   - NotarizedTransaction for notarized transactions;
+//   - RootSubintent for subintents and partial transactions;
+//   - NonRootSubintents for V2 transaction intents and partial transactions,
+//     and the transactions enclosing them.
+type Hashes struct {
+	Payload PayloadKind
+	// NetworkId is the network of the root intent of the payload.
+	NetworkId uint8
+
+	TransactionIntent       *Hash
+	SignedTransactionIntent *Hash
+	NotarizedTransaction    *Hash
+	RootSubintent           *Hash
+	NonRootSubintents       []Hash
+}
+
+// Hash returns the hash of the payload itself, the one returned by the Hash
+// method of its native object: the notarized transaction hash of a notarized
+// transaction, the signed intent hash of a signed intent, and the root
+// subintent hash of a partial transaction.
+func (hashes Hashes) Hash() Hash {
+	for _, hash := range []*Hash{hashes.NotarizedTransaction, hashes.SignedTransactionIntent, hashes.TransactionIntent, hashes.RootSubintent} {
+		if hash != nil {
+			return *hash
+		}
+	}
+	return Hash{}
+}
+
+// Compute returns the hashes of a transaction payload, as returned by the
+// ToPayloadBytes methods of the transaction objects.
+//
+// The payload is decoded only as far as hashing needs it: a payload which
+// the native library would reject when validating it may still be hashed.
+func Compute(payload []byte) (Hashes, error) {
+	value, err := sbor.Decode(payload, sbor.Manifest)
+	if err != nil {
+		return Hashes{}, err
+	}
+	if value.Kind != sbor.KindEnum {
+		return Hashes{}, invalidPayload("expected the enum of the transaction payloads, found kind 0x%02x", byte(value.Kind))
+	}
+	hashes := Hashes{Payload: PayloadKind(value.Discriminator)}
+	if _, err := hashes.payload(hashes.Payload, value.Elements); err != nil {
+		return Hashes{}, err
+	}
+	return hashes, nil
+}
+
+type digest = [Length]byte
+
+// payload records the hashes of a payload of the kind with the given fields,
+// and returns its digest.
+func (hashes *Hashes) payload(kind PayloadKind, fields []sbor.Value) (digest, error) {
+	lengths := map[PayloadKind]int{
+		PayloadIntentV1:                   4,
+		PayloadSignedIntentV1:             2,
+		PayloadNotarizedTransactionV1:     2,
+		PayloadTransactionIntentV2:        3,
+		PayloadSignedTransactionIntentV2:  3,
+		PayloadSubintentV2:                1,
+		PayloadNotarizedTransactionV2:     2,
+		PayloadPartialTransactionV2:       2,
+		PayloadSignedPartialTransactionV2: 3,
+	}
+	length, ok := lengths[kind]
+	if !ok {
+		return digest{}, invalidPayload("unknown payload discriminator %d", uint8(kind))
+	}
+	if len(fields) != length {
+		return digest{}, invalidPayload("expected %d fields in a %s, found %d", length, kind, len(fields))
+	}
+
+	switch kind {
+	case PayloadIntentV1:
+		networkId, err := headerNetworkId(fields[0])
+		if err != nil {
+			return digest{}, err
+		}
+		instructions, err := instructionsDigest(fields[1])
+		if err != nil {
+			return digest{}, err
+		}
+		blobs, err := blobsDigest(fields[2])
+		if err != nil {
+			return digest{}, err
+		}
+		intent := payloadDigest(kind, valueDigest(fields[0]), instructions, blobs, valueDigest(fields[3]))
+		hashes.NetworkId = networkId
+		hashes.TransactionIntent = &Hash{kind: KindTransactionIntent, hash: intent, networkId: networkId}
+		return intent, nil
+	case PayloadSignedIntentV1, PayloadSignedTransactionIntentV2:
+		enclosed := PayloadIntentV1
+		if kind == PayloadSignedTransactionIntentV2 {
+			enclosed = PayloadTransactionIntentV2
+		}
+		intent, err := hashes.enclosed(enclosed, fields[0])
+		if err != nil {
+			return digest{}, err
+		}
+		signed := payloadDigest(kind, append([]digest{intent}, valueDigests(fields[1:])...)...)
+		hashes.SignedTransactionIntent = &Hash{kind: KindSignedTransactionIntent, hash: signed, networkId: hashes.NetworkId}
+		return signed, nil
+	case PayloadNotarizedTransactionV1, PayloadNotarizedTransactionV2:
+		enclosed := PayloadSignedIntentV1
+		if kind == PayloadNotarizedTransactionV2 {
+			enclosed = PayloadSignedTransactionIntentV2
+		}
+		signed, err := hashes.enclosed(enclosed, fields[0])
+		if err != nil {
+			return digest{}, err
+		}
+		notarized := payloadDigest(kind, signed, valueDigest(fields[1]))
+		hashes.NotarizedTransaction = &Hash{kind: KindNotarizedTransaction, hash: notarized, networkId: hashes.NetworkId}
+		return notarized, nil
+	case PayloadTransactionIntentV2:
+		core, networkId, err := intentCoreDigest(fields[1])
+		if err != nil {
+			return digest{}, err
+		}
+		nonRoot, err := hashes.nonRootSubintents(fields[2])
+		if err != nil {
+			return digest{}, err
+		}
+		intent := payloadDigest(kind, valueDigest(fields[0]), core, nonRoot)
+		hashes.NetworkId = networkId
+		hashes.TransactionIntent = &Hash{kind: KindTransactionIntent, hash: intent, networkId: networkId}
+		return intent, nil
+	case PayloadSubintentV2:
+		subintent, err := subintentHash(fields)
+		if err != nil {
+			return digest{}, err
+		}
+		hashes.NetworkId = subintent.networkId
+		hashes.RootSubintent = &subintent
+		return subintent.hash, nil
+	case PayloadPartialTransactionV2:
+		root, err := hashes.enclosed(PayloadSubintentV2, fields[0])
+		if err != nil {
+			return digest{}, err
+		}
+		nonRoot, err := hashes.nonRootSubintents(fields[1])
+		if err != nil {
+			return digest{}, err
+		}
+		return payloadDigest(kind, root, nonRoot), nil
+	default:
+		partial, err := hashes.enclosed(PayloadPartialTransactionV2, fields[0])
+		if err != nil {
+			return digest{}, err
+		}
+		return payloadDigest(kind, append([]digest{partial}, valueDigests(fields[1:])...)...), nil
+	}
+}
+
+// enclosed records the hashes of a payload of the kind enclosed in another
+// one, where it is encoded as the tuple of its fields, and returns its
+// digest.
+func (hashes *Hashes) enclosed(kind PayloadKind, value sbor.Value) (digest, error) {
+	if value.Kind != sbor.KindTuple {
+		return digest{}, invalidPayload("expected a %s tuple, found kind 0x%02x", kind, byte(value.Kind))
+	}
+	return hashes.payload(kind, value.Elements)
+}
+
+// nonRootSubintents records the hashes of the non root subintents of a
+// payload and returns their digest.
+func (hashes *Hashes) nonRootSubintents(value sbor.Value) (digest, error) {
+	subintents := value.Unwrap()
+	if subintents.Kind != sbor.KindArray || subintents.ElementKind != sbor.KindTuple {
+		return digest{}, invalidPayload("expected an array of subintents")
+	}
+	digests := make([]digest, len(subintents.Elements))
+	for index, subintent := range subintents.Elements {
+		hash, err := subintentHash(subintent.Elements)
+		if err != nil {
+			return digest{}, err
+		}
+		hashes.NonRootSubintents = append(hashes.NonRootSubintents, hash)
+		digests[index] = hash.hash
+	}
+	return concatenatedDigest(digests...), nil
+}
+
+// subintentHash returns the hash of a subintent with the given fields.
+func subintentHash(fields []sbor.Value) (Hash, error) {
+	if len(fields) != 1 {
+		return Hash{}, invalidPayload("expected 1 field in a %s, found %d", PayloadSubintentV2, len(fields))
+	}
+	core, networkId, err := intentCoreDigest(fields[0])
+	if err != nil {
+		return Hash{}, err
+	}
+	return Hash{kind: KindSubintent, hash: payloadDigest(PayloadSubintentV2, core), networkId: networkId}, nil
+}
+
+// intentCoreDigest returns the digest of IntentCoreV2 { header, blobs,
+// message, children, instructions } and the network of its header.
+func intentCoreDigest(value sbor.Value) (digest, uint8, error) {
+	if value.Kind != sbor.KindTuple || len(value.Elements) != 5 {
+		return digest{}, 0, invalidPayload("expected an intent core of 5 fields")
+	}
+	networkId, err := headerNetworkId(value.Elements[0])
+	if err != nil {
+		return digest{}, 0, err
+	}
+	blobs, err := blobsDigest(value.Elements[1])
+	if err != nil {
+		return digest{}, 0, err
+	}
+	children, err := childrenDigest(value.Elements[3])
+	if err != nil {
+		return digest{}, 0, err
+	}
+	instructions, err := instructionsDigest(value.Elements[4])
+	if err != nil {
+		return digest{}, 0, err
+	}
+	return concatenatedDigest(valueDigest(value.Elements[0]), blobs, valueDigest(value.Elements[2]), children, instructions), networkId, nil
+}
+
+// headerNetworkId returns the network of a V1 transaction header or a V2
+// intent header, their first field.
+func headerNetworkId(header sbor.Value) (uint8, error) {
+	if header.Kind != sbor.KindTuple || len(header.Elements) == 0 {
+		return 0, invalidPayload("expected a header tuple")
+	}
+	networkId := header.Elements[0].Unwrap()
+	if networkId.Kind != sbor.KindU8 {
+		return 0, invalidPayload("expected the network id of a header")
+	}
+	return uint8(networkId.Int.Uint64()), nil
+}
+
+func instructionsDigest(value sbor.Value) (digest, error) {
+	instructions := value.Unwrap()
+	if instructions.Kind != sbor.KindArray {
+		return digest{}, invalidPayload("expected an array of instructions")
+	}
+	return blake2b.Sum256(instructions.Body), nil
+}
+
+func blobsDigest(value sbor.Value) (digest, error) {
+	blobs := value.Unwrap()
+	if blobs.Kind != sbor.KindArray {
+		return digest{}, invalidPayload("expected an array of blobs")
+	}
+	digests := make([]digest, len(blobs.Elements))
+	for index, blob := range blobs.Elements {
+		content, ok := blob.Unwrap().Bytes()
+		if !ok {
+			return digest{}, invalidPayload("blob %d is not an array of bytes", index)
+		}
+		digests[index] = blake2b.Sum256(content)
+	}
+	return concatenatedDigest(digests...), nil
+}
+
+func childrenDigest(value sbor.Value) (digest, error) {
+	children := value.Unwrap()
+	if children.Kind != sbor.KindArray {
+		return digest{}, invalidPayload("expected an array of child subintents")
+	}
+	digests := make([]digest, len(children.Elements))
+	for index, child := range children.Elements {
+		hash, ok := child.Unwrap().Bytes()
+		if !ok || len(hash) != Length {
+			return digest{}, invalidPayload("child subintent %d is not a hash", index)
+		}
+		digests[index] = digest(hash)
+	}
+	return concatenatedDigest(digests...), nil
+}
+
+// valueDigest returns the digest of the encoding of a value, value kind
+// included.
+func valueDigest(value sbor.Value) digest {
+	hasher := blake2b.New()
+	hasher.Write([]byte{byte(value.Kind)})
+	hasher.Write(value.Body)
+	return hasher.Sum()
+}
+
+func valueDigests(values []sbor.Value) []digest {
+	digests := make([]digest, len(values))
+	for index, value := range values {
+		digests[index] = valueDigest(value)
+	}
+	return digests
+}
+
+// payloadDigest returns the digest of a payload of the kind made of parts.
+func payloadDigest(kind PayloadKind, parts ...digest) digest {
+	hasher := blake2b.New()
+	hasher.Write([]byte{hashablePayloadPrefix, byte(kind)})
+	for _, part := range parts {
+		hasher.Write(part[:])
+	}
+	return hasher.Sum()
+}
+
+func concatenatedDigest(parts ...digest) digest {
+	hasher := blake2b.New()
+	for _, part := range parts {
+		hasher.Write(part[:])
+	}
+	return hasher.Sum()
+}
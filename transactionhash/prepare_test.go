@@ -0,0 +1,135 @@
+package transactionhash
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// The payloads are small hand encoded transactions, whose hashes were
+// computed apart from this package following the prepared hashing of the
+// engine.
+//
+// These vectors are self-derived: no engine produced them, so they catch
+// regressions but not a misreading of the engine shared by both
+// computations, such as a wrong V2 discriminator. They are to be joined by
+// vectors of the Rust toolkit or of committed ledger transactions, which were
+// not at hand when they were written.
+const (
+	intentV1Payload = "4d220104210707010a0a000000000000000a14000000000000000905000000220101200720111111111111111111111111111111111111111111111111111111111111111101000800002022015000210120210101200702aabb220000"
+
+	signedIntentV1Payload = "4d2202022104210707010a0a000000000000000a14000000000000000905000000220101200720111111111111111111111111111111111111111111111111111111111111111101000800002022015000210120210101200702aabb2200002101202100"
+
+	notarizedTransactionV1Payload = "4d22030221022104210707010a0a000000000000000a14000000000000000905000000220101200720111111111111111111111111111111111111111111111111111111111111111101000800002022015000210120210101200702aabb220000210120210022010120074022222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222"
+
+	subintentV2Payload = "4d220b012105210607020a0a000000000000000a14000000000000002200002200000a0700000000000000210120210022000021012021010120072033333333333333333333333333333333333333333333333333333333333333332022014100"
+
+	transactionIntentV2Payload = "4d22090321032201012007201111111111111111111111111111111111111111111111111111111111111111010109000000002105210607020a0a000000000000000a14000000000000002200002200000a0700000000000000210120210022000021012021010120072033333333333333333333333333333333333333333333333333333333333333332022014100202101012105210607020a0a000000000000000a14000000000000002200002200000a0700000000000000210120210022000021012021010120072033333333333333333333333333333333333333333333333333333333333333332022014100"
+)
+
+const (
+	intentV1Hash             = "txid_rdx1xh4a7f2578fe32qsc083dqv009hfv48nstvga5jtwfanv2267e2qjfpv9h"
+	signedIntentV1Hash       = "signedintent_rdx1ad0vr9jj864ex8xf592ufqmsrndwsa93dzdt3lnu7q2mhatwzjzqxs78s8"
+	notarizedTransactionHash = "notarizedtransaction_rdx1wu5p7qv70y5v83n5rttqyw5md7c0e82t2j6lapch547kee9ha5zsrxn97x"
+	subintentV2Hash          = "subtxid_tdx_2_1y7xvdyem9r6lmrn9lvqaxqvnz0kcy720zza3e8fjtaeze4nzcxwqzzqet5"
+	transactionIntentV2Hash  = "txid_tdx_2_1ejsz6l3dvwv82n7tn38qsh0klpwa62wkz3gc0ce2m9j9jchmvatsstpejw"
+)
+
+func hashString(hash *Hash) string {
+	if hash == nil {
+		return ""
+	}
+	return hash.AsStr()
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		payload                 string
+		kind                    PayloadKind
+		networkId               uint8
+		transactionIntent       string
+		signedTransactionIntent string
+		notarizedTransaction    string
+		rootSubintent           string
+		nonRootSubintents       []string
+	}{
+		{intentV1Payload, PayloadIntentV1, 1, intentV1Hash, "", "", "", nil},
+		{signedIntentV1Payload, PayloadSignedIntentV1, 1, intentV1Hash, signedIntentV1Hash, "", "", nil},
+		{notarizedTransactionV1Payload, PayloadNotarizedTransactionV1, 1, intentV1Hash, signedIntentV1Hash, notarizedTransactionHash, "", nil},
+		{subintentV2Payload, PayloadSubintentV2, 2, "", "", "", subintentV2Hash, nil},
+		{transactionIntentV2Payload, PayloadTransactionIntentV2, 2, transactionIntentV2Hash, "", "", "", []string{subintentV2Hash}},
+	}
+	for _, test := range tests {
+		payload, _ := hex.DecodeString(test.payload)
+		hashes, err := Compute(payload)
+		if err != nil {
+			t.Errorf("Compute(%s): %v", test.kind, err)
+			continue
+		}
+		if hashes.Payload != test.kind || hashes.NetworkId != test.networkId {
+			t.Errorf("Compute(%s) = %s on network %d", test.kind, hashes.Payload, hashes.NetworkId)
+		}
+		for _, hash := range []struct {
+			name          string
+			got, expected string
+		}{
+			{"TransactionIntent", hashString(hashes.TransactionIntent), test.transactionIntent},
+			{"SignedTransactionIntent", hashString(hashes.SignedTransactionIntent), test.signedTransactionIntent},
+			{"NotarizedTransaction", hashString(hashes.NotarizedTransaction), test.notarizedTransaction},
+			{"RootSubintent", hashString(hashes.RootSubintent), test.rootSubintent},
+		} {
+			if hash.got != hash.expected {
+				t.Errorf("Compute(%s).%s = %q, want %q", test.kind, hash.name, hash.got, hash.expected)
+			}
+		}
+		if len(hashes.NonRootSubintents) != len(test.nonRootSubintents) {
+			t.Errorf("Compute(%s) has %d non root subintents, want %d", test.kind, len(hashes.NonRootSubintents), len(test.nonRootSubintents))
+			continue
+		}
+		for index, hash := range hashes.NonRootSubintents {
+			if hash.AsStr() != test.nonRootSubintents[index] {
+				t.Errorf("Compute(%s).NonRootSubintents[%d] = %s, want %s", test.kind, index, hash.AsStr(), test.nonRootSubintents[index])
+			}
+		}
+	}
+}
+
+func TestPayloadKind(t *testing.T) {
+	// The TransactionDiscriminator of the engine.
+	kinds := map[PayloadKind]uint8{
+		PayloadIntentV1:                   1,
+		PayloadSignedIntentV1:             2,
+		PayloadNotarizedTransactionV1:     3,
+		PayloadTransactionIntentV2:        9,
+		PayloadSignedTransactionIntentV2:  10,
+		PayloadSubintentV2:                11,
+		PayloadNotarizedTransactionV2:     12,
+		PayloadPartialTransactionV2:       13,
+		PayloadSignedPartialTransactionV2: 14,
+	}
+	for kind, discriminator := range kinds {
+		if uint8(kind) != discriminator {
+			t.Errorf("%s = %d, want %d", kind, uint8(kind), discriminator)
+		}
+	}
+}
+
+func TestComputeInvalid(t *testing.T) {
+	for _, payload := range []string{
+		"4d2100",               // a tuple
+		"4d2204010700",         // a V1 system transaction
+		"4d220f010700",         // a V2 preview transaction
+		"4d2201010700",         // an intent of one field
+		"4d220b01210207000700", // a subintent whose core is no intent core
+	} {
+		data, _ := hex.DecodeString(payload)
+		_, err := Compute(data)
+		var invalid *InvalidPayloadError
+		if !errors.As(err, &invalid) {
+			t.Errorf("Compute(%s) = %v, want an InvalidPayloadError", payload, err)
+		}
+	}
+	if _, err := Compute([]byte{0x5c, 0x22, 0x01, 0x00}); err == nil {
+		t.Error("Compute of a Scrypto payload succeeded")
+	}
+}